@@ -0,0 +1,94 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/DaanV2/f1-game-dashboards/server/authenication"
+	"github.com/DaanV2/f1-game-dashboards/server/jwt"
+	"github.com/DaanV2/f1-game-dashboards/server/users"
+	"github.com/charmbracelet/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type (
+	AuthenicationKey   struct{}
+	AuthenicationValue struct {
+		Token *jwt.Token
+		User  *users.User
+		Error error
+	}
+)
+
+// authenicate verifies the JWT token if one is given, and stores the result in the context.
+// Shared between the grpc and http server, so both authenicate the same way
+func authenicate(ctx context.Context, authenicator *authenication.Authenticator, method, token string) context.Context {
+	token = strings.TrimPrefix(token, "Bearer ")
+	logger := log.FromContext(ctx).With("method", method)
+	authV := AuthenicationValue{
+		Token: nil,
+		User:  nil,
+		Error: nil,
+	}
+
+	if token != "" {
+		t, u, err := authenicator.Verify(ctx, token)
+		authV = AuthenicationValue{
+			Token: t,
+			User:  u,
+			Error: err,
+		}
+		if err != nil {
+			authV.Error = status.Error(codes.Unauthenticated, err.Error())
+		}
+		if u != nil {
+			logger = logger.With(
+				"user", u.Email,
+				"admin", u.Admin,
+				"guest", u.Guest,
+			)
+		}
+		if t != nil {
+			logger = logger.With("valid", t.Valid)
+		}
+	}
+
+	ctx = log.WithContext(ctx, logger)
+	return context.WithValue(ctx, AuthenicationKey{}, authV)
+}
+
+func getAuth(ctx context.Context) AuthenicationValue {
+	v := ctx.Value(AuthenicationKey{})
+	if v == nil {
+		return AuthenicationValue{
+			Error: errors.New("no authenication value found"),
+		}
+	}
+	return v.(AuthenicationValue)
+}
+
+// mustBeAdmin returns the user if the user is an admin, otherwise an error is returned.
+func mustBeAdmin(ctx context.Context) (*users.User, error) {
+	auth := getAuth(ctx)
+	if auth.Error != nil {
+		return auth.User, auth.Error
+	}
+	if auth.User == nil {
+		return nil, status.Error(codes.Unauthenticated, "user is not authenicated")
+	}
+	if auth.User.Guest || !auth.User.Admin {
+		return auth.User, status.Error(codes.PermissionDenied, "user is not an admin")
+	}
+	return auth.User, nil
+}
+
+// atleastGuest returns the user if the user is authenicated atleast as a guest, otherwise an error is returned.
+func atleastGuest(ctx context.Context) (*users.User, error) {
+	auth := getAuth(ctx)
+	if auth.Error != nil {
+		return auth.User, auth.Error
+	}
+	return auth.User, nil
+}
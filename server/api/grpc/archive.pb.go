@@ -0,0 +1,983 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v4.24.1
+// source: archive.proto
+
+package grpc_gen
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ListArchivedSessionsRequest is a request to list the archived sessions
+type ListArchivedSessionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Port string `protobuf:"bytes,1,opt,name=port,proto3" json:"port,omitempty"` // the upd port of the chair, empty for all chairs
+}
+
+func (x *ListArchivedSessionsRequest) Reset() {
+	*x = ListArchivedSessionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_archive_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListArchivedSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListArchivedSessionsRequest) ProtoMessage() {}
+
+func (x *ListArchivedSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_archive_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListArchivedSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListArchivedSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_archive_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListArchivedSessionsRequest) GetPort() string {
+	if x != nil {
+		return x.Port
+	}
+	return ""
+}
+
+// ListArchivedSessionsResponse is a response to a ListArchivedSessionsRequest
+type ListArchivedSessionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sessions []*ArchivedSession `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+}
+
+func (x *ListArchivedSessionsResponse) Reset() {
+	*x = ListArchivedSessionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_archive_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListArchivedSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListArchivedSessionsResponse) ProtoMessage() {}
+
+func (x *ListArchivedSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_archive_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListArchivedSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListArchivedSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_archive_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListArchivedSessionsResponse) GetSessions() []*ArchivedSession {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+// QueryTelemetryRequest is a request to read channels of an archived session
+type QueryTelemetryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId    string   `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`           // same as the id of the session in the lap history
+	Channels     []string `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty"`                              // e.g. speed, throttle, brake. Empty for all channels
+	ResolutionMs uint32   `protobuf:"varint,3,opt,name=resolution_ms,json=resolutionMs,proto3" json:"resolution_ms,omitempty"` // the interval the samples may be at most apart, 0 for the finest available
+	Lap          uint32   `protobuf:"varint,4,opt,name=lap,proto3" json:"lap,omitempty"`                                       // only the samples of the lap, 0 for all laps
+	From         int64    `protobuf:"varint,5,opt,name=from,proto3" json:"from,omitempty"`                                     // unix seconds, inclusive
+	To           int64    `protobuf:"varint,6,opt,name=to,proto3" json:"to,omitempty"`                                         // unix seconds, inclusive
+}
+
+func (x *QueryTelemetryRequest) Reset() {
+	*x = QueryTelemetryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_archive_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryTelemetryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTelemetryRequest) ProtoMessage() {}
+
+func (x *QueryTelemetryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_archive_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryTelemetryRequest.ProtoReflect.Descriptor instead.
+func (*QueryTelemetryRequest) Descriptor() ([]byte, []int) {
+	return file_archive_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QueryTelemetryRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *QueryTelemetryRequest) GetChannels() []string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *QueryTelemetryRequest) GetResolutionMs() uint32 {
+	if x != nil {
+		return x.ResolutionMs
+	}
+	return 0
+}
+
+func (x *QueryTelemetryRequest) GetLap() uint32 {
+	if x != nil {
+		return x.Lap
+	}
+	return 0
+}
+
+func (x *QueryTelemetryRequest) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *QueryTelemetryRequest) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+// QueryTelemetryResponse is a response to a QueryTelemetryRequest
+type QueryTelemetryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId string           `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Tier      string           `protobuf:"bytes,2,opt,name=tier,proto3" json:"tier,omitempty"`           // the resolution that was read: raw, 100ms, 1s, ...
+	Times     []int64          `protobuf:"varint,3,rep,packed,name=times,proto3" json:"times,omitempty"` // unix milliseconds of every sample
+	Series    []*ChannelSeries `protobuf:"bytes,4,rep,name=series,proto3" json:"series,omitempty"`
+}
+
+func (x *QueryTelemetryResponse) Reset() {
+	*x = QueryTelemetryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_archive_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryTelemetryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTelemetryResponse) ProtoMessage() {}
+
+func (x *QueryTelemetryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_archive_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryTelemetryResponse.ProtoReflect.Descriptor instead.
+func (*QueryTelemetryResponse) Descriptor() ([]byte, []int) {
+	return file_archive_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QueryTelemetryResponse) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *QueryTelemetryResponse) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+func (x *QueryTelemetryResponse) GetTimes() []int64 {
+	if x != nil {
+		return x.Times
+	}
+	return nil
+}
+
+func (x *QueryTelemetryResponse) GetSeries() []*ChannelSeries {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+// CompareLapsRequest is a request to compare a lap with a reference lap, such as the track record
+type CompareLapsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reference *LapReference `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
+	Lap       *LapReference `protobuf:"bytes,2,opt,name=lap,proto3" json:"lap,omitempty"`
+	Channels  []string      `protobuf:"bytes,3,rep,name=channels,proto3" json:"channels,omitempty"` // e.g. speed, throttle, brake. Empty for all channels
+	Step      float32       `protobuf:"fixed32,4,opt,name=step,proto3" json:"step,omitempty"`       // meters between the points of the comparison, at least 0.5, 0 for 5 meters
+}
+
+func (x *CompareLapsRequest) Reset() {
+	*x = CompareLapsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_archive_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompareLapsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareLapsRequest) ProtoMessage() {}
+
+func (x *CompareLapsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_archive_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareLapsRequest.ProtoReflect.Descriptor instead.
+func (*CompareLapsRequest) Descriptor() ([]byte, []int) {
+	return file_archive_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CompareLapsRequest) GetReference() *LapReference {
+	if x != nil {
+		return x.Reference
+	}
+	return nil
+}
+
+func (x *CompareLapsRequest) GetLap() *LapReference {
+	if x != nil {
+		return x.Lap
+	}
+	return nil
+}
+
+func (x *CompareLapsRequest) GetChannels() []string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *CompareLapsRequest) GetStep() float32 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
+// CompareLapsResponse is a response to a CompareLapsRequest
+type CompareLapsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Distances []float32 `protobuf:"fixed32,1,rep,packed,name=distances,proto3" json:"distances,omitempty"` // meters into the lap
+	Reference *LapTrace `protobuf:"bytes,2,opt,name=reference,proto3" json:"reference,omitempty"`
+	Lap       *LapTrace `protobuf:"bytes,3,opt,name=lap,proto3" json:"lap,omitempty"`
+	Delta     []float32 `protobuf:"fixed32,4,rep,packed,name=delta,proto3" json:"delta,omitempty"` // seconds the lap is behind the reference at every distance, negative when it is ahead
+}
+
+func (x *CompareLapsResponse) Reset() {
+	*x = CompareLapsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_archive_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompareLapsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareLapsResponse) ProtoMessage() {}
+
+func (x *CompareLapsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_archive_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareLapsResponse.ProtoReflect.Descriptor instead.
+func (*CompareLapsResponse) Descriptor() ([]byte, []int) {
+	return file_archive_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CompareLapsResponse) GetDistances() []float32 {
+	if x != nil {
+		return x.Distances
+	}
+	return nil
+}
+
+func (x *CompareLapsResponse) GetReference() *LapTrace {
+	if x != nil {
+		return x.Reference
+	}
+	return nil
+}
+
+func (x *CompareLapsResponse) GetLap() *LapTrace {
+	if x != nil {
+		return x.Lap
+	}
+	return nil
+}
+
+func (x *CompareLapsResponse) GetDelta() []float32 {
+	if x != nil {
+		return x.Delta
+	}
+	return nil
+}
+
+// LapReference points to a lap of an archived session
+type LapReference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // same as the id of the session in the lap history
+	Lap       uint32 `protobuf:"varint,2,opt,name=lap,proto3" json:"lap,omitempty"`                             // the lap number, starting at 1
+}
+
+func (x *LapReference) Reset() {
+	*x = LapReference{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_archive_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LapReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LapReference) ProtoMessage() {}
+
+func (x *LapReference) ProtoReflect() protoreflect.Message {
+	mi := &file_archive_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LapReference.ProtoReflect.Descriptor instead.
+func (*LapReference) Descriptor() ([]byte, []int) {
+	return file_archive_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *LapReference) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *LapReference) GetLap() uint32 {
+	if x != nil {
+		return x.Lap
+	}
+	return 0
+}
+
+// LapTrace holds the channels of a lap at the distances of a comparison
+type LapTrace struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lap    *LapReference    `protobuf:"bytes,1,opt,name=lap,proto3" json:"lap,omitempty"`
+	Times  []float32        `protobuf:"fixed32,2,rep,packed,name=times,proto3" json:"times,omitempty"` // seconds since the first distance of the comparison
+	Series []*ChannelSeries `protobuf:"bytes,3,rep,name=series,proto3" json:"series,omitempty"`
+}
+
+func (x *LapTrace) Reset() {
+	*x = LapTrace{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_archive_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LapTrace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LapTrace) ProtoMessage() {}
+
+func (x *LapTrace) ProtoReflect() protoreflect.Message {
+	mi := &file_archive_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LapTrace.ProtoReflect.Descriptor instead.
+func (*LapTrace) Descriptor() ([]byte, []int) {
+	return file_archive_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *LapTrace) GetLap() *LapReference {
+	if x != nil {
+		return x.Lap
+	}
+	return nil
+}
+
+func (x *LapTrace) GetTimes() []float32 {
+	if x != nil {
+		return x.Times
+	}
+	return nil
+}
+
+func (x *LapTrace) GetSeries() []*ChannelSeries {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+// ChannelSeries holds the values of a channel, one for every time of the response
+type ChannelSeries struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Channel string    `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Values  []float32 `protobuf:"fixed32,2,rep,packed,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *ChannelSeries) Reset() {
+	*x = ChannelSeries{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_archive_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelSeries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelSeries) ProtoMessage() {}
+
+func (x *ChannelSeries) ProtoReflect() protoreflect.Message {
+	mi := &file_archive_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelSeries.ProtoReflect.Descriptor instead.
+func (*ChannelSeries) Descriptor() ([]byte, []int) {
+	return file_archive_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ChannelSeries) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *ChannelSeries) GetValues() []float32 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// ArchivedSession is a session with archived telemetry
+type ArchivedSession struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`     // same as the id of the session in the lap history
+	Port       string   `protobuf:"bytes,2,opt,name=port,proto3" json:"port,omitempty"` // the upd port of the chair
+	SessionUid uint64   `protobuf:"varint,3,opt,name=session_uid,json=sessionUid,proto3" json:"session_uid,omitempty"`
+	Start      int64    `protobuf:"varint,4,opt,name=start,proto3" json:"start,omitempty"`     // unix milliseconds
+	Updated    int64    `protobuf:"varint,5,opt,name=updated,proto3" json:"updated,omitempty"` // unix milliseconds
+	Tiers      []string `protobuf:"bytes,6,rep,name=tiers,proto3" json:"tiers,omitempty"`      // the resolutions that are still available, finest first
+}
+
+func (x *ArchivedSession) Reset() {
+	*x = ArchivedSession{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_archive_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArchivedSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchivedSession) ProtoMessage() {}
+
+func (x *ArchivedSession) ProtoReflect() protoreflect.Message {
+	mi := &file_archive_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchivedSession.ProtoReflect.Descriptor instead.
+func (*ArchivedSession) Descriptor() ([]byte, []int) {
+	return file_archive_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ArchivedSession) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ArchivedSession) GetPort() string {
+	if x != nil {
+		return x.Port
+	}
+	return ""
+}
+
+func (x *ArchivedSession) GetSessionUid() uint64 {
+	if x != nil {
+		return x.SessionUid
+	}
+	return 0
+}
+
+func (x *ArchivedSession) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *ArchivedSession) GetUpdated() int64 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ArchivedSession) GetTiers() []string {
+	if x != nil {
+		return x.Tiers
+	}
+	return nil
+}
+
+var File_archive_proto protoreflect.FileDescriptor
+
+var file_archive_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x22, 0x31, 0x0a, 0x1b, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x57,
+	0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37,
+	0x0a, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xad, 0x01, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x23, 0x0a, 0x0d,
+	0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x73, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x61, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03,
+	0x6c, 0x61, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x94, 0x01, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x69, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x03, 0x52, 0x05, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x06, 0x73,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x06, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x22, 0xa8,
+	0x01, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x4c, 0x61, 0x70, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x70, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x2a, 0x0a,
+	0x03, 0x6c, 0x61, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x70, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x52, 0x03, 0x6c, 0x61, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x22, 0xa5, 0x01, 0x0a, 0x13, 0x43, 0x6f,
+	0x6d, 0x70, 0x61, 0x72, 0x65, 0x4c, 0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x02, 0x52, 0x09, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12,
+	0x32, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x61, 0x70, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x26, 0x0a, 0x03, 0x6c, 0x61, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61,
+	0x70, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x03, 0x6c, 0x61, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x64,
+	0x65, 0x6c, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x02, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x74,
+	0x61, 0x22, 0x3f, 0x0a, 0x0c, 0x4c, 0x61, 0x70, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x10, 0x0a, 0x03, 0x6c, 0x61, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6c,
+	0x61, 0x70, 0x22, 0x7f, 0x0a, 0x08, 0x4c, 0x61, 0x70, 0x54, 0x72, 0x61, 0x63, 0x65, 0x12, 0x2a,
+	0x0a, 0x03, 0x6c, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x70, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x03, 0x6c, 0x61, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x02, 0x52, 0x05, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x12, 0x31, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x06, 0x73, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x22, 0x41, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x16,
+	0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x02, 0x52, 0x06,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x9c, 0x01, 0x0a, 0x0f, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55, 0x69, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x69, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x69, 0x65, 0x72, 0x73, 0x32, 0xad, 0x02, 0x0a, 0x17, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65,
+	0x74, 0x72, 0x79, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x69, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x2e, 0x61, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x0e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x12, 0x21,
+	0x2e, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65,
+	0x4c, 0x61, 0x70, 0x73, 0x12, 0x1e, 0x2e, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x4c, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x4c, 0x61, 0x70, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0c, 0x5a, 0x0a, 0x2e, 0x3b, 0x67, 0x72, 0x70, 0x63, 0x5f,
+	0x67, 0x65, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_archive_proto_rawDescOnce sync.Once
+	file_archive_proto_rawDescData = file_archive_proto_rawDesc
+)
+
+func file_archive_proto_rawDescGZIP() []byte {
+	file_archive_proto_rawDescOnce.Do(func() {
+		file_archive_proto_rawDescData = protoimpl.X.CompressGZIP(file_archive_proto_rawDescData)
+	})
+	return file_archive_proto_rawDescData
+}
+
+var file_archive_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_archive_proto_goTypes = []interface{}{
+	(*ListArchivedSessionsRequest)(nil),  // 0: archive.v1.ListArchivedSessionsRequest
+	(*ListArchivedSessionsResponse)(nil), // 1: archive.v1.ListArchivedSessionsResponse
+	(*QueryTelemetryRequest)(nil),        // 2: archive.v1.QueryTelemetryRequest
+	(*QueryTelemetryResponse)(nil),       // 3: archive.v1.QueryTelemetryResponse
+	(*CompareLapsRequest)(nil),           // 4: archive.v1.CompareLapsRequest
+	(*CompareLapsResponse)(nil),          // 5: archive.v1.CompareLapsResponse
+	(*LapReference)(nil),                 // 6: archive.v1.LapReference
+	(*LapTrace)(nil),                     // 7: archive.v1.LapTrace
+	(*ChannelSeries)(nil),                // 8: archive.v1.ChannelSeries
+	(*ArchivedSession)(nil),              // 9: archive.v1.ArchivedSession
+}
+var file_archive_proto_depIdxs = []int32{
+	9,  // 0: archive.v1.ListArchivedSessionsResponse.sessions:type_name -> archive.v1.ArchivedSession
+	8,  // 1: archive.v1.QueryTelemetryResponse.series:type_name -> archive.v1.ChannelSeries
+	6,  // 2: archive.v1.CompareLapsRequest.reference:type_name -> archive.v1.LapReference
+	6,  // 3: archive.v1.CompareLapsRequest.lap:type_name -> archive.v1.LapReference
+	7,  // 4: archive.v1.CompareLapsResponse.reference:type_name -> archive.v1.LapTrace
+	7,  // 5: archive.v1.CompareLapsResponse.lap:type_name -> archive.v1.LapTrace
+	6,  // 6: archive.v1.LapTrace.lap:type_name -> archive.v1.LapReference
+	8,  // 7: archive.v1.LapTrace.series:type_name -> archive.v1.ChannelSeries
+	0,  // 8: archive.v1.TelemetryArchiveService.ListArchivedSessions:input_type -> archive.v1.ListArchivedSessionsRequest
+	2,  // 9: archive.v1.TelemetryArchiveService.QueryTelemetry:input_type -> archive.v1.QueryTelemetryRequest
+	4,  // 10: archive.v1.TelemetryArchiveService.CompareLaps:input_type -> archive.v1.CompareLapsRequest
+	1,  // 11: archive.v1.TelemetryArchiveService.ListArchivedSessions:output_type -> archive.v1.ListArchivedSessionsResponse
+	3,  // 12: archive.v1.TelemetryArchiveService.QueryTelemetry:output_type -> archive.v1.QueryTelemetryResponse
+	5,  // 13: archive.v1.TelemetryArchiveService.CompareLaps:output_type -> archive.v1.CompareLapsResponse
+	11, // [11:14] is the sub-list for method output_type
+	8,  // [8:11] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_archive_proto_init() }
+func file_archive_proto_init() {
+	if File_archive_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_archive_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListArchivedSessionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_archive_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListArchivedSessionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_archive_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTelemetryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_archive_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTelemetryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_archive_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompareLapsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_archive_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompareLapsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_archive_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LapReference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_archive_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LapTrace); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_archive_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelSeries); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_archive_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchivedSession); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_archive_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_archive_proto_goTypes,
+		DependencyIndexes: file_archive_proto_depIdxs,
+		MessageInfos:      file_archive_proto_msgTypes,
+	}.Build()
+	File_archive_proto = out.File
+	file_archive_proto_rawDesc = nil
+	file_archive_proto_goTypes = nil
+	file_archive_proto_depIdxs = nil
+}
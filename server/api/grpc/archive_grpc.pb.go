@@ -0,0 +1,184 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v4.24.1
+// source: archive.proto
+
+package grpc_gen
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// TelemetryArchiveServiceClient is the client API for TelemetryArchiveService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TelemetryArchiveServiceClient interface {
+	// ListArchivedSessions lists the sessions with archived telemetry, newest first
+	ListArchivedSessions(ctx context.Context, in *ListArchivedSessionsRequest, opts ...grpc.CallOption) (*ListArchivedSessionsResponse, error)
+	// QueryTelemetry reads channels of an archived session, for a lap or a time range
+	QueryTelemetry(ctx context.Context, in *QueryTelemetryRequest, opts ...grpc.CallOption) (*QueryTelemetryResponse, error)
+	// CompareLaps aligns two laps on the same track by the distance into the lap, and returns how far the lap is behind the reference
+	CompareLaps(ctx context.Context, in *CompareLapsRequest, opts ...grpc.CallOption) (*CompareLapsResponse, error)
+}
+
+type telemetryArchiveServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTelemetryArchiveServiceClient(cc grpc.ClientConnInterface) TelemetryArchiveServiceClient {
+	return &telemetryArchiveServiceClient{cc}
+}
+
+func (c *telemetryArchiveServiceClient) ListArchivedSessions(ctx context.Context, in *ListArchivedSessionsRequest, opts ...grpc.CallOption) (*ListArchivedSessionsResponse, error) {
+	out := new(ListArchivedSessionsResponse)
+	err := c.cc.Invoke(ctx, "/archive.v1.TelemetryArchiveService/ListArchivedSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *telemetryArchiveServiceClient) QueryTelemetry(ctx context.Context, in *QueryTelemetryRequest, opts ...grpc.CallOption) (*QueryTelemetryResponse, error) {
+	out := new(QueryTelemetryResponse)
+	err := c.cc.Invoke(ctx, "/archive.v1.TelemetryArchiveService/QueryTelemetry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *telemetryArchiveServiceClient) CompareLaps(ctx context.Context, in *CompareLapsRequest, opts ...grpc.CallOption) (*CompareLapsResponse, error) {
+	out := new(CompareLapsResponse)
+	err := c.cc.Invoke(ctx, "/archive.v1.TelemetryArchiveService/CompareLaps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TelemetryArchiveServiceServer is the server API for TelemetryArchiveService service.
+// All implementations must embed UnimplementedTelemetryArchiveServiceServer
+// for forward compatibility
+type TelemetryArchiveServiceServer interface {
+	// ListArchivedSessions lists the sessions with archived telemetry, newest first
+	ListArchivedSessions(context.Context, *ListArchivedSessionsRequest) (*ListArchivedSessionsResponse, error)
+	// QueryTelemetry reads channels of an archived session, for a lap or a time range
+	QueryTelemetry(context.Context, *QueryTelemetryRequest) (*QueryTelemetryResponse, error)
+	// CompareLaps aligns two laps on the same track by the distance into the lap, and returns how far the lap is behind the reference
+	CompareLaps(context.Context, *CompareLapsRequest) (*CompareLapsResponse, error)
+	mustEmbedUnimplementedTelemetryArchiveServiceServer()
+}
+
+// UnimplementedTelemetryArchiveServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTelemetryArchiveServiceServer struct {
+}
+
+func (UnimplementedTelemetryArchiveServiceServer) ListArchivedSessions(context.Context, *ListArchivedSessionsRequest) (*ListArchivedSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListArchivedSessions not implemented")
+}
+func (UnimplementedTelemetryArchiveServiceServer) QueryTelemetry(context.Context, *QueryTelemetryRequest) (*QueryTelemetryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryTelemetry not implemented")
+}
+func (UnimplementedTelemetryArchiveServiceServer) CompareLaps(context.Context, *CompareLapsRequest) (*CompareLapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareLaps not implemented")
+}
+func (UnimplementedTelemetryArchiveServiceServer) mustEmbedUnimplementedTelemetryArchiveServiceServer() {
+}
+
+// UnsafeTelemetryArchiveServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TelemetryArchiveServiceServer will
+// result in compilation errors.
+type UnsafeTelemetryArchiveServiceServer interface {
+	mustEmbedUnimplementedTelemetryArchiveServiceServer()
+}
+
+func RegisterTelemetryArchiveServiceServer(s grpc.ServiceRegistrar, srv TelemetryArchiveServiceServer) {
+	s.RegisterService(&TelemetryArchiveService_ServiceDesc, srv)
+}
+
+func _TelemetryArchiveService_ListArchivedSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListArchivedSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TelemetryArchiveServiceServer).ListArchivedSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archive.v1.TelemetryArchiveService/ListArchivedSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelemetryArchiveServiceServer).ListArchivedSessions(ctx, req.(*ListArchivedSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TelemetryArchiveService_QueryTelemetry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTelemetryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TelemetryArchiveServiceServer).QueryTelemetry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archive.v1.TelemetryArchiveService/QueryTelemetry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelemetryArchiveServiceServer).QueryTelemetry(ctx, req.(*QueryTelemetryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TelemetryArchiveService_CompareLaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareLapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TelemetryArchiveServiceServer).CompareLaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archive.v1.TelemetryArchiveService/CompareLaps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelemetryArchiveServiceServer).CompareLaps(ctx, req.(*CompareLapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TelemetryArchiveService_ServiceDesc is the grpc.ServiceDesc for TelemetryArchiveService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TelemetryArchiveService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "archive.v1.TelemetryArchiveService",
+	HandlerType: (*TelemetryArchiveServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListArchivedSessions",
+			Handler:    _TelemetryArchiveService_ListArchivedSessions_Handler,
+		},
+		{
+			MethodName: "QueryTelemetry",
+			Handler:    _TelemetryArchiveService_QueryTelemetry_Handler,
+		},
+		{
+			MethodName: "CompareLaps",
+			Handler:    _TelemetryArchiveService_CompareLaps_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "archive.proto",
+}
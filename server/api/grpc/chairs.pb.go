@@ -20,6 +20,65 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ChairListenerState is the state of the listener of a chair
+type ChairListenerState int32
+
+const (
+	ChairListenerState_CHAIR_LISTENER_STATE_UNSPECIFIED ChairListenerState = 0
+	ChairListenerState_CHAIR_LISTENER_STATE_STARTING    ChairListenerState = 1 // binding the port for the first time
+	ChairListenerState_CHAIR_LISTENER_STATE_LISTENING   ChairListenerState = 2 // bound, but no datagrams are received yet
+	ChairListenerState_CHAIR_LISTENER_STATE_RECEIVING   ChairListenerState = 3 // datagrams are received recently
+	ChairListenerState_CHAIR_LISTENER_STATE_IDLE        ChairListenerState = 4 // no datagrams are received for a while, the rig stopped sending
+	ChairListenerState_CHAIR_LISTENER_STATE_FAILED      ChairListenerState = 5 // binding or reading failed, the port is bound again after a backoff
+)
+
+// Enum value maps for ChairListenerState.
+var (
+	ChairListenerState_name = map[int32]string{
+		0: "CHAIR_LISTENER_STATE_UNSPECIFIED",
+		1: "CHAIR_LISTENER_STATE_STARTING",
+		2: "CHAIR_LISTENER_STATE_LISTENING",
+		3: "CHAIR_LISTENER_STATE_RECEIVING",
+		4: "CHAIR_LISTENER_STATE_IDLE",
+		5: "CHAIR_LISTENER_STATE_FAILED",
+	}
+	ChairListenerState_value = map[string]int32{
+		"CHAIR_LISTENER_STATE_UNSPECIFIED": 0,
+		"CHAIR_LISTENER_STATE_STARTING":    1,
+		"CHAIR_LISTENER_STATE_LISTENING":   2,
+		"CHAIR_LISTENER_STATE_RECEIVING":   3,
+		"CHAIR_LISTENER_STATE_IDLE":        4,
+		"CHAIR_LISTENER_STATE_FAILED":      5,
+	}
+)
+
+func (x ChairListenerState) Enum() *ChairListenerState {
+	p := new(ChairListenerState)
+	*p = x
+	return p
+}
+
+func (x ChairListenerState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChairListenerState) Descriptor() protoreflect.EnumDescriptor {
+	return file_chairs_proto_enumTypes[0].Descriptor()
+}
+
+func (ChairListenerState) Type() protoreflect.EnumType {
+	return &file_chairs_proto_enumTypes[0]
+}
+
+func (x ChairListenerState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChairListenerState.Descriptor instead.
+func (ChairListenerState) EnumDescriptor() ([]byte, []int) {
+	return file_chairs_proto_rawDescGZIP(), []int{0}
+}
+
 // CreateChairRequest is a request to get a chair by id
 type CreateChairRequest struct {
 	state         protoimpl.MessageState
@@ -122,7 +181,7 @@ type GetChairRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Port string `protobuf:"bytes,1,opt,name=port,proto3" json:"port,omitempty"` // the upd port of the chair
+	Port string `protobuf:"bytes,1,opt,name=port,proto3" json:"port,omitempty"` // the id of the chair, the upd port if it has no stable id
 }
 
 func (x *GetChairRequest) Reset() {
@@ -212,7 +271,7 @@ func (x *GetChairResponse) GetChair() *Chair {
 	return nil
 }
 
-// UpdateChairRequest is a request to update a chair. Ports can only be updated by an admin
+// UpdateChairRequest is a request to update a chair, found by its id or port. Names and sources can only be updated by an admin, empty sources are left as they are
 type UpdateChairRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -500,8 +559,13 @@ type Chair struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// active is whether the chair is driving or not
 	Active bool `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
-	// the upd port of the chair
+	// the upd port of the chair, 0 if it only receives on the shared port
 	Port int32 `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	// id is the stable id of the chair, the port is the id if it is empty
+	Id string `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	// sources are the addresses, ip or ip:port, routed to the chair on the shared port. Rigs behind the same ip need their ip:port,
+	// the session and player car of a rig are only learned after it matched a source
+	Sources []string `protobuf:"bytes,5,rep,name=sources,proto3" json:"sources,omitempty"`
 }
 
 func (x *Chair) Reset() {
@@ -557,189 +621,1383 @@ func (x *Chair) GetPort() int32 {
 	return 0
 }
 
-var File_chairs_proto protoreflect.FileDescriptor
+func (x *Chair) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
 
-var file_chairs_proto_rawDesc = []byte{
-	0x0a, 0x0c, 0x63, 0x68, 0x61, 0x69, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09,
-	0x63, 0x68, 0x61, 0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x22, 0x3c, 0x0a, 0x12, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x26, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x63, 0x68, 0x61, 0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x72,
-	0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x72, 0x22, 0x3d, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x43, 0x68, 0x61, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26,
-	0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
-	0x63, 0x68, 0x61, 0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x72, 0x52,
-	0x05, 0x63, 0x68, 0x61, 0x69, 0x72, 0x22, 0x25, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61,
-	0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x3a, 0x0a,
-	0x10, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x26, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61,
-	0x69, 0x72, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x72, 0x22, 0x3c, 0x0a, 0x12, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x26, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x63, 0x68, 0x61, 0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x72,
-	0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x72, 0x22, 0x3d, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x43, 0x68, 0x61, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26,
-	0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
-	0x63, 0x68, 0x61, 0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x72, 0x52,
-	0x05, 0x63, 0x68, 0x61, 0x69, 0x72, 0x22, 0x13, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68,
-	0x61, 0x69, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3e, 0x0a, 0x12, 0x4c,
-	0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x69, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x28, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x69, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x10, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68,
-	0x61, 0x69, 0x72, 0x52, 0x06, 0x63, 0x68, 0x61, 0x69, 0x72, 0x73, 0x22, 0x28, 0x0a, 0x12, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x3d, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
-	0x68, 0x61, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x05,
-	0x63, 0x68, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x68,
-	0x61, 0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x72, 0x52, 0x05, 0x63,
-	0x68, 0x61, 0x69, 0x72, 0x22, 0x47, 0x0a, 0x05, 0x43, 0x68, 0x61, 0x69, 0x72, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x32, 0x88, 0x03,
-	0x0a, 0x0c, 0x43, 0x68, 0x61, 0x69, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4c,
-	0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69, 0x72, 0x12, 0x1d, 0x2e,
-	0x63, 0x68, 0x61, 0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x43, 0x68, 0x61, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63,
-	0x68, 0x61, 0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
-	0x68, 0x61, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x08,
-	0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x72, 0x12, 0x1a, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x72,
-	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x72, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x49, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x69, 0x72, 0x73, 0x12,
-	0x1c, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x43, 0x68, 0x61, 0x69, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
-	0x63, 0x68, 0x61, 0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68,
-	0x61, 0x69, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0b,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69, 0x72, 0x12, 0x1d, 0x2e, 0x63, 0x68,
-	0x61, 0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68,
-	0x61, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x68, 0x61,
-	0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61,
-	0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0b, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69, 0x72, 0x12, 0x1d, 0x2e, 0x63, 0x68, 0x61, 0x69,
-	0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x72,
-	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0c, 0x5a, 0x0a, 0x2e, 0x3b, 0x67, 0x72,
-	0x70, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *Chair) GetSources() []string {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
 }
 
-var (
-	file_chairs_proto_rawDescOnce sync.Once
-	file_chairs_proto_rawDescData = file_chairs_proto_rawDesc
-)
+// GetChairForwardsRequest is a request to get the forwards of a chair
+type GetChairForwardsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func file_chairs_proto_rawDescGZIP() []byte {
-	file_chairs_proto_rawDescOnce.Do(func() {
-		file_chairs_proto_rawDescData = protoimpl.X.CompressGZIP(file_chairs_proto_rawDescData)
-	})
-	return file_chairs_proto_rawDescData
+	Port string `protobuf:"bytes,1,opt,name=port,proto3" json:"port,omitempty"` // the upd port of the chair
 }
 
-var file_chairs_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
-var file_chairs_proto_goTypes = []interface{}{
-	(*CreateChairRequest)(nil),  // 0: chairs.v1.CreateChairRequest
-	(*CreateChairResponse)(nil), // 1: chairs.v1.CreateChairResponse
-	(*GetChairRequest)(nil),     // 2: chairs.v1.GetChairRequest
-	(*GetChairResponse)(nil),    // 3: chairs.v1.GetChairResponse
-	(*UpdateChairRequest)(nil),  // 4: chairs.v1.UpdateChairRequest
-	(*UpdateChairResponse)(nil), // 5: chairs.v1.UpdateChairResponse
-	(*ListChairsRequest)(nil),   // 6: chairs.v1.ListChairsRequest
-	(*ListChairsResponse)(nil),  // 7: chairs.v1.ListChairsResponse
-	(*DeleteChairRequest)(nil),  // 8: chairs.v1.DeleteChairRequest
-	(*DeleteChairResponse)(nil), // 9: chairs.v1.DeleteChairResponse
-	(*Chair)(nil),               // 10: chairs.v1.Chair
+func (x *GetChairForwardsRequest) Reset() {
+	*x = GetChairForwardsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chairs_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-var file_chairs_proto_depIdxs = []int32{
-	10, // 0: chairs.v1.CreateChairRequest.chair:type_name -> chairs.v1.Chair
-	10, // 1: chairs.v1.CreateChairResponse.chair:type_name -> chairs.v1.Chair
-	10, // 2: chairs.v1.GetChairResponse.chair:type_name -> chairs.v1.Chair
-	10, // 3: chairs.v1.UpdateChairRequest.chair:type_name -> chairs.v1.Chair
-	10, // 4: chairs.v1.UpdateChairResponse.chair:type_name -> chairs.v1.Chair
-	10, // 5: chairs.v1.ListChairsResponse.chairs:type_name -> chairs.v1.Chair
-	10, // 6: chairs.v1.DeleteChairResponse.chair:type_name -> chairs.v1.Chair
-	0,  // 7: chairs.v1.ChairService.CreateChair:input_type -> chairs.v1.CreateChairRequest
-	2,  // 8: chairs.v1.ChairService.GetChair:input_type -> chairs.v1.GetChairRequest
-	6,  // 9: chairs.v1.ChairService.ListChairs:input_type -> chairs.v1.ListChairsRequest
-	4,  // 10: chairs.v1.ChairService.UpdateChair:input_type -> chairs.v1.UpdateChairRequest
-	8,  // 11: chairs.v1.ChairService.DeleteChair:input_type -> chairs.v1.DeleteChairRequest
-	1,  // 12: chairs.v1.ChairService.CreateChair:output_type -> chairs.v1.CreateChairResponse
-	3,  // 13: chairs.v1.ChairService.GetChair:output_type -> chairs.v1.GetChairResponse
-	7,  // 14: chairs.v1.ChairService.ListChairs:output_type -> chairs.v1.ListChairsResponse
-	5,  // 15: chairs.v1.ChairService.UpdateChair:output_type -> chairs.v1.UpdateChairResponse
-	9,  // 16: chairs.v1.ChairService.DeleteChair:output_type -> chairs.v1.DeleteChairResponse
-	12, // [12:17] is the sub-list for method output_type
-	7,  // [7:12] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+
+func (x *GetChairForwardsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_chairs_proto_init() }
-func file_chairs_proto_init() {
-	if File_chairs_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_chairs_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateChairRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+func (*GetChairForwardsRequest) ProtoMessage() {}
+
+func (x *GetChairForwardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chairs_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_chairs_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateChairResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChairForwardsRequest.ProtoReflect.Descriptor instead.
+func (*GetChairForwardsRequest) Descriptor() ([]byte, []int) {
+	return file_chairs_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetChairForwardsRequest) GetPort() string {
+	if x != nil {
+		return x.Port
+	}
+	return ""
+}
+
+// GetChairForwardsResponse is a response to a GetChairForwardsRequest
+type GetChairForwardsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Forwards []*ChairForward `protobuf:"bytes,1,rep,name=forwards,proto3" json:"forwards,omitempty"`
+}
+
+func (x *GetChairForwardsResponse) Reset() {
+	*x = GetChairForwardsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chairs_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChairForwardsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChairForwardsResponse) ProtoMessage() {}
+
+func (x *GetChairForwardsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chairs_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_chairs_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetChairRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChairForwardsResponse.ProtoReflect.Descriptor instead.
+func (*GetChairForwardsResponse) Descriptor() ([]byte, []int) {
+	return file_chairs_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetChairForwardsResponse) GetForwards() []*ChairForward {
+	if x != nil {
+		return x.Forwards
+	}
+	return nil
+}
+
+// SetChairForwardsRequest is a request to replace the forwards of a chair, an empty list stops forwarding
+type SetChairForwardsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Port     string          `protobuf:"bytes,1,opt,name=port,proto3" json:"port,omitempty"` // the upd port of the chair
+	Forwards []*ChairForward `protobuf:"bytes,2,rep,name=forwards,proto3" json:"forwards,omitempty"`
+}
+
+func (x *SetChairForwardsRequest) Reset() {
+	*x = SetChairForwardsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chairs_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetChairForwardsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChairForwardsRequest) ProtoMessage() {}
+
+func (x *SetChairForwardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chairs_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_chairs_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetChairResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChairForwardsRequest.ProtoReflect.Descriptor instead.
+func (*SetChairForwardsRequest) Descriptor() ([]byte, []int) {
+	return file_chairs_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SetChairForwardsRequest) GetPort() string {
+	if x != nil {
+		return x.Port
+	}
+	return ""
+}
+
+func (x *SetChairForwardsRequest) GetForwards() []*ChairForward {
+	if x != nil {
+		return x.Forwards
+	}
+	return nil
+}
+
+// SetChairForwardsResponse is a response to a SetChairForwardsRequest
+type SetChairForwardsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Forwards []*ChairForward `protobuf:"bytes,1,rep,name=forwards,proto3" json:"forwards,omitempty"`
+}
+
+func (x *SetChairForwardsResponse) Reset() {
+	*x = SetChairForwardsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chairs_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetChairForwardsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChairForwardsResponse) ProtoMessage() {}
+
+func (x *SetChairForwardsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chairs_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_chairs_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateChairRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChairForwardsResponse.ProtoReflect.Descriptor instead.
+func (*SetChairForwardsResponse) Descriptor() ([]byte, []int) {
+	return file_chairs_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SetChairForwardsResponse) GetForwards() []*ChairForward {
+	if x != nil {
+		return x.Forwards
+	}
+	return nil
+}
+
+// ChairForward is a destination every datagram the chair receives is re-sent to, such as SimHub or a motion platform
+type ChairForward struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address   string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`                              // host:port of the destination
+	PacketIds []uint32 `protobuf:"varint,2,rep,packed,name=packet_ids,json=packetIds,proto3" json:"packet_ids,omitempty"` // only these packet ids are forwarded, all packets if empty
+	MaxRate   uint32   `protobuf:"varint,3,opt,name=max_rate,json=maxRate,proto3" json:"max_rate,omitempty"`              // the most datagrams per second, unlimited if 0
+}
+
+func (x *ChairForward) Reset() {
+	*x = ChairForward{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chairs_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChairForward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChairForward) ProtoMessage() {}
+
+func (x *ChairForward) ProtoReflect() protoreflect.Message {
+	mi := &file_chairs_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChairForward.ProtoReflect.Descriptor instead.
+func (*ChairForward) Descriptor() ([]byte, []int) {
+	return file_chairs_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ChairForward) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ChairForward) GetPacketIds() []uint32 {
+	if x != nil {
+		return x.PacketIds
+	}
+	return nil
+}
+
+func (x *ChairForward) GetMaxRate() uint32 {
+	if x != nil {
+		return x.MaxRate
+	}
+	return 0
+}
+
+// ListPendingChairsRequest is a request to list the pending chairs
+type ListPendingChairsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListPendingChairsRequest) Reset() {
+	*x = ListPendingChairsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chairs_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPendingChairsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingChairsRequest) ProtoMessage() {}
+
+func (x *ListPendingChairsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chairs_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingChairsRequest.ProtoReflect.Descriptor instead.
+func (*ListPendingChairsRequest) Descriptor() ([]byte, []int) {
+	return file_chairs_proto_rawDescGZIP(), []int{16}
+}
+
+// ListPendingChairsResponse is a response to a ListPendingChairsRequest, the most recently seen first
+type ListPendingChairsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pending []*PendingChair `protobuf:"bytes,1,rep,name=pending,proto3" json:"pending,omitempty"`
+}
+
+func (x *ListPendingChairsResponse) Reset() {
+	*x = ListPendingChairsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chairs_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPendingChairsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingChairsResponse) ProtoMessage() {}
+
+func (x *ListPendingChairsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chairs_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingChairsResponse.ProtoReflect.Descriptor instead.
+func (*ListPendingChairsResponse) Descriptor() ([]byte, []int) {
+	return file_chairs_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListPendingChairsResponse) GetPending() []*PendingChair {
+	if x != nil {
+		return x.Pending
+	}
+	return nil
+}
+
+// AdoptPendingChairRequest is a request to create a chair for a pending chair, the fields of CreateChair that are left empty are filled in from the pending chair
+type AdoptPendingChairRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"` // the ip:port of the pending chair
+	Id     string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`         // the stable id of the new chair, required if it has no port
+	Name   string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`     // the name of the new chair, the player name if empty
+	Active bool   `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+	Port   int32  `protobuf:"varint,5,opt,name=port,proto3" json:"port,omitempty"`             // the upd port of the new chair, 0 if it only receives on the shared port
+	ByIp   bool   `protobuf:"varint,6,opt,name=by_ip,json=byIp,proto3" json:"by_ip,omitempty"` // route every datagram of the ip to the chair, instead of only those of the ip:port
+}
+
+func (x *AdoptPendingChairRequest) Reset() {
+	*x = AdoptPendingChairRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chairs_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdoptPendingChairRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdoptPendingChairRequest) ProtoMessage() {}
+
+func (x *AdoptPendingChairRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chairs_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdoptPendingChairRequest.ProtoReflect.Descriptor instead.
+func (*AdoptPendingChairRequest) Descriptor() ([]byte, []int) {
+	return file_chairs_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AdoptPendingChairRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *AdoptPendingChairRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AdoptPendingChairRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AdoptPendingChairRequest) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *AdoptPendingChairRequest) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *AdoptPendingChairRequest) GetByIp() bool {
+	if x != nil {
+		return x.ByIp
+	}
+	return false
+}
+
+// AdoptPendingChairResponse is a response to an AdoptPendingChairRequest
+type AdoptPendingChairResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chair *Chair `protobuf:"bytes,1,opt,name=chair,proto3" json:"chair,omitempty"`
+}
+
+func (x *AdoptPendingChairResponse) Reset() {
+	*x = AdoptPendingChairResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chairs_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdoptPendingChairResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdoptPendingChairResponse) ProtoMessage() {}
+
+func (x *AdoptPendingChairResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chairs_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdoptPendingChairResponse.ProtoReflect.Descriptor instead.
+func (*AdoptPendingChairResponse) Descriptor() ([]byte, []int) {
+	return file_chairs_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AdoptPendingChairResponse) GetChair() *Chair {
+	if x != nil {
+		return x.Chair
+	}
+	return nil
+}
+
+// DismissPendingChairRequest is a request to forget a pending chair
+type DismissPendingChairRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"` // the ip:port of the pending chair
+}
+
+func (x *DismissPendingChairRequest) Reset() {
+	*x = DismissPendingChairRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chairs_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DismissPendingChairRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DismissPendingChairRequest) ProtoMessage() {}
+
+func (x *DismissPendingChairRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chairs_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DismissPendingChairRequest.ProtoReflect.Descriptor instead.
+func (*DismissPendingChairRequest) Descriptor() ([]byte, []int) {
+	return file_chairs_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DismissPendingChairRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+// DismissPendingChairResponse is a response to a DismissPendingChairRequest
+type DismissPendingChairResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DismissPendingChairResponse) Reset() {
+	*x = DismissPendingChairResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chairs_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DismissPendingChairResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DismissPendingChairResponse) ProtoMessage() {}
+
+func (x *DismissPendingChairResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chairs_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DismissPendingChairResponse.ProtoReflect.Descriptor instead.
+func (*DismissPendingChairResponse) Descriptor() ([]byte, []int) {
+	return file_chairs_proto_rawDescGZIP(), []int{21}
+}
+
+// PendingChair is a rig that sends datagrams to the shared or discovery port, that are not routed to a chair
+type PendingChair struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Source       string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"` // ip:port the datagrams are send from
+	Ip           string `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
+	Port         int32  `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	PacketFormat uint32 `protobuf:"varint,4,opt,name=packet_format,json=packetFormat,proto3" json:"packet_format,omitempty"` // 2022, 2023 or 2024
+	GameYear     uint32 `protobuf:"varint,5,opt,name=game_year,json=gameYear,proto3" json:"game_year,omitempty"`
+	PlayerName   string `protobuf:"bytes,6,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"` // empty until the participants are received
+	SessionUid   uint64 `protobuf:"varint,7,opt,name=session_uid,json=sessionUid,proto3" json:"session_uid,omitempty"`
+	Packets      uint64 `protobuf:"varint,8,opt,name=packets,proto3" json:"packets,omitempty"`                      // the number of datagrams received
+	FirstSeen    int64  `protobuf:"varint,9,opt,name=first_seen,json=firstSeen,proto3" json:"first_seen,omitempty"` // unix milliseconds
+	LastSeen     int64  `protobuf:"varint,10,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`   // unix milliseconds
+}
+
+func (x *PendingChair) Reset() {
+	*x = PendingChair{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chairs_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PendingChair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingChair) ProtoMessage() {}
+
+func (x *PendingChair) ProtoReflect() protoreflect.Message {
+	mi := &file_chairs_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingChair.ProtoReflect.Descriptor instead.
+func (*PendingChair) Descriptor() ([]byte, []int) {
+	return file_chairs_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PendingChair) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *PendingChair) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *PendingChair) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *PendingChair) GetPacketFormat() uint32 {
+	if x != nil {
+		return x.PacketFormat
+	}
+	return 0
+}
+
+func (x *PendingChair) GetGameYear() uint32 {
+	if x != nil {
+		return x.GameYear
+	}
+	return 0
+}
+
+func (x *PendingChair) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *PendingChair) GetSessionUid() uint64 {
+	if x != nil {
+		return x.SessionUid
+	}
+	return 0
+}
+
+func (x *PendingChair) GetPackets() uint64 {
+	if x != nil {
+		return x.Packets
+	}
+	return 0
+}
+
+func (x *PendingChair) GetFirstSeen() int64 {
+	if x != nil {
+		return x.FirstSeen
+	}
+	return 0
+}
+
+func (x *PendingChair) GetLastSeen() int64 {
+	if x != nil {
+		return x.LastSeen
+	}
+	return 0
+}
+
+// ListChairHealthRequest is a request to list the health of all chairs
+type ListChairHealthRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListChairHealthRequest) Reset() {
+	*x = ListChairHealthRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chairs_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListChairHealthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChairHealthRequest) ProtoMessage() {}
+
+func (x *ListChairHealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chairs_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChairHealthRequest.ProtoReflect.Descriptor instead.
+func (*ListChairHealthRequest) Descriptor() ([]byte, []int) {
+	return file_chairs_proto_rawDescGZIP(), []int{23}
+}
+
+// ListChairHealthResponse is a response to a ListChairHealthRequest, sorted by chair id
+type ListChairHealthResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Health []*ChairHealth `protobuf:"bytes,1,rep,name=health,proto3" json:"health,omitempty"`
+}
+
+func (x *ListChairHealthResponse) Reset() {
+	*x = ListChairHealthResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chairs_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListChairHealthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChairHealthResponse) ProtoMessage() {}
+
+func (x *ListChairHealthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chairs_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChairHealthResponse.ProtoReflect.Descriptor instead.
+func (*ListChairHealthResponse) Descriptor() ([]byte, []int) {
+	return file_chairs_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListChairHealthResponse) GetHealth() []*ChairHealth {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+// GetChairHealthRequest is a request to get the health of a chair
+type GetChairHealthRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Port string `protobuf:"bytes,1,opt,name=port,proto3" json:"port,omitempty"` // the id of the chair, the upd port if it has no stable id
+}
+
+func (x *GetChairHealthRequest) Reset() {
+	*x = GetChairHealthRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chairs_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChairHealthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChairHealthRequest) ProtoMessage() {}
+
+func (x *GetChairHealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chairs_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChairHealthRequest.ProtoReflect.Descriptor instead.
+func (*GetChairHealthRequest) Descriptor() ([]byte, []int) {
+	return file_chairs_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetChairHealthRequest) GetPort() string {
+	if x != nil {
+		return x.Port
+	}
+	return ""
+}
+
+// GetChairHealthResponse is a response to a GetChairHealthRequest
+type GetChairHealthResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Health *ChairHealth `protobuf:"bytes,1,opt,name=health,proto3" json:"health,omitempty"`
+}
+
+func (x *GetChairHealthResponse) Reset() {
+	*x = GetChairHealthResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chairs_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChairHealthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChairHealthResponse) ProtoMessage() {}
+
+func (x *GetChairHealthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chairs_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChairHealthResponse.ProtoReflect.Descriptor instead.
+func (*GetChairHealthResponse) Descriptor() ([]byte, []int) {
+	return file_chairs_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetChairHealthResponse) GetHealth() *ChairHealth {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+// ChairHealth is the state of the listener of a chair and the datagrams it received
+type ChairHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`      // the id of the chair
+	Port       int32              `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"` // the upd port of the chair, 0 if it only receives on the shared port
+	State      ChairListenerState `protobuf:"varint,3,opt,name=state,proto3,enum=chairs.v1.ChairListenerState" json:"state,omitempty"`
+	Error      string             `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`                              // the last error of binding or reading the port, empty once it is bound again
+	Restarts   uint32             `protobuf:"varint,5,opt,name=restarts,proto3" json:"restarts,omitempty"`                       // how many times the port is bound again after a failure
+	NextRetry  int64              `protobuf:"varint,6,opt,name=next_retry,json=nextRetry,proto3" json:"next_retry,omitempty"`    // unix milliseconds the port is bound again, 0 unless failed
+	Packets    uint64             `protobuf:"varint,7,opt,name=packets,proto3" json:"packets,omitempty"`                         // the number of datagrams received
+	LastPacket int64              `protobuf:"varint,8,opt,name=last_packet,json=lastPacket,proto3" json:"last_packet,omitempty"` // unix milliseconds of the last datagram, 0 if none are received yet
+}
+
+func (x *ChairHealth) Reset() {
+	*x = ChairHealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chairs_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChairHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChairHealth) ProtoMessage() {}
+
+func (x *ChairHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_chairs_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChairHealth.ProtoReflect.Descriptor instead.
+func (*ChairHealth) Descriptor() ([]byte, []int) {
+	return file_chairs_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ChairHealth) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChairHealth) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *ChairHealth) GetState() ChairListenerState {
+	if x != nil {
+		return x.State
+	}
+	return ChairListenerState_CHAIR_LISTENER_STATE_UNSPECIFIED
+}
+
+func (x *ChairHealth) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ChairHealth) GetRestarts() uint32 {
+	if x != nil {
+		return x.Restarts
+	}
+	return 0
+}
+
+func (x *ChairHealth) GetNextRetry() int64 {
+	if x != nil {
+		return x.NextRetry
+	}
+	return 0
+}
+
+func (x *ChairHealth) GetPackets() uint64 {
+	if x != nil {
+		return x.Packets
+	}
+	return 0
+}
+
+func (x *ChairHealth) GetLastPacket() int64 {
+	if x != nil {
+		return x.LastPacket
+	}
+	return 0
+}
+
+var File_chairs_proto protoreflect.FileDescriptor
+
+var file_chairs_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x63, 0x68, 0x61, 0x69, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09,
+	0x63, 0x68, 0x61, 0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x22, 0x3c, 0x0a, 0x12, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x26, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x63, 0x68, 0x61, 0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x72,
+	0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x72, 0x22, 0x3d, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x43, 0x68, 0x61, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26,
+	0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x63, 0x68, 0x61, 0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x72, 0x52,
+	0x05, 0x63, 0x68, 0x61, 0x69, 0x72, 0x22, 0x25, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61,
+	0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x3a, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x26, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61,
+	0x69, 0x72, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x72, 0x22, 0x3c, 0x0a, 0x12, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x26, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x63, 0x68, 0x61, 0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x72,
+	0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x72, 0x22, 0x3d, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x43, 0x68, 0x61, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26,
+	0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x63, 0x68, 0x61, 0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x72, 0x52,
+	0x05, 0x63, 0x68, 0x61, 0x69, 0x72, 0x22, 0x13, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68,
+	0x61, 0x69, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3e, 0x0a, 0x12, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x69, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x28, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x69, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68,
+	0x61, 0x69, 0x72, 0x52, 0x06, 0x63, 0x68, 0x61, 0x69, 0x72, 0x73, 0x22, 0x28, 0x0a, 0x12, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x3d, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
+	0x68, 0x61, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x05,
+	0x63, 0x68, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x68,
+	0x61, 0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x72, 0x52, 0x05, 0x63,
+	0x68, 0x61, 0x69, 0x72, 0x22, 0x71, 0x0a, 0x05, 0x43, 0x68, 0x61, 0x69, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x2d, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x68,
+	0x61, 0x69, 0x72, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x4f, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61,
+	0x69, 0x72, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x72, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x68, 0x61, 0x69, 0x72, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x08, 0x66,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x73, 0x22, 0x62, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x43, 0x68,
+	0x61, 0x69, 0x72, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x33, 0x0a, 0x08, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72,
+	0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x72,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x72, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72,
+	0x64, 0x52, 0x08, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x73, 0x22, 0x4f, 0x0a, 0x18, 0x53,
+	0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x72, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x66, 0x6f, 0x72, 0x77, 0x61,
+	0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x61, 0x69,
+	0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x72, 0x46, 0x6f, 0x72, 0x77, 0x61,
+	0x72, 0x64, 0x52, 0x08, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x73, 0x22, 0x62, 0x0a, 0x0c,
+	0x43, 0x68, 0x61, 0x69, 0x72, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x09, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x49, 0x64, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x61, 0x74,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x52, 0x61, 0x74, 0x65,
+	0x22, 0x1a, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43,
+	0x68, 0x61, 0x69, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4e, 0x0a, 0x19,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x69, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x61,
+	0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x68,
+	0x61, 0x69, 0x72, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x97, 0x01, 0x0a,
+	0x18, 0x41, 0x64, 0x6f, 0x70, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61,
+	0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x13, 0x0a, 0x05, 0x62, 0x79, 0x5f, 0x69, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x04, 0x62, 0x79, 0x49, 0x70, 0x22, 0x43, 0x0a, 0x19, 0x41, 0x64, 0x6f, 0x70, 0x74, 0x50,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x68, 0x61, 0x69, 0x72, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x72, 0x22, 0x34, 0x0a, 0x1a, 0x44,
+	0x69, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61,
+	0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x22, 0x1d, 0x0a, 0x1b, 0x44, 0x69, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x50, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0xa4, 0x02, 0x0a, 0x0c, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x69,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x79, 0x65, 0x61, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x67, 0x61, 0x6d, 0x65, 0x59, 0x65, 0x61, 0x72, 0x12,
+	0x1f, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x69, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55, 0x69,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c,
+	0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x22, 0x18, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x68, 0x61, 0x69, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x49, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x69, 0x72, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63,
+	0x68, 0x61, 0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x72, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x22, 0x2b, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x48, 0x0a, 0x16, 0x47, 0x65, 0x74,
+	0x43, 0x68, 0x61, 0x69, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x68, 0x61, 0x69, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x06, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x22, 0xf2, 0x01, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x69, 0x72, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x33, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x72, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x65, 0x74, 0x72, 0x79, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x61,
+	0x73, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x2a, 0xe5, 0x01, 0x0a, 0x12, 0x43, 0x68, 0x61,
+	0x69, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x24, 0x0a, 0x20, 0x43, 0x48, 0x41, 0x49, 0x52, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x45, 0x4e, 0x45,
+	0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x43, 0x48, 0x41, 0x49, 0x52, 0x5f, 0x4c,
+	0x49, 0x53, 0x54, 0x45, 0x4e, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x54,
+	0x41, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e, 0x43, 0x48, 0x41, 0x49,
+	0x52, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x45, 0x4e, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x4c, 0x49, 0x53, 0x54, 0x45, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e,
+	0x43, 0x48, 0x41, 0x49, 0x52, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x45, 0x4e, 0x45, 0x52, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x49, 0x4e, 0x47, 0x10, 0x03,
+	0x12, 0x1d, 0x0a, 0x19, 0x43, 0x48, 0x41, 0x49, 0x52, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x45, 0x4e,
+	0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x44, 0x4c, 0x45, 0x10, 0x04, 0x12,
+	0x1f, 0x0a, 0x1b, 0x43, 0x48, 0x41, 0x49, 0x52, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x45, 0x4e, 0x45,
+	0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x05,
+	0x32, 0x99, 0x08, 0x0a, 0x0c, 0x43, 0x68, 0x61, 0x69, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x4c, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69, 0x72,
+	0x12, 0x1d, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x43, 0x68, 0x61, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x43, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x72, 0x12, 0x1a, 0x2e, 0x63, 0x68,
+	0x61, 0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x72, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x69,
+	0x72, 0x73, 0x12, 0x1c, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x69, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x68, 0x61, 0x69, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4c, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69, 0x72, 0x12, 0x1d,
+	0x2e, 0x63, 0x68, 0x61, 0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x43, 0x68, 0x61, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
+	0x63, 0x68, 0x61, 0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x43, 0x68, 0x61, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a,
+	0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69, 0x72, 0x12, 0x1d, 0x2e, 0x63,
+	0x68, 0x61, 0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
+	0x68, 0x61, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x68,
+	0x61, 0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68,
+	0x61, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x72, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12,
+	0x22, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x68, 0x61, 0x69, 0x72, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x72, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x43,
+	0x68, 0x61, 0x69, 0x72, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x22, 0x2e, 0x63,
+	0x68, 0x61, 0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69,
+	0x72, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74,
+	0x43, 0x68, 0x61, 0x69, 0x72, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x69, 0x72, 0x73, 0x12, 0x23, 0x2e, 0x63, 0x68, 0x61,
+	0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x43, 0x68, 0x61, 0x69, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x24, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x69, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x11, 0x41, 0x64, 0x6f, 0x70, 0x74, 0x50, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x69, 0x72, 0x12, 0x23, 0x2e, 0x63, 0x68, 0x61,
+	0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x6f, 0x70, 0x74, 0x50, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x24, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x6f, 0x70,
+	0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x69, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x13, 0x44, 0x69, 0x73, 0x6d, 0x69, 0x73, 0x73,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x69, 0x72, 0x12, 0x25, 0x2e, 0x63,
+	0x68, 0x61, 0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x6d, 0x69, 0x73, 0x73,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x69, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x68,
+	0x61, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0f, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x69, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x21,
+	0x2e, 0x63, 0x68, 0x61, 0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x68, 0x61, 0x69, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x68, 0x61, 0x69, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69,
+	0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x20, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x72, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x72, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x68, 0x61, 0x69,
+	0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x72, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0c, 0x5a, 0x0a,
+	0x2e, 0x3b, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_chairs_proto_rawDescOnce sync.Once
+	file_chairs_proto_rawDescData = file_chairs_proto_rawDesc
+)
+
+func file_chairs_proto_rawDescGZIP() []byte {
+	file_chairs_proto_rawDescOnce.Do(func() {
+		file_chairs_proto_rawDescData = protoimpl.X.CompressGZIP(file_chairs_proto_rawDescData)
+	})
+	return file_chairs_proto_rawDescData
+}
+
+var file_chairs_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_chairs_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_chairs_proto_goTypes = []interface{}{
+	(ChairListenerState)(0),             // 0: chairs.v1.ChairListenerState
+	(*CreateChairRequest)(nil),          // 1: chairs.v1.CreateChairRequest
+	(*CreateChairResponse)(nil),         // 2: chairs.v1.CreateChairResponse
+	(*GetChairRequest)(nil),             // 3: chairs.v1.GetChairRequest
+	(*GetChairResponse)(nil),            // 4: chairs.v1.GetChairResponse
+	(*UpdateChairRequest)(nil),          // 5: chairs.v1.UpdateChairRequest
+	(*UpdateChairResponse)(nil),         // 6: chairs.v1.UpdateChairResponse
+	(*ListChairsRequest)(nil),           // 7: chairs.v1.ListChairsRequest
+	(*ListChairsResponse)(nil),          // 8: chairs.v1.ListChairsResponse
+	(*DeleteChairRequest)(nil),          // 9: chairs.v1.DeleteChairRequest
+	(*DeleteChairResponse)(nil),         // 10: chairs.v1.DeleteChairResponse
+	(*Chair)(nil),                       // 11: chairs.v1.Chair
+	(*GetChairForwardsRequest)(nil),     // 12: chairs.v1.GetChairForwardsRequest
+	(*GetChairForwardsResponse)(nil),    // 13: chairs.v1.GetChairForwardsResponse
+	(*SetChairForwardsRequest)(nil),     // 14: chairs.v1.SetChairForwardsRequest
+	(*SetChairForwardsResponse)(nil),    // 15: chairs.v1.SetChairForwardsResponse
+	(*ChairForward)(nil),                // 16: chairs.v1.ChairForward
+	(*ListPendingChairsRequest)(nil),    // 17: chairs.v1.ListPendingChairsRequest
+	(*ListPendingChairsResponse)(nil),   // 18: chairs.v1.ListPendingChairsResponse
+	(*AdoptPendingChairRequest)(nil),    // 19: chairs.v1.AdoptPendingChairRequest
+	(*AdoptPendingChairResponse)(nil),   // 20: chairs.v1.AdoptPendingChairResponse
+	(*DismissPendingChairRequest)(nil),  // 21: chairs.v1.DismissPendingChairRequest
+	(*DismissPendingChairResponse)(nil), // 22: chairs.v1.DismissPendingChairResponse
+	(*PendingChair)(nil),                // 23: chairs.v1.PendingChair
+	(*ListChairHealthRequest)(nil),      // 24: chairs.v1.ListChairHealthRequest
+	(*ListChairHealthResponse)(nil),     // 25: chairs.v1.ListChairHealthResponse
+	(*GetChairHealthRequest)(nil),       // 26: chairs.v1.GetChairHealthRequest
+	(*GetChairHealthResponse)(nil),      // 27: chairs.v1.GetChairHealthResponse
+	(*ChairHealth)(nil),                 // 28: chairs.v1.ChairHealth
+}
+var file_chairs_proto_depIdxs = []int32{
+	11, // 0: chairs.v1.CreateChairRequest.chair:type_name -> chairs.v1.Chair
+	11, // 1: chairs.v1.CreateChairResponse.chair:type_name -> chairs.v1.Chair
+	11, // 2: chairs.v1.GetChairResponse.chair:type_name -> chairs.v1.Chair
+	11, // 3: chairs.v1.UpdateChairRequest.chair:type_name -> chairs.v1.Chair
+	11, // 4: chairs.v1.UpdateChairResponse.chair:type_name -> chairs.v1.Chair
+	11, // 5: chairs.v1.ListChairsResponse.chairs:type_name -> chairs.v1.Chair
+	11, // 6: chairs.v1.DeleteChairResponse.chair:type_name -> chairs.v1.Chair
+	16, // 7: chairs.v1.GetChairForwardsResponse.forwards:type_name -> chairs.v1.ChairForward
+	16, // 8: chairs.v1.SetChairForwardsRequest.forwards:type_name -> chairs.v1.ChairForward
+	16, // 9: chairs.v1.SetChairForwardsResponse.forwards:type_name -> chairs.v1.ChairForward
+	23, // 10: chairs.v1.ListPendingChairsResponse.pending:type_name -> chairs.v1.PendingChair
+	11, // 11: chairs.v1.AdoptPendingChairResponse.chair:type_name -> chairs.v1.Chair
+	28, // 12: chairs.v1.ListChairHealthResponse.health:type_name -> chairs.v1.ChairHealth
+	28, // 13: chairs.v1.GetChairHealthResponse.health:type_name -> chairs.v1.ChairHealth
+	0,  // 14: chairs.v1.ChairHealth.state:type_name -> chairs.v1.ChairListenerState
+	1,  // 15: chairs.v1.ChairService.CreateChair:input_type -> chairs.v1.CreateChairRequest
+	3,  // 16: chairs.v1.ChairService.GetChair:input_type -> chairs.v1.GetChairRequest
+	7,  // 17: chairs.v1.ChairService.ListChairs:input_type -> chairs.v1.ListChairsRequest
+	5,  // 18: chairs.v1.ChairService.UpdateChair:input_type -> chairs.v1.UpdateChairRequest
+	9,  // 19: chairs.v1.ChairService.DeleteChair:input_type -> chairs.v1.DeleteChairRequest
+	12, // 20: chairs.v1.ChairService.GetChairForwards:input_type -> chairs.v1.GetChairForwardsRequest
+	14, // 21: chairs.v1.ChairService.SetChairForwards:input_type -> chairs.v1.SetChairForwardsRequest
+	17, // 22: chairs.v1.ChairService.ListPendingChairs:input_type -> chairs.v1.ListPendingChairsRequest
+	19, // 23: chairs.v1.ChairService.AdoptPendingChair:input_type -> chairs.v1.AdoptPendingChairRequest
+	21, // 24: chairs.v1.ChairService.DismissPendingChair:input_type -> chairs.v1.DismissPendingChairRequest
+	24, // 25: chairs.v1.ChairService.ListChairHealth:input_type -> chairs.v1.ListChairHealthRequest
+	26, // 26: chairs.v1.ChairService.GetChairHealth:input_type -> chairs.v1.GetChairHealthRequest
+	2,  // 27: chairs.v1.ChairService.CreateChair:output_type -> chairs.v1.CreateChairResponse
+	4,  // 28: chairs.v1.ChairService.GetChair:output_type -> chairs.v1.GetChairResponse
+	8,  // 29: chairs.v1.ChairService.ListChairs:output_type -> chairs.v1.ListChairsResponse
+	6,  // 30: chairs.v1.ChairService.UpdateChair:output_type -> chairs.v1.UpdateChairResponse
+	10, // 31: chairs.v1.ChairService.DeleteChair:output_type -> chairs.v1.DeleteChairResponse
+	13, // 32: chairs.v1.ChairService.GetChairForwards:output_type -> chairs.v1.GetChairForwardsResponse
+	15, // 33: chairs.v1.ChairService.SetChairForwards:output_type -> chairs.v1.SetChairForwardsResponse
+	18, // 34: chairs.v1.ChairService.ListPendingChairs:output_type -> chairs.v1.ListPendingChairsResponse
+	20, // 35: chairs.v1.ChairService.AdoptPendingChair:output_type -> chairs.v1.AdoptPendingChairResponse
+	22, // 36: chairs.v1.ChairService.DismissPendingChair:output_type -> chairs.v1.DismissPendingChairResponse
+	25, // 37: chairs.v1.ChairService.ListChairHealth:output_type -> chairs.v1.ListChairHealthResponse
+	27, // 38: chairs.v1.ChairService.GetChairHealth:output_type -> chairs.v1.GetChairHealthResponse
+	27, // [27:39] is the sub-list for method output_type
+	15, // [15:27] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_chairs_proto_init() }
+func file_chairs_proto_init() {
+	if File_chairs_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_chairs_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateChairRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chairs_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateChairResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chairs_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChairRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chairs_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChairResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chairs_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateChairRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
 				return &v.unknownFields
 			default:
 				return nil
@@ -817,19 +2075,224 @@ func file_chairs_proto_init() {
 				return nil
 			}
 		}
+		file_chairs_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChairForwardsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chairs_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChairForwardsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chairs_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetChairForwardsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chairs_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetChairForwardsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chairs_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChairForward); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chairs_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPendingChairsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chairs_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPendingChairsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chairs_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdoptPendingChairRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chairs_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdoptPendingChairResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chairs_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DismissPendingChairRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chairs_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DismissPendingChairResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chairs_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingChair); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chairs_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListChairHealthRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chairs_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListChairHealthResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chairs_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChairHealthRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chairs_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChairHealthResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chairs_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChairHealth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_chairs_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   11,
+			NumEnums:      1,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_chairs_proto_goTypes,
 		DependencyIndexes: file_chairs_proto_depIdxs,
+		EnumInfos:         file_chairs_proto_enumTypes,
 		MessageInfos:      file_chairs_proto_msgTypes,
 	}.Build()
 	File_chairs_proto = out.File
@@ -32,6 +32,20 @@ type ChairServiceClient interface {
 	UpdateChair(ctx context.Context, in *UpdateChairRequest, opts ...grpc.CallOption) (*UpdateChairResponse, error)
 	// DeleteChair deletes a chair
 	DeleteChair(ctx context.Context, in *DeleteChairRequest, opts ...grpc.CallOption) (*DeleteChairResponse, error)
+	// GetChairForwards gets the destinations the datagrams of a chair are re-sent to
+	GetChairForwards(ctx context.Context, in *GetChairForwardsRequest, opts ...grpc.CallOption) (*GetChairForwardsResponse, error)
+	// SetChairForwards replaces the destinations the datagrams of a chair are re-sent to. Can only be an admin
+	SetChairForwards(ctx context.Context, in *SetChairForwardsRequest, opts ...grpc.CallOption) (*SetChairForwardsResponse, error)
+	// ListPendingChairs lists the rigs that send datagrams that are not routed to a chair. Can only be an admin
+	ListPendingChairs(ctx context.Context, in *ListPendingChairsRequest, opts ...grpc.CallOption) (*ListPendingChairsResponse, error)
+	// AdoptPendingChair creates a chair that the datagrams of a pending chair are routed to. Can only be an admin
+	AdoptPendingChair(ctx context.Context, in *AdoptPendingChairRequest, opts ...grpc.CallOption) (*AdoptPendingChairResponse, error)
+	// DismissPendingChair forgets a pending chair until it sends datagrams again. Can only be an admin
+	DismissPendingChair(ctx context.Context, in *DismissPendingChairRequest, opts ...grpc.CallOption) (*DismissPendingChairResponse, error)
+	// ListChairHealth lists the state of the listeners of all chairs, to see which rig stopped sending
+	ListChairHealth(ctx context.Context, in *ListChairHealthRequest, opts ...grpc.CallOption) (*ListChairHealthResponse, error)
+	// GetChairHealth gets the state of the listener of a chair
+	GetChairHealth(ctx context.Context, in *GetChairHealthRequest, opts ...grpc.CallOption) (*GetChairHealthResponse, error)
 }
 
 type chairServiceClient struct {
@@ -87,6 +101,69 @@ func (c *chairServiceClient) DeleteChair(ctx context.Context, in *DeleteChairReq
 	return out, nil
 }
 
+func (c *chairServiceClient) GetChairForwards(ctx context.Context, in *GetChairForwardsRequest, opts ...grpc.CallOption) (*GetChairForwardsResponse, error) {
+	out := new(GetChairForwardsResponse)
+	err := c.cc.Invoke(ctx, "/chairs.v1.ChairService/GetChairForwards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chairServiceClient) SetChairForwards(ctx context.Context, in *SetChairForwardsRequest, opts ...grpc.CallOption) (*SetChairForwardsResponse, error) {
+	out := new(SetChairForwardsResponse)
+	err := c.cc.Invoke(ctx, "/chairs.v1.ChairService/SetChairForwards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chairServiceClient) ListPendingChairs(ctx context.Context, in *ListPendingChairsRequest, opts ...grpc.CallOption) (*ListPendingChairsResponse, error) {
+	out := new(ListPendingChairsResponse)
+	err := c.cc.Invoke(ctx, "/chairs.v1.ChairService/ListPendingChairs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chairServiceClient) AdoptPendingChair(ctx context.Context, in *AdoptPendingChairRequest, opts ...grpc.CallOption) (*AdoptPendingChairResponse, error) {
+	out := new(AdoptPendingChairResponse)
+	err := c.cc.Invoke(ctx, "/chairs.v1.ChairService/AdoptPendingChair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chairServiceClient) DismissPendingChair(ctx context.Context, in *DismissPendingChairRequest, opts ...grpc.CallOption) (*DismissPendingChairResponse, error) {
+	out := new(DismissPendingChairResponse)
+	err := c.cc.Invoke(ctx, "/chairs.v1.ChairService/DismissPendingChair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chairServiceClient) ListChairHealth(ctx context.Context, in *ListChairHealthRequest, opts ...grpc.CallOption) (*ListChairHealthResponse, error) {
+	out := new(ListChairHealthResponse)
+	err := c.cc.Invoke(ctx, "/chairs.v1.ChairService/ListChairHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chairServiceClient) GetChairHealth(ctx context.Context, in *GetChairHealthRequest, opts ...grpc.CallOption) (*GetChairHealthResponse, error) {
+	out := new(GetChairHealthResponse)
+	err := c.cc.Invoke(ctx, "/chairs.v1.ChairService/GetChairHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ChairServiceServer is the server API for ChairService service.
 // All implementations must embed UnimplementedChairServiceServer
 // for forward compatibility
@@ -101,6 +178,20 @@ type ChairServiceServer interface {
 	UpdateChair(context.Context, *UpdateChairRequest) (*UpdateChairResponse, error)
 	// DeleteChair deletes a chair
 	DeleteChair(context.Context, *DeleteChairRequest) (*DeleteChairResponse, error)
+	// GetChairForwards gets the destinations the datagrams of a chair are re-sent to
+	GetChairForwards(context.Context, *GetChairForwardsRequest) (*GetChairForwardsResponse, error)
+	// SetChairForwards replaces the destinations the datagrams of a chair are re-sent to. Can only be an admin
+	SetChairForwards(context.Context, *SetChairForwardsRequest) (*SetChairForwardsResponse, error)
+	// ListPendingChairs lists the rigs that send datagrams that are not routed to a chair. Can only be an admin
+	ListPendingChairs(context.Context, *ListPendingChairsRequest) (*ListPendingChairsResponse, error)
+	// AdoptPendingChair creates a chair that the datagrams of a pending chair are routed to. Can only be an admin
+	AdoptPendingChair(context.Context, *AdoptPendingChairRequest) (*AdoptPendingChairResponse, error)
+	// DismissPendingChair forgets a pending chair until it sends datagrams again. Can only be an admin
+	DismissPendingChair(context.Context, *DismissPendingChairRequest) (*DismissPendingChairResponse, error)
+	// ListChairHealth lists the state of the listeners of all chairs, to see which rig stopped sending
+	ListChairHealth(context.Context, *ListChairHealthRequest) (*ListChairHealthResponse, error)
+	// GetChairHealth gets the state of the listener of a chair
+	GetChairHealth(context.Context, *GetChairHealthRequest) (*GetChairHealthResponse, error)
 	mustEmbedUnimplementedChairServiceServer()
 }
 
@@ -123,6 +214,27 @@ func (UnimplementedChairServiceServer) UpdateChair(context.Context, *UpdateChair
 func (UnimplementedChairServiceServer) DeleteChair(context.Context, *DeleteChairRequest) (*DeleteChairResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteChair not implemented")
 }
+func (UnimplementedChairServiceServer) GetChairForwards(context.Context, *GetChairForwardsRequest) (*GetChairForwardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChairForwards not implemented")
+}
+func (UnimplementedChairServiceServer) SetChairForwards(context.Context, *SetChairForwardsRequest) (*SetChairForwardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetChairForwards not implemented")
+}
+func (UnimplementedChairServiceServer) ListPendingChairs(context.Context, *ListPendingChairsRequest) (*ListPendingChairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingChairs not implemented")
+}
+func (UnimplementedChairServiceServer) AdoptPendingChair(context.Context, *AdoptPendingChairRequest) (*AdoptPendingChairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdoptPendingChair not implemented")
+}
+func (UnimplementedChairServiceServer) DismissPendingChair(context.Context, *DismissPendingChairRequest) (*DismissPendingChairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DismissPendingChair not implemented")
+}
+func (UnimplementedChairServiceServer) ListChairHealth(context.Context, *ListChairHealthRequest) (*ListChairHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChairHealth not implemented")
+}
+func (UnimplementedChairServiceServer) GetChairHealth(context.Context, *GetChairHealthRequest) (*GetChairHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChairHealth not implemented")
+}
 func (UnimplementedChairServiceServer) mustEmbedUnimplementedChairServiceServer() {}
 
 // UnsafeChairServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -226,6 +338,132 @@ func _ChairService_DeleteChair_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChairService_GetChairForwards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChairForwardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChairServiceServer).GetChairForwards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chairs.v1.ChairService/GetChairForwards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChairServiceServer).GetChairForwards(ctx, req.(*GetChairForwardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChairService_SetChairForwards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetChairForwardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChairServiceServer).SetChairForwards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chairs.v1.ChairService/SetChairForwards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChairServiceServer).SetChairForwards(ctx, req.(*SetChairForwardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChairService_ListPendingChairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingChairsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChairServiceServer).ListPendingChairs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chairs.v1.ChairService/ListPendingChairs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChairServiceServer).ListPendingChairs(ctx, req.(*ListPendingChairsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChairService_AdoptPendingChair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdoptPendingChairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChairServiceServer).AdoptPendingChair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chairs.v1.ChairService/AdoptPendingChair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChairServiceServer).AdoptPendingChair(ctx, req.(*AdoptPendingChairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChairService_DismissPendingChair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DismissPendingChairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChairServiceServer).DismissPendingChair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chairs.v1.ChairService/DismissPendingChair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChairServiceServer).DismissPendingChair(ctx, req.(*DismissPendingChairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChairService_ListChairHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChairHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChairServiceServer).ListChairHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chairs.v1.ChairService/ListChairHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChairServiceServer).ListChairHealth(ctx, req.(*ListChairHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChairService_GetChairHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChairHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChairServiceServer).GetChairHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chairs.v1.ChairService/GetChairHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChairServiceServer).GetChairHealth(ctx, req.(*GetChairHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ChairService_ServiceDesc is the grpc.ServiceDesc for ChairService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -253,6 +491,34 @@ var ChairService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteChair",
 			Handler:    _ChairService_DeleteChair_Handler,
 		},
+		{
+			MethodName: "GetChairForwards",
+			Handler:    _ChairService_GetChairForwards_Handler,
+		},
+		{
+			MethodName: "SetChairForwards",
+			Handler:    _ChairService_SetChairForwards_Handler,
+		},
+		{
+			MethodName: "ListPendingChairs",
+			Handler:    _ChairService_ListPendingChairs_Handler,
+		},
+		{
+			MethodName: "AdoptPendingChair",
+			Handler:    _ChairService_AdoptPendingChair_Handler,
+		},
+		{
+			MethodName: "DismissPendingChair",
+			Handler:    _ChairService_DismissPendingChair_Handler,
+		},
+		{
+			MethodName: "ListChairHealth",
+			Handler:    _ChairService_ListChairHealth_Handler,
+		},
+		{
+			MethodName: "GetChairHealth",
+			Handler:    _ChairService_GetChairHealth_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "chairs.proto",
@@ -0,0 +1,603 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v4.24.1
+// source: ghost.proto
+
+package grpc_gen
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GhostReferenceKind is the kind of lap the live lap is compared with
+type GhostReferenceKind int32
+
+const (
+	GhostReferenceKind_GHOST_REFERENCE_KIND_UNSPECIFIED   GhostReferenceKind = 0
+	GhostReferenceKind_GHOST_REFERENCE_KIND_PERSONAL_BEST GhostReferenceKind = 1 // the fastest valid lap of the driver on the track
+	GhostReferenceKind_GHOST_REFERENCE_KIND_TRACK_RECORD  GhostReferenceKind = 2 // the fastest valid lap of anyone on the track
+)
+
+// Enum value maps for GhostReferenceKind.
+var (
+	GhostReferenceKind_name = map[int32]string{
+		0: "GHOST_REFERENCE_KIND_UNSPECIFIED",
+		1: "GHOST_REFERENCE_KIND_PERSONAL_BEST",
+		2: "GHOST_REFERENCE_KIND_TRACK_RECORD",
+	}
+	GhostReferenceKind_value = map[string]int32{
+		"GHOST_REFERENCE_KIND_UNSPECIFIED":   0,
+		"GHOST_REFERENCE_KIND_PERSONAL_BEST": 1,
+		"GHOST_REFERENCE_KIND_TRACK_RECORD":  2,
+	}
+)
+
+func (x GhostReferenceKind) Enum() *GhostReferenceKind {
+	p := new(GhostReferenceKind)
+	*p = x
+	return p
+}
+
+func (x GhostReferenceKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GhostReferenceKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_ghost_proto_enumTypes[0].Descriptor()
+}
+
+func (GhostReferenceKind) Type() protoreflect.EnumType {
+	return &file_ghost_proto_enumTypes[0]
+}
+
+func (x GhostReferenceKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GhostReferenceKind.Descriptor instead.
+func (GhostReferenceKind) EnumDescriptor() ([]byte, []int) {
+	return file_ghost_proto_rawDescGZIP(), []int{0}
+}
+
+// GetGhostReferenceRequest is a request to get the reference lap of a chair
+type GetGhostReferenceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Port string `protobuf:"bytes,1,opt,name=port,proto3" json:"port,omitempty"` // the upd port of the chair
+}
+
+func (x *GetGhostReferenceRequest) Reset() {
+	*x = GetGhostReferenceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ghost_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGhostReferenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGhostReferenceRequest) ProtoMessage() {}
+
+func (x *GetGhostReferenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ghost_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGhostReferenceRequest.ProtoReflect.Descriptor instead.
+func (*GetGhostReferenceRequest) Descriptor() ([]byte, []int) {
+	return file_ghost_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetGhostReferenceRequest) GetPort() string {
+	if x != nil {
+		return x.Port
+	}
+	return ""
+}
+
+// GetGhostReferenceResponse is a response to a GetGhostReferenceRequest
+type GetGhostReferenceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reference *GhostReference `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
+}
+
+func (x *GetGhostReferenceResponse) Reset() {
+	*x = GetGhostReferenceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ghost_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGhostReferenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGhostReferenceResponse) ProtoMessage() {}
+
+func (x *GetGhostReferenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ghost_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGhostReferenceResponse.ProtoReflect.Descriptor instead.
+func (*GetGhostReferenceResponse) Descriptor() ([]byte, []int) {
+	return file_ghost_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetGhostReferenceResponse) GetReference() *GhostReference {
+	if x != nil {
+		return x.Reference
+	}
+	return nil
+}
+
+// WatchDeltasRequest is a request to watch the live deltas
+type WatchDeltasRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Port string `protobuf:"bytes,1,opt,name=port,proto3" json:"port,omitempty"` // the upd port of the chair, empty for all chairs
+}
+
+func (x *WatchDeltasRequest) Reset() {
+	*x = WatchDeltasRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ghost_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchDeltasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchDeltasRequest) ProtoMessage() {}
+
+func (x *WatchDeltasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ghost_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchDeltasRequest.ProtoReflect.Descriptor instead.
+func (*WatchDeltasRequest) Descriptor() ([]byte, []int) {
+	return file_ghost_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WatchDeltasRequest) GetPort() string {
+	if x != nil {
+		return x.Port
+	}
+	return ""
+}
+
+// GhostReference is the lap the live lap is compared with
+type GhostReference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind        GhostReferenceKind `protobuf:"varint,1,opt,name=kind,proto3,enum=ghost.v1.GhostReferenceKind" json:"kind,omitempty"`
+	SessionId   string             `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // the id of the session in the lap history
+	LapNumber   uint32             `protobuf:"varint,3,opt,name=lap_number,json=lapNumber,proto3" json:"lap_number,omitempty"`
+	LapTimeInMs uint32             `protobuf:"varint,4,opt,name=lap_time_in_ms,json=lapTimeInMs,proto3" json:"lap_time_in_ms,omitempty"`
+	DriverId    string             `protobuf:"bytes,5,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"` // the driver that was checked in, empty if none
+	Driver      string             `protobuf:"bytes,6,opt,name=driver,proto3" json:"driver,omitempty"`                     // the display name of the driver, or the name of the player in the game
+}
+
+func (x *GhostReference) Reset() {
+	*x = GhostReference{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ghost_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GhostReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GhostReference) ProtoMessage() {}
+
+func (x *GhostReference) ProtoReflect() protoreflect.Message {
+	mi := &file_ghost_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GhostReference.ProtoReflect.Descriptor instead.
+func (*GhostReference) Descriptor() ([]byte, []int) {
+	return file_ghost_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GhostReference) GetKind() GhostReferenceKind {
+	if x != nil {
+		return x.Kind
+	}
+	return GhostReferenceKind_GHOST_REFERENCE_KIND_UNSPECIFIED
+}
+
+func (x *GhostReference) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *GhostReference) GetLapNumber() uint32 {
+	if x != nil {
+		return x.LapNumber
+	}
+	return 0
+}
+
+func (x *GhostReference) GetLapTimeInMs() uint32 {
+	if x != nil {
+		return x.LapTimeInMs
+	}
+	return 0
+}
+
+func (x *GhostReference) GetDriverId() string {
+	if x != nil {
+		return x.DriverId
+	}
+	return ""
+}
+
+func (x *GhostReference) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+// GhostDelta is how far the player on a chair is behind the reference, at the distance into the current lap
+type GhostDelta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Port               string          `protobuf:"bytes,1,opt,name=port,proto3" json:"port,omitempty"` // the upd port of the chair
+	SessionUid         uint64          `protobuf:"varint,2,opt,name=session_uid,json=sessionUid,proto3" json:"session_uid,omitempty"`
+	LapNumber          uint32          `protobuf:"varint,3,opt,name=lap_number,json=lapNumber,proto3" json:"lap_number,omitempty"`
+	LapDistance        float32         `protobuf:"fixed32,4,opt,name=lap_distance,json=lapDistance,proto3" json:"lap_distance,omitempty"` // meters
+	CurrentLapTimeInMs uint32          `protobuf:"varint,5,opt,name=current_lap_time_in_ms,json=currentLapTimeInMs,proto3" json:"current_lap_time_in_ms,omitempty"`
+	Delta              float32         `protobuf:"fixed32,6,opt,name=delta,proto3" json:"delta,omitempty"` // seconds behind the reference, negative when ahead
+	Reference          *GhostReference `protobuf:"bytes,7,opt,name=reference,proto3" json:"reference,omitempty"`
+	Received           int64           `protobuf:"varint,8,opt,name=received,proto3" json:"received,omitempty"` // unix milliseconds
+}
+
+func (x *GhostDelta) Reset() {
+	*x = GhostDelta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ghost_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GhostDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GhostDelta) ProtoMessage() {}
+
+func (x *GhostDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_ghost_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GhostDelta.ProtoReflect.Descriptor instead.
+func (*GhostDelta) Descriptor() ([]byte, []int) {
+	return file_ghost_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GhostDelta) GetPort() string {
+	if x != nil {
+		return x.Port
+	}
+	return ""
+}
+
+func (x *GhostDelta) GetSessionUid() uint64 {
+	if x != nil {
+		return x.SessionUid
+	}
+	return 0
+}
+
+func (x *GhostDelta) GetLapNumber() uint32 {
+	if x != nil {
+		return x.LapNumber
+	}
+	return 0
+}
+
+func (x *GhostDelta) GetLapDistance() float32 {
+	if x != nil {
+		return x.LapDistance
+	}
+	return 0
+}
+
+func (x *GhostDelta) GetCurrentLapTimeInMs() uint32 {
+	if x != nil {
+		return x.CurrentLapTimeInMs
+	}
+	return 0
+}
+
+func (x *GhostDelta) GetDelta() float32 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *GhostDelta) GetReference() *GhostReference {
+	if x != nil {
+		return x.Reference
+	}
+	return nil
+}
+
+func (x *GhostDelta) GetReceived() int64 {
+	if x != nil {
+		return x.Received
+	}
+	return 0
+}
+
+var File_ghost_proto protoreflect.FileDescriptor
+
+var file_ghost_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x67, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x67,
+	0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x22, 0x2e, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x47, 0x68,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x53, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x47, 0x68,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x68, 0x6f, 0x73, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x68, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x28, 0x0a, 0x12,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0xda, 0x01, 0x0a, 0x0e, 0x47, 0x68, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x67, 0x68, 0x6f, 0x73, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x68, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61,
+	0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x6c, 0x61, 0x70, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0e, 0x6c, 0x61, 0x70,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0b, 0x6c, 0x61, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x4d, 0x73, 0x12, 0x1b,
+	0x0a, 0x09, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x22, 0xa1, 0x02, 0x0a, 0x0a, 0x47, 0x68, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x6c,
+	0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x55, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x70, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6c, 0x61, 0x70,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x70, 0x5f, 0x64, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x6c, 0x61,
+	0x70, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x16, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x61, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x6e,
+	0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x4c, 0x61, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x4d, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x64, 0x65,
+	0x6c, 0x74, 0x61, 0x12, 0x36, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x68, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x2a, 0x89, 0x01, 0x0a, 0x12, 0x47, 0x68, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x24,
+	0x0a, 0x20, 0x47, 0x48, 0x4f, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x46, 0x45, 0x52, 0x45, 0x4e, 0x43,
+	0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x26, 0x0a, 0x22, 0x47, 0x48, 0x4f, 0x53, 0x54, 0x5f, 0x52, 0x45,
+	0x46, 0x45, 0x52, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x50, 0x45, 0x52,
+	0x53, 0x4f, 0x4e, 0x41, 0x4c, 0x5f, 0x42, 0x45, 0x53, 0x54, 0x10, 0x01, 0x12, 0x25, 0x0a, 0x21,
+	0x47, 0x48, 0x4f, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x46, 0x45, 0x52, 0x45, 0x4e, 0x43, 0x45, 0x5f,
+	0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x54, 0x52, 0x41, 0x43, 0x4b, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x52,
+	0x44, 0x10, 0x02, 0x32, 0xb1, 0x01, 0x0a, 0x0c, 0x47, 0x68, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x5c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x47, 0x68, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x22, 0x2e, 0x67, 0x68, 0x6f, 0x73,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x68, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x67, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x68, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x43, 0x0a, 0x0b, 0x57, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x74, 0x61,
+	0x73, 0x12, 0x1c, 0x2e, 0x67, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x14, 0x2e, 0x67, 0x68, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x68, 0x6f, 0x73, 0x74,
+	0x44, 0x65, 0x6c, 0x74, 0x61, 0x30, 0x01, 0x42, 0x0c, 0x5a, 0x0a, 0x2e, 0x3b, 0x67, 0x72, 0x70,
+	0x63, 0x5f, 0x67, 0x65, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ghost_proto_rawDescOnce sync.Once
+	file_ghost_proto_rawDescData = file_ghost_proto_rawDesc
+)
+
+func file_ghost_proto_rawDescGZIP() []byte {
+	file_ghost_proto_rawDescOnce.Do(func() {
+		file_ghost_proto_rawDescData = protoimpl.X.CompressGZIP(file_ghost_proto_rawDescData)
+	})
+	return file_ghost_proto_rawDescData
+}
+
+var file_ghost_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_ghost_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_ghost_proto_goTypes = []interface{}{
+	(GhostReferenceKind)(0),           // 0: ghost.v1.GhostReferenceKind
+	(*GetGhostReferenceRequest)(nil),  // 1: ghost.v1.GetGhostReferenceRequest
+	(*GetGhostReferenceResponse)(nil), // 2: ghost.v1.GetGhostReferenceResponse
+	(*WatchDeltasRequest)(nil),        // 3: ghost.v1.WatchDeltasRequest
+	(*GhostReference)(nil),            // 4: ghost.v1.GhostReference
+	(*GhostDelta)(nil),                // 5: ghost.v1.GhostDelta
+}
+var file_ghost_proto_depIdxs = []int32{
+	4, // 0: ghost.v1.GetGhostReferenceResponse.reference:type_name -> ghost.v1.GhostReference
+	0, // 1: ghost.v1.GhostReference.kind:type_name -> ghost.v1.GhostReferenceKind
+	4, // 2: ghost.v1.GhostDelta.reference:type_name -> ghost.v1.GhostReference
+	1, // 3: ghost.v1.GhostService.GetGhostReference:input_type -> ghost.v1.GetGhostReferenceRequest
+	3, // 4: ghost.v1.GhostService.WatchDeltas:input_type -> ghost.v1.WatchDeltasRequest
+	2, // 5: ghost.v1.GhostService.GetGhostReference:output_type -> ghost.v1.GetGhostReferenceResponse
+	5, // 6: ghost.v1.GhostService.WatchDeltas:output_type -> ghost.v1.GhostDelta
+	5, // [5:7] is the sub-list for method output_type
+	3, // [3:5] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_ghost_proto_init() }
+func file_ghost_proto_init() {
+	if File_ghost_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ghost_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGhostReferenceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ghost_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGhostReferenceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ghost_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchDeltasRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ghost_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GhostReference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ghost_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GhostDelta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ghost_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ghost_proto_goTypes,
+		DependencyIndexes: file_ghost_proto_depIdxs,
+		EnumInfos:         file_ghost_proto_enumTypes,
+		MessageInfos:      file_ghost_proto_msgTypes,
+	}.Build()
+	File_ghost_proto = out.File
+	file_ghost_proto_rawDesc = nil
+	file_ghost_proto_goTypes = nil
+	file_ghost_proto_depIdxs = nil
+}
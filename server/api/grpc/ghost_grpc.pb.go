@@ -0,0 +1,173 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v4.24.1
+// source: ghost.proto
+
+package grpc_gen
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// GhostServiceClient is the client API for GhostService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GhostServiceClient interface {
+	// GetGhostReference gets the lap the player on the chair is compared with
+	GetGhostReference(ctx context.Context, in *GetGhostReferenceRequest, opts ...grpc.CallOption) (*GetGhostReferenceResponse, error)
+	// WatchDeltas sends the live delta of the player to the reference, for every lap data packet
+	WatchDeltas(ctx context.Context, in *WatchDeltasRequest, opts ...grpc.CallOption) (GhostService_WatchDeltasClient, error)
+}
+
+type ghostServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGhostServiceClient(cc grpc.ClientConnInterface) GhostServiceClient {
+	return &ghostServiceClient{cc}
+}
+
+func (c *ghostServiceClient) GetGhostReference(ctx context.Context, in *GetGhostReferenceRequest, opts ...grpc.CallOption) (*GetGhostReferenceResponse, error) {
+	out := new(GetGhostReferenceResponse)
+	err := c.cc.Invoke(ctx, "/ghost.v1.GhostService/GetGhostReference", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ghostServiceClient) WatchDeltas(ctx context.Context, in *WatchDeltasRequest, opts ...grpc.CallOption) (GhostService_WatchDeltasClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GhostService_ServiceDesc.Streams[0], "/ghost.v1.GhostService/WatchDeltas", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ghostServiceWatchDeltasClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GhostService_WatchDeltasClient interface {
+	Recv() (*GhostDelta, error)
+	grpc.ClientStream
+}
+
+type ghostServiceWatchDeltasClient struct {
+	grpc.ClientStream
+}
+
+func (x *ghostServiceWatchDeltasClient) Recv() (*GhostDelta, error) {
+	m := new(GhostDelta)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// GhostServiceServer is the server API for GhostService service.
+// All implementations must embed UnimplementedGhostServiceServer
+// for forward compatibility
+type GhostServiceServer interface {
+	// GetGhostReference gets the lap the player on the chair is compared with
+	GetGhostReference(context.Context, *GetGhostReferenceRequest) (*GetGhostReferenceResponse, error)
+	// WatchDeltas sends the live delta of the player to the reference, for every lap data packet
+	WatchDeltas(*WatchDeltasRequest, GhostService_WatchDeltasServer) error
+	mustEmbedUnimplementedGhostServiceServer()
+}
+
+// UnimplementedGhostServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedGhostServiceServer struct {
+}
+
+func (UnimplementedGhostServiceServer) GetGhostReference(context.Context, *GetGhostReferenceRequest) (*GetGhostReferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGhostReference not implemented")
+}
+func (UnimplementedGhostServiceServer) WatchDeltas(*WatchDeltasRequest, GhostService_WatchDeltasServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchDeltas not implemented")
+}
+func (UnimplementedGhostServiceServer) mustEmbedUnimplementedGhostServiceServer() {}
+
+// UnsafeGhostServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GhostServiceServer will
+// result in compilation errors.
+type UnsafeGhostServiceServer interface {
+	mustEmbedUnimplementedGhostServiceServer()
+}
+
+func RegisterGhostServiceServer(s grpc.ServiceRegistrar, srv GhostServiceServer) {
+	s.RegisterService(&GhostService_ServiceDesc, srv)
+}
+
+func _GhostService_GetGhostReference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGhostReferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GhostServiceServer).GetGhostReference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ghost.v1.GhostService/GetGhostReference",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GhostServiceServer).GetGhostReference(ctx, req.(*GetGhostReferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GhostService_WatchDeltas_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchDeltasRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GhostServiceServer).WatchDeltas(m, &ghostServiceWatchDeltasServer{stream})
+}
+
+type GhostService_WatchDeltasServer interface {
+	Send(*GhostDelta) error
+	grpc.ServerStream
+}
+
+type ghostServiceWatchDeltasServer struct {
+	grpc.ServerStream
+}
+
+func (x *ghostServiceWatchDeltasServer) Send(m *GhostDelta) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// GhostService_ServiceDesc is the grpc.ServiceDesc for GhostService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GhostService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ghost.v1.GhostService",
+	HandlerType: (*GhostServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetGhostReference",
+			Handler:    _GhostService_GetGhostReference_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchDeltas",
+			Handler:       _GhostService_WatchDeltas_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "ghost.proto",
+}
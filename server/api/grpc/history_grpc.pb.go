@@ -0,0 +1,183 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v4.24.1
+// source: history.proto
+
+package grpc_gen
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// LapHistoryServiceClient is the client API for LapHistoryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LapHistoryServiceClient interface {
+	// ListLaps lists the laps that match the query, newest first
+	ListLaps(ctx context.Context, in *ListLapsRequest, opts ...grpc.CallOption) (*ListLapsResponse, error)
+	// ListSessions lists the sessions that match the query, newest first. The laps are not included
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	// GetSession gets a session with its laps
+	GetSession(ctx context.Context, in *GetSessionRequest, opts ...grpc.CallOption) (*GetSessionResponse, error)
+}
+
+type lapHistoryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLapHistoryServiceClient(cc grpc.ClientConnInterface) LapHistoryServiceClient {
+	return &lapHistoryServiceClient{cc}
+}
+
+func (c *lapHistoryServiceClient) ListLaps(ctx context.Context, in *ListLapsRequest, opts ...grpc.CallOption) (*ListLapsResponse, error) {
+	out := new(ListLapsResponse)
+	err := c.cc.Invoke(ctx, "/history.v1.LapHistoryService/ListLaps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lapHistoryServiceClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, "/history.v1.LapHistoryService/ListSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lapHistoryServiceClient) GetSession(ctx context.Context, in *GetSessionRequest, opts ...grpc.CallOption) (*GetSessionResponse, error) {
+	out := new(GetSessionResponse)
+	err := c.cc.Invoke(ctx, "/history.v1.LapHistoryService/GetSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LapHistoryServiceServer is the server API for LapHistoryService service.
+// All implementations must embed UnimplementedLapHistoryServiceServer
+// for forward compatibility
+type LapHistoryServiceServer interface {
+	// ListLaps lists the laps that match the query, newest first
+	ListLaps(context.Context, *ListLapsRequest) (*ListLapsResponse, error)
+	// ListSessions lists the sessions that match the query, newest first. The laps are not included
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
+	// GetSession gets a session with its laps
+	GetSession(context.Context, *GetSessionRequest) (*GetSessionResponse, error)
+	mustEmbedUnimplementedLapHistoryServiceServer()
+}
+
+// UnimplementedLapHistoryServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedLapHistoryServiceServer struct {
+}
+
+func (UnimplementedLapHistoryServiceServer) ListLaps(context.Context, *ListLapsRequest) (*ListLapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLaps not implemented")
+}
+func (UnimplementedLapHistoryServiceServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedLapHistoryServiceServer) GetSession(context.Context, *GetSessionRequest) (*GetSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSession not implemented")
+}
+func (UnimplementedLapHistoryServiceServer) mustEmbedUnimplementedLapHistoryServiceServer() {}
+
+// UnsafeLapHistoryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LapHistoryServiceServer will
+// result in compilation errors.
+type UnsafeLapHistoryServiceServer interface {
+	mustEmbedUnimplementedLapHistoryServiceServer()
+}
+
+func RegisterLapHistoryServiceServer(s grpc.ServiceRegistrar, srv LapHistoryServiceServer) {
+	s.RegisterService(&LapHistoryService_ServiceDesc, srv)
+}
+
+func _LapHistoryService_ListLaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LapHistoryServiceServer).ListLaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/history.v1.LapHistoryService/ListLaps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LapHistoryServiceServer).ListLaps(ctx, req.(*ListLapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LapHistoryService_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LapHistoryServiceServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/history.v1.LapHistoryService/ListSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LapHistoryServiceServer).ListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LapHistoryService_GetSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LapHistoryServiceServer).GetSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/history.v1.LapHistoryService/GetSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LapHistoryServiceServer).GetSession(ctx, req.(*GetSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LapHistoryService_ServiceDesc is the grpc.ServiceDesc for LapHistoryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LapHistoryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "history.v1.LapHistoryService",
+	HandlerType: (*LapHistoryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListLaps",
+			Handler:    _LapHistoryService_ListLaps_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _LapHistoryService_ListSessions_Handler,
+		},
+		{
+			MethodName: "GetSession",
+			Handler:    _LapHistoryService_GetSession_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "history.proto",
+}
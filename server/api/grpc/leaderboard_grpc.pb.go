@@ -0,0 +1,211 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v4.24.1
+// source: leaderboard.proto
+
+package grpc_gen
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// LeaderboardServiceClient is the client API for LeaderboardService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LeaderboardServiceClient interface {
+	// ListLeaderboards lists the leaderboards that have laps
+	ListLeaderboards(ctx context.Context, in *ListLeaderboardsRequest, opts ...grpc.CallOption) (*ListLeaderboardsResponse, error)
+	// GetLeaderboard gets the best lap of every driver on a leaderboard, the fastest first
+	GetLeaderboard(ctx context.Context, in *GetLeaderboardRequest, opts ...grpc.CallOption) (*GetLeaderboardResponse, error)
+	// WatchLeaderboards sends the matching leaderboards, and after that an update whenever a driver sets a new personal or overall best
+	WatchLeaderboards(ctx context.Context, in *WatchLeaderboardsRequest, opts ...grpc.CallOption) (LeaderboardService_WatchLeaderboardsClient, error)
+}
+
+type leaderboardServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLeaderboardServiceClient(cc grpc.ClientConnInterface) LeaderboardServiceClient {
+	return &leaderboardServiceClient{cc}
+}
+
+func (c *leaderboardServiceClient) ListLeaderboards(ctx context.Context, in *ListLeaderboardsRequest, opts ...grpc.CallOption) (*ListLeaderboardsResponse, error) {
+	out := new(ListLeaderboardsResponse)
+	err := c.cc.Invoke(ctx, "/leaderboard.v1.LeaderboardService/ListLeaderboards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaderboardServiceClient) GetLeaderboard(ctx context.Context, in *GetLeaderboardRequest, opts ...grpc.CallOption) (*GetLeaderboardResponse, error) {
+	out := new(GetLeaderboardResponse)
+	err := c.cc.Invoke(ctx, "/leaderboard.v1.LeaderboardService/GetLeaderboard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaderboardServiceClient) WatchLeaderboards(ctx context.Context, in *WatchLeaderboardsRequest, opts ...grpc.CallOption) (LeaderboardService_WatchLeaderboardsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &LeaderboardService_ServiceDesc.Streams[0], "/leaderboard.v1.LeaderboardService/WatchLeaderboards", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &leaderboardServiceWatchLeaderboardsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type LeaderboardService_WatchLeaderboardsClient interface {
+	Recv() (*LeaderboardUpdate, error)
+	grpc.ClientStream
+}
+
+type leaderboardServiceWatchLeaderboardsClient struct {
+	grpc.ClientStream
+}
+
+func (x *leaderboardServiceWatchLeaderboardsClient) Recv() (*LeaderboardUpdate, error) {
+	m := new(LeaderboardUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// LeaderboardServiceServer is the server API for LeaderboardService service.
+// All implementations must embed UnimplementedLeaderboardServiceServer
+// for forward compatibility
+type LeaderboardServiceServer interface {
+	// ListLeaderboards lists the leaderboards that have laps
+	ListLeaderboards(context.Context, *ListLeaderboardsRequest) (*ListLeaderboardsResponse, error)
+	// GetLeaderboard gets the best lap of every driver on a leaderboard, the fastest first
+	GetLeaderboard(context.Context, *GetLeaderboardRequest) (*GetLeaderboardResponse, error)
+	// WatchLeaderboards sends the matching leaderboards, and after that an update whenever a driver sets a new personal or overall best
+	WatchLeaderboards(*WatchLeaderboardsRequest, LeaderboardService_WatchLeaderboardsServer) error
+	mustEmbedUnimplementedLeaderboardServiceServer()
+}
+
+// UnimplementedLeaderboardServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedLeaderboardServiceServer struct {
+}
+
+func (UnimplementedLeaderboardServiceServer) ListLeaderboards(context.Context, *ListLeaderboardsRequest) (*ListLeaderboardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLeaderboards not implemented")
+}
+func (UnimplementedLeaderboardServiceServer) GetLeaderboard(context.Context, *GetLeaderboardRequest) (*GetLeaderboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeaderboard not implemented")
+}
+func (UnimplementedLeaderboardServiceServer) WatchLeaderboards(*WatchLeaderboardsRequest, LeaderboardService_WatchLeaderboardsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchLeaderboards not implemented")
+}
+func (UnimplementedLeaderboardServiceServer) mustEmbedUnimplementedLeaderboardServiceServer() {}
+
+// UnsafeLeaderboardServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LeaderboardServiceServer will
+// result in compilation errors.
+type UnsafeLeaderboardServiceServer interface {
+	mustEmbedUnimplementedLeaderboardServiceServer()
+}
+
+func RegisterLeaderboardServiceServer(s grpc.ServiceRegistrar, srv LeaderboardServiceServer) {
+	s.RegisterService(&LeaderboardService_ServiceDesc, srv)
+}
+
+func _LeaderboardService_ListLeaderboards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLeaderboardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaderboardServiceServer).ListLeaderboards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/leaderboard.v1.LeaderboardService/ListLeaderboards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaderboardServiceServer).ListLeaderboards(ctx, req.(*ListLeaderboardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaderboardService_GetLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaderboardServiceServer).GetLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/leaderboard.v1.LeaderboardService/GetLeaderboard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaderboardServiceServer).GetLeaderboard(ctx, req.(*GetLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaderboardService_WatchLeaderboards_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchLeaderboardsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LeaderboardServiceServer).WatchLeaderboards(m, &leaderboardServiceWatchLeaderboardsServer{stream})
+}
+
+type LeaderboardService_WatchLeaderboardsServer interface {
+	Send(*LeaderboardUpdate) error
+	grpc.ServerStream
+}
+
+type leaderboardServiceWatchLeaderboardsServer struct {
+	grpc.ServerStream
+}
+
+func (x *leaderboardServiceWatchLeaderboardsServer) Send(m *LeaderboardUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// LeaderboardService_ServiceDesc is the grpc.ServiceDesc for LeaderboardService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LeaderboardService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "leaderboard.v1.LeaderboardService",
+	HandlerType: (*LeaderboardServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListLeaderboards",
+			Handler:    _LeaderboardService_ListLeaderboards_Handler,
+		},
+		{
+			MethodName: "GetLeaderboard",
+			Handler:    _LeaderboardService_GetLeaderboard_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchLeaderboards",
+			Handler:       _LeaderboardService_WatchLeaderboards_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "leaderboard.proto",
+}
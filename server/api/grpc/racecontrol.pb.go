@@ -0,0 +1,1059 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v4.24.1
+// source: racecontrol.proto
+
+package grpc_gen
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ListRaceControlLogsRequest is a request to list the logs
+type ListRaceControlLogsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Port string `protobuf:"bytes,1,opt,name=port,proto3" json:"port,omitempty"` // the upd port of the chair, empty for all chairs
+}
+
+func (x *ListRaceControlLogsRequest) Reset() {
+	*x = ListRaceControlLogsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_racecontrol_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRaceControlLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRaceControlLogsRequest) ProtoMessage() {}
+
+func (x *ListRaceControlLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_racecontrol_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRaceControlLogsRequest.ProtoReflect.Descriptor instead.
+func (*ListRaceControlLogsRequest) Descriptor() ([]byte, []int) {
+	return file_racecontrol_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListRaceControlLogsRequest) GetPort() string {
+	if x != nil {
+		return x.Port
+	}
+	return ""
+}
+
+// ListRaceControlLogsResponse is a response to a ListRaceControlLogsRequest
+type ListRaceControlLogsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Logs []*RaceControlLog `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"` // without events
+}
+
+func (x *ListRaceControlLogsResponse) Reset() {
+	*x = ListRaceControlLogsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_racecontrol_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRaceControlLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRaceControlLogsResponse) ProtoMessage() {}
+
+func (x *ListRaceControlLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_racecontrol_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRaceControlLogsResponse.ProtoReflect.Descriptor instead.
+func (*ListRaceControlLogsResponse) Descriptor() ([]byte, []int) {
+	return file_racecontrol_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListRaceControlLogsResponse) GetLogs() []*RaceControlLog {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+// GetRaceControlLogRequest is a request to get the events of a session
+type GetRaceControlLogRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId string   `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // the same id as the session in the lap history
+	Kinds     []string `protobuf:"bytes,2,rep,name=kinds,proto3" json:"kinds,omitempty"`                          // e.g. penalty, safety_car, collision. Empty for all kinds
+}
+
+func (x *GetRaceControlLogRequest) Reset() {
+	*x = GetRaceControlLogRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_racecontrol_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRaceControlLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRaceControlLogRequest) ProtoMessage() {}
+
+func (x *GetRaceControlLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_racecontrol_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRaceControlLogRequest.ProtoReflect.Descriptor instead.
+func (*GetRaceControlLogRequest) Descriptor() ([]byte, []int) {
+	return file_racecontrol_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetRaceControlLogRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *GetRaceControlLogRequest) GetKinds() []string {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+// GetRaceControlLogResponse is a response to a GetRaceControlLogRequest
+type GetRaceControlLogResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Log *RaceControlLog `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
+}
+
+func (x *GetRaceControlLogResponse) Reset() {
+	*x = GetRaceControlLogResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_racecontrol_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRaceControlLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRaceControlLogResponse) ProtoMessage() {}
+
+func (x *GetRaceControlLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_racecontrol_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRaceControlLogResponse.ProtoReflect.Descriptor instead.
+func (*GetRaceControlLogResponse) Descriptor() ([]byte, []int) {
+	return file_racecontrol_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetRaceControlLogResponse) GetLog() *RaceControlLog {
+	if x != nil {
+		return x.Log
+	}
+	return nil
+}
+
+// WatchRaceControlRequest is a request to watch the new events
+type WatchRaceControlRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Port  string   `protobuf:"bytes,1,opt,name=port,proto3" json:"port,omitempty"`   // the upd port of the chair, empty for all chairs
+	Kinds []string `protobuf:"bytes,2,rep,name=kinds,proto3" json:"kinds,omitempty"` // e.g. penalty, safety_car, collision. Empty for all kinds
+}
+
+func (x *WatchRaceControlRequest) Reset() {
+	*x = WatchRaceControlRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_racecontrol_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchRaceControlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchRaceControlRequest) ProtoMessage() {}
+
+func (x *WatchRaceControlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_racecontrol_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchRaceControlRequest.ProtoReflect.Descriptor instead.
+func (*WatchRaceControlRequest) Descriptor() ([]byte, []int) {
+	return file_racecontrol_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *WatchRaceControlRequest) GetPort() string {
+	if x != nil {
+		return x.Port
+	}
+	return ""
+}
+
+func (x *WatchRaceControlRequest) GetKinds() []string {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+// RaceControlLog is the record of the race control events of a session played on a chair
+type RaceControlLog struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId   string              `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Port        string              `protobuf:"bytes,2,opt,name=port,proto3" json:"port,omitempty"` // the upd port of the chair
+	SessionUid  uint64              `protobuf:"varint,3,opt,name=session_uid,json=sessionUid,proto3" json:"session_uid,omitempty"`
+	TrackId     int32               `protobuf:"varint,4,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`             // -1 if unknown
+	SessionType uint32              `protobuf:"varint,5,opt,name=session_type,json=sessionType,proto3" json:"session_type,omitempty"` // 0 if unknown
+	Started     int64               `protobuf:"varint,6,opt,name=started,proto3" json:"started,omitempty"`                            // unix seconds
+	Updated     int64               `protobuf:"varint,7,opt,name=updated,proto3" json:"updated,omitempty"`                            // unix seconds
+	EventCount  uint32              `protobuf:"varint,8,opt,name=event_count,json=eventCount,proto3" json:"event_count,omitempty"`
+	Events      []*RaceControlEvent `protobuf:"bytes,9,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *RaceControlLog) Reset() {
+	*x = RaceControlLog{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_racecontrol_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RaceControlLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RaceControlLog) ProtoMessage() {}
+
+func (x *RaceControlLog) ProtoReflect() protoreflect.Message {
+	mi := &file_racecontrol_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RaceControlLog.ProtoReflect.Descriptor instead.
+func (*RaceControlLog) Descriptor() ([]byte, []int) {
+	return file_racecontrol_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RaceControlLog) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *RaceControlLog) GetPort() string {
+	if x != nil {
+		return x.Port
+	}
+	return ""
+}
+
+func (x *RaceControlLog) GetSessionUid() uint64 {
+	if x != nil {
+		return x.SessionUid
+	}
+	return 0
+}
+
+func (x *RaceControlLog) GetTrackId() int32 {
+	if x != nil {
+		return x.TrackId
+	}
+	return 0
+}
+
+func (x *RaceControlLog) GetSessionType() uint32 {
+	if x != nil {
+		return x.SessionType
+	}
+	return 0
+}
+
+func (x *RaceControlLog) GetStarted() int64 {
+	if x != nil {
+		return x.Started
+	}
+	return 0
+}
+
+func (x *RaceControlLog) GetUpdated() int64 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *RaceControlLog) GetEventCount() uint32 {
+	if x != nil {
+		return x.EventCount
+	}
+	return 0
+}
+
+func (x *RaceControlLog) GetEvents() []*RaceControlEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// RaceControlMessage is a new event of a session
+type RaceControlMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId  string            `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Port       string            `protobuf:"bytes,2,opt,name=port,proto3" json:"port,omitempty"` // the upd port of the chair
+	SessionUid uint64            `protobuf:"varint,3,opt,name=session_uid,json=sessionUid,proto3" json:"session_uid,omitempty"`
+	Event      *RaceControlEvent `protobuf:"bytes,4,opt,name=event,proto3" json:"event,omitempty"`
+}
+
+func (x *RaceControlMessage) Reset() {
+	*x = RaceControlMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_racecontrol_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RaceControlMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RaceControlMessage) ProtoMessage() {}
+
+func (x *RaceControlMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_racecontrol_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RaceControlMessage.ProtoReflect.Descriptor instead.
+func (*RaceControlMessage) Descriptor() ([]byte, []int) {
+	return file_racecontrol_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RaceControlMessage) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *RaceControlMessage) GetPort() string {
+	if x != nil {
+		return x.Port
+	}
+	return ""
+}
+
+func (x *RaceControlMessage) GetSessionUid() uint64 {
+	if x != nil {
+		return x.SessionUid
+	}
+	return 0
+}
+
+func (x *RaceControlMessage) GetEvent() *RaceControlEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+// RaceControlEvent is something that happened in a session
+type RaceControlEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sequence uint32 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"` // increases with every event of the session
+	Kind     string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`          // session_started, session_ended, lights_out, fastest_lap, retirement, penalty, drive_through_served,
+	// stop_go_served, safety_car, overtake, collision, drs_enabled, drs_disabled, red_flag, chequered_flag or race_winner
+	SessionTime float32             `protobuf:"fixed32,3,opt,name=session_time,json=sessionTime,proto3" json:"session_time,omitempty"`    // seconds
+	Cars        []*RaceControlCar   `protobuf:"bytes,4,rep,name=cars,proto3" json:"cars,omitempty"`                                       // the car the event is about first, e.g. the overtaking car
+	LapTimeInMs uint32              `protobuf:"varint,5,opt,name=lap_time_in_ms,json=lapTimeInMs,proto3" json:"lap_time_in_ms,omitempty"` // the fastest lap
+	Penalty     *RaceControlPenalty `protobuf:"bytes,6,opt,name=penalty,proto3" json:"penalty,omitempty"`
+	SafetyCar   string              `protobuf:"bytes,7,opt,name=safety_car,json=safetyCar,proto3" json:"safety_car,omitempty"` // the kind of safety car: full, virtual or formation lap
+	Phase       string              `protobuf:"bytes,8,opt,name=phase,proto3" json:"phase,omitempty"`                          // the phase of the safety car: deployed, returning, returned or resumed
+	Message     string              `protobuf:"bytes,9,opt,name=message,proto3" json:"message,omitempty"`                      // a readable description of the event
+	Received    int64               `protobuf:"varint,10,opt,name=received,proto3" json:"received,omitempty"`                  // unix milliseconds
+}
+
+func (x *RaceControlEvent) Reset() {
+	*x = RaceControlEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_racecontrol_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RaceControlEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RaceControlEvent) ProtoMessage() {}
+
+func (x *RaceControlEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_racecontrol_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RaceControlEvent.ProtoReflect.Descriptor instead.
+func (*RaceControlEvent) Descriptor() ([]byte, []int) {
+	return file_racecontrol_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RaceControlEvent) GetSequence() uint32 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *RaceControlEvent) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *RaceControlEvent) GetSessionTime() float32 {
+	if x != nil {
+		return x.SessionTime
+	}
+	return 0
+}
+
+func (x *RaceControlEvent) GetCars() []*RaceControlCar {
+	if x != nil {
+		return x.Cars
+	}
+	return nil
+}
+
+func (x *RaceControlEvent) GetLapTimeInMs() uint32 {
+	if x != nil {
+		return x.LapTimeInMs
+	}
+	return 0
+}
+
+func (x *RaceControlEvent) GetPenalty() *RaceControlPenalty {
+	if x != nil {
+		return x.Penalty
+	}
+	return nil
+}
+
+func (x *RaceControlEvent) GetSafetyCar() string {
+	if x != nil {
+		return x.SafetyCar
+	}
+	return ""
+}
+
+func (x *RaceControlEvent) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *RaceControlEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RaceControlEvent) GetReceived() int64 {
+	if x != nil {
+		return x.Received
+	}
+	return 0
+}
+
+// RaceControlCar is a car involved in an event
+type RaceControlCar struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index      uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // the name of the driver in the game, empty if unknown
+	RaceNumber uint32 `protobuf:"varint,3,opt,name=race_number,json=raceNumber,proto3" json:"race_number,omitempty"`
+	TeamId     uint32 `protobuf:"varint,4,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	LapNumber  uint32 `protobuf:"varint,5,opt,name=lap_number,json=lapNumber,proto3" json:"lap_number,omitempty"` // the lap the car was on, 0 if unknown
+}
+
+func (x *RaceControlCar) Reset() {
+	*x = RaceControlCar{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_racecontrol_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RaceControlCar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RaceControlCar) ProtoMessage() {}
+
+func (x *RaceControlCar) ProtoReflect() protoreflect.Message {
+	mi := &file_racecontrol_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RaceControlCar.ProtoReflect.Descriptor instead.
+func (*RaceControlCar) Descriptor() ([]byte, []int) {
+	return file_racecontrol_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RaceControlCar) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *RaceControlCar) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RaceControlCar) GetRaceNumber() uint32 {
+	if x != nil {
+		return x.RaceNumber
+	}
+	return 0
+}
+
+func (x *RaceControlCar) GetTeamId() uint32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *RaceControlCar) GetLapNumber() uint32 {
+	if x != nil {
+		return x.LapNumber
+	}
+	return 0
+}
+
+// RaceControlPenalty holds the details of a penalty
+type RaceControlPenalty struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type         string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Infringement string `protobuf:"bytes,2,opt,name=infringement,proto3" json:"infringement,omitempty"`
+	Time         uint32 `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"` // seconds of a time penalty, or of the action
+	LapNumber    uint32 `protobuf:"varint,4,opt,name=lap_number,json=lapNumber,proto3" json:"lap_number,omitempty"`
+	PlacesGained uint32 `protobuf:"varint,5,opt,name=places_gained,json=placesGained,proto3" json:"places_gained,omitempty"`
+}
+
+func (x *RaceControlPenalty) Reset() {
+	*x = RaceControlPenalty{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_racecontrol_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RaceControlPenalty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RaceControlPenalty) ProtoMessage() {}
+
+func (x *RaceControlPenalty) ProtoReflect() protoreflect.Message {
+	mi := &file_racecontrol_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RaceControlPenalty.ProtoReflect.Descriptor instead.
+func (*RaceControlPenalty) Descriptor() ([]byte, []int) {
+	return file_racecontrol_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RaceControlPenalty) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RaceControlPenalty) GetInfringement() string {
+	if x != nil {
+		return x.Infringement
+	}
+	return ""
+}
+
+func (x *RaceControlPenalty) GetTime() uint32 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *RaceControlPenalty) GetLapNumber() uint32 {
+	if x != nil {
+		return x.LapNumber
+	}
+	return 0
+}
+
+func (x *RaceControlPenalty) GetPlacesGained() uint32 {
+	if x != nil {
+		return x.PlacesGained
+	}
+	return 0
+}
+
+var File_racecontrol_proto protoreflect.FileDescriptor
+
+var file_racecontrol_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x72, 0x61, 0x63, 0x65, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x72, 0x61, 0x63, 0x65, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x2e, 0x76, 0x31, 0x22, 0x30, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x61, 0x63, 0x65, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x51, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x61, 0x63,
+	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x61, 0x63, 0x65, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x4c,
+	0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x22, 0x4f, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x52,
+	0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x22, 0x4d, 0x0a, 0x19, 0x47, 0x65, 0x74,
+	0x52, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x4c, 0x6f, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x03, 0x6c, 0x6f, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x61, 0x63, 0x65, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x4c, 0x6f, 0x67, 0x52, 0x03, 0x6c, 0x6f, 0x67, 0x22, 0x43, 0x0a, 0x17, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x22, 0xb1, 0x02,
+	0x0a, 0x0e, 0x52, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x4c, 0x6f, 0x67,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x75,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x55, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x61, 0x63, 0x65, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x22, 0xa0, 0x01, 0x0a, 0x12, 0x52, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55, 0x69, 0x64, 0x12, 0x36, 0x0a, 0x05,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x61,
+	0x63, 0x65, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x63,
+	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x22, 0xe7, 0x02, 0x0a, 0x10, 0x52, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x04,
+	0x63, 0x61, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x61, 0x63,
+	0x65, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x63, 0x65,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x43, 0x61, 0x72, 0x52, 0x04, 0x63, 0x61, 0x72, 0x73,
+	0x12, 0x23, 0x0a, 0x0e, 0x6c, 0x61, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x5f,
+	0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6c, 0x61, 0x70, 0x54, 0x69, 0x6d,
+	0x65, 0x49, 0x6e, 0x4d, 0x73, 0x12, 0x3c, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x61, 0x63, 0x65, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x61,
+	0x6c, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x5f, 0x63, 0x61,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x43,
+	0x61, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x22, 0x93,
+	0x01, 0x0a, 0x0e, 0x52, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x43, 0x61,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72,
+	0x61, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x72, 0x61, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07,
+	0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x74,
+	0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x70, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6c, 0x61, 0x70, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x22, 0xa4, 0x01, 0x0a, 0x12, 0x52, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x66, 0x72, 0x69, 0x6e, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x66, 0x72, 0x69, 0x6e, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x70, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6c, 0x61, 0x70,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73,
+	0x5f, 0x67, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x73, 0x47, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x32, 0xd1, 0x02, 0x0a, 0x12,
+	0x52, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x6e, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x61, 0x63, 0x65, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x2a, 0x2e, 0x72, 0x61, 0x63, 0x65,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x72, 0x61, 0x63, 0x65, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x61, 0x63, 0x65, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x68, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x52, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x4c, 0x6f, 0x67, 0x12, 0x28, 0x2e, 0x72, 0x61, 0x63, 0x65, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x63, 0x65,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x29, 0x2e, 0x72, 0x61, 0x63, 0x65, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x10,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x12, 0x27, 0x2e, 0x72, 0x61, 0x63, 0x65, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x72, 0x61, 0x63, 0x65,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x63, 0x65, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x30, 0x01, 0x42,
+	0x0c, 0x5a, 0x0a, 0x2e, 0x3b, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_racecontrol_proto_rawDescOnce sync.Once
+	file_racecontrol_proto_rawDescData = file_racecontrol_proto_rawDesc
+)
+
+func file_racecontrol_proto_rawDescGZIP() []byte {
+	file_racecontrol_proto_rawDescOnce.Do(func() {
+		file_racecontrol_proto_rawDescData = protoimpl.X.CompressGZIP(file_racecontrol_proto_rawDescData)
+	})
+	return file_racecontrol_proto_rawDescData
+}
+
+var file_racecontrol_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_racecontrol_proto_goTypes = []interface{}{
+	(*ListRaceControlLogsRequest)(nil),  // 0: racecontrol.v1.ListRaceControlLogsRequest
+	(*ListRaceControlLogsResponse)(nil), // 1: racecontrol.v1.ListRaceControlLogsResponse
+	(*GetRaceControlLogRequest)(nil),    // 2: racecontrol.v1.GetRaceControlLogRequest
+	(*GetRaceControlLogResponse)(nil),   // 3: racecontrol.v1.GetRaceControlLogResponse
+	(*WatchRaceControlRequest)(nil),     // 4: racecontrol.v1.WatchRaceControlRequest
+	(*RaceControlLog)(nil),              // 5: racecontrol.v1.RaceControlLog
+	(*RaceControlMessage)(nil),          // 6: racecontrol.v1.RaceControlMessage
+	(*RaceControlEvent)(nil),            // 7: racecontrol.v1.RaceControlEvent
+	(*RaceControlCar)(nil),              // 8: racecontrol.v1.RaceControlCar
+	(*RaceControlPenalty)(nil),          // 9: racecontrol.v1.RaceControlPenalty
+}
+var file_racecontrol_proto_depIdxs = []int32{
+	5, // 0: racecontrol.v1.ListRaceControlLogsResponse.logs:type_name -> racecontrol.v1.RaceControlLog
+	5, // 1: racecontrol.v1.GetRaceControlLogResponse.log:type_name -> racecontrol.v1.RaceControlLog
+	7, // 2: racecontrol.v1.RaceControlLog.events:type_name -> racecontrol.v1.RaceControlEvent
+	7, // 3: racecontrol.v1.RaceControlMessage.event:type_name -> racecontrol.v1.RaceControlEvent
+	8, // 4: racecontrol.v1.RaceControlEvent.cars:type_name -> racecontrol.v1.RaceControlCar
+	9, // 5: racecontrol.v1.RaceControlEvent.penalty:type_name -> racecontrol.v1.RaceControlPenalty
+	0, // 6: racecontrol.v1.RaceControlService.ListRaceControlLogs:input_type -> racecontrol.v1.ListRaceControlLogsRequest
+	2, // 7: racecontrol.v1.RaceControlService.GetRaceControlLog:input_type -> racecontrol.v1.GetRaceControlLogRequest
+	4, // 8: racecontrol.v1.RaceControlService.WatchRaceControl:input_type -> racecontrol.v1.WatchRaceControlRequest
+	1, // 9: racecontrol.v1.RaceControlService.ListRaceControlLogs:output_type -> racecontrol.v1.ListRaceControlLogsResponse
+	3, // 10: racecontrol.v1.RaceControlService.GetRaceControlLog:output_type -> racecontrol.v1.GetRaceControlLogResponse
+	6, // 11: racecontrol.v1.RaceControlService.WatchRaceControl:output_type -> racecontrol.v1.RaceControlMessage
+	9, // [9:12] is the sub-list for method output_type
+	6, // [6:9] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_racecontrol_proto_init() }
+func file_racecontrol_proto_init() {
+	if File_racecontrol_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_racecontrol_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRaceControlLogsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_racecontrol_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRaceControlLogsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_racecontrol_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRaceControlLogRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_racecontrol_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRaceControlLogResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_racecontrol_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchRaceControlRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_racecontrol_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RaceControlLog); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_racecontrol_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RaceControlMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_racecontrol_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RaceControlEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_racecontrol_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RaceControlCar); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_racecontrol_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RaceControlPenalty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_racecontrol_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_racecontrol_proto_goTypes,
+		DependencyIndexes: file_racecontrol_proto_depIdxs,
+		MessageInfos:      file_racecontrol_proto_msgTypes,
+	}.Build()
+	File_racecontrol_proto = out.File
+	file_racecontrol_proto_rawDesc = nil
+	file_racecontrol_proto_goTypes = nil
+	file_racecontrol_proto_depIdxs = nil
+}
@@ -0,0 +1,211 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v4.24.1
+// source: racecontrol.proto
+
+package grpc_gen
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// RaceControlServiceClient is the client API for RaceControlService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RaceControlServiceClient interface {
+	// ListRaceControlLogs lists the sessions that have a log, without their events. Newest first
+	ListRaceControlLogs(ctx context.Context, in *ListRaceControlLogsRequest, opts ...grpc.CallOption) (*ListRaceControlLogsResponse, error)
+	// GetRaceControlLog gets the events of a session, ordered by session time
+	GetRaceControlLog(ctx context.Context, in *GetRaceControlLogRequest, opts ...grpc.CallOption) (*GetRaceControlLogResponse, error)
+	// WatchRaceControl sends the new events as they happen
+	WatchRaceControl(ctx context.Context, in *WatchRaceControlRequest, opts ...grpc.CallOption) (RaceControlService_WatchRaceControlClient, error)
+}
+
+type raceControlServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRaceControlServiceClient(cc grpc.ClientConnInterface) RaceControlServiceClient {
+	return &raceControlServiceClient{cc}
+}
+
+func (c *raceControlServiceClient) ListRaceControlLogs(ctx context.Context, in *ListRaceControlLogsRequest, opts ...grpc.CallOption) (*ListRaceControlLogsResponse, error) {
+	out := new(ListRaceControlLogsResponse)
+	err := c.cc.Invoke(ctx, "/racecontrol.v1.RaceControlService/ListRaceControlLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raceControlServiceClient) GetRaceControlLog(ctx context.Context, in *GetRaceControlLogRequest, opts ...grpc.CallOption) (*GetRaceControlLogResponse, error) {
+	out := new(GetRaceControlLogResponse)
+	err := c.cc.Invoke(ctx, "/racecontrol.v1.RaceControlService/GetRaceControlLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raceControlServiceClient) WatchRaceControl(ctx context.Context, in *WatchRaceControlRequest, opts ...grpc.CallOption) (RaceControlService_WatchRaceControlClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RaceControlService_ServiceDesc.Streams[0], "/racecontrol.v1.RaceControlService/WatchRaceControl", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &raceControlServiceWatchRaceControlClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RaceControlService_WatchRaceControlClient interface {
+	Recv() (*RaceControlMessage, error)
+	grpc.ClientStream
+}
+
+type raceControlServiceWatchRaceControlClient struct {
+	grpc.ClientStream
+}
+
+func (x *raceControlServiceWatchRaceControlClient) Recv() (*RaceControlMessage, error) {
+	m := new(RaceControlMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// RaceControlServiceServer is the server API for RaceControlService service.
+// All implementations must embed UnimplementedRaceControlServiceServer
+// for forward compatibility
+type RaceControlServiceServer interface {
+	// ListRaceControlLogs lists the sessions that have a log, without their events. Newest first
+	ListRaceControlLogs(context.Context, *ListRaceControlLogsRequest) (*ListRaceControlLogsResponse, error)
+	// GetRaceControlLog gets the events of a session, ordered by session time
+	GetRaceControlLog(context.Context, *GetRaceControlLogRequest) (*GetRaceControlLogResponse, error)
+	// WatchRaceControl sends the new events as they happen
+	WatchRaceControl(*WatchRaceControlRequest, RaceControlService_WatchRaceControlServer) error
+	mustEmbedUnimplementedRaceControlServiceServer()
+}
+
+// UnimplementedRaceControlServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedRaceControlServiceServer struct {
+}
+
+func (UnimplementedRaceControlServiceServer) ListRaceControlLogs(context.Context, *ListRaceControlLogsRequest) (*ListRaceControlLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRaceControlLogs not implemented")
+}
+func (UnimplementedRaceControlServiceServer) GetRaceControlLog(context.Context, *GetRaceControlLogRequest) (*GetRaceControlLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRaceControlLog not implemented")
+}
+func (UnimplementedRaceControlServiceServer) WatchRaceControl(*WatchRaceControlRequest, RaceControlService_WatchRaceControlServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchRaceControl not implemented")
+}
+func (UnimplementedRaceControlServiceServer) mustEmbedUnimplementedRaceControlServiceServer() {}
+
+// UnsafeRaceControlServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RaceControlServiceServer will
+// result in compilation errors.
+type UnsafeRaceControlServiceServer interface {
+	mustEmbedUnimplementedRaceControlServiceServer()
+}
+
+func RegisterRaceControlServiceServer(s grpc.ServiceRegistrar, srv RaceControlServiceServer) {
+	s.RegisterService(&RaceControlService_ServiceDesc, srv)
+}
+
+func _RaceControlService_ListRaceControlLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRaceControlLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaceControlServiceServer).ListRaceControlLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/racecontrol.v1.RaceControlService/ListRaceControlLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaceControlServiceServer).ListRaceControlLogs(ctx, req.(*ListRaceControlLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaceControlService_GetRaceControlLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRaceControlLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaceControlServiceServer).GetRaceControlLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/racecontrol.v1.RaceControlService/GetRaceControlLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaceControlServiceServer).GetRaceControlLog(ctx, req.(*GetRaceControlLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaceControlService_WatchRaceControl_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRaceControlRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RaceControlServiceServer).WatchRaceControl(m, &raceControlServiceWatchRaceControlServer{stream})
+}
+
+type RaceControlService_WatchRaceControlServer interface {
+	Send(*RaceControlMessage) error
+	grpc.ServerStream
+}
+
+type raceControlServiceWatchRaceControlServer struct {
+	grpc.ServerStream
+}
+
+func (x *raceControlServiceWatchRaceControlServer) Send(m *RaceControlMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// RaceControlService_ServiceDesc is the grpc.ServiceDesc for RaceControlService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RaceControlService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "racecontrol.v1.RaceControlService",
+	HandlerType: (*RaceControlServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListRaceControlLogs",
+			Handler:    _RaceControlService_ListRaceControlLogs_Handler,
+		},
+		{
+			MethodName: "GetRaceControlLog",
+			Handler:    _RaceControlService_GetRaceControlLog_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchRaceControl",
+			Handler:       _RaceControlService_WatchRaceControl_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "racecontrol.proto",
+}
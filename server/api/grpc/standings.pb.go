@@ -0,0 +1,650 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v4.24.1
+// source: standings.proto
+
+package grpc_gen
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GetStandingsRequest is a request to get the standings of a chair
+type GetStandingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Port string `protobuf:"bytes,1,opt,name=port,proto3" json:"port,omitempty"` // the upd port of the chair
+}
+
+func (x *GetStandingsRequest) Reset() {
+	*x = GetStandingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_standings_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStandingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStandingsRequest) ProtoMessage() {}
+
+func (x *GetStandingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_standings_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStandingsRequest.ProtoReflect.Descriptor instead.
+func (*GetStandingsRequest) Descriptor() ([]byte, []int) {
+	return file_standings_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetStandingsRequest) GetPort() string {
+	if x != nil {
+		return x.Port
+	}
+	return ""
+}
+
+// GetStandingsResponse is a response to a GetStandingsRequest
+type GetStandingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Standings *Standings `protobuf:"bytes,1,opt,name=standings,proto3" json:"standings,omitempty"`
+}
+
+func (x *GetStandingsResponse) Reset() {
+	*x = GetStandingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_standings_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStandingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStandingsResponse) ProtoMessage() {}
+
+func (x *GetStandingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_standings_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStandingsResponse.ProtoReflect.Descriptor instead.
+func (*GetStandingsResponse) Descriptor() ([]byte, []int) {
+	return file_standings_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetStandingsResponse) GetStandings() *Standings {
+	if x != nil {
+		return x.Standings
+	}
+	return nil
+}
+
+// WatchStandingsRequest is a request to watch the standings
+type WatchStandingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Port string `protobuf:"bytes,1,opt,name=port,proto3" json:"port,omitempty"` // the upd port of the chair, empty for all chairs
+}
+
+func (x *WatchStandingsRequest) Reset() {
+	*x = WatchStandingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_standings_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchStandingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchStandingsRequest) ProtoMessage() {}
+
+func (x *WatchStandingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_standings_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchStandingsRequest.ProtoReflect.Descriptor instead.
+func (*WatchStandingsRequest) Descriptor() ([]byte, []int) {
+	return file_standings_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WatchStandingsRequest) GetPort() string {
+	if x != nil {
+		return x.Port
+	}
+	return ""
+}
+
+// Standings are the positions of the cars in the session on a chair
+type Standings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Port        string            `protobuf:"bytes,1,opt,name=port,proto3" json:"port,omitempty"` // the upd port of the chair
+	SessionUid  uint64            `protobuf:"varint,2,opt,name=session_uid,json=sessionUid,proto3" json:"session_uid,omitempty"`
+	Race        bool              `protobuf:"varint,3,opt,name=race,proto3" json:"race,omitempty"`                                   // the gaps are the time between the cars on track, otherwise between their best laps
+	SessionTime float32           `protobuf:"fixed32,4,opt,name=session_time,json=sessionTime,proto3" json:"session_time,omitempty"` // seconds
+	Entries     []*StandingsEntry `protobuf:"bytes,5,rep,name=entries,proto3" json:"entries,omitempty"`                              // ordered by position
+	Updated     int64             `protobuf:"varint,6,opt,name=updated,proto3" json:"updated,omitempty"`                             // unix milliseconds
+}
+
+func (x *Standings) Reset() {
+	*x = Standings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_standings_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Standings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Standings) ProtoMessage() {}
+
+func (x *Standings) ProtoReflect() protoreflect.Message {
+	mi := &file_standings_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Standings.ProtoReflect.Descriptor instead.
+func (*Standings) Descriptor() ([]byte, []int) {
+	return file_standings_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Standings) GetPort() string {
+	if x != nil {
+		return x.Port
+	}
+	return ""
+}
+
+func (x *Standings) GetSessionUid() uint64 {
+	if x != nil {
+		return x.SessionUid
+	}
+	return 0
+}
+
+func (x *Standings) GetRace() bool {
+	if x != nil {
+		return x.Race
+	}
+	return false
+}
+
+func (x *Standings) GetSessionTime() float32 {
+	if x != nil {
+		return x.SessionTime
+	}
+	return 0
+}
+
+func (x *Standings) GetEntries() []*StandingsEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *Standings) GetUpdated() int64 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+// StandingsEntry is the place of a car in the standings
+type StandingsEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CarIndex        uint32  `protobuf:"varint,1,opt,name=car_index,json=carIndex,proto3" json:"car_index,omitempty"`
+	Position        uint32  `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	Name            string  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"` // the name of the driver in the game, empty until the participants are received
+	TeamId          uint32  `protobuf:"varint,4,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	RaceNumber      uint32  `protobuf:"varint,5,opt,name=race_number,json=raceNumber,proto3" json:"race_number,omitempty"`
+	Player          bool    `protobuf:"varint,6,opt,name=player,proto3" json:"player,omitempty"` // the car of the player on the chair
+	LapNumber       uint32  `protobuf:"varint,7,opt,name=lap_number,json=lapNumber,proto3" json:"lap_number,omitempty"`
+	LapDistance     float32 `protobuf:"fixed32,8,opt,name=lap_distance,json=lapDistance,proto3" json:"lap_distance,omitempty"`       // meters
+	TotalDistance   float32 `protobuf:"fixed32,9,opt,name=total_distance,json=totalDistance,proto3" json:"total_distance,omitempty"` // meters
+	GapToLeader     float32 `protobuf:"fixed32,10,opt,name=gap_to_leader,json=gapToLeader,proto3" json:"gap_to_leader,omitempty"`    // seconds, 0 for the leader or when unknown
+	Interval        float32 `protobuf:"fixed32,11,opt,name=interval,proto3" json:"interval,omitempty"`                               // seconds to the car ahead, 0 for the leader or when unknown
+	LapsDown        uint32  `protobuf:"varint,12,opt,name=laps_down,json=lapsDown,proto3" json:"laps_down,omitempty"`
+	PitStops        uint32  `protobuf:"varint,13,opt,name=pit_stops,json=pitStops,proto3" json:"pit_stops,omitempty"`
+	PitStatus       uint32  `protobuf:"varint,14,opt,name=pit_status,json=pitStatus,proto3" json:"pit_status,omitempty"`          // 0 = none, 1 = pitting, 2 = in pit area
+	TyreCompound    uint32  `protobuf:"varint,15,opt,name=tyre_compound,json=tyreCompound,proto3" json:"tyre_compound,omitempty"` // the visual compound, 0 if unknown
+	TyreAgeLaps     uint32  `protobuf:"varint,16,opt,name=tyre_age_laps,json=tyreAgeLaps,proto3" json:"tyre_age_laps,omitempty"`
+	LastLapTimeInMs uint32  `protobuf:"varint,17,opt,name=last_lap_time_in_ms,json=lastLapTimeInMs,proto3" json:"last_lap_time_in_ms,omitempty"`
+	BestLapTimeInMs uint32  `protobuf:"varint,18,opt,name=best_lap_time_in_ms,json=bestLapTimeInMs,proto3" json:"best_lap_time_in_ms,omitempty"` // 0 until the session history of the car is received
+	ResultStatus    uint32  `protobuf:"varint,19,opt,name=result_status,json=resultStatus,proto3" json:"result_status,omitempty"`                // 2 = active, 3 = finished, 4 = did not finish, 5 = disqualified, 6 = not classified, 7 = retired
+}
+
+func (x *StandingsEntry) Reset() {
+	*x = StandingsEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_standings_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StandingsEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StandingsEntry) ProtoMessage() {}
+
+func (x *StandingsEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_standings_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StandingsEntry.ProtoReflect.Descriptor instead.
+func (*StandingsEntry) Descriptor() ([]byte, []int) {
+	return file_standings_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StandingsEntry) GetCarIndex() uint32 {
+	if x != nil {
+		return x.CarIndex
+	}
+	return 0
+}
+
+func (x *StandingsEntry) GetPosition() uint32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *StandingsEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StandingsEntry) GetTeamId() uint32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *StandingsEntry) GetRaceNumber() uint32 {
+	if x != nil {
+		return x.RaceNumber
+	}
+	return 0
+}
+
+func (x *StandingsEntry) GetPlayer() bool {
+	if x != nil {
+		return x.Player
+	}
+	return false
+}
+
+func (x *StandingsEntry) GetLapNumber() uint32 {
+	if x != nil {
+		return x.LapNumber
+	}
+	return 0
+}
+
+func (x *StandingsEntry) GetLapDistance() float32 {
+	if x != nil {
+		return x.LapDistance
+	}
+	return 0
+}
+
+func (x *StandingsEntry) GetTotalDistance() float32 {
+	if x != nil {
+		return x.TotalDistance
+	}
+	return 0
+}
+
+func (x *StandingsEntry) GetGapToLeader() float32 {
+	if x != nil {
+		return x.GapToLeader
+	}
+	return 0
+}
+
+func (x *StandingsEntry) GetInterval() float32 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *StandingsEntry) GetLapsDown() uint32 {
+	if x != nil {
+		return x.LapsDown
+	}
+	return 0
+}
+
+func (x *StandingsEntry) GetPitStops() uint32 {
+	if x != nil {
+		return x.PitStops
+	}
+	return 0
+}
+
+func (x *StandingsEntry) GetPitStatus() uint32 {
+	if x != nil {
+		return x.PitStatus
+	}
+	return 0
+}
+
+func (x *StandingsEntry) GetTyreCompound() uint32 {
+	if x != nil {
+		return x.TyreCompound
+	}
+	return 0
+}
+
+func (x *StandingsEntry) GetTyreAgeLaps() uint32 {
+	if x != nil {
+		return x.TyreAgeLaps
+	}
+	return 0
+}
+
+func (x *StandingsEntry) GetLastLapTimeInMs() uint32 {
+	if x != nil {
+		return x.LastLapTimeInMs
+	}
+	return 0
+}
+
+func (x *StandingsEntry) GetBestLapTimeInMs() uint32 {
+	if x != nil {
+		return x.BestLapTimeInMs
+	}
+	return 0
+}
+
+func (x *StandingsEntry) GetResultStatus() uint32 {
+	if x != nil {
+		return x.ResultStatus
+	}
+	return 0
+}
+
+var File_standings_proto protoreflect.FileDescriptor
+
+var file_standings_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x0c, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x76, 0x31, 0x22,
+	0x29, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x4d, 0x0a, 0x14, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x35, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x2b, 0x0a, 0x15, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0xc9, 0x01, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x72, 0x61, 0x63, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x36, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x22, 0xfb, 0x04, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x61, 0x72, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x63, 0x61, 0x72, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72,
+	0x61, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x72, 0x61, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6c, 0x61, 0x70, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x70, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x6c, 0x61, 0x70, 0x44, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x22, 0x0a,
+	0x0d, 0x67, 0x61, 0x70, 0x5f, 0x74, 0x6f, 0x5f, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x67, 0x61, 0x70, 0x54, 0x6f, 0x4c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x1b, 0x0a,
+	0x09, 0x6c, 0x61, 0x70, 0x73, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x6c, 0x61, 0x70, 0x73, 0x44, 0x6f, 0x77, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x69,
+	0x74, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70,
+	0x69, 0x74, 0x53, 0x74, 0x6f, 0x70, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x69, 0x74, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x70, 0x69, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x79, 0x72, 0x65, 0x5f, 0x63,
+	0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x74,
+	0x79, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x74,
+	0x79, 0x72, 0x65, 0x5f, 0x61, 0x67, 0x65, 0x5f, 0x6c, 0x61, 0x70, 0x73, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x74, 0x79, 0x72, 0x65, 0x41, 0x67, 0x65, 0x4c, 0x61, 0x70, 0x73, 0x12,
+	0x2c, 0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6c, 0x61, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6c, 0x61,
+	0x73, 0x74, 0x4c, 0x61, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x4d, 0x73, 0x12, 0x2c, 0x0a,
+	0x13, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x6c, 0x61, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69,
+	0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x62, 0x65, 0x73, 0x74,
+	0x4c, 0x61, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x4d, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x32, 0xbb, 0x01, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x55, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x21, 0x2e, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x74, 0x61, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0e,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x23,
+	0x2e, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x30, 0x01, 0x42, 0x0c,
+	0x5a, 0x0a, 0x2e, 0x3b, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_standings_proto_rawDescOnce sync.Once
+	file_standings_proto_rawDescData = file_standings_proto_rawDesc
+)
+
+func file_standings_proto_rawDescGZIP() []byte {
+	file_standings_proto_rawDescOnce.Do(func() {
+		file_standings_proto_rawDescData = protoimpl.X.CompressGZIP(file_standings_proto_rawDescData)
+	})
+	return file_standings_proto_rawDescData
+}
+
+var file_standings_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_standings_proto_goTypes = []interface{}{
+	(*GetStandingsRequest)(nil),   // 0: standings.v1.GetStandingsRequest
+	(*GetStandingsResponse)(nil),  // 1: standings.v1.GetStandingsResponse
+	(*WatchStandingsRequest)(nil), // 2: standings.v1.WatchStandingsRequest
+	(*Standings)(nil),             // 3: standings.v1.Standings
+	(*StandingsEntry)(nil),        // 4: standings.v1.StandingsEntry
+}
+var file_standings_proto_depIdxs = []int32{
+	3, // 0: standings.v1.GetStandingsResponse.standings:type_name -> standings.v1.Standings
+	4, // 1: standings.v1.Standings.entries:type_name -> standings.v1.StandingsEntry
+	0, // 2: standings.v1.StandingsService.GetStandings:input_type -> standings.v1.GetStandingsRequest
+	2, // 3: standings.v1.StandingsService.WatchStandings:input_type -> standings.v1.WatchStandingsRequest
+	1, // 4: standings.v1.StandingsService.GetStandings:output_type -> standings.v1.GetStandingsResponse
+	3, // 5: standings.v1.StandingsService.WatchStandings:output_type -> standings.v1.Standings
+	4, // [4:6] is the sub-list for method output_type
+	2, // [2:4] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_standings_proto_init() }
+func file_standings_proto_init() {
+	if File_standings_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_standings_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStandingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_standings_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStandingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_standings_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchStandingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_standings_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Standings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_standings_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StandingsEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_standings_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_standings_proto_goTypes,
+		DependencyIndexes: file_standings_proto_depIdxs,
+		MessageInfos:      file_standings_proto_msgTypes,
+	}.Build()
+	File_standings_proto = out.File
+	file_standings_proto_rawDesc = nil
+	file_standings_proto_goTypes = nil
+	file_standings_proto_depIdxs = nil
+}
@@ -0,0 +1,173 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v4.24.1
+// source: standings.proto
+
+package grpc_gen
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// StandingsServiceClient is the client API for StandingsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StandingsServiceClient interface {
+	// GetStandings gets the current standings of the session on a chair
+	GetStandings(ctx context.Context, in *GetStandingsRequest, opts ...grpc.CallOption) (*GetStandingsResponse, error)
+	// WatchStandings sends the current standings, and after that every change. Changes of only the gaps are sent at most twice a second
+	WatchStandings(ctx context.Context, in *WatchStandingsRequest, opts ...grpc.CallOption) (StandingsService_WatchStandingsClient, error)
+}
+
+type standingsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStandingsServiceClient(cc grpc.ClientConnInterface) StandingsServiceClient {
+	return &standingsServiceClient{cc}
+}
+
+func (c *standingsServiceClient) GetStandings(ctx context.Context, in *GetStandingsRequest, opts ...grpc.CallOption) (*GetStandingsResponse, error) {
+	out := new(GetStandingsResponse)
+	err := c.cc.Invoke(ctx, "/standings.v1.StandingsService/GetStandings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *standingsServiceClient) WatchStandings(ctx context.Context, in *WatchStandingsRequest, opts ...grpc.CallOption) (StandingsService_WatchStandingsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &StandingsService_ServiceDesc.Streams[0], "/standings.v1.StandingsService/WatchStandings", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &standingsServiceWatchStandingsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type StandingsService_WatchStandingsClient interface {
+	Recv() (*Standings, error)
+	grpc.ClientStream
+}
+
+type standingsServiceWatchStandingsClient struct {
+	grpc.ClientStream
+}
+
+func (x *standingsServiceWatchStandingsClient) Recv() (*Standings, error) {
+	m := new(Standings)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// StandingsServiceServer is the server API for StandingsService service.
+// All implementations must embed UnimplementedStandingsServiceServer
+// for forward compatibility
+type StandingsServiceServer interface {
+	// GetStandings gets the current standings of the session on a chair
+	GetStandings(context.Context, *GetStandingsRequest) (*GetStandingsResponse, error)
+	// WatchStandings sends the current standings, and after that every change. Changes of only the gaps are sent at most twice a second
+	WatchStandings(*WatchStandingsRequest, StandingsService_WatchStandingsServer) error
+	mustEmbedUnimplementedStandingsServiceServer()
+}
+
+// UnimplementedStandingsServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedStandingsServiceServer struct {
+}
+
+func (UnimplementedStandingsServiceServer) GetStandings(context.Context, *GetStandingsRequest) (*GetStandingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStandings not implemented")
+}
+func (UnimplementedStandingsServiceServer) WatchStandings(*WatchStandingsRequest, StandingsService_WatchStandingsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchStandings not implemented")
+}
+func (UnimplementedStandingsServiceServer) mustEmbedUnimplementedStandingsServiceServer() {}
+
+// UnsafeStandingsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StandingsServiceServer will
+// result in compilation errors.
+type UnsafeStandingsServiceServer interface {
+	mustEmbedUnimplementedStandingsServiceServer()
+}
+
+func RegisterStandingsServiceServer(s grpc.ServiceRegistrar, srv StandingsServiceServer) {
+	s.RegisterService(&StandingsService_ServiceDesc, srv)
+}
+
+func _StandingsService_GetStandings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStandingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StandingsServiceServer).GetStandings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/standings.v1.StandingsService/GetStandings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StandingsServiceServer).GetStandings(ctx, req.(*GetStandingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StandingsService_WatchStandings_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchStandingsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StandingsServiceServer).WatchStandings(m, &standingsServiceWatchStandingsServer{stream})
+}
+
+type StandingsService_WatchStandingsServer interface {
+	Send(*Standings) error
+	grpc.ServerStream
+}
+
+type standingsServiceWatchStandingsServer struct {
+	grpc.ServerStream
+}
+
+func (x *standingsServiceWatchStandingsServer) Send(m *Standings) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// StandingsService_ServiceDesc is the grpc.ServiceDesc for StandingsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StandingsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "standings.v1.StandingsService",
+	HandlerType: (*StandingsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetStandings",
+			Handler:    _StandingsService_GetStandings_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchStandings",
+			Handler:       _StandingsService_WatchStandings_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "standings.proto",
+}
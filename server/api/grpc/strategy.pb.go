@@ -0,0 +1,926 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v4.24.1
+// source: strategy.proto
+
+package grpc_gen
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ListStrategiesRequest is a request to get the predictions of the cars on a chair
+type ListStrategiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Port string `protobuf:"bytes,1,opt,name=port,proto3" json:"port,omitempty"` // the upd port of the chair
+}
+
+func (x *ListStrategiesRequest) Reset() {
+	*x = ListStrategiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_strategy_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStrategiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStrategiesRequest) ProtoMessage() {}
+
+func (x *ListStrategiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_strategy_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStrategiesRequest.ProtoReflect.Descriptor instead.
+func (*ListStrategiesRequest) Descriptor() ([]byte, []int) {
+	return file_strategy_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListStrategiesRequest) GetPort() string {
+	if x != nil {
+		return x.Port
+	}
+	return ""
+}
+
+// ListStrategiesResponse is a response to a ListStrategiesRequest
+type ListStrategiesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Strategies []*Strategy `protobuf:"bytes,1,rep,name=strategies,proto3" json:"strategies,omitempty"` // ordered by car index
+}
+
+func (x *ListStrategiesResponse) Reset() {
+	*x = ListStrategiesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_strategy_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStrategiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStrategiesResponse) ProtoMessage() {}
+
+func (x *ListStrategiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_strategy_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStrategiesResponse.ProtoReflect.Descriptor instead.
+func (*ListStrategiesResponse) Descriptor() ([]byte, []int) {
+	return file_strategy_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListStrategiesResponse) GetStrategies() []*Strategy {
+	if x != nil {
+		return x.Strategies
+	}
+	return nil
+}
+
+// GetStrategyRequest is a request to get the prediction of a car
+type GetStrategyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Port     string `protobuf:"bytes,1,opt,name=port,proto3" json:"port,omitempty"` // the upd port of the chair
+	CarIndex uint32 `protobuf:"varint,2,opt,name=car_index,json=carIndex,proto3" json:"car_index,omitempty"`
+}
+
+func (x *GetStrategyRequest) Reset() {
+	*x = GetStrategyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_strategy_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStrategyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStrategyRequest) ProtoMessage() {}
+
+func (x *GetStrategyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_strategy_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStrategyRequest.ProtoReflect.Descriptor instead.
+func (*GetStrategyRequest) Descriptor() ([]byte, []int) {
+	return file_strategy_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetStrategyRequest) GetPort() string {
+	if x != nil {
+		return x.Port
+	}
+	return ""
+}
+
+func (x *GetStrategyRequest) GetCarIndex() uint32 {
+	if x != nil {
+		return x.CarIndex
+	}
+	return 0
+}
+
+// GetStrategyResponse is a response to a GetStrategyRequest
+type GetStrategyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Strategy *Strategy `protobuf:"bytes,1,opt,name=strategy,proto3" json:"strategy,omitempty"`
+}
+
+func (x *GetStrategyResponse) Reset() {
+	*x = GetStrategyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_strategy_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStrategyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStrategyResponse) ProtoMessage() {}
+
+func (x *GetStrategyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_strategy_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStrategyResponse.ProtoReflect.Descriptor instead.
+func (*GetStrategyResponse) Descriptor() ([]byte, []int) {
+	return file_strategy_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetStrategyResponse) GetStrategy() *Strategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return nil
+}
+
+// Strategy is the fuel and tyre prediction of a car for the remaining race distance
+type Strategy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Port              string             `protobuf:"bytes,1,opt,name=port,proto3" json:"port,omitempty"` // the upd port of the chair
+	SessionUid        uint64             `protobuf:"varint,2,opt,name=session_uid,json=sessionUid,proto3" json:"session_uid,omitempty"`
+	CarIndex          uint32             `protobuf:"varint,3,opt,name=car_index,json=carIndex,proto3" json:"car_index,omitempty"`
+	Race              bool               `protobuf:"varint,4,opt,name=race,proto3" json:"race,omitempty"` // pit windows are only recommended for a race
+	LapNumber         uint32             `protobuf:"varint,5,opt,name=lap_number,json=lapNumber,proto3" json:"lap_number,omitempty"`
+	TotalLaps         uint32             `protobuf:"varint,6,opt,name=total_laps,json=totalLaps,proto3" json:"total_laps,omitempty"`                            // 0 if the session is not known
+	FuelInTank        float32            `protobuf:"fixed32,7,opt,name=fuel_in_tank,json=fuelInTank,proto3" json:"fuel_in_tank,omitempty"`                      // kilograms
+	FuelRemainingLaps float32            `protobuf:"fixed32,8,opt,name=fuel_remaining_laps,json=fuelRemainingLaps,proto3" json:"fuel_remaining_laps,omitempty"` // the laps of fuel left over at the finish, as shown in the game
+	FuelPerLap        float32            `protobuf:"fixed32,9,opt,name=fuel_per_lap,json=fuelPerLap,proto3" json:"fuel_per_lap,omitempty"`                      // kilograms, 0 until two laps are driven
+	FuelAtFinish      float32            `protobuf:"fixed32,10,opt,name=fuel_at_finish,json=fuelAtFinish,proto3" json:"fuel_at_finish,omitempty"`               // kilograms, the projected fuel at the finish when the current lap counts as a full lap
+	TyreCompound      uint32             `protobuf:"varint,11,opt,name=tyre_compound,json=tyreCompound,proto3" json:"tyre_compound,omitempty"`                  // the visual compound, 0 if unknown
+	TyreAgeLaps       uint32             `protobuf:"varint,12,opt,name=tyre_age_laps,json=tyreAgeLaps,proto3" json:"tyre_age_laps,omitempty"`
+	TyreWear          []float32          `protobuf:"fixed32,13,rep,packed,name=tyre_wear,json=tyreWear,proto3" json:"tyre_wear,omitempty"`         // percent: rear left, rear right, front left, front right
+	WearPerLap        []float32          `protobuf:"fixed32,14,rep,packed,name=wear_per_lap,json=wearPerLap,proto3" json:"wear_per_lap,omitempty"` // percent, 0 until the rate is known
+	ProjectedWear     []*LapWear         `protobuf:"bytes,15,rep,name=projected_wear,json=projectedWear,proto3" json:"projected_wear,omitempty"`   // until the finish, or the next laps if the race distance is not known
+	CliffLap          uint32             `protobuf:"varint,16,opt,name=cliff_lap,json=cliffLap,proto3" json:"cliff_lap,omitempty"`                 // the lap the fitted tyres hit the cliff, 0 if the wear rate is not known
+	TyreSets          []*StrategyTyreSet `protobuf:"bytes,17,rep,name=tyre_sets,json=tyreSets,proto3" json:"tyre_sets,omitempty"`                  // empty until the tyre sets of the car are received
+	PitWindows        []*PitWindow       `protobuf:"bytes,18,rep,name=pit_windows,json=pitWindows,proto3" json:"pit_windows,omitempty"`            // the fewest stops that reach the finish
+	ReachesFinish     bool               `protobuf:"varint,19,opt,name=reaches_finish,json=reachesFinish,proto3" json:"reaches_finish,omitempty"`  // false if the available sets do not reach the finish, or nothing is known yet
+	Updated           int64              `protobuf:"varint,20,opt,name=updated,proto3" json:"updated,omitempty"`                                   // unix milliseconds
+}
+
+func (x *Strategy) Reset() {
+	*x = Strategy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_strategy_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Strategy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Strategy) ProtoMessage() {}
+
+func (x *Strategy) ProtoReflect() protoreflect.Message {
+	mi := &file_strategy_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Strategy.ProtoReflect.Descriptor instead.
+func (*Strategy) Descriptor() ([]byte, []int) {
+	return file_strategy_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Strategy) GetPort() string {
+	if x != nil {
+		return x.Port
+	}
+	return ""
+}
+
+func (x *Strategy) GetSessionUid() uint64 {
+	if x != nil {
+		return x.SessionUid
+	}
+	return 0
+}
+
+func (x *Strategy) GetCarIndex() uint32 {
+	if x != nil {
+		return x.CarIndex
+	}
+	return 0
+}
+
+func (x *Strategy) GetRace() bool {
+	if x != nil {
+		return x.Race
+	}
+	return false
+}
+
+func (x *Strategy) GetLapNumber() uint32 {
+	if x != nil {
+		return x.LapNumber
+	}
+	return 0
+}
+
+func (x *Strategy) GetTotalLaps() uint32 {
+	if x != nil {
+		return x.TotalLaps
+	}
+	return 0
+}
+
+func (x *Strategy) GetFuelInTank() float32 {
+	if x != nil {
+		return x.FuelInTank
+	}
+	return 0
+}
+
+func (x *Strategy) GetFuelRemainingLaps() float32 {
+	if x != nil {
+		return x.FuelRemainingLaps
+	}
+	return 0
+}
+
+func (x *Strategy) GetFuelPerLap() float32 {
+	if x != nil {
+		return x.FuelPerLap
+	}
+	return 0
+}
+
+func (x *Strategy) GetFuelAtFinish() float32 {
+	if x != nil {
+		return x.FuelAtFinish
+	}
+	return 0
+}
+
+func (x *Strategy) GetTyreCompound() uint32 {
+	if x != nil {
+		return x.TyreCompound
+	}
+	return 0
+}
+
+func (x *Strategy) GetTyreAgeLaps() uint32 {
+	if x != nil {
+		return x.TyreAgeLaps
+	}
+	return 0
+}
+
+func (x *Strategy) GetTyreWear() []float32 {
+	if x != nil {
+		return x.TyreWear
+	}
+	return nil
+}
+
+func (x *Strategy) GetWearPerLap() []float32 {
+	if x != nil {
+		return x.WearPerLap
+	}
+	return nil
+}
+
+func (x *Strategy) GetProjectedWear() []*LapWear {
+	if x != nil {
+		return x.ProjectedWear
+	}
+	return nil
+}
+
+func (x *Strategy) GetCliffLap() uint32 {
+	if x != nil {
+		return x.CliffLap
+	}
+	return 0
+}
+
+func (x *Strategy) GetTyreSets() []*StrategyTyreSet {
+	if x != nil {
+		return x.TyreSets
+	}
+	return nil
+}
+
+func (x *Strategy) GetPitWindows() []*PitWindow {
+	if x != nil {
+		return x.PitWindows
+	}
+	return nil
+}
+
+func (x *Strategy) GetReachesFinish() bool {
+	if x != nil {
+		return x.ReachesFinish
+	}
+	return false
+}
+
+func (x *Strategy) GetUpdated() int64 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+// LapWear is the projected wear of the tyres at the end of a lap
+type LapWear struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lap  uint32    `protobuf:"varint,1,opt,name=lap,proto3" json:"lap,omitempty"`
+	Wear []float32 `protobuf:"fixed32,2,rep,packed,name=wear,proto3" json:"wear,omitempty"` // percent: rear left, rear right, front left, front right
+}
+
+func (x *LapWear) Reset() {
+	*x = LapWear{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_strategy_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LapWear) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LapWear) ProtoMessage() {}
+
+func (x *LapWear) ProtoReflect() protoreflect.Message {
+	mi := &file_strategy_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LapWear.ProtoReflect.Descriptor instead.
+func (*LapWear) Descriptor() ([]byte, []int) {
+	return file_strategy_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *LapWear) GetLap() uint32 {
+	if x != nil {
+		return x.Lap
+	}
+	return 0
+}
+
+func (x *LapWear) GetWear() []float32 {
+	if x != nil {
+		return x.Wear
+	}
+	return nil
+}
+
+// StrategyTyreSet is a set of tyres assigned to a car, with the laps it lasts until the cliff
+type StrategyTyreSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index          uint32  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"` // the index of the set in the tyre sets of the car
+	ActualCompound uint32  `protobuf:"varint,2,opt,name=actual_compound,json=actualCompound,proto3" json:"actual_compound,omitempty"`
+	VisualCompound uint32  `protobuf:"varint,3,opt,name=visual_compound,json=visualCompound,proto3" json:"visual_compound,omitempty"`
+	Wear           float32 `protobuf:"fixed32,4,opt,name=wear,proto3" json:"wear,omitempty"` // percent
+	Fitted         bool    `protobuf:"varint,5,opt,name=fitted,proto3" json:"fitted,omitempty"`
+	LifeLaps       uint32  `protobuf:"varint,6,opt,name=life_laps,json=lifeLaps,proto3" json:"life_laps,omitempty"` // the laps the set lasts until it hits the cliff, 0 if it is worn out or the wear rate is not known
+	CliffLap       uint32  `protobuf:"varint,7,opt,name=cliff_lap,json=cliffLap,proto3" json:"cliff_lap,omitempty"` // the lap the set hits the cliff when it is fitted at the end of the current lap, or the fitted set from now on
+}
+
+func (x *StrategyTyreSet) Reset() {
+	*x = StrategyTyreSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_strategy_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StrategyTyreSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StrategyTyreSet) ProtoMessage() {}
+
+func (x *StrategyTyreSet) ProtoReflect() protoreflect.Message {
+	mi := &file_strategy_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StrategyTyreSet.ProtoReflect.Descriptor instead.
+func (*StrategyTyreSet) Descriptor() ([]byte, []int) {
+	return file_strategy_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StrategyTyreSet) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *StrategyTyreSet) GetActualCompound() uint32 {
+	if x != nil {
+		return x.ActualCompound
+	}
+	return 0
+}
+
+func (x *StrategyTyreSet) GetVisualCompound() uint32 {
+	if x != nil {
+		return x.VisualCompound
+	}
+	return 0
+}
+
+func (x *StrategyTyreSet) GetWear() float32 {
+	if x != nil {
+		return x.Wear
+	}
+	return 0
+}
+
+func (x *StrategyTyreSet) GetFitted() bool {
+	if x != nil {
+		return x.Fitted
+	}
+	return false
+}
+
+func (x *StrategyTyreSet) GetLifeLaps() uint32 {
+	if x != nil {
+		return x.LifeLaps
+	}
+	return 0
+}
+
+func (x *StrategyTyreSet) GetCliffLap() uint32 {
+	if x != nil {
+		return x.CliffLap
+	}
+	return 0
+}
+
+// PitWindow is a recommended stop, made at the end of a lap between opening and closing
+type PitWindow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stop           uint32 `protobuf:"varint,1,opt,name=stop,proto3" json:"stop,omitempty"` // 1 for the first stop
+	Opens          uint32 `protobuf:"varint,2,opt,name=opens,proto3" json:"opens,omitempty"`
+	Closes         uint32 `protobuf:"varint,3,opt,name=closes,proto3" json:"closes,omitempty"`
+	TyreSet        uint32 `protobuf:"varint,4,opt,name=tyre_set,json=tyreSet,proto3" json:"tyre_set,omitempty"` // the index of the set to fit
+	VisualCompound uint32 `protobuf:"varint,5,opt,name=visual_compound,json=visualCompound,proto3" json:"visual_compound,omitempty"`
+}
+
+func (x *PitWindow) Reset() {
+	*x = PitWindow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_strategy_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PitWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PitWindow) ProtoMessage() {}
+
+func (x *PitWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_strategy_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PitWindow.ProtoReflect.Descriptor instead.
+func (*PitWindow) Descriptor() ([]byte, []int) {
+	return file_strategy_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PitWindow) GetStop() uint32 {
+	if x != nil {
+		return x.Stop
+	}
+	return 0
+}
+
+func (x *PitWindow) GetOpens() uint32 {
+	if x != nil {
+		return x.Opens
+	}
+	return 0
+}
+
+func (x *PitWindow) GetCloses() uint32 {
+	if x != nil {
+		return x.Closes
+	}
+	return 0
+}
+
+func (x *PitWindow) GetTyreSet() uint32 {
+	if x != nil {
+		return x.TyreSet
+	}
+	return 0
+}
+
+func (x *PitWindow) GetVisualCompound() uint32 {
+	if x != nil {
+		return x.VisualCompound
+	}
+	return 0
+}
+
+var File_strategy_proto protoreflect.FileDescriptor
+
+var file_strategy_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x0b, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x2e, 0x76, 0x31, 0x22, 0x2b, 0x0a,
+	0x15, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x4f, 0x0a, 0x16, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0a, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x69,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52,
+	0x0a, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x69, 0x65, 0x73, 0x22, 0x45, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x61, 0x72, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x63, 0x61, 0x72, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x22, 0x48, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x79, 0x52, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x22, 0xdf, 0x05, 0x0a,
+	0x08, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55, 0x69, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x63, 0x61, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x63, 0x61, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x72,
+	0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x72, 0x61, 0x63, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x09, 0x6c, 0x61, 0x70, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d,
+	0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6c, 0x61, 0x70, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x61, 0x70, 0x73, 0x12, 0x20, 0x0a,
+	0x0c, 0x66, 0x75, 0x65, 0x6c, 0x5f, 0x69, 0x6e, 0x5f, 0x74, 0x61, 0x6e, 0x6b, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x0a, 0x66, 0x75, 0x65, 0x6c, 0x49, 0x6e, 0x54, 0x61, 0x6e, 0x6b, 0x12,
+	0x2e, 0x0a, 0x13, 0x66, 0x75, 0x65, 0x6c, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x5f, 0x6c, 0x61, 0x70, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x11, 0x66, 0x75,
+	0x65, 0x6c, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x4c, 0x61, 0x70, 0x73, 0x12,
+	0x20, 0x0a, 0x0c, 0x66, 0x75, 0x65, 0x6c, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6c, 0x61, 0x70, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x66, 0x75, 0x65, 0x6c, 0x50, 0x65, 0x72, 0x4c, 0x61,
+	0x70, 0x12, 0x24, 0x0a, 0x0e, 0x66, 0x75, 0x65, 0x6c, 0x5f, 0x61, 0x74, 0x5f, 0x66, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x66, 0x75, 0x65, 0x6c, 0x41,
+	0x74, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x79, 0x72, 0x65, 0x5f,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c,
+	0x74, 0x79, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x22, 0x0a, 0x0d,
+	0x74, 0x79, 0x72, 0x65, 0x5f, 0x61, 0x67, 0x65, 0x5f, 0x6c, 0x61, 0x70, 0x73, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x74, 0x79, 0x72, 0x65, 0x41, 0x67, 0x65, 0x4c, 0x61, 0x70, 0x73,
+	0x12, 0x1b, 0x0a, 0x09, 0x74, 0x79, 0x72, 0x65, 0x5f, 0x77, 0x65, 0x61, 0x72, 0x18, 0x0d, 0x20,
+	0x03, 0x28, 0x02, 0x52, 0x08, 0x74, 0x79, 0x72, 0x65, 0x57, 0x65, 0x61, 0x72, 0x12, 0x20, 0x0a,
+	0x0c, 0x77, 0x65, 0x61, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6c, 0x61, 0x70, 0x18, 0x0e, 0x20,
+	0x03, 0x28, 0x02, 0x52, 0x0a, 0x77, 0x65, 0x61, 0x72, 0x50, 0x65, 0x72, 0x4c, 0x61, 0x70, 0x12,
+	0x3b, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x77, 0x65, 0x61,
+	0x72, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x70, 0x57, 0x65, 0x61, 0x72, 0x52, 0x0d, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x57, 0x65, 0x61, 0x72, 0x12, 0x1b, 0x0a, 0x09,
+	0x63, 0x6c, 0x69, 0x66, 0x66, 0x5f, 0x6c, 0x61, 0x70, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x63, 0x6c, 0x69, 0x66, 0x66, 0x4c, 0x61, 0x70, 0x12, 0x39, 0x0a, 0x09, 0x74, 0x79, 0x72,
+	0x65, 0x5f, 0x73, 0x65, 0x74, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x54, 0x79, 0x72, 0x65, 0x53, 0x65, 0x74, 0x52, 0x08, 0x74, 0x79, 0x72, 0x65,
+	0x53, 0x65, 0x74, 0x73, 0x12, 0x37, 0x0a, 0x0b, 0x70, 0x69, 0x74, 0x5f, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x65, 0x67, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x52, 0x0a, 0x70, 0x69, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x12, 0x25, 0x0a,
+	0x0e, 0x72, 0x65, 0x61, 0x63, 0x68, 0x65, 0x73, 0x5f, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x18,
+	0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x72, 0x65, 0x61, 0x63, 0x68, 0x65, 0x73, 0x46, 0x69,
+	0x6e, 0x69, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0x2f,
+	0x0a, 0x07, 0x4c, 0x61, 0x70, 0x57, 0x65, 0x61, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x61, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6c, 0x61, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x77,
+	0x65, 0x61, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x02, 0x52, 0x04, 0x77, 0x65, 0x61, 0x72, 0x22,
+	0xdf, 0x01, 0x0a, 0x0f, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x54, 0x79, 0x72, 0x65,
+	0x53, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63, 0x74,
+	0x75, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0e, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x75,
+	0x6e, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x76, 0x69, 0x73,
+	0x75, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x77,
+	0x65, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x77, 0x65, 0x61, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x66, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x66, 0x69, 0x74, 0x74, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x69, 0x66, 0x65, 0x5f,
+	0x6c, 0x61, 0x70, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6c, 0x69, 0x66, 0x65,
+	0x4c, 0x61, 0x70, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x66, 0x66, 0x5f, 0x6c, 0x61,
+	0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x66, 0x66, 0x4c, 0x61,
+	0x70, 0x22, 0x91, 0x01, 0x0a, 0x09, 0x50, 0x69, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x74, 0x6f, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73,
+	0x74, 0x6f, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6c, 0x6f,
+	0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x63, 0x6c, 0x6f, 0x73, 0x65,
+	0x73, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x79, 0x72, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x79, 0x72, 0x65, 0x53, 0x65, 0x74, 0x12, 0x27, 0x0a, 0x0f,
+	0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x43, 0x6f, 0x6d,
+	0x70, 0x6f, 0x75, 0x6e, 0x64, 0x32, 0xbe, 0x01, 0x0a, 0x0f, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x59, 0x0a, 0x0e, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x69, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x12, 0x1f, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0c, 0x5a, 0x0a, 0x2e, 0x3b, 0x67, 0x72, 0x70, 0x63,
+	0x5f, 0x67, 0x65, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_strategy_proto_rawDescOnce sync.Once
+	file_strategy_proto_rawDescData = file_strategy_proto_rawDesc
+)
+
+func file_strategy_proto_rawDescGZIP() []byte {
+	file_strategy_proto_rawDescOnce.Do(func() {
+		file_strategy_proto_rawDescData = protoimpl.X.CompressGZIP(file_strategy_proto_rawDescData)
+	})
+	return file_strategy_proto_rawDescData
+}
+
+var file_strategy_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_strategy_proto_goTypes = []interface{}{
+	(*ListStrategiesRequest)(nil),  // 0: strategy.v1.ListStrategiesRequest
+	(*ListStrategiesResponse)(nil), // 1: strategy.v1.ListStrategiesResponse
+	(*GetStrategyRequest)(nil),     // 2: strategy.v1.GetStrategyRequest
+	(*GetStrategyResponse)(nil),    // 3: strategy.v1.GetStrategyResponse
+	(*Strategy)(nil),               // 4: strategy.v1.Strategy
+	(*LapWear)(nil),                // 5: strategy.v1.LapWear
+	(*StrategyTyreSet)(nil),        // 6: strategy.v1.StrategyTyreSet
+	(*PitWindow)(nil),              // 7: strategy.v1.PitWindow
+}
+var file_strategy_proto_depIdxs = []int32{
+	4, // 0: strategy.v1.ListStrategiesResponse.strategies:type_name -> strategy.v1.Strategy
+	4, // 1: strategy.v1.GetStrategyResponse.strategy:type_name -> strategy.v1.Strategy
+	5, // 2: strategy.v1.Strategy.projected_wear:type_name -> strategy.v1.LapWear
+	6, // 3: strategy.v1.Strategy.tyre_sets:type_name -> strategy.v1.StrategyTyreSet
+	7, // 4: strategy.v1.Strategy.pit_windows:type_name -> strategy.v1.PitWindow
+	0, // 5: strategy.v1.StrategyService.ListStrategies:input_type -> strategy.v1.ListStrategiesRequest
+	2, // 6: strategy.v1.StrategyService.GetStrategy:input_type -> strategy.v1.GetStrategyRequest
+	1, // 7: strategy.v1.StrategyService.ListStrategies:output_type -> strategy.v1.ListStrategiesResponse
+	3, // 8: strategy.v1.StrategyService.GetStrategy:output_type -> strategy.v1.GetStrategyResponse
+	7, // [7:9] is the sub-list for method output_type
+	5, // [5:7] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_strategy_proto_init() }
+func file_strategy_proto_init() {
+	if File_strategy_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_strategy_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStrategiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_strategy_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStrategiesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_strategy_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStrategyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_strategy_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStrategyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_strategy_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Strategy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_strategy_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LapWear); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_strategy_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StrategyTyreSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_strategy_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PitWindow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_strategy_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_strategy_proto_goTypes,
+		DependencyIndexes: file_strategy_proto_depIdxs,
+		MessageInfos:      file_strategy_proto_msgTypes,
+	}.Build()
+	File_strategy_proto = out.File
+	file_strategy_proto_rawDesc = nil
+	file_strategy_proto_goTypes = nil
+	file_strategy_proto_depIdxs = nil
+}
@@ -0,0 +1,6883 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v4.24.1
+// source: telemetry.proto
+
+package grpc_gen
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PacketCategory is the kind of packet to subscribe to
+type PacketCategory int32
+
+const (
+	PacketCategory_PACKET_CATEGORY_UNSPECIFIED          PacketCategory = 0
+	PacketCategory_PACKET_CATEGORY_MOTION               PacketCategory = 1
+	PacketCategory_PACKET_CATEGORY_SESSION              PacketCategory = 2
+	PacketCategory_PACKET_CATEGORY_LAP_DATA             PacketCategory = 3
+	PacketCategory_PACKET_CATEGORY_EVENT                PacketCategory = 4
+	PacketCategory_PACKET_CATEGORY_PARTICIPANTS         PacketCategory = 5
+	PacketCategory_PACKET_CATEGORY_CAR_SETUPS           PacketCategory = 6
+	PacketCategory_PACKET_CATEGORY_CAR_TELEMETRY        PacketCategory = 7
+	PacketCategory_PACKET_CATEGORY_CAR_STATUS           PacketCategory = 8
+	PacketCategory_PACKET_CATEGORY_FINAL_CLASSIFICATION PacketCategory = 9
+	PacketCategory_PACKET_CATEGORY_LOBBY_INFO           PacketCategory = 10
+	PacketCategory_PACKET_CATEGORY_CAR_DAMAGE           PacketCategory = 11
+	PacketCategory_PACKET_CATEGORY_SESSION_HISTORY      PacketCategory = 12
+	PacketCategory_PACKET_CATEGORY_TYRE_SETS            PacketCategory = 13
+	PacketCategory_PACKET_CATEGORY_MOTION_EX            PacketCategory = 14
+)
+
+// Enum value maps for PacketCategory.
+var (
+	PacketCategory_name = map[int32]string{
+		0:  "PACKET_CATEGORY_UNSPECIFIED",
+		1:  "PACKET_CATEGORY_MOTION",
+		2:  "PACKET_CATEGORY_SESSION",
+		3:  "PACKET_CATEGORY_LAP_DATA",
+		4:  "PACKET_CATEGORY_EVENT",
+		5:  "PACKET_CATEGORY_PARTICIPANTS",
+		6:  "PACKET_CATEGORY_CAR_SETUPS",
+		7:  "PACKET_CATEGORY_CAR_TELEMETRY",
+		8:  "PACKET_CATEGORY_CAR_STATUS",
+		9:  "PACKET_CATEGORY_FINAL_CLASSIFICATION",
+		10: "PACKET_CATEGORY_LOBBY_INFO",
+		11: "PACKET_CATEGORY_CAR_DAMAGE",
+		12: "PACKET_CATEGORY_SESSION_HISTORY",
+		13: "PACKET_CATEGORY_TYRE_SETS",
+		14: "PACKET_CATEGORY_MOTION_EX",
+	}
+	PacketCategory_value = map[string]int32{
+		"PACKET_CATEGORY_UNSPECIFIED":          0,
+		"PACKET_CATEGORY_MOTION":               1,
+		"PACKET_CATEGORY_SESSION":              2,
+		"PACKET_CATEGORY_LAP_DATA":             3,
+		"PACKET_CATEGORY_EVENT":                4,
+		"PACKET_CATEGORY_PARTICIPANTS":         5,
+		"PACKET_CATEGORY_CAR_SETUPS":           6,
+		"PACKET_CATEGORY_CAR_TELEMETRY":        7,
+		"PACKET_CATEGORY_CAR_STATUS":           8,
+		"PACKET_CATEGORY_FINAL_CLASSIFICATION": 9,
+		"PACKET_CATEGORY_LOBBY_INFO":           10,
+		"PACKET_CATEGORY_CAR_DAMAGE":           11,
+		"PACKET_CATEGORY_SESSION_HISTORY":      12,
+		"PACKET_CATEGORY_TYRE_SETS":            13,
+		"PACKET_CATEGORY_MOTION_EX":            14,
+	}
+)
+
+func (x PacketCategory) Enum() *PacketCategory {
+	p := new(PacketCategory)
+	*p = x
+	return p
+}
+
+func (x PacketCategory) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PacketCategory) Descriptor() protoreflect.EnumDescriptor {
+	return file_telemetry_proto_enumTypes[0].Descriptor()
+}
+
+func (PacketCategory) Type() protoreflect.EnumType {
+	return &file_telemetry_proto_enumTypes[0]
+}
+
+func (x PacketCategory) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PacketCategory.Descriptor instead.
+func (PacketCategory) EnumDescriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{0}
+}
+
+// SubscribeRequest is a request to stream the packets of a chair
+type SubscribeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Port       string           `protobuf:"bytes,1,opt,name=port,proto3" json:"port,omitempty"`                                                      // the upd port of the chair
+	Categories []PacketCategory `protobuf:"varint,2,rep,packed,name=categories,proto3,enum=telemetry.v1.PacketCategory" json:"categories,omitempty"` // the categories to receive, all categories if empty
+	// max_rate is the maximum amount of updates per second per category, 0 for no limit.
+	// Events and final classifications are never dropped, session history and tyre sets are limited per car
+	MaxRate uint32 `protobuf:"varint,3,opt,name=max_rate,json=maxRate,proto3" json:"max_rate,omitempty"`
+}
+
+func (x *SubscribeRequest) Reset() {
+	*x = SubscribeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRequest) ProtoMessage() {}
+
+func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SubscribeRequest) GetPort() string {
+	if x != nil {
+		return x.Port
+	}
+	return ""
+}
+
+func (x *SubscribeRequest) GetCategories() []PacketCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *SubscribeRequest) GetMaxRate() uint32 {
+	if x != nil {
+		return x.MaxRate
+	}
+	return 0
+}
+
+// TelemetryUpdate is a single decoded packet of a chair
+type TelemetryUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Port     string         `protobuf:"bytes,1,opt,name=port,proto3" json:"port,omitempty"` // the upd port of the chair
+	Category PacketCategory `protobuf:"varint,2,opt,name=category,proto3,enum=telemetry.v1.PacketCategory" json:"category,omitempty"`
+	// Types that are assignable to Packet:
+	//	*TelemetryUpdate_Motion
+	//	*TelemetryUpdate_Session
+	//	*TelemetryUpdate_LapData
+	//	*TelemetryUpdate_Event
+	//	*TelemetryUpdate_Participants
+	//	*TelemetryUpdate_CarSetups
+	//	*TelemetryUpdate_CarTelemetry
+	//	*TelemetryUpdate_CarStatus
+	//	*TelemetryUpdate_FinalClassification
+	//	*TelemetryUpdate_LobbyInfo
+	//	*TelemetryUpdate_CarDamage
+	//	*TelemetryUpdate_SessionHistory
+	//	*TelemetryUpdate_TyreSets
+	//	*TelemetryUpdate_MotionEx
+	Packet isTelemetryUpdate_Packet `protobuf_oneof:"packet"`
+}
+
+func (x *TelemetryUpdate) Reset() {
+	*x = TelemetryUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TelemetryUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TelemetryUpdate) ProtoMessage() {}
+
+func (x *TelemetryUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TelemetryUpdate.ProtoReflect.Descriptor instead.
+func (*TelemetryUpdate) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TelemetryUpdate) GetPort() string {
+	if x != nil {
+		return x.Port
+	}
+	return ""
+}
+
+func (x *TelemetryUpdate) GetCategory() PacketCategory {
+	if x != nil {
+		return x.Category
+	}
+	return PacketCategory_PACKET_CATEGORY_UNSPECIFIED
+}
+
+func (m *TelemetryUpdate) GetPacket() isTelemetryUpdate_Packet {
+	if m != nil {
+		return m.Packet
+	}
+	return nil
+}
+
+func (x *TelemetryUpdate) GetMotion() *PacketMotionData {
+	if x, ok := x.GetPacket().(*TelemetryUpdate_Motion); ok {
+		return x.Motion
+	}
+	return nil
+}
+
+func (x *TelemetryUpdate) GetSession() *PacketSessionData {
+	if x, ok := x.GetPacket().(*TelemetryUpdate_Session); ok {
+		return x.Session
+	}
+	return nil
+}
+
+func (x *TelemetryUpdate) GetLapData() *PacketLapData {
+	if x, ok := x.GetPacket().(*TelemetryUpdate_LapData); ok {
+		return x.LapData
+	}
+	return nil
+}
+
+func (x *TelemetryUpdate) GetEvent() *PacketEventData {
+	if x, ok := x.GetPacket().(*TelemetryUpdate_Event); ok {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *TelemetryUpdate) GetParticipants() *PacketParticipantsData {
+	if x, ok := x.GetPacket().(*TelemetryUpdate_Participants); ok {
+		return x.Participants
+	}
+	return nil
+}
+
+func (x *TelemetryUpdate) GetCarSetups() *PacketCarSetupsData {
+	if x, ok := x.GetPacket().(*TelemetryUpdate_CarSetups); ok {
+		return x.CarSetups
+	}
+	return nil
+}
+
+func (x *TelemetryUpdate) GetCarTelemetry() *PacketCarTelemetryData {
+	if x, ok := x.GetPacket().(*TelemetryUpdate_CarTelemetry); ok {
+		return x.CarTelemetry
+	}
+	return nil
+}
+
+func (x *TelemetryUpdate) GetCarStatus() *PacketCarStatusData {
+	if x, ok := x.GetPacket().(*TelemetryUpdate_CarStatus); ok {
+		return x.CarStatus
+	}
+	return nil
+}
+
+func (x *TelemetryUpdate) GetFinalClassification() *PacketFinalClassificationData {
+	if x, ok := x.GetPacket().(*TelemetryUpdate_FinalClassification); ok {
+		return x.FinalClassification
+	}
+	return nil
+}
+
+func (x *TelemetryUpdate) GetLobbyInfo() *PacketLobbyInfoData {
+	if x, ok := x.GetPacket().(*TelemetryUpdate_LobbyInfo); ok {
+		return x.LobbyInfo
+	}
+	return nil
+}
+
+func (x *TelemetryUpdate) GetCarDamage() *PacketCarDamageData {
+	if x, ok := x.GetPacket().(*TelemetryUpdate_CarDamage); ok {
+		return x.CarDamage
+	}
+	return nil
+}
+
+func (x *TelemetryUpdate) GetSessionHistory() *PacketSessionHistoryData {
+	if x, ok := x.GetPacket().(*TelemetryUpdate_SessionHistory); ok {
+		return x.SessionHistory
+	}
+	return nil
+}
+
+func (x *TelemetryUpdate) GetTyreSets() *PacketTyreSetsData {
+	if x, ok := x.GetPacket().(*TelemetryUpdate_TyreSets); ok {
+		return x.TyreSets
+	}
+	return nil
+}
+
+func (x *TelemetryUpdate) GetMotionEx() *PacketMotionExData {
+	if x, ok := x.GetPacket().(*TelemetryUpdate_MotionEx); ok {
+		return x.MotionEx
+	}
+	return nil
+}
+
+type isTelemetryUpdate_Packet interface {
+	isTelemetryUpdate_Packet()
+}
+
+type TelemetryUpdate_Motion struct {
+	Motion *PacketMotionData `protobuf:"bytes,10,opt,name=motion,proto3,oneof"`
+}
+
+type TelemetryUpdate_Session struct {
+	Session *PacketSessionData `protobuf:"bytes,11,opt,name=session,proto3,oneof"`
+}
+
+type TelemetryUpdate_LapData struct {
+	LapData *PacketLapData `protobuf:"bytes,12,opt,name=lap_data,json=lapData,proto3,oneof"`
+}
+
+type TelemetryUpdate_Event struct {
+	Event *PacketEventData `protobuf:"bytes,13,opt,name=event,proto3,oneof"`
+}
+
+type TelemetryUpdate_Participants struct {
+	Participants *PacketParticipantsData `protobuf:"bytes,14,opt,name=participants,proto3,oneof"`
+}
+
+type TelemetryUpdate_CarSetups struct {
+	CarSetups *PacketCarSetupsData `protobuf:"bytes,15,opt,name=car_setups,json=carSetups,proto3,oneof"`
+}
+
+type TelemetryUpdate_CarTelemetry struct {
+	CarTelemetry *PacketCarTelemetryData `protobuf:"bytes,16,opt,name=car_telemetry,json=carTelemetry,proto3,oneof"`
+}
+
+type TelemetryUpdate_CarStatus struct {
+	CarStatus *PacketCarStatusData `protobuf:"bytes,17,opt,name=car_status,json=carStatus,proto3,oneof"`
+}
+
+type TelemetryUpdate_FinalClassification struct {
+	FinalClassification *PacketFinalClassificationData `protobuf:"bytes,18,opt,name=final_classification,json=finalClassification,proto3,oneof"`
+}
+
+type TelemetryUpdate_LobbyInfo struct {
+	LobbyInfo *PacketLobbyInfoData `protobuf:"bytes,19,opt,name=lobby_info,json=lobbyInfo,proto3,oneof"`
+}
+
+type TelemetryUpdate_CarDamage struct {
+	CarDamage *PacketCarDamageData `protobuf:"bytes,20,opt,name=car_damage,json=carDamage,proto3,oneof"`
+}
+
+type TelemetryUpdate_SessionHistory struct {
+	SessionHistory *PacketSessionHistoryData `protobuf:"bytes,21,opt,name=session_history,json=sessionHistory,proto3,oneof"`
+}
+
+type TelemetryUpdate_TyreSets struct {
+	TyreSets *PacketTyreSetsData `protobuf:"bytes,22,opt,name=tyre_sets,json=tyreSets,proto3,oneof"`
+}
+
+type TelemetryUpdate_MotionEx struct {
+	MotionEx *PacketMotionExData `protobuf:"bytes,23,opt,name=motion_ex,json=motionEx,proto3,oneof"`
+}
+
+func (*TelemetryUpdate_Motion) isTelemetryUpdate_Packet() {}
+
+func (*TelemetryUpdate_Session) isTelemetryUpdate_Packet() {}
+
+func (*TelemetryUpdate_LapData) isTelemetryUpdate_Packet() {}
+
+func (*TelemetryUpdate_Event) isTelemetryUpdate_Packet() {}
+
+func (*TelemetryUpdate_Participants) isTelemetryUpdate_Packet() {}
+
+func (*TelemetryUpdate_CarSetups) isTelemetryUpdate_Packet() {}
+
+func (*TelemetryUpdate_CarTelemetry) isTelemetryUpdate_Packet() {}
+
+func (*TelemetryUpdate_CarStatus) isTelemetryUpdate_Packet() {}
+
+func (*TelemetryUpdate_FinalClassification) isTelemetryUpdate_Packet() {}
+
+func (*TelemetryUpdate_LobbyInfo) isTelemetryUpdate_Packet() {}
+
+func (*TelemetryUpdate_CarDamage) isTelemetryUpdate_Packet() {}
+
+func (*TelemetryUpdate_SessionHistory) isTelemetryUpdate_Packet() {}
+
+func (*TelemetryUpdate_TyreSets) isTelemetryUpdate_Packet() {}
+
+func (*TelemetryUpdate_MotionEx) isTelemetryUpdate_Packet() {}
+
+// PacketHeader is the header every packet starts with
+type PacketHeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PacketFormat            uint32  `protobuf:"varint,1,opt,name=packet_format,json=packetFormat,proto3" json:"packet_format,omitempty"` // the game year the packet was send by, e.g. 2023
+	GameYear                uint32  `protobuf:"varint,2,opt,name=game_year,json=gameYear,proto3" json:"game_year,omitempty"`             // last two digits e.g. 23
+	GameMajorVersion        uint32  `protobuf:"varint,3,opt,name=game_major_version,json=gameMajorVersion,proto3" json:"game_major_version,omitempty"`
+	GameMinorVersion        uint32  `protobuf:"varint,4,opt,name=game_minor_version,json=gameMinorVersion,proto3" json:"game_minor_version,omitempty"`
+	PacketVersion           uint32  `protobuf:"varint,5,opt,name=packet_version,json=packetVersion,proto3" json:"packet_version,omitempty"`
+	PacketId                uint32  `protobuf:"varint,6,opt,name=packet_id,json=packetId,proto3" json:"packet_id,omitempty"`
+	SessionUid              uint64  `protobuf:"varint,7,opt,name=session_uid,json=sessionUid,proto3" json:"session_uid,omitempty"`
+	SessionTime             float32 `protobuf:"fixed32,8,opt,name=session_time,json=sessionTime,proto3" json:"session_time,omitempty"`
+	FrameIdentifier         uint32  `protobuf:"varint,9,opt,name=frame_identifier,json=frameIdentifier,proto3" json:"frame_identifier,omitempty"`
+	OverallFrameIdentifier  uint32  `protobuf:"varint,10,opt,name=overall_frame_identifier,json=overallFrameIdentifier,proto3" json:"overall_frame_identifier,omitempty"` // doesn't go back after flashbacks
+	PlayerCarIndex          uint32  `protobuf:"varint,11,opt,name=player_car_index,json=playerCarIndex,proto3" json:"player_car_index,omitempty"`
+	SecondaryPlayerCarIndex uint32  `protobuf:"varint,12,opt,name=secondary_player_car_index,json=secondaryPlayerCarIndex,proto3" json:"secondary_player_car_index,omitempty"` // 255 if no second player
+}
+
+func (x *PacketHeader) Reset() {
+	*x = PacketHeader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PacketHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PacketHeader) ProtoMessage() {}
+
+func (x *PacketHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PacketHeader.ProtoReflect.Descriptor instead.
+func (*PacketHeader) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PacketHeader) GetPacketFormat() uint32 {
+	if x != nil {
+		return x.PacketFormat
+	}
+	return 0
+}
+
+func (x *PacketHeader) GetGameYear() uint32 {
+	if x != nil {
+		return x.GameYear
+	}
+	return 0
+}
+
+func (x *PacketHeader) GetGameMajorVersion() uint32 {
+	if x != nil {
+		return x.GameMajorVersion
+	}
+	return 0
+}
+
+func (x *PacketHeader) GetGameMinorVersion() uint32 {
+	if x != nil {
+		return x.GameMinorVersion
+	}
+	return 0
+}
+
+func (x *PacketHeader) GetPacketVersion() uint32 {
+	if x != nil {
+		return x.PacketVersion
+	}
+	return 0
+}
+
+func (x *PacketHeader) GetPacketId() uint32 {
+	if x != nil {
+		return x.PacketId
+	}
+	return 0
+}
+
+func (x *PacketHeader) GetSessionUid() uint64 {
+	if x != nil {
+		return x.SessionUid
+	}
+	return 0
+}
+
+func (x *PacketHeader) GetSessionTime() float32 {
+	if x != nil {
+		return x.SessionTime
+	}
+	return 0
+}
+
+func (x *PacketHeader) GetFrameIdentifier() uint32 {
+	if x != nil {
+		return x.FrameIdentifier
+	}
+	return 0
+}
+
+func (x *PacketHeader) GetOverallFrameIdentifier() uint32 {
+	if x != nil {
+		return x.OverallFrameIdentifier
+	}
+	return 0
+}
+
+func (x *PacketHeader) GetPlayerCarIndex() uint32 {
+	if x != nil {
+		return x.PlayerCarIndex
+	}
+	return 0
+}
+
+func (x *PacketHeader) GetSecondaryPlayerCarIndex() uint32 {
+	if x != nil {
+		return x.SecondaryPlayerCarIndex
+	}
+	return 0
+}
+
+// Wheels holds a value per wheel, in the order of the game: rear left, rear right, front left, front right
+type Wheels struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RearLeft   float32 `protobuf:"fixed32,1,opt,name=rear_left,json=rearLeft,proto3" json:"rear_left,omitempty"`
+	RearRight  float32 `protobuf:"fixed32,2,opt,name=rear_right,json=rearRight,proto3" json:"rear_right,omitempty"`
+	FrontLeft  float32 `protobuf:"fixed32,3,opt,name=front_left,json=frontLeft,proto3" json:"front_left,omitempty"`
+	FrontRight float32 `protobuf:"fixed32,4,opt,name=front_right,json=frontRight,proto3" json:"front_right,omitempty"`
+}
+
+func (x *Wheels) Reset() {
+	*x = Wheels{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Wheels) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Wheels) ProtoMessage() {}
+
+func (x *Wheels) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Wheels.ProtoReflect.Descriptor instead.
+func (*Wheels) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Wheels) GetRearLeft() float32 {
+	if x != nil {
+		return x.RearLeft
+	}
+	return 0
+}
+
+func (x *Wheels) GetRearRight() float32 {
+	if x != nil {
+		return x.RearRight
+	}
+	return 0
+}
+
+func (x *Wheels) GetFrontLeft() float32 {
+	if x != nil {
+		return x.FrontLeft
+	}
+	return 0
+}
+
+func (x *Wheels) GetFrontRight() float32 {
+	if x != nil {
+		return x.FrontRight
+	}
+	return 0
+}
+
+type PacketMotionData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header        *PacketHeader    `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	CarMotionData []*CarMotionData `protobuf:"bytes,2,rep,name=car_motion_data,json=carMotionData,proto3" json:"car_motion_data,omitempty"` // 22 cars
+}
+
+func (x *PacketMotionData) Reset() {
+	*x = PacketMotionData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PacketMotionData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PacketMotionData) ProtoMessage() {}
+
+func (x *PacketMotionData) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PacketMotionData.ProtoReflect.Descriptor instead.
+func (*PacketMotionData) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PacketMotionData) GetHeader() *PacketHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *PacketMotionData) GetCarMotionData() []*CarMotionData {
+	if x != nil {
+		return x.CarMotionData
+	}
+	return nil
+}
+
+type CarMotionData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorldPositionX     float32 `protobuf:"fixed32,1,opt,name=world_position_x,json=worldPositionX,proto3" json:"world_position_x,omitempty"` // metres
+	WorldPositionY     float32 `protobuf:"fixed32,2,opt,name=world_position_y,json=worldPositionY,proto3" json:"world_position_y,omitempty"`
+	WorldPositionZ     float32 `protobuf:"fixed32,3,opt,name=world_position_z,json=worldPositionZ,proto3" json:"world_position_z,omitempty"`
+	WorldVelocityX     float32 `protobuf:"fixed32,4,opt,name=world_velocity_x,json=worldVelocityX,proto3" json:"world_velocity_x,omitempty"` // metres/s
+	WorldVelocityY     float32 `protobuf:"fixed32,5,opt,name=world_velocity_y,json=worldVelocityY,proto3" json:"world_velocity_y,omitempty"`
+	WorldVelocityZ     float32 `protobuf:"fixed32,6,opt,name=world_velocity_z,json=worldVelocityZ,proto3" json:"world_velocity_z,omitempty"`
+	WorldForwardDirX   int32   `protobuf:"varint,7,opt,name=world_forward_dir_x,json=worldForwardDirX,proto3" json:"world_forward_dir_x,omitempty"` // normalised
+	WorldForwardDirY   int32   `protobuf:"varint,8,opt,name=world_forward_dir_y,json=worldForwardDirY,proto3" json:"world_forward_dir_y,omitempty"`
+	WorldForwardDirZ   int32   `protobuf:"varint,9,opt,name=world_forward_dir_z,json=worldForwardDirZ,proto3" json:"world_forward_dir_z,omitempty"`
+	WorldRightDirX     int32   `protobuf:"varint,10,opt,name=world_right_dir_x,json=worldRightDirX,proto3" json:"world_right_dir_x,omitempty"` // normalised
+	WorldRightDirY     int32   `protobuf:"varint,11,opt,name=world_right_dir_y,json=worldRightDirY,proto3" json:"world_right_dir_y,omitempty"`
+	WorldRightDirZ     int32   `protobuf:"varint,12,opt,name=world_right_dir_z,json=worldRightDirZ,proto3" json:"world_right_dir_z,omitempty"`
+	GForceLateral      float32 `protobuf:"fixed32,13,opt,name=g_force_lateral,json=gForceLateral,proto3" json:"g_force_lateral,omitempty"`
+	GForceLongitudinal float32 `protobuf:"fixed32,14,opt,name=g_force_longitudinal,json=gForceLongitudinal,proto3" json:"g_force_longitudinal,omitempty"`
+	GForceVertical     float32 `protobuf:"fixed32,15,opt,name=g_force_vertical,json=gForceVertical,proto3" json:"g_force_vertical,omitempty"`
+	Yaw                float32 `protobuf:"fixed32,16,opt,name=yaw,proto3" json:"yaw,omitempty"`     // radians
+	Pitch              float32 `protobuf:"fixed32,17,opt,name=pitch,proto3" json:"pitch,omitempty"` // radians
+	Roll               float32 `protobuf:"fixed32,18,opt,name=roll,proto3" json:"roll,omitempty"`   // radians
+}
+
+func (x *CarMotionData) Reset() {
+	*x = CarMotionData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CarMotionData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CarMotionData) ProtoMessage() {}
+
+func (x *CarMotionData) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CarMotionData.ProtoReflect.Descriptor instead.
+func (*CarMotionData) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CarMotionData) GetWorldPositionX() float32 {
+	if x != nil {
+		return x.WorldPositionX
+	}
+	return 0
+}
+
+func (x *CarMotionData) GetWorldPositionY() float32 {
+	if x != nil {
+		return x.WorldPositionY
+	}
+	return 0
+}
+
+func (x *CarMotionData) GetWorldPositionZ() float32 {
+	if x != nil {
+		return x.WorldPositionZ
+	}
+	return 0
+}
+
+func (x *CarMotionData) GetWorldVelocityX() float32 {
+	if x != nil {
+		return x.WorldVelocityX
+	}
+	return 0
+}
+
+func (x *CarMotionData) GetWorldVelocityY() float32 {
+	if x != nil {
+		return x.WorldVelocityY
+	}
+	return 0
+}
+
+func (x *CarMotionData) GetWorldVelocityZ() float32 {
+	if x != nil {
+		return x.WorldVelocityZ
+	}
+	return 0
+}
+
+func (x *CarMotionData) GetWorldForwardDirX() int32 {
+	if x != nil {
+		return x.WorldForwardDirX
+	}
+	return 0
+}
+
+func (x *CarMotionData) GetWorldForwardDirY() int32 {
+	if x != nil {
+		return x.WorldForwardDirY
+	}
+	return 0
+}
+
+func (x *CarMotionData) GetWorldForwardDirZ() int32 {
+	if x != nil {
+		return x.WorldForwardDirZ
+	}
+	return 0
+}
+
+func (x *CarMotionData) GetWorldRightDirX() int32 {
+	if x != nil {
+		return x.WorldRightDirX
+	}
+	return 0
+}
+
+func (x *CarMotionData) GetWorldRightDirY() int32 {
+	if x != nil {
+		return x.WorldRightDirY
+	}
+	return 0
+}
+
+func (x *CarMotionData) GetWorldRightDirZ() int32 {
+	if x != nil {
+		return x.WorldRightDirZ
+	}
+	return 0
+}
+
+func (x *CarMotionData) GetGForceLateral() float32 {
+	if x != nil {
+		return x.GForceLateral
+	}
+	return 0
+}
+
+func (x *CarMotionData) GetGForceLongitudinal() float32 {
+	if x != nil {
+		return x.GForceLongitudinal
+	}
+	return 0
+}
+
+func (x *CarMotionData) GetGForceVertical() float32 {
+	if x != nil {
+		return x.GForceVertical
+	}
+	return 0
+}
+
+func (x *CarMotionData) GetYaw() float32 {
+	if x != nil {
+		return x.Yaw
+	}
+	return 0
+}
+
+func (x *CarMotionData) GetPitch() float32 {
+	if x != nil {
+		return x.Pitch
+	}
+	return 0
+}
+
+func (x *CarMotionData) GetRoll() float32 {
+	if x != nil {
+		return x.Roll
+	}
+	return 0
+}
+
+type PacketSessionData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header                          *PacketHeader            `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Weather                         uint32                   `protobuf:"varint,2,opt,name=weather,proto3" json:"weather,omitempty"`
+	TrackTemperature                int32                    `protobuf:"varint,3,opt,name=track_temperature,json=trackTemperature,proto3" json:"track_temperature,omitempty"` // celsius
+	AirTemperature                  int32                    `protobuf:"varint,4,opt,name=air_temperature,json=airTemperature,proto3" json:"air_temperature,omitempty"`       // celsius
+	TotalLaps                       uint32                   `protobuf:"varint,5,opt,name=total_laps,json=totalLaps,proto3" json:"total_laps,omitempty"`
+	TrackLength                     uint32                   `protobuf:"varint,6,opt,name=track_length,json=trackLength,proto3" json:"track_length,omitempty"` // metres
+	SessionType                     uint32                   `protobuf:"varint,7,opt,name=session_type,json=sessionType,proto3" json:"session_type,omitempty"`
+	TrackId                         int32                    `protobuf:"varint,8,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"` // -1 for unknown
+	Formula                         uint32                   `protobuf:"varint,9,opt,name=formula,proto3" json:"formula,omitempty"`
+	SessionTimeLeft                 uint32                   `protobuf:"varint,10,opt,name=session_time_left,json=sessionTimeLeft,proto3" json:"session_time_left,omitempty"` // seconds
+	SessionDuration                 uint32                   `protobuf:"varint,11,opt,name=session_duration,json=sessionDuration,proto3" json:"session_duration,omitempty"`   // seconds
+	PitSpeedLimit                   uint32                   `protobuf:"varint,12,opt,name=pit_speed_limit,json=pitSpeedLimit,proto3" json:"pit_speed_limit,omitempty"`       // km/h
+	GamePaused                      uint32                   `protobuf:"varint,13,opt,name=game_paused,json=gamePaused,proto3" json:"game_paused,omitempty"`
+	IsSpectating                    uint32                   `protobuf:"varint,14,opt,name=is_spectating,json=isSpectating,proto3" json:"is_spectating,omitempty"`
+	SpectatorCarIndex               uint32                   `protobuf:"varint,15,opt,name=spectator_car_index,json=spectatorCarIndex,proto3" json:"spectator_car_index,omitempty"`
+	SliProNativeSupport             uint32                   `protobuf:"varint,16,opt,name=sli_pro_native_support,json=sliProNativeSupport,proto3" json:"sli_pro_native_support,omitempty"`
+	NumMarshalZones                 uint32                   `protobuf:"varint,17,opt,name=num_marshal_zones,json=numMarshalZones,proto3" json:"num_marshal_zones,omitempty"`
+	MarshalZones                    []*MarshalZone           `protobuf:"bytes,18,rep,name=marshal_zones,json=marshalZones,proto3" json:"marshal_zones,omitempty"`
+	SafetyCarStatus                 uint32                   `protobuf:"varint,19,opt,name=safety_car_status,json=safetyCarStatus,proto3" json:"safety_car_status,omitempty"` // 0 = no safety car, 1 = full, 2 = virtual, 3 = formation lap
+	NetworkGame                     uint32                   `protobuf:"varint,20,opt,name=network_game,json=networkGame,proto3" json:"network_game,omitempty"`
+	NumWeatherForecastSamples       uint32                   `protobuf:"varint,21,opt,name=num_weather_forecast_samples,json=numWeatherForecastSamples,proto3" json:"num_weather_forecast_samples,omitempty"`
+	WeatherForecastSamples          []*WeatherForecastSample `protobuf:"bytes,22,rep,name=weather_forecast_samples,json=weatherForecastSamples,proto3" json:"weather_forecast_samples,omitempty"`
+	ForecastAccuracy                uint32                   `protobuf:"varint,23,opt,name=forecast_accuracy,json=forecastAccuracy,proto3" json:"forecast_accuracy,omitempty"`
+	AiDifficulty                    uint32                   `protobuf:"varint,24,opt,name=ai_difficulty,json=aiDifficulty,proto3" json:"ai_difficulty,omitempty"`
+	SeasonLinkIdentifier            uint32                   `protobuf:"varint,25,opt,name=season_link_identifier,json=seasonLinkIdentifier,proto3" json:"season_link_identifier,omitempty"`
+	WeekendLinkIdentifier           uint32                   `protobuf:"varint,26,opt,name=weekend_link_identifier,json=weekendLinkIdentifier,proto3" json:"weekend_link_identifier,omitempty"`
+	SessionLinkIdentifier           uint32                   `protobuf:"varint,27,opt,name=session_link_identifier,json=sessionLinkIdentifier,proto3" json:"session_link_identifier,omitempty"`
+	PitStopWindowIdealLap           uint32                   `protobuf:"varint,28,opt,name=pit_stop_window_ideal_lap,json=pitStopWindowIdealLap,proto3" json:"pit_stop_window_ideal_lap,omitempty"`
+	PitStopWindowLatestLap          uint32                   `protobuf:"varint,29,opt,name=pit_stop_window_latest_lap,json=pitStopWindowLatestLap,proto3" json:"pit_stop_window_latest_lap,omitempty"`
+	PitStopRejoinPosition           uint32                   `protobuf:"varint,30,opt,name=pit_stop_rejoin_position,json=pitStopRejoinPosition,proto3" json:"pit_stop_rejoin_position,omitempty"`
+	SteeringAssist                  uint32                   `protobuf:"varint,31,opt,name=steering_assist,json=steeringAssist,proto3" json:"steering_assist,omitempty"`
+	BrakingAssist                   uint32                   `protobuf:"varint,32,opt,name=braking_assist,json=brakingAssist,proto3" json:"braking_assist,omitempty"`
+	GearboxAssist                   uint32                   `protobuf:"varint,33,opt,name=gearbox_assist,json=gearboxAssist,proto3" json:"gearbox_assist,omitempty"`
+	PitAssist                       uint32                   `protobuf:"varint,34,opt,name=pit_assist,json=pitAssist,proto3" json:"pit_assist,omitempty"`
+	PitReleaseAssist                uint32                   `protobuf:"varint,35,opt,name=pit_release_assist,json=pitReleaseAssist,proto3" json:"pit_release_assist,omitempty"`
+	ErsAssist                       uint32                   `protobuf:"varint,36,opt,name=ers_assist,json=ersAssist,proto3" json:"ers_assist,omitempty"`
+	DrsAssist                       uint32                   `protobuf:"varint,37,opt,name=drs_assist,json=drsAssist,proto3" json:"drs_assist,omitempty"`
+	DynamicRacingLine               uint32                   `protobuf:"varint,38,opt,name=dynamic_racing_line,json=dynamicRacingLine,proto3" json:"dynamic_racing_line,omitempty"`
+	DynamicRacingLineType           uint32                   `protobuf:"varint,39,opt,name=dynamic_racing_line_type,json=dynamicRacingLineType,proto3" json:"dynamic_racing_line_type,omitempty"`
+	GameMode                        uint32                   `protobuf:"varint,40,opt,name=game_mode,json=gameMode,proto3" json:"game_mode,omitempty"`
+	RuleSet                         uint32                   `protobuf:"varint,41,opt,name=rule_set,json=ruleSet,proto3" json:"rule_set,omitempty"`
+	TimeOfDay                       uint32                   `protobuf:"varint,42,opt,name=time_of_day,json=timeOfDay,proto3" json:"time_of_day,omitempty"` // minutes since midnight
+	SessionLength                   uint32                   `protobuf:"varint,43,opt,name=session_length,json=sessionLength,proto3" json:"session_length,omitempty"`
+	SpeedUnitsLeadPlayer            uint32                   `protobuf:"varint,44,opt,name=speed_units_lead_player,json=speedUnitsLeadPlayer,proto3" json:"speed_units_lead_player,omitempty"`
+	TemperatureUnitsLeadPlayer      uint32                   `protobuf:"varint,45,opt,name=temperature_units_lead_player,json=temperatureUnitsLeadPlayer,proto3" json:"temperature_units_lead_player,omitempty"`
+	SpeedUnitsSecondaryPlayer       uint32                   `protobuf:"varint,46,opt,name=speed_units_secondary_player,json=speedUnitsSecondaryPlayer,proto3" json:"speed_units_secondary_player,omitempty"`
+	TemperatureUnitsSecondaryPlayer uint32                   `protobuf:"varint,47,opt,name=temperature_units_secondary_player,json=temperatureUnitsSecondaryPlayer,proto3" json:"temperature_units_secondary_player,omitempty"`
+	NumSafetyCarPeriods             uint32                   `protobuf:"varint,48,opt,name=num_safety_car_periods,json=numSafetyCarPeriods,proto3" json:"num_safety_car_periods,omitempty"`
+	NumVirtualSafetyCarPeriods      uint32                   `protobuf:"varint,49,opt,name=num_virtual_safety_car_periods,json=numVirtualSafetyCarPeriods,proto3" json:"num_virtual_safety_car_periods,omitempty"`
+	NumRedFlagPeriods               uint32                   `protobuf:"varint,50,opt,name=num_red_flag_periods,json=numRedFlagPeriods,proto3" json:"num_red_flag_periods,omitempty"`
+}
+
+func (x *PacketSessionData) Reset() {
+	*x = PacketSessionData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PacketSessionData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PacketSessionData) ProtoMessage() {}
+
+func (x *PacketSessionData) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PacketSessionData.ProtoReflect.Descriptor instead.
+func (*PacketSessionData) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PacketSessionData) GetHeader() *PacketHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *PacketSessionData) GetWeather() uint32 {
+	if x != nil {
+		return x.Weather
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetTrackTemperature() int32 {
+	if x != nil {
+		return x.TrackTemperature
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetAirTemperature() int32 {
+	if x != nil {
+		return x.AirTemperature
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetTotalLaps() uint32 {
+	if x != nil {
+		return x.TotalLaps
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetTrackLength() uint32 {
+	if x != nil {
+		return x.TrackLength
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetSessionType() uint32 {
+	if x != nil {
+		return x.SessionType
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetTrackId() int32 {
+	if x != nil {
+		return x.TrackId
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetFormula() uint32 {
+	if x != nil {
+		return x.Formula
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetSessionTimeLeft() uint32 {
+	if x != nil {
+		return x.SessionTimeLeft
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetSessionDuration() uint32 {
+	if x != nil {
+		return x.SessionDuration
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetPitSpeedLimit() uint32 {
+	if x != nil {
+		return x.PitSpeedLimit
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetGamePaused() uint32 {
+	if x != nil {
+		return x.GamePaused
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetIsSpectating() uint32 {
+	if x != nil {
+		return x.IsSpectating
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetSpectatorCarIndex() uint32 {
+	if x != nil {
+		return x.SpectatorCarIndex
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetSliProNativeSupport() uint32 {
+	if x != nil {
+		return x.SliProNativeSupport
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetNumMarshalZones() uint32 {
+	if x != nil {
+		return x.NumMarshalZones
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetMarshalZones() []*MarshalZone {
+	if x != nil {
+		return x.MarshalZones
+	}
+	return nil
+}
+
+func (x *PacketSessionData) GetSafetyCarStatus() uint32 {
+	if x != nil {
+		return x.SafetyCarStatus
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetNetworkGame() uint32 {
+	if x != nil {
+		return x.NetworkGame
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetNumWeatherForecastSamples() uint32 {
+	if x != nil {
+		return x.NumWeatherForecastSamples
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetWeatherForecastSamples() []*WeatherForecastSample {
+	if x != nil {
+		return x.WeatherForecastSamples
+	}
+	return nil
+}
+
+func (x *PacketSessionData) GetForecastAccuracy() uint32 {
+	if x != nil {
+		return x.ForecastAccuracy
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetAiDifficulty() uint32 {
+	if x != nil {
+		return x.AiDifficulty
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetSeasonLinkIdentifier() uint32 {
+	if x != nil {
+		return x.SeasonLinkIdentifier
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetWeekendLinkIdentifier() uint32 {
+	if x != nil {
+		return x.WeekendLinkIdentifier
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetSessionLinkIdentifier() uint32 {
+	if x != nil {
+		return x.SessionLinkIdentifier
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetPitStopWindowIdealLap() uint32 {
+	if x != nil {
+		return x.PitStopWindowIdealLap
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetPitStopWindowLatestLap() uint32 {
+	if x != nil {
+		return x.PitStopWindowLatestLap
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetPitStopRejoinPosition() uint32 {
+	if x != nil {
+		return x.PitStopRejoinPosition
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetSteeringAssist() uint32 {
+	if x != nil {
+		return x.SteeringAssist
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetBrakingAssist() uint32 {
+	if x != nil {
+		return x.BrakingAssist
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetGearboxAssist() uint32 {
+	if x != nil {
+		return x.GearboxAssist
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetPitAssist() uint32 {
+	if x != nil {
+		return x.PitAssist
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetPitReleaseAssist() uint32 {
+	if x != nil {
+		return x.PitReleaseAssist
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetErsAssist() uint32 {
+	if x != nil {
+		return x.ErsAssist
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetDrsAssist() uint32 {
+	if x != nil {
+		return x.DrsAssist
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetDynamicRacingLine() uint32 {
+	if x != nil {
+		return x.DynamicRacingLine
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetDynamicRacingLineType() uint32 {
+	if x != nil {
+		return x.DynamicRacingLineType
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetGameMode() uint32 {
+	if x != nil {
+		return x.GameMode
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetRuleSet() uint32 {
+	if x != nil {
+		return x.RuleSet
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetTimeOfDay() uint32 {
+	if x != nil {
+		return x.TimeOfDay
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetSessionLength() uint32 {
+	if x != nil {
+		return x.SessionLength
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetSpeedUnitsLeadPlayer() uint32 {
+	if x != nil {
+		return x.SpeedUnitsLeadPlayer
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetTemperatureUnitsLeadPlayer() uint32 {
+	if x != nil {
+		return x.TemperatureUnitsLeadPlayer
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetSpeedUnitsSecondaryPlayer() uint32 {
+	if x != nil {
+		return x.SpeedUnitsSecondaryPlayer
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetTemperatureUnitsSecondaryPlayer() uint32 {
+	if x != nil {
+		return x.TemperatureUnitsSecondaryPlayer
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetNumSafetyCarPeriods() uint32 {
+	if x != nil {
+		return x.NumSafetyCarPeriods
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetNumVirtualSafetyCarPeriods() uint32 {
+	if x != nil {
+		return x.NumVirtualSafetyCarPeriods
+	}
+	return 0
+}
+
+func (x *PacketSessionData) GetNumRedFlagPeriods() uint32 {
+	if x != nil {
+		return x.NumRedFlagPeriods
+	}
+	return 0
+}
+
+type MarshalZone struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ZoneStart float32 `protobuf:"fixed32,1,opt,name=zone_start,json=zoneStart,proto3" json:"zone_start,omitempty"` // fraction (0..1) of the lap
+	ZoneFlag  int32   `protobuf:"varint,2,opt,name=zone_flag,json=zoneFlag,proto3" json:"zone_flag,omitempty"`
+}
+
+func (x *MarshalZone) Reset() {
+	*x = MarshalZone{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarshalZone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarshalZone) ProtoMessage() {}
+
+func (x *MarshalZone) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarshalZone.ProtoReflect.Descriptor instead.
+func (*MarshalZone) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MarshalZone) GetZoneStart() float32 {
+	if x != nil {
+		return x.ZoneStart
+	}
+	return 0
+}
+
+func (x *MarshalZone) GetZoneFlag() int32 {
+	if x != nil {
+		return x.ZoneFlag
+	}
+	return 0
+}
+
+type WeatherForecastSample struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionType            uint32 `protobuf:"varint,1,opt,name=session_type,json=sessionType,proto3" json:"session_type,omitempty"`
+	TimeOffset             uint32 `protobuf:"varint,2,opt,name=time_offset,json=timeOffset,proto3" json:"time_offset,omitempty"` // minutes
+	WeatherType            uint32 `protobuf:"varint,3,opt,name=weather_type,json=weatherType,proto3" json:"weather_type,omitempty"`
+	TrackTemperature       int32  `protobuf:"varint,4,opt,name=track_temperature,json=trackTemperature,proto3" json:"track_temperature,omitempty"`
+	TrackTemperatureChange int32  `protobuf:"varint,5,opt,name=track_temperature_change,json=trackTemperatureChange,proto3" json:"track_temperature_change,omitempty"`
+	AirTemperature         int32  `protobuf:"varint,6,opt,name=air_temperature,json=airTemperature,proto3" json:"air_temperature,omitempty"`
+	AirTemperatureChange   int32  `protobuf:"varint,7,opt,name=air_temperature_change,json=airTemperatureChange,proto3" json:"air_temperature_change,omitempty"`
+	RainPercentage         uint32 `protobuf:"varint,8,opt,name=rain_percentage,json=rainPercentage,proto3" json:"rain_percentage,omitempty"`
+}
+
+func (x *WeatherForecastSample) Reset() {
+	*x = WeatherForecastSample{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WeatherForecastSample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeatherForecastSample) ProtoMessage() {}
+
+func (x *WeatherForecastSample) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeatherForecastSample.ProtoReflect.Descriptor instead.
+func (*WeatherForecastSample) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *WeatherForecastSample) GetSessionType() uint32 {
+	if x != nil {
+		return x.SessionType
+	}
+	return 0
+}
+
+func (x *WeatherForecastSample) GetTimeOffset() uint32 {
+	if x != nil {
+		return x.TimeOffset
+	}
+	return 0
+}
+
+func (x *WeatherForecastSample) GetWeatherType() uint32 {
+	if x != nil {
+		return x.WeatherType
+	}
+	return 0
+}
+
+func (x *WeatherForecastSample) GetTrackTemperature() int32 {
+	if x != nil {
+		return x.TrackTemperature
+	}
+	return 0
+}
+
+func (x *WeatherForecastSample) GetTrackTemperatureChange() int32 {
+	if x != nil {
+		return x.TrackTemperatureChange
+	}
+	return 0
+}
+
+func (x *WeatherForecastSample) GetAirTemperature() int32 {
+	if x != nil {
+		return x.AirTemperature
+	}
+	return 0
+}
+
+func (x *WeatherForecastSample) GetAirTemperatureChange() int32 {
+	if x != nil {
+		return x.AirTemperatureChange
+	}
+	return 0
+}
+
+func (x *WeatherForecastSample) GetRainPercentage() uint32 {
+	if x != nil {
+		return x.RainPercentage
+	}
+	return 0
+}
+
+type PacketLapData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header               *PacketHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	LapData              []*LapData    `protobuf:"bytes,2,rep,name=lap_data,json=lapData,proto3" json:"lap_data,omitempty"` // 22 cars
+	TimeTrialPbCarIdx    uint32        `protobuf:"varint,3,opt,name=time_trial_pb_car_idx,json=timeTrialPbCarIdx,proto3" json:"time_trial_pb_car_idx,omitempty"`
+	TimeTrialRivalCarIdx uint32        `protobuf:"varint,4,opt,name=time_trial_rival_car_idx,json=timeTrialRivalCarIdx,proto3" json:"time_trial_rival_car_idx,omitempty"`
+}
+
+func (x *PacketLapData) Reset() {
+	*x = PacketLapData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PacketLapData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PacketLapData) ProtoMessage() {}
+
+func (x *PacketLapData) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PacketLapData.ProtoReflect.Descriptor instead.
+func (*PacketLapData) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PacketLapData) GetHeader() *PacketHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *PacketLapData) GetLapData() []*LapData {
+	if x != nil {
+		return x.LapData
+	}
+	return nil
+}
+
+func (x *PacketLapData) GetTimeTrialPbCarIdx() uint32 {
+	if x != nil {
+		return x.TimeTrialPbCarIdx
+	}
+	return 0
+}
+
+func (x *PacketLapData) GetTimeTrialRivalCarIdx() uint32 {
+	if x != nil {
+		return x.TimeTrialRivalCarIdx
+	}
+	return 0
+}
+
+type LapData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LastLapTimeInMs             uint32  `protobuf:"varint,1,opt,name=last_lap_time_in_ms,json=lastLapTimeInMs,proto3" json:"last_lap_time_in_ms,omitempty"`
+	CurrentLapTimeInMs          uint32  `protobuf:"varint,2,opt,name=current_lap_time_in_ms,json=currentLapTimeInMs,proto3" json:"current_lap_time_in_ms,omitempty"`
+	Sector1TimeInMs             uint32  `protobuf:"varint,3,opt,name=sector1_time_in_ms,json=sector1TimeInMs,proto3" json:"sector1_time_in_ms,omitempty"`
+	Sector1TimeMinutes          uint32  `protobuf:"varint,4,opt,name=sector1_time_minutes,json=sector1TimeMinutes,proto3" json:"sector1_time_minutes,omitempty"`
+	Sector2TimeInMs             uint32  `protobuf:"varint,5,opt,name=sector2_time_in_ms,json=sector2TimeInMs,proto3" json:"sector2_time_in_ms,omitempty"`
+	Sector2TimeMinutes          uint32  `protobuf:"varint,6,opt,name=sector2_time_minutes,json=sector2TimeMinutes,proto3" json:"sector2_time_minutes,omitempty"`
+	DeltaToCarInFrontInMs       uint32  `protobuf:"varint,7,opt,name=delta_to_car_in_front_in_ms,json=deltaToCarInFrontInMs,proto3" json:"delta_to_car_in_front_in_ms,omitempty"`
+	DeltaToRaceLeaderInMs       uint32  `protobuf:"varint,8,opt,name=delta_to_race_leader_in_ms,json=deltaToRaceLeaderInMs,proto3" json:"delta_to_race_leader_in_ms,omitempty"`
+	LapDistance                 float32 `protobuf:"fixed32,9,opt,name=lap_distance,json=lapDistance,proto3" json:"lap_distance,omitempty"`             // metres, can be negative before crossing the line
+	TotalDistance               float32 `protobuf:"fixed32,10,opt,name=total_distance,json=totalDistance,proto3" json:"total_distance,omitempty"`      // metres
+	SafetyCarDelta              float32 `protobuf:"fixed32,11,opt,name=safety_car_delta,json=safetyCarDelta,proto3" json:"safety_car_delta,omitempty"` // seconds
+	CarPosition                 uint32  `protobuf:"varint,12,opt,name=car_position,json=carPosition,proto3" json:"car_position,omitempty"`
+	CurrentLapNum               uint32  `protobuf:"varint,13,opt,name=current_lap_num,json=currentLapNum,proto3" json:"current_lap_num,omitempty"`
+	PitStatus                   uint32  `protobuf:"varint,14,opt,name=pit_status,json=pitStatus,proto3" json:"pit_status,omitempty"`
+	NumPitStops                 uint32  `protobuf:"varint,15,opt,name=num_pit_stops,json=numPitStops,proto3" json:"num_pit_stops,omitempty"`
+	Sector                      uint32  `protobuf:"varint,16,opt,name=sector,proto3" json:"sector,omitempty"`
+	CurrentLapInvalid           uint32  `protobuf:"varint,17,opt,name=current_lap_invalid,json=currentLapInvalid,proto3" json:"current_lap_invalid,omitempty"`
+	Penalties                   uint32  `protobuf:"varint,18,opt,name=penalties,proto3" json:"penalties,omitempty"`
+	TotalWarnings               uint32  `protobuf:"varint,19,opt,name=total_warnings,json=totalWarnings,proto3" json:"total_warnings,omitempty"`
+	CornerCuttingWarnings       uint32  `protobuf:"varint,20,opt,name=corner_cutting_warnings,json=cornerCuttingWarnings,proto3" json:"corner_cutting_warnings,omitempty"`
+	NumUnservedDriveThroughPens uint32  `protobuf:"varint,21,opt,name=num_unserved_drive_through_pens,json=numUnservedDriveThroughPens,proto3" json:"num_unserved_drive_through_pens,omitempty"`
+	NumUnservedStopGoPens       uint32  `protobuf:"varint,22,opt,name=num_unserved_stop_go_pens,json=numUnservedStopGoPens,proto3" json:"num_unserved_stop_go_pens,omitempty"`
+	GridPosition                uint32  `protobuf:"varint,23,opt,name=grid_position,json=gridPosition,proto3" json:"grid_position,omitempty"`
+	DriverStatus                uint32  `protobuf:"varint,24,opt,name=driver_status,json=driverStatus,proto3" json:"driver_status,omitempty"`
+	ResultStatus                uint32  `protobuf:"varint,25,opt,name=result_status,json=resultStatus,proto3" json:"result_status,omitempty"`
+	PitLaneTimerActive          uint32  `protobuf:"varint,26,opt,name=pit_lane_timer_active,json=pitLaneTimerActive,proto3" json:"pit_lane_timer_active,omitempty"`
+	PitLaneTimeInLaneInMs       uint32  `protobuf:"varint,27,opt,name=pit_lane_time_in_lane_in_ms,json=pitLaneTimeInLaneInMs,proto3" json:"pit_lane_time_in_lane_in_ms,omitempty"`
+	PitStopTimerInMs            uint32  `protobuf:"varint,28,opt,name=pit_stop_timer_in_ms,json=pitStopTimerInMs,proto3" json:"pit_stop_timer_in_ms,omitempty"`
+	PitStopShouldServePen       uint32  `protobuf:"varint,29,opt,name=pit_stop_should_serve_pen,json=pitStopShouldServePen,proto3" json:"pit_stop_should_serve_pen,omitempty"`
+}
+
+func (x *LapData) Reset() {
+	*x = LapData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LapData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LapData) ProtoMessage() {}
+
+func (x *LapData) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LapData.ProtoReflect.Descriptor instead.
+func (*LapData) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *LapData) GetLastLapTimeInMs() uint32 {
+	if x != nil {
+		return x.LastLapTimeInMs
+	}
+	return 0
+}
+
+func (x *LapData) GetCurrentLapTimeInMs() uint32 {
+	if x != nil {
+		return x.CurrentLapTimeInMs
+	}
+	return 0
+}
+
+func (x *LapData) GetSector1TimeInMs() uint32 {
+	if x != nil {
+		return x.Sector1TimeInMs
+	}
+	return 0
+}
+
+func (x *LapData) GetSector1TimeMinutes() uint32 {
+	if x != nil {
+		return x.Sector1TimeMinutes
+	}
+	return 0
+}
+
+func (x *LapData) GetSector2TimeInMs() uint32 {
+	if x != nil {
+		return x.Sector2TimeInMs
+	}
+	return 0
+}
+
+func (x *LapData) GetSector2TimeMinutes() uint32 {
+	if x != nil {
+		return x.Sector2TimeMinutes
+	}
+	return 0
+}
+
+func (x *LapData) GetDeltaToCarInFrontInMs() uint32 {
+	if x != nil {
+		return x.DeltaToCarInFrontInMs
+	}
+	return 0
+}
+
+func (x *LapData) GetDeltaToRaceLeaderInMs() uint32 {
+	if x != nil {
+		return x.DeltaToRaceLeaderInMs
+	}
+	return 0
+}
+
+func (x *LapData) GetLapDistance() float32 {
+	if x != nil {
+		return x.LapDistance
+	}
+	return 0
+}
+
+func (x *LapData) GetTotalDistance() float32 {
+	if x != nil {
+		return x.TotalDistance
+	}
+	return 0
+}
+
+func (x *LapData) GetSafetyCarDelta() float32 {
+	if x != nil {
+		return x.SafetyCarDelta
+	}
+	return 0
+}
+
+func (x *LapData) GetCarPosition() uint32 {
+	if x != nil {
+		return x.CarPosition
+	}
+	return 0
+}
+
+func (x *LapData) GetCurrentLapNum() uint32 {
+	if x != nil {
+		return x.CurrentLapNum
+	}
+	return 0
+}
+
+func (x *LapData) GetPitStatus() uint32 {
+	if x != nil {
+		return x.PitStatus
+	}
+	return 0
+}
+
+func (x *LapData) GetNumPitStops() uint32 {
+	if x != nil {
+		return x.NumPitStops
+	}
+	return 0
+}
+
+func (x *LapData) GetSector() uint32 {
+	if x != nil {
+		return x.Sector
+	}
+	return 0
+}
+
+func (x *LapData) GetCurrentLapInvalid() uint32 {
+	if x != nil {
+		return x.CurrentLapInvalid
+	}
+	return 0
+}
+
+func (x *LapData) GetPenalties() uint32 {
+	if x != nil {
+		return x.Penalties
+	}
+	return 0
+}
+
+func (x *LapData) GetTotalWarnings() uint32 {
+	if x != nil {
+		return x.TotalWarnings
+	}
+	return 0
+}
+
+func (x *LapData) GetCornerCuttingWarnings() uint32 {
+	if x != nil {
+		return x.CornerCuttingWarnings
+	}
+	return 0
+}
+
+func (x *LapData) GetNumUnservedDriveThroughPens() uint32 {
+	if x != nil {
+		return x.NumUnservedDriveThroughPens
+	}
+	return 0
+}
+
+func (x *LapData) GetNumUnservedStopGoPens() uint32 {
+	if x != nil {
+		return x.NumUnservedStopGoPens
+	}
+	return 0
+}
+
+func (x *LapData) GetGridPosition() uint32 {
+	if x != nil {
+		return x.GridPosition
+	}
+	return 0
+}
+
+func (x *LapData) GetDriverStatus() uint32 {
+	if x != nil {
+		return x.DriverStatus
+	}
+	return 0
+}
+
+func (x *LapData) GetResultStatus() uint32 {
+	if x != nil {
+		return x.ResultStatus
+	}
+	return 0
+}
+
+func (x *LapData) GetPitLaneTimerActive() uint32 {
+	if x != nil {
+		return x.PitLaneTimerActive
+	}
+	return 0
+}
+
+func (x *LapData) GetPitLaneTimeInLaneInMs() uint32 {
+	if x != nil {
+		return x.PitLaneTimeInLaneInMs
+	}
+	return 0
+}
+
+func (x *LapData) GetPitStopTimerInMs() uint32 {
+	if x != nil {
+		return x.PitStopTimerInMs
+	}
+	return 0
+}
+
+func (x *LapData) GetPitStopShouldServePen() uint32 {
+	if x != nil {
+		return x.PitStopShouldServePen
+	}
+	return 0
+}
+
+type PacketEventData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header          *PacketHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	EventStringCode string        `protobuf:"bytes,2,opt,name=event_string_code,json=eventStringCode,proto3" json:"event_string_code,omitempty"` // e.g. FTLP
+	// Types that are assignable to Details:
+	//	*PacketEventData_FastestLap
+	//	*PacketEventData_Retirement
+	//	*PacketEventData_TeamMateInPits
+	//	*PacketEventData_RaceWinner
+	//	*PacketEventData_Penalty
+	//	*PacketEventData_SpeedTrap
+	//	*PacketEventData_StartLights
+	//	*PacketEventData_DriveThroughPenaltyServed
+	//	*PacketEventData_StopGoPenaltyServed
+	//	*PacketEventData_Flashback
+	//	*PacketEventData_Buttons
+	//	*PacketEventData_Overtake
+	//	*PacketEventData_SafetyCar
+	//	*PacketEventData_Collision
+	Details isPacketEventData_Details `protobuf_oneof:"details"`
+}
+
+func (x *PacketEventData) Reset() {
+	*x = PacketEventData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PacketEventData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PacketEventData) ProtoMessage() {}
+
+func (x *PacketEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PacketEventData.ProtoReflect.Descriptor instead.
+func (*PacketEventData) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PacketEventData) GetHeader() *PacketHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *PacketEventData) GetEventStringCode() string {
+	if x != nil {
+		return x.EventStringCode
+	}
+	return ""
+}
+
+func (m *PacketEventData) GetDetails() isPacketEventData_Details {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+func (x *PacketEventData) GetFastestLap() *FastestLap {
+	if x, ok := x.GetDetails().(*PacketEventData_FastestLap); ok {
+		return x.FastestLap
+	}
+	return nil
+}
+
+func (x *PacketEventData) GetRetirement() *VehicleEvent {
+	if x, ok := x.GetDetails().(*PacketEventData_Retirement); ok {
+		return x.Retirement
+	}
+	return nil
+}
+
+func (x *PacketEventData) GetTeamMateInPits() *VehicleEvent {
+	if x, ok := x.GetDetails().(*PacketEventData_TeamMateInPits); ok {
+		return x.TeamMateInPits
+	}
+	return nil
+}
+
+func (x *PacketEventData) GetRaceWinner() *VehicleEvent {
+	if x, ok := x.GetDetails().(*PacketEventData_RaceWinner); ok {
+		return x.RaceWinner
+	}
+	return nil
+}
+
+func (x *PacketEventData) GetPenalty() *Penalty {
+	if x, ok := x.GetDetails().(*PacketEventData_Penalty); ok {
+		return x.Penalty
+	}
+	return nil
+}
+
+func (x *PacketEventData) GetSpeedTrap() *SpeedTrap {
+	if x, ok := x.GetDetails().(*PacketEventData_SpeedTrap); ok {
+		return x.SpeedTrap
+	}
+	return nil
+}
+
+func (x *PacketEventData) GetStartLights() *StartLights {
+	if x, ok := x.GetDetails().(*PacketEventData_StartLights); ok {
+		return x.StartLights
+	}
+	return nil
+}
+
+func (x *PacketEventData) GetDriveThroughPenaltyServed() *VehicleEvent {
+	if x, ok := x.GetDetails().(*PacketEventData_DriveThroughPenaltyServed); ok {
+		return x.DriveThroughPenaltyServed
+	}
+	return nil
+}
+
+func (x *PacketEventData) GetStopGoPenaltyServed() *VehicleEvent {
+	if x, ok := x.GetDetails().(*PacketEventData_StopGoPenaltyServed); ok {
+		return x.StopGoPenaltyServed
+	}
+	return nil
+}
+
+func (x *PacketEventData) GetFlashback() *Flashback {
+	if x, ok := x.GetDetails().(*PacketEventData_Flashback); ok {
+		return x.Flashback
+	}
+	return nil
+}
+
+func (x *PacketEventData) GetButtons() *Buttons {
+	if x, ok := x.GetDetails().(*PacketEventData_Buttons); ok {
+		return x.Buttons
+	}
+	return nil
+}
+
+func (x *PacketEventData) GetOvertake() *Overtake {
+	if x, ok := x.GetDetails().(*PacketEventData_Overtake); ok {
+		return x.Overtake
+	}
+	return nil
+}
+
+func (x *PacketEventData) GetSafetyCar() *SafetyCar {
+	if x, ok := x.GetDetails().(*PacketEventData_SafetyCar); ok {
+		return x.SafetyCar
+	}
+	return nil
+}
+
+func (x *PacketEventData) GetCollision() *Collision {
+	if x, ok := x.GetDetails().(*PacketEventData_Collision); ok {
+		return x.Collision
+	}
+	return nil
+}
+
+type isPacketEventData_Details interface {
+	isPacketEventData_Details()
+}
+
+type PacketEventData_FastestLap struct {
+	FastestLap *FastestLap `protobuf:"bytes,10,opt,name=fastest_lap,json=fastestLap,proto3,oneof"`
+}
+
+type PacketEventData_Retirement struct {
+	Retirement *VehicleEvent `protobuf:"bytes,11,opt,name=retirement,proto3,oneof"`
+}
+
+type PacketEventData_TeamMateInPits struct {
+	TeamMateInPits *VehicleEvent `protobuf:"bytes,12,opt,name=team_mate_in_pits,json=teamMateInPits,proto3,oneof"`
+}
+
+type PacketEventData_RaceWinner struct {
+	RaceWinner *VehicleEvent `protobuf:"bytes,13,opt,name=race_winner,json=raceWinner,proto3,oneof"`
+}
+
+type PacketEventData_Penalty struct {
+	Penalty *Penalty `protobuf:"bytes,14,opt,name=penalty,proto3,oneof"`
+}
+
+type PacketEventData_SpeedTrap struct {
+	SpeedTrap *SpeedTrap `protobuf:"bytes,15,opt,name=speed_trap,json=speedTrap,proto3,oneof"`
+}
+
+type PacketEventData_StartLights struct {
+	StartLights *StartLights `protobuf:"bytes,16,opt,name=start_lights,json=startLights,proto3,oneof"`
+}
+
+type PacketEventData_DriveThroughPenaltyServed struct {
+	DriveThroughPenaltyServed *VehicleEvent `protobuf:"bytes,17,opt,name=drive_through_penalty_served,json=driveThroughPenaltyServed,proto3,oneof"`
+}
+
+type PacketEventData_StopGoPenaltyServed struct {
+	StopGoPenaltyServed *VehicleEvent `protobuf:"bytes,18,opt,name=stop_go_penalty_served,json=stopGoPenaltyServed,proto3,oneof"`
+}
+
+type PacketEventData_Flashback struct {
+	Flashback *Flashback `protobuf:"bytes,19,opt,name=flashback,proto3,oneof"`
+}
+
+type PacketEventData_Buttons struct {
+	Buttons *Buttons `protobuf:"bytes,20,opt,name=buttons,proto3,oneof"`
+}
+
+type PacketEventData_Overtake struct {
+	Overtake *Overtake `protobuf:"bytes,21,opt,name=overtake,proto3,oneof"`
+}
+
+type PacketEventData_SafetyCar struct {
+	SafetyCar *SafetyCar `protobuf:"bytes,22,opt,name=safety_car,json=safetyCar,proto3,oneof"`
+}
+
+type PacketEventData_Collision struct {
+	Collision *Collision `protobuf:"bytes,23,opt,name=collision,proto3,oneof"`
+}
+
+func (*PacketEventData_FastestLap) isPacketEventData_Details() {}
+
+func (*PacketEventData_Retirement) isPacketEventData_Details() {}
+
+func (*PacketEventData_TeamMateInPits) isPacketEventData_Details() {}
+
+func (*PacketEventData_RaceWinner) isPacketEventData_Details() {}
+
+func (*PacketEventData_Penalty) isPacketEventData_Details() {}
+
+func (*PacketEventData_SpeedTrap) isPacketEventData_Details() {}
+
+func (*PacketEventData_StartLights) isPacketEventData_Details() {}
+
+func (*PacketEventData_DriveThroughPenaltyServed) isPacketEventData_Details() {}
+
+func (*PacketEventData_StopGoPenaltyServed) isPacketEventData_Details() {}
+
+func (*PacketEventData_Flashback) isPacketEventData_Details() {}
+
+func (*PacketEventData_Buttons) isPacketEventData_Details() {}
+
+func (*PacketEventData_Overtake) isPacketEventData_Details() {}
+
+func (*PacketEventData_SafetyCar) isPacketEventData_Details() {}
+
+func (*PacketEventData_Collision) isPacketEventData_Details() {}
+
+// VehicleEvent is the details of an event that only points to a car
+type VehicleEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VehicleIdx uint32 `protobuf:"varint,1,opt,name=vehicle_idx,json=vehicleIdx,proto3" json:"vehicle_idx,omitempty"`
+}
+
+func (x *VehicleEvent) Reset() {
+	*x = VehicleEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VehicleEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VehicleEvent) ProtoMessage() {}
+
+func (x *VehicleEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VehicleEvent.ProtoReflect.Descriptor instead.
+func (*VehicleEvent) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *VehicleEvent) GetVehicleIdx() uint32 {
+	if x != nil {
+		return x.VehicleIdx
+	}
+	return 0
+}
+
+type FastestLap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VehicleIdx uint32  `protobuf:"varint,1,opt,name=vehicle_idx,json=vehicleIdx,proto3" json:"vehicle_idx,omitempty"`
+	LapTime    float32 `protobuf:"fixed32,2,opt,name=lap_time,json=lapTime,proto3" json:"lap_time,omitempty"` // seconds
+}
+
+func (x *FastestLap) Reset() {
+	*x = FastestLap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FastestLap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FastestLap) ProtoMessage() {}
+
+func (x *FastestLap) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FastestLap.ProtoReflect.Descriptor instead.
+func (*FastestLap) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *FastestLap) GetVehicleIdx() uint32 {
+	if x != nil {
+		return x.VehicleIdx
+	}
+	return 0
+}
+
+func (x *FastestLap) GetLapTime() float32 {
+	if x != nil {
+		return x.LapTime
+	}
+	return 0
+}
+
+type Penalty struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PenaltyType      uint32 `protobuf:"varint,1,opt,name=penalty_type,json=penaltyType,proto3" json:"penalty_type,omitempty"`
+	InfringementType uint32 `protobuf:"varint,2,opt,name=infringement_type,json=infringementType,proto3" json:"infringement_type,omitempty"`
+	VehicleIdx       uint32 `protobuf:"varint,3,opt,name=vehicle_idx,json=vehicleIdx,proto3" json:"vehicle_idx,omitempty"`
+	OtherVehicleIdx  uint32 `protobuf:"varint,4,opt,name=other_vehicle_idx,json=otherVehicleIdx,proto3" json:"other_vehicle_idx,omitempty"`
+	Time             uint32 `protobuf:"varint,5,opt,name=time,proto3" json:"time,omitempty"` // seconds
+	LapNum           uint32 `protobuf:"varint,6,opt,name=lap_num,json=lapNum,proto3" json:"lap_num,omitempty"`
+	PlacesGained     uint32 `protobuf:"varint,7,opt,name=places_gained,json=placesGained,proto3" json:"places_gained,omitempty"`
+}
+
+func (x *Penalty) Reset() {
+	*x = Penalty{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Penalty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Penalty) ProtoMessage() {}
+
+func (x *Penalty) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Penalty.ProtoReflect.Descriptor instead.
+func (*Penalty) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Penalty) GetPenaltyType() uint32 {
+	if x != nil {
+		return x.PenaltyType
+	}
+	return 0
+}
+
+func (x *Penalty) GetInfringementType() uint32 {
+	if x != nil {
+		return x.InfringementType
+	}
+	return 0
+}
+
+func (x *Penalty) GetVehicleIdx() uint32 {
+	if x != nil {
+		return x.VehicleIdx
+	}
+	return 0
+}
+
+func (x *Penalty) GetOtherVehicleIdx() uint32 {
+	if x != nil {
+		return x.OtherVehicleIdx
+	}
+	return 0
+}
+
+func (x *Penalty) GetTime() uint32 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *Penalty) GetLapNum() uint32 {
+	if x != nil {
+		return x.LapNum
+	}
+	return 0
+}
+
+func (x *Penalty) GetPlacesGained() uint32 {
+	if x != nil {
+		return x.PlacesGained
+	}
+	return 0
+}
+
+type SpeedTrap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VehicleIdx                 uint32  `protobuf:"varint,1,opt,name=vehicle_idx,json=vehicleIdx,proto3" json:"vehicle_idx,omitempty"`
+	Speed                      float32 `protobuf:"fixed32,2,opt,name=speed,proto3" json:"speed,omitempty"` // km/h
+	IsOverallFastestInSession  uint32  `protobuf:"varint,3,opt,name=is_overall_fastest_in_session,json=isOverallFastestInSession,proto3" json:"is_overall_fastest_in_session,omitempty"`
+	IsDriverFastestInSession   uint32  `protobuf:"varint,4,opt,name=is_driver_fastest_in_session,json=isDriverFastestInSession,proto3" json:"is_driver_fastest_in_session,omitempty"`
+	FastestVehicleIdxInSession uint32  `protobuf:"varint,5,opt,name=fastest_vehicle_idx_in_session,json=fastestVehicleIdxInSession,proto3" json:"fastest_vehicle_idx_in_session,omitempty"`
+	FastestSpeedInSession      float32 `protobuf:"fixed32,6,opt,name=fastest_speed_in_session,json=fastestSpeedInSession,proto3" json:"fastest_speed_in_session,omitempty"`
+}
+
+func (x *SpeedTrap) Reset() {
+	*x = SpeedTrap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpeedTrap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpeedTrap) ProtoMessage() {}
+
+func (x *SpeedTrap) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpeedTrap.ProtoReflect.Descriptor instead.
+func (*SpeedTrap) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SpeedTrap) GetVehicleIdx() uint32 {
+	if x != nil {
+		return x.VehicleIdx
+	}
+	return 0
+}
+
+func (x *SpeedTrap) GetSpeed() float32 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *SpeedTrap) GetIsOverallFastestInSession() uint32 {
+	if x != nil {
+		return x.IsOverallFastestInSession
+	}
+	return 0
+}
+
+func (x *SpeedTrap) GetIsDriverFastestInSession() uint32 {
+	if x != nil {
+		return x.IsDriverFastestInSession
+	}
+	return 0
+}
+
+func (x *SpeedTrap) GetFastestVehicleIdxInSession() uint32 {
+	if x != nil {
+		return x.FastestVehicleIdxInSession
+	}
+	return 0
+}
+
+func (x *SpeedTrap) GetFastestSpeedInSession() float32 {
+	if x != nil {
+		return x.FastestSpeedInSession
+	}
+	return 0
+}
+
+type StartLights struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NumLights uint32 `protobuf:"varint,1,opt,name=num_lights,json=numLights,proto3" json:"num_lights,omitempty"`
+}
+
+func (x *StartLights) Reset() {
+	*x = StartLights{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartLights) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartLights) ProtoMessage() {}
+
+func (x *StartLights) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartLights.ProtoReflect.Descriptor instead.
+func (*StartLights) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *StartLights) GetNumLights() uint32 {
+	if x != nil {
+		return x.NumLights
+	}
+	return 0
+}
+
+type Flashback struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FlashbackFrameIdentifier uint32  `protobuf:"varint,1,opt,name=flashback_frame_identifier,json=flashbackFrameIdentifier,proto3" json:"flashback_frame_identifier,omitempty"`
+	FlashbackSessionTime     float32 `protobuf:"fixed32,2,opt,name=flashback_session_time,json=flashbackSessionTime,proto3" json:"flashback_session_time,omitempty"`
+}
+
+func (x *Flashback) Reset() {
+	*x = Flashback{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Flashback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Flashback) ProtoMessage() {}
+
+func (x *Flashback) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Flashback.ProtoReflect.Descriptor instead.
+func (*Flashback) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Flashback) GetFlashbackFrameIdentifier() uint32 {
+	if x != nil {
+		return x.FlashbackFrameIdentifier
+	}
+	return 0
+}
+
+func (x *Flashback) GetFlashbackSessionTime() float32 {
+	if x != nil {
+		return x.FlashbackSessionTime
+	}
+	return 0
+}
+
+type Buttons struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ButtonStatus uint32 `protobuf:"varint,1,opt,name=button_status,json=buttonStatus,proto3" json:"button_status,omitempty"` // bit flags
+}
+
+func (x *Buttons) Reset() {
+	*x = Buttons{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Buttons) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Buttons) ProtoMessage() {}
+
+func (x *Buttons) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Buttons.ProtoReflect.Descriptor instead.
+func (*Buttons) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Buttons) GetButtonStatus() uint32 {
+	if x != nil {
+		return x.ButtonStatus
+	}
+	return 0
+}
+
+type Overtake struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OvertakingVehicleIdx     uint32 `protobuf:"varint,1,opt,name=overtaking_vehicle_idx,json=overtakingVehicleIdx,proto3" json:"overtaking_vehicle_idx,omitempty"`
+	BeingOvertakenVehicleIdx uint32 `protobuf:"varint,2,opt,name=being_overtaken_vehicle_idx,json=beingOvertakenVehicleIdx,proto3" json:"being_overtaken_vehicle_idx,omitempty"`
+}
+
+func (x *Overtake) Reset() {
+	*x = Overtake{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Overtake) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Overtake) ProtoMessage() {}
+
+func (x *Overtake) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Overtake.ProtoReflect.Descriptor instead.
+func (*Overtake) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Overtake) GetOvertakingVehicleIdx() uint32 {
+	if x != nil {
+		return x.OvertakingVehicleIdx
+	}
+	return 0
+}
+
+func (x *Overtake) GetBeingOvertakenVehicleIdx() uint32 {
+	if x != nil {
+		return x.BeingOvertakenVehicleIdx
+	}
+	return 0
+}
+
+// SafetyCar is only send by F1 24
+type SafetyCar struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SafetyCarType uint32 `protobuf:"varint,1,opt,name=safety_car_type,json=safetyCarType,proto3" json:"safety_car_type,omitempty"` // 0 = no safety car, 1 = full, 2 = virtual, 3 = formation lap
+	EventType     uint32 `protobuf:"varint,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`               // 0 = deployed, 1 = returning, 2 = returned, 3 = resume race
+}
+
+func (x *SafetyCar) Reset() {
+	*x = SafetyCar{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SafetyCar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SafetyCar) ProtoMessage() {}
+
+func (x *SafetyCar) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SafetyCar.ProtoReflect.Descriptor instead.
+func (*SafetyCar) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SafetyCar) GetSafetyCarType() uint32 {
+	if x != nil {
+		return x.SafetyCarType
+	}
+	return 0
+}
+
+func (x *SafetyCar) GetEventType() uint32 {
+	if x != nil {
+		return x.EventType
+	}
+	return 0
+}
+
+// Collision is only send by F1 24
+type Collision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vehicle1Idx uint32 `protobuf:"varint,1,opt,name=vehicle1_idx,json=vehicle1Idx,proto3" json:"vehicle1_idx,omitempty"`
+	Vehicle2Idx uint32 `protobuf:"varint,2,opt,name=vehicle2_idx,json=vehicle2Idx,proto3" json:"vehicle2_idx,omitempty"`
+}
+
+func (x *Collision) Reset() {
+	*x = Collision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Collision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Collision) ProtoMessage() {}
+
+func (x *Collision) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Collision.ProtoReflect.Descriptor instead.
+func (*Collision) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Collision) GetVehicle1Idx() uint32 {
+	if x != nil {
+		return x.Vehicle1Idx
+	}
+	return 0
+}
+
+func (x *Collision) GetVehicle2Idx() uint32 {
+	if x != nil {
+		return x.Vehicle2Idx
+	}
+	return 0
+}
+
+type PacketParticipantsData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header        *PacketHeader      `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	NumActiveCars uint32             `protobuf:"varint,2,opt,name=num_active_cars,json=numActiveCars,proto3" json:"num_active_cars,omitempty"`
+	Participants  []*ParticipantData `protobuf:"bytes,3,rep,name=participants,proto3" json:"participants,omitempty"` // 22 cars
+}
+
+func (x *PacketParticipantsData) Reset() {
+	*x = PacketParticipantsData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PacketParticipantsData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PacketParticipantsData) ProtoMessage() {}
+
+func (x *PacketParticipantsData) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PacketParticipantsData.ProtoReflect.Descriptor instead.
+func (*PacketParticipantsData) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PacketParticipantsData) GetHeader() *PacketHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *PacketParticipantsData) GetNumActiveCars() uint32 {
+	if x != nil {
+		return x.NumActiveCars
+	}
+	return 0
+}
+
+func (x *PacketParticipantsData) GetParticipants() []*ParticipantData {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+type ParticipantData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AiControlled    uint32 `protobuf:"varint,1,opt,name=ai_controlled,json=aiControlled,proto3" json:"ai_controlled,omitempty"`
+	DriverId        uint32 `protobuf:"varint,2,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	NetworkId       uint32 `protobuf:"varint,3,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	TeamId          uint32 `protobuf:"varint,4,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	MyTeam          uint32 `protobuf:"varint,5,opt,name=my_team,json=myTeam,proto3" json:"my_team,omitempty"`
+	RaceNumber      uint32 `protobuf:"varint,6,opt,name=race_number,json=raceNumber,proto3" json:"race_number,omitempty"`
+	Nationality     uint32 `protobuf:"varint,7,opt,name=nationality,proto3" json:"nationality,omitempty"`
+	Name            string `protobuf:"bytes,8,opt,name=name,proto3" json:"name,omitempty"`
+	YourTelemetry   uint32 `protobuf:"varint,9,opt,name=your_telemetry,json=yourTelemetry,proto3" json:"your_telemetry,omitempty"`
+	ShowOnlineNames uint32 `protobuf:"varint,10,opt,name=show_online_names,json=showOnlineNames,proto3" json:"show_online_names,omitempty"`
+	Platform        uint32 `protobuf:"varint,11,opt,name=platform,proto3" json:"platform,omitempty"`
+}
+
+func (x *ParticipantData) Reset() {
+	*x = ParticipantData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParticipantData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParticipantData) ProtoMessage() {}
+
+func (x *ParticipantData) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParticipantData.ProtoReflect.Descriptor instead.
+func (*ParticipantData) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ParticipantData) GetAiControlled() uint32 {
+	if x != nil {
+		return x.AiControlled
+	}
+	return 0
+}
+
+func (x *ParticipantData) GetDriverId() uint32 {
+	if x != nil {
+		return x.DriverId
+	}
+	return 0
+}
+
+func (x *ParticipantData) GetNetworkId() uint32 {
+	if x != nil {
+		return x.NetworkId
+	}
+	return 0
+}
+
+func (x *ParticipantData) GetTeamId() uint32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *ParticipantData) GetMyTeam() uint32 {
+	if x != nil {
+		return x.MyTeam
+	}
+	return 0
+}
+
+func (x *ParticipantData) GetRaceNumber() uint32 {
+	if x != nil {
+		return x.RaceNumber
+	}
+	return 0
+}
+
+func (x *ParticipantData) GetNationality() uint32 {
+	if x != nil {
+		return x.Nationality
+	}
+	return 0
+}
+
+func (x *ParticipantData) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ParticipantData) GetYourTelemetry() uint32 {
+	if x != nil {
+		return x.YourTelemetry
+	}
+	return 0
+}
+
+func (x *ParticipantData) GetShowOnlineNames() uint32 {
+	if x != nil {
+		return x.ShowOnlineNames
+	}
+	return 0
+}
+
+func (x *ParticipantData) GetPlatform() uint32 {
+	if x != nil {
+		return x.Platform
+	}
+	return 0
+}
+
+type PacketCarSetupsData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header    *PacketHeader   `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	CarSetups []*CarSetupData `protobuf:"bytes,2,rep,name=car_setups,json=carSetups,proto3" json:"car_setups,omitempty"` // 22 cars
+}
+
+func (x *PacketCarSetupsData) Reset() {
+	*x = PacketCarSetupsData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PacketCarSetupsData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PacketCarSetupsData) ProtoMessage() {}
+
+func (x *PacketCarSetupsData) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PacketCarSetupsData.ProtoReflect.Descriptor instead.
+func (*PacketCarSetupsData) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PacketCarSetupsData) GetHeader() *PacketHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *PacketCarSetupsData) GetCarSetups() []*CarSetupData {
+	if x != nil {
+		return x.CarSetups
+	}
+	return nil
+}
+
+type CarSetupData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FrontWing              uint32  `protobuf:"varint,1,opt,name=front_wing,json=frontWing,proto3" json:"front_wing,omitempty"`
+	RearWing               uint32  `protobuf:"varint,2,opt,name=rear_wing,json=rearWing,proto3" json:"rear_wing,omitempty"`
+	OnThrottle             uint32  `protobuf:"varint,3,opt,name=on_throttle,json=onThrottle,proto3" json:"on_throttle,omitempty"`
+	OffThrottle            uint32  `protobuf:"varint,4,opt,name=off_throttle,json=offThrottle,proto3" json:"off_throttle,omitempty"`
+	FrontCamber            float32 `protobuf:"fixed32,5,opt,name=front_camber,json=frontCamber,proto3" json:"front_camber,omitempty"`
+	RearCamber             float32 `protobuf:"fixed32,6,opt,name=rear_camber,json=rearCamber,proto3" json:"rear_camber,omitempty"`
+	FrontToe               float32 `protobuf:"fixed32,7,opt,name=front_toe,json=frontToe,proto3" json:"front_toe,omitempty"`
+	RearToe                float32 `protobuf:"fixed32,8,opt,name=rear_toe,json=rearToe,proto3" json:"rear_toe,omitempty"`
+	FrontSuspension        uint32  `protobuf:"varint,9,opt,name=front_suspension,json=frontSuspension,proto3" json:"front_suspension,omitempty"`
+	RearSuspension         uint32  `protobuf:"varint,10,opt,name=rear_suspension,json=rearSuspension,proto3" json:"rear_suspension,omitempty"`
+	FrontAntiRollBar       uint32  `protobuf:"varint,11,opt,name=front_anti_roll_bar,json=frontAntiRollBar,proto3" json:"front_anti_roll_bar,omitempty"`
+	RearAntiRollBar        uint32  `protobuf:"varint,12,opt,name=rear_anti_roll_bar,json=rearAntiRollBar,proto3" json:"rear_anti_roll_bar,omitempty"`
+	FrontSuspensionHeight  uint32  `protobuf:"varint,13,opt,name=front_suspension_height,json=frontSuspensionHeight,proto3" json:"front_suspension_height,omitempty"`
+	RearSuspensionHeight   uint32  `protobuf:"varint,14,opt,name=rear_suspension_height,json=rearSuspensionHeight,proto3" json:"rear_suspension_height,omitempty"`
+	BrakePressure          uint32  `protobuf:"varint,15,opt,name=brake_pressure,json=brakePressure,proto3" json:"brake_pressure,omitempty"`
+	BrakeBias              uint32  `protobuf:"varint,16,opt,name=brake_bias,json=brakeBias,proto3" json:"brake_bias,omitempty"`
+	RearLeftTyrePressure   float32 `protobuf:"fixed32,17,opt,name=rear_left_tyre_pressure,json=rearLeftTyrePressure,proto3" json:"rear_left_tyre_pressure,omitempty"`
+	RearRightTyrePressure  float32 `protobuf:"fixed32,18,opt,name=rear_right_tyre_pressure,json=rearRightTyrePressure,proto3" json:"rear_right_tyre_pressure,omitempty"`
+	FrontLeftTyrePressure  float32 `protobuf:"fixed32,19,opt,name=front_left_tyre_pressure,json=frontLeftTyrePressure,proto3" json:"front_left_tyre_pressure,omitempty"`
+	FrontRightTyrePressure float32 `protobuf:"fixed32,20,opt,name=front_right_tyre_pressure,json=frontRightTyrePressure,proto3" json:"front_right_tyre_pressure,omitempty"`
+	Ballast                uint32  `protobuf:"varint,21,opt,name=ballast,proto3" json:"ballast,omitempty"`
+	FuelLoad               float32 `protobuf:"fixed32,22,opt,name=fuel_load,json=fuelLoad,proto3" json:"fuel_load,omitempty"`
+}
+
+func (x *CarSetupData) Reset() {
+	*x = CarSetupData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CarSetupData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CarSetupData) ProtoMessage() {}
+
+func (x *CarSetupData) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CarSetupData.ProtoReflect.Descriptor instead.
+func (*CarSetupData) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CarSetupData) GetFrontWing() uint32 {
+	if x != nil {
+		return x.FrontWing
+	}
+	return 0
+}
+
+func (x *CarSetupData) GetRearWing() uint32 {
+	if x != nil {
+		return x.RearWing
+	}
+	return 0
+}
+
+func (x *CarSetupData) GetOnThrottle() uint32 {
+	if x != nil {
+		return x.OnThrottle
+	}
+	return 0
+}
+
+func (x *CarSetupData) GetOffThrottle() uint32 {
+	if x != nil {
+		return x.OffThrottle
+	}
+	return 0
+}
+
+func (x *CarSetupData) GetFrontCamber() float32 {
+	if x != nil {
+		return x.FrontCamber
+	}
+	return 0
+}
+
+func (x *CarSetupData) GetRearCamber() float32 {
+	if x != nil {
+		return x.RearCamber
+	}
+	return 0
+}
+
+func (x *CarSetupData) GetFrontToe() float32 {
+	if x != nil {
+		return x.FrontToe
+	}
+	return 0
+}
+
+func (x *CarSetupData) GetRearToe() float32 {
+	if x != nil {
+		return x.RearToe
+	}
+	return 0
+}
+
+func (x *CarSetupData) GetFrontSuspension() uint32 {
+	if x != nil {
+		return x.FrontSuspension
+	}
+	return 0
+}
+
+func (x *CarSetupData) GetRearSuspension() uint32 {
+	if x != nil {
+		return x.RearSuspension
+	}
+	return 0
+}
+
+func (x *CarSetupData) GetFrontAntiRollBar() uint32 {
+	if x != nil {
+		return x.FrontAntiRollBar
+	}
+	return 0
+}
+
+func (x *CarSetupData) GetRearAntiRollBar() uint32 {
+	if x != nil {
+		return x.RearAntiRollBar
+	}
+	return 0
+}
+
+func (x *CarSetupData) GetFrontSuspensionHeight() uint32 {
+	if x != nil {
+		return x.FrontSuspensionHeight
+	}
+	return 0
+}
+
+func (x *CarSetupData) GetRearSuspensionHeight() uint32 {
+	if x != nil {
+		return x.RearSuspensionHeight
+	}
+	return 0
+}
+
+func (x *CarSetupData) GetBrakePressure() uint32 {
+	if x != nil {
+		return x.BrakePressure
+	}
+	return 0
+}
+
+func (x *CarSetupData) GetBrakeBias() uint32 {
+	if x != nil {
+		return x.BrakeBias
+	}
+	return 0
+}
+
+func (x *CarSetupData) GetRearLeftTyrePressure() float32 {
+	if x != nil {
+		return x.RearLeftTyrePressure
+	}
+	return 0
+}
+
+func (x *CarSetupData) GetRearRightTyrePressure() float32 {
+	if x != nil {
+		return x.RearRightTyrePressure
+	}
+	return 0
+}
+
+func (x *CarSetupData) GetFrontLeftTyrePressure() float32 {
+	if x != nil {
+		return x.FrontLeftTyrePressure
+	}
+	return 0
+}
+
+func (x *CarSetupData) GetFrontRightTyrePressure() float32 {
+	if x != nil {
+		return x.FrontRightTyrePressure
+	}
+	return 0
+}
+
+func (x *CarSetupData) GetBallast() uint32 {
+	if x != nil {
+		return x.Ballast
+	}
+	return 0
+}
+
+func (x *CarSetupData) GetFuelLoad() float32 {
+	if x != nil {
+		return x.FuelLoad
+	}
+	return 0
+}
+
+type PacketCarTelemetryData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header                       *PacketHeader       `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	CarTelemetryData             []*CarTelemetryData `protobuf:"bytes,2,rep,name=car_telemetry_data,json=carTelemetryData,proto3" json:"car_telemetry_data,omitempty"` // 22 cars
+	MfdPanelIndex                uint32              `protobuf:"varint,3,opt,name=mfd_panel_index,json=mfdPanelIndex,proto3" json:"mfd_panel_index,omitempty"`
+	MfdPanelIndexSecondaryPlayer uint32              `protobuf:"varint,4,opt,name=mfd_panel_index_secondary_player,json=mfdPanelIndexSecondaryPlayer,proto3" json:"mfd_panel_index_secondary_player,omitempty"`
+	SuggestedGear                int32               `protobuf:"varint,5,opt,name=suggested_gear,json=suggestedGear,proto3" json:"suggested_gear,omitempty"`
+}
+
+func (x *PacketCarTelemetryData) Reset() {
+	*x = PacketCarTelemetryData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PacketCarTelemetryData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PacketCarTelemetryData) ProtoMessage() {}
+
+func (x *PacketCarTelemetryData) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PacketCarTelemetryData.ProtoReflect.Descriptor instead.
+func (*PacketCarTelemetryData) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PacketCarTelemetryData) GetHeader() *PacketHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *PacketCarTelemetryData) GetCarTelemetryData() []*CarTelemetryData {
+	if x != nil {
+		return x.CarTelemetryData
+	}
+	return nil
+}
+
+func (x *PacketCarTelemetryData) GetMfdPanelIndex() uint32 {
+	if x != nil {
+		return x.MfdPanelIndex
+	}
+	return 0
+}
+
+func (x *PacketCarTelemetryData) GetMfdPanelIndexSecondaryPlayer() uint32 {
+	if x != nil {
+		return x.MfdPanelIndexSecondaryPlayer
+	}
+	return 0
+}
+
+func (x *PacketCarTelemetryData) GetSuggestedGear() int32 {
+	if x != nil {
+		return x.SuggestedGear
+	}
+	return 0
+}
+
+type CarTelemetryData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Speed                   uint32  `protobuf:"varint,1,opt,name=speed,proto3" json:"speed,omitempty"`        // km/h
+	Throttle                float32 `protobuf:"fixed32,2,opt,name=throttle,proto3" json:"throttle,omitempty"` // 0.0 to 1.0
+	Steer                   float32 `protobuf:"fixed32,3,opt,name=steer,proto3" json:"steer,omitempty"`       // -1.0 to 1.0
+	Brake                   float32 `protobuf:"fixed32,4,opt,name=brake,proto3" json:"brake,omitempty"`       // 0.0 to 1.0
+	Clutch                  uint32  `protobuf:"varint,5,opt,name=clutch,proto3" json:"clutch,omitempty"`      // 0 to 100
+	Gear                    int32   `protobuf:"varint,6,opt,name=gear,proto3" json:"gear,omitempty"`          // 1-8, N=0, R=-1
+	EngineRpm               uint32  `protobuf:"varint,7,opt,name=engine_rpm,json=engineRpm,proto3" json:"engine_rpm,omitempty"`
+	Drs                     uint32  `protobuf:"varint,8,opt,name=drs,proto3" json:"drs,omitempty"`
+	RevLightsPercent        uint32  `protobuf:"varint,9,opt,name=rev_lights_percent,json=revLightsPercent,proto3" json:"rev_lights_percent,omitempty"`
+	RevLightsBitValue       uint32  `protobuf:"varint,10,opt,name=rev_lights_bit_value,json=revLightsBitValue,proto3" json:"rev_lights_bit_value,omitempty"`
+	BrakesTemperature       *Wheels `protobuf:"bytes,11,opt,name=brakes_temperature,json=brakesTemperature,proto3" json:"brakes_temperature,omitempty"`                     // celsius
+	TyresSurfaceTemperature *Wheels `protobuf:"bytes,12,opt,name=tyres_surface_temperature,json=tyresSurfaceTemperature,proto3" json:"tyres_surface_temperature,omitempty"` // celsius
+	TyresInnerTemperature   *Wheels `protobuf:"bytes,13,opt,name=tyres_inner_temperature,json=tyresInnerTemperature,proto3" json:"tyres_inner_temperature,omitempty"`       // celsius
+	EngineTemperature       uint32  `protobuf:"varint,14,opt,name=engine_temperature,json=engineTemperature,proto3" json:"engine_temperature,omitempty"`                    // celsius
+	TyresPressure           *Wheels `protobuf:"bytes,15,opt,name=tyres_pressure,json=tyresPressure,proto3" json:"tyres_pressure,omitempty"`                                 // PSI
+	SurfaceType             *Wheels `protobuf:"bytes,16,opt,name=surface_type,json=surfaceType,proto3" json:"surface_type,omitempty"`
+}
+
+func (x *CarTelemetryData) Reset() {
+	*x = CarTelemetryData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CarTelemetryData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CarTelemetryData) ProtoMessage() {}
+
+func (x *CarTelemetryData) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CarTelemetryData.ProtoReflect.Descriptor instead.
+func (*CarTelemetryData) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CarTelemetryData) GetSpeed() uint32 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *CarTelemetryData) GetThrottle() float32 {
+	if x != nil {
+		return x.Throttle
+	}
+	return 0
+}
+
+func (x *CarTelemetryData) GetSteer() float32 {
+	if x != nil {
+		return x.Steer
+	}
+	return 0
+}
+
+func (x *CarTelemetryData) GetBrake() float32 {
+	if x != nil {
+		return x.Brake
+	}
+	return 0
+}
+
+func (x *CarTelemetryData) GetClutch() uint32 {
+	if x != nil {
+		return x.Clutch
+	}
+	return 0
+}
+
+func (x *CarTelemetryData) GetGear() int32 {
+	if x != nil {
+		return x.Gear
+	}
+	return 0
+}
+
+func (x *CarTelemetryData) GetEngineRpm() uint32 {
+	if x != nil {
+		return x.EngineRpm
+	}
+	return 0
+}
+
+func (x *CarTelemetryData) GetDrs() uint32 {
+	if x != nil {
+		return x.Drs
+	}
+	return 0
+}
+
+func (x *CarTelemetryData) GetRevLightsPercent() uint32 {
+	if x != nil {
+		return x.RevLightsPercent
+	}
+	return 0
+}
+
+func (x *CarTelemetryData) GetRevLightsBitValue() uint32 {
+	if x != nil {
+		return x.RevLightsBitValue
+	}
+	return 0
+}
+
+func (x *CarTelemetryData) GetBrakesTemperature() *Wheels {
+	if x != nil {
+		return x.BrakesTemperature
+	}
+	return nil
+}
+
+func (x *CarTelemetryData) GetTyresSurfaceTemperature() *Wheels {
+	if x != nil {
+		return x.TyresSurfaceTemperature
+	}
+	return nil
+}
+
+func (x *CarTelemetryData) GetTyresInnerTemperature() *Wheels {
+	if x != nil {
+		return x.TyresInnerTemperature
+	}
+	return nil
+}
+
+func (x *CarTelemetryData) GetEngineTemperature() uint32 {
+	if x != nil {
+		return x.EngineTemperature
+	}
+	return 0
+}
+
+func (x *CarTelemetryData) GetTyresPressure() *Wheels {
+	if x != nil {
+		return x.TyresPressure
+	}
+	return nil
+}
+
+func (x *CarTelemetryData) GetSurfaceType() *Wheels {
+	if x != nil {
+		return x.SurfaceType
+	}
+	return nil
+}
+
+type PacketCarStatusData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header        *PacketHeader    `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	CarStatusData []*CarStatusData `protobuf:"bytes,2,rep,name=car_status_data,json=carStatusData,proto3" json:"car_status_data,omitempty"` // 22 cars
+}
+
+func (x *PacketCarStatusData) Reset() {
+	*x = PacketCarStatusData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PacketCarStatusData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PacketCarStatusData) ProtoMessage() {}
+
+func (x *PacketCarStatusData) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PacketCarStatusData.ProtoReflect.Descriptor instead.
+func (*PacketCarStatusData) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PacketCarStatusData) GetHeader() *PacketHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *PacketCarStatusData) GetCarStatusData() []*CarStatusData {
+	if x != nil {
+		return x.CarStatusData
+	}
+	return nil
+}
+
+type CarStatusData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TractionControl         uint32  `protobuf:"varint,1,opt,name=traction_control,json=tractionControl,proto3" json:"traction_control,omitempty"`
+	AntiLockBrakes          uint32  `protobuf:"varint,2,opt,name=anti_lock_brakes,json=antiLockBrakes,proto3" json:"anti_lock_brakes,omitempty"`
+	FuelMix                 uint32  `protobuf:"varint,3,opt,name=fuel_mix,json=fuelMix,proto3" json:"fuel_mix,omitempty"`
+	FrontBrakeBias          uint32  `protobuf:"varint,4,opt,name=front_brake_bias,json=frontBrakeBias,proto3" json:"front_brake_bias,omitempty"`
+	PitLimiterStatus        uint32  `protobuf:"varint,5,opt,name=pit_limiter_status,json=pitLimiterStatus,proto3" json:"pit_limiter_status,omitempty"`
+	FuelInTank              float32 `protobuf:"fixed32,6,opt,name=fuel_in_tank,json=fuelInTank,proto3" json:"fuel_in_tank,omitempty"`
+	FuelCapacity            float32 `protobuf:"fixed32,7,opt,name=fuel_capacity,json=fuelCapacity,proto3" json:"fuel_capacity,omitempty"`
+	FuelRemainingLaps       float32 `protobuf:"fixed32,8,opt,name=fuel_remaining_laps,json=fuelRemainingLaps,proto3" json:"fuel_remaining_laps,omitempty"`
+	MaxRpm                  uint32  `protobuf:"varint,9,opt,name=max_rpm,json=maxRpm,proto3" json:"max_rpm,omitempty"`
+	IdleRpm                 uint32  `protobuf:"varint,10,opt,name=idle_rpm,json=idleRpm,proto3" json:"idle_rpm,omitempty"`
+	MaxGears                uint32  `protobuf:"varint,11,opt,name=max_gears,json=maxGears,proto3" json:"max_gears,omitempty"`
+	DrsAllowed              uint32  `protobuf:"varint,12,opt,name=drs_allowed,json=drsAllowed,proto3" json:"drs_allowed,omitempty"`
+	DrsActivationDistance   uint32  `protobuf:"varint,13,opt,name=drs_activation_distance,json=drsActivationDistance,proto3" json:"drs_activation_distance,omitempty"`
+	ActualTyreCompound      uint32  `protobuf:"varint,14,opt,name=actual_tyre_compound,json=actualTyreCompound,proto3" json:"actual_tyre_compound,omitempty"`
+	VisualTyreCompound      uint32  `protobuf:"varint,15,opt,name=visual_tyre_compound,json=visualTyreCompound,proto3" json:"visual_tyre_compound,omitempty"`
+	TyresAgeLaps            uint32  `protobuf:"varint,16,opt,name=tyres_age_laps,json=tyresAgeLaps,proto3" json:"tyres_age_laps,omitempty"`
+	VehicleFiaFlags         int32   `protobuf:"varint,17,opt,name=vehicle_fia_flags,json=vehicleFiaFlags,proto3" json:"vehicle_fia_flags,omitempty"`
+	EnginePowerIce          float32 `protobuf:"fixed32,18,opt,name=engine_power_ice,json=enginePowerIce,proto3" json:"engine_power_ice,omitempty"`
+	EnginePowerMguk         float32 `protobuf:"fixed32,19,opt,name=engine_power_mguk,json=enginePowerMguk,proto3" json:"engine_power_mguk,omitempty"`
+	ErsStoreEnergy          float32 `protobuf:"fixed32,20,opt,name=ers_store_energy,json=ersStoreEnergy,proto3" json:"ers_store_energy,omitempty"`
+	ErsDeployMode           uint32  `protobuf:"varint,21,opt,name=ers_deploy_mode,json=ersDeployMode,proto3" json:"ers_deploy_mode,omitempty"`
+	ErsHarvestedThisLapMguk float32 `protobuf:"fixed32,22,opt,name=ers_harvested_this_lap_mguk,json=ersHarvestedThisLapMguk,proto3" json:"ers_harvested_this_lap_mguk,omitempty"`
+	ErsHarvestedThisLapMguh float32 `protobuf:"fixed32,23,opt,name=ers_harvested_this_lap_mguh,json=ersHarvestedThisLapMguh,proto3" json:"ers_harvested_this_lap_mguh,omitempty"`
+	ErsDeployedThisLap      float32 `protobuf:"fixed32,24,opt,name=ers_deployed_this_lap,json=ersDeployedThisLap,proto3" json:"ers_deployed_this_lap,omitempty"`
+	NetworkPaused           uint32  `protobuf:"varint,25,opt,name=network_paused,json=networkPaused,proto3" json:"network_paused,omitempty"`
+}
+
+func (x *CarStatusData) Reset() {
+	*x = CarStatusData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CarStatusData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CarStatusData) ProtoMessage() {}
+
+func (x *CarStatusData) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CarStatusData.ProtoReflect.Descriptor instead.
+func (*CarStatusData) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CarStatusData) GetTractionControl() uint32 {
+	if x != nil {
+		return x.TractionControl
+	}
+	return 0
+}
+
+func (x *CarStatusData) GetAntiLockBrakes() uint32 {
+	if x != nil {
+		return x.AntiLockBrakes
+	}
+	return 0
+}
+
+func (x *CarStatusData) GetFuelMix() uint32 {
+	if x != nil {
+		return x.FuelMix
+	}
+	return 0
+}
+
+func (x *CarStatusData) GetFrontBrakeBias() uint32 {
+	if x != nil {
+		return x.FrontBrakeBias
+	}
+	return 0
+}
+
+func (x *CarStatusData) GetPitLimiterStatus() uint32 {
+	if x != nil {
+		return x.PitLimiterStatus
+	}
+	return 0
+}
+
+func (x *CarStatusData) GetFuelInTank() float32 {
+	if x != nil {
+		return x.FuelInTank
+	}
+	return 0
+}
+
+func (x *CarStatusData) GetFuelCapacity() float32 {
+	if x != nil {
+		return x.FuelCapacity
+	}
+	return 0
+}
+
+func (x *CarStatusData) GetFuelRemainingLaps() float32 {
+	if x != nil {
+		return x.FuelRemainingLaps
+	}
+	return 0
+}
+
+func (x *CarStatusData) GetMaxRpm() uint32 {
+	if x != nil {
+		return x.MaxRpm
+	}
+	return 0
+}
+
+func (x *CarStatusData) GetIdleRpm() uint32 {
+	if x != nil {
+		return x.IdleRpm
+	}
+	return 0
+}
+
+func (x *CarStatusData) GetMaxGears() uint32 {
+	if x != nil {
+		return x.MaxGears
+	}
+	return 0
+}
+
+func (x *CarStatusData) GetDrsAllowed() uint32 {
+	if x != nil {
+		return x.DrsAllowed
+	}
+	return 0
+}
+
+func (x *CarStatusData) GetDrsActivationDistance() uint32 {
+	if x != nil {
+		return x.DrsActivationDistance
+	}
+	return 0
+}
+
+func (x *CarStatusData) GetActualTyreCompound() uint32 {
+	if x != nil {
+		return x.ActualTyreCompound
+	}
+	return 0
+}
+
+func (x *CarStatusData) GetVisualTyreCompound() uint32 {
+	if x != nil {
+		return x.VisualTyreCompound
+	}
+	return 0
+}
+
+func (x *CarStatusData) GetTyresAgeLaps() uint32 {
+	if x != nil {
+		return x.TyresAgeLaps
+	}
+	return 0
+}
+
+func (x *CarStatusData) GetVehicleFiaFlags() int32 {
+	if x != nil {
+		return x.VehicleFiaFlags
+	}
+	return 0
+}
+
+func (x *CarStatusData) GetEnginePowerIce() float32 {
+	if x != nil {
+		return x.EnginePowerIce
+	}
+	return 0
+}
+
+func (x *CarStatusData) GetEnginePowerMguk() float32 {
+	if x != nil {
+		return x.EnginePowerMguk
+	}
+	return 0
+}
+
+func (x *CarStatusData) GetErsStoreEnergy() float32 {
+	if x != nil {
+		return x.ErsStoreEnergy
+	}
+	return 0
+}
+
+func (x *CarStatusData) GetErsDeployMode() uint32 {
+	if x != nil {
+		return x.ErsDeployMode
+	}
+	return 0
+}
+
+func (x *CarStatusData) GetErsHarvestedThisLapMguk() float32 {
+	if x != nil {
+		return x.ErsHarvestedThisLapMguk
+	}
+	return 0
+}
+
+func (x *CarStatusData) GetErsHarvestedThisLapMguh() float32 {
+	if x != nil {
+		return x.ErsHarvestedThisLapMguh
+	}
+	return 0
+}
+
+func (x *CarStatusData) GetErsDeployedThisLap() float32 {
+	if x != nil {
+		return x.ErsDeployedThisLap
+	}
+	return 0
+}
+
+func (x *CarStatusData) GetNetworkPaused() uint32 {
+	if x != nil {
+		return x.NetworkPaused
+	}
+	return 0
+}
+
+type PacketFinalClassificationData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header             *PacketHeader              `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	NumCars            uint32                     `protobuf:"varint,2,opt,name=num_cars,json=numCars,proto3" json:"num_cars,omitempty"`
+	ClassificationData []*FinalClassificationData `protobuf:"bytes,3,rep,name=classification_data,json=classificationData,proto3" json:"classification_data,omitempty"` // 22 cars
+}
+
+func (x *PacketFinalClassificationData) Reset() {
+	*x = PacketFinalClassificationData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PacketFinalClassificationData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PacketFinalClassificationData) ProtoMessage() {}
+
+func (x *PacketFinalClassificationData) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PacketFinalClassificationData.ProtoReflect.Descriptor instead.
+func (*PacketFinalClassificationData) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *PacketFinalClassificationData) GetHeader() *PacketHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *PacketFinalClassificationData) GetNumCars() uint32 {
+	if x != nil {
+		return x.NumCars
+	}
+	return 0
+}
+
+func (x *PacketFinalClassificationData) GetClassificationData() []*FinalClassificationData {
+	if x != nil {
+		return x.ClassificationData
+	}
+	return nil
+}
+
+type FinalClassificationData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Position          uint32   `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"`
+	NumLaps           uint32   `protobuf:"varint,2,opt,name=num_laps,json=numLaps,proto3" json:"num_laps,omitempty"`
+	GridPosition      uint32   `protobuf:"varint,3,opt,name=grid_position,json=gridPosition,proto3" json:"grid_position,omitempty"`
+	Points            uint32   `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`
+	NumPitStops       uint32   `protobuf:"varint,5,opt,name=num_pit_stops,json=numPitStops,proto3" json:"num_pit_stops,omitempty"`
+	ResultStatus      uint32   `protobuf:"varint,6,opt,name=result_status,json=resultStatus,proto3" json:"result_status,omitempty"`
+	BestLapTimeInMs   uint32   `protobuf:"varint,7,opt,name=best_lap_time_in_ms,json=bestLapTimeInMs,proto3" json:"best_lap_time_in_ms,omitempty"`
+	TotalRaceTime     float64  `protobuf:"fixed64,8,opt,name=total_race_time,json=totalRaceTime,proto3" json:"total_race_time,omitempty"` // seconds without penalties
+	PenaltiesTime     uint32   `protobuf:"varint,9,opt,name=penalties_time,json=penaltiesTime,proto3" json:"penalties_time,omitempty"`    // seconds
+	NumPenalties      uint32   `protobuf:"varint,10,opt,name=num_penalties,json=numPenalties,proto3" json:"num_penalties,omitempty"`
+	NumTyreStints     uint32   `protobuf:"varint,11,opt,name=num_tyre_stints,json=numTyreStints,proto3" json:"num_tyre_stints,omitempty"`
+	TyreStintsActual  []uint32 `protobuf:"varint,12,rep,packed,name=tyre_stints_actual,json=tyreStintsActual,proto3" json:"tyre_stints_actual,omitempty"`      // 8 stints
+	TyreStintsVisual  []uint32 `protobuf:"varint,13,rep,packed,name=tyre_stints_visual,json=tyreStintsVisual,proto3" json:"tyre_stints_visual,omitempty"`      // 8 stints
+	TyreStintsEndLaps []uint32 `protobuf:"varint,14,rep,packed,name=tyre_stints_end_laps,json=tyreStintsEndLaps,proto3" json:"tyre_stints_end_laps,omitempty"` // 8 stints
+}
+
+func (x *FinalClassificationData) Reset() {
+	*x = FinalClassificationData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FinalClassificationData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinalClassificationData) ProtoMessage() {}
+
+func (x *FinalClassificationData) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinalClassificationData.ProtoReflect.Descriptor instead.
+func (*FinalClassificationData) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *FinalClassificationData) GetPosition() uint32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *FinalClassificationData) GetNumLaps() uint32 {
+	if x != nil {
+		return x.NumLaps
+	}
+	return 0
+}
+
+func (x *FinalClassificationData) GetGridPosition() uint32 {
+	if x != nil {
+		return x.GridPosition
+	}
+	return 0
+}
+
+func (x *FinalClassificationData) GetPoints() uint32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *FinalClassificationData) GetNumPitStops() uint32 {
+	if x != nil {
+		return x.NumPitStops
+	}
+	return 0
+}
+
+func (x *FinalClassificationData) GetResultStatus() uint32 {
+	if x != nil {
+		return x.ResultStatus
+	}
+	return 0
+}
+
+func (x *FinalClassificationData) GetBestLapTimeInMs() uint32 {
+	if x != nil {
+		return x.BestLapTimeInMs
+	}
+	return 0
+}
+
+func (x *FinalClassificationData) GetTotalRaceTime() float64 {
+	if x != nil {
+		return x.TotalRaceTime
+	}
+	return 0
+}
+
+func (x *FinalClassificationData) GetPenaltiesTime() uint32 {
+	if x != nil {
+		return x.PenaltiesTime
+	}
+	return 0
+}
+
+func (x *FinalClassificationData) GetNumPenalties() uint32 {
+	if x != nil {
+		return x.NumPenalties
+	}
+	return 0
+}
+
+func (x *FinalClassificationData) GetNumTyreStints() uint32 {
+	if x != nil {
+		return x.NumTyreStints
+	}
+	return 0
+}
+
+func (x *FinalClassificationData) GetTyreStintsActual() []uint32 {
+	if x != nil {
+		return x.TyreStintsActual
+	}
+	return nil
+}
+
+func (x *FinalClassificationData) GetTyreStintsVisual() []uint32 {
+	if x != nil {
+		return x.TyreStintsVisual
+	}
+	return nil
+}
+
+func (x *FinalClassificationData) GetTyreStintsEndLaps() []uint32 {
+	if x != nil {
+		return x.TyreStintsEndLaps
+	}
+	return nil
+}
+
+type PacketLobbyInfoData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header       *PacketHeader    `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	NumPlayers   uint32           `protobuf:"varint,2,opt,name=num_players,json=numPlayers,proto3" json:"num_players,omitempty"`
+	LobbyPlayers []*LobbyInfoData `protobuf:"bytes,3,rep,name=lobby_players,json=lobbyPlayers,proto3" json:"lobby_players,omitempty"` // 22 players
+}
+
+func (x *PacketLobbyInfoData) Reset() {
+	*x = PacketLobbyInfoData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PacketLobbyInfoData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PacketLobbyInfoData) ProtoMessage() {}
+
+func (x *PacketLobbyInfoData) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PacketLobbyInfoData.ProtoReflect.Descriptor instead.
+func (*PacketLobbyInfoData) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PacketLobbyInfoData) GetHeader() *PacketHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *PacketLobbyInfoData) GetNumPlayers() uint32 {
+	if x != nil {
+		return x.NumPlayers
+	}
+	return 0
+}
+
+func (x *PacketLobbyInfoData) GetLobbyPlayers() []*LobbyInfoData {
+	if x != nil {
+		return x.LobbyPlayers
+	}
+	return nil
+}
+
+type LobbyInfoData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AiControlled uint32 `protobuf:"varint,1,opt,name=ai_controlled,json=aiControlled,proto3" json:"ai_controlled,omitempty"`
+	TeamId       uint32 `protobuf:"varint,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Nationality  uint32 `protobuf:"varint,3,opt,name=nationality,proto3" json:"nationality,omitempty"`
+	Platform     uint32 `protobuf:"varint,4,opt,name=platform,proto3" json:"platform,omitempty"`
+	Name         string `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	CarNumber    uint32 `protobuf:"varint,6,opt,name=car_number,json=carNumber,proto3" json:"car_number,omitempty"`
+	ReadyStatus  uint32 `protobuf:"varint,7,opt,name=ready_status,json=readyStatus,proto3" json:"ready_status,omitempty"`
+}
+
+func (x *LobbyInfoData) Reset() {
+	*x = LobbyInfoData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LobbyInfoData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LobbyInfoData) ProtoMessage() {}
+
+func (x *LobbyInfoData) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LobbyInfoData.ProtoReflect.Descriptor instead.
+func (*LobbyInfoData) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *LobbyInfoData) GetAiControlled() uint32 {
+	if x != nil {
+		return x.AiControlled
+	}
+	return 0
+}
+
+func (x *LobbyInfoData) GetTeamId() uint32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *LobbyInfoData) GetNationality() uint32 {
+	if x != nil {
+		return x.Nationality
+	}
+	return 0
+}
+
+func (x *LobbyInfoData) GetPlatform() uint32 {
+	if x != nil {
+		return x.Platform
+	}
+	return 0
+}
+
+func (x *LobbyInfoData) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LobbyInfoData) GetCarNumber() uint32 {
+	if x != nil {
+		return x.CarNumber
+	}
+	return 0
+}
+
+func (x *LobbyInfoData) GetReadyStatus() uint32 {
+	if x != nil {
+		return x.ReadyStatus
+	}
+	return 0
+}
+
+type PacketCarDamageData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header        *PacketHeader    `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	CarDamageData []*CarDamageData `protobuf:"bytes,2,rep,name=car_damage_data,json=carDamageData,proto3" json:"car_damage_data,omitempty"` // 22 cars
+}
+
+func (x *PacketCarDamageData) Reset() {
+	*x = PacketCarDamageData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PacketCarDamageData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PacketCarDamageData) ProtoMessage() {}
+
+func (x *PacketCarDamageData) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PacketCarDamageData.ProtoReflect.Descriptor instead.
+func (*PacketCarDamageData) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *PacketCarDamageData) GetHeader() *PacketHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *PacketCarDamageData) GetCarDamageData() []*CarDamageData {
+	if x != nil {
+		return x.CarDamageData
+	}
+	return nil
+}
+
+type CarDamageData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TyresWear            *Wheels `protobuf:"bytes,1,opt,name=tyres_wear,json=tyresWear,proto3" json:"tyres_wear,omitempty"`          // percentage
+	TyresDamage          *Wheels `protobuf:"bytes,2,opt,name=tyres_damage,json=tyresDamage,proto3" json:"tyres_damage,omitempty"`    // percentage
+	BrakesDamage         *Wheels `protobuf:"bytes,3,opt,name=brakes_damage,json=brakesDamage,proto3" json:"brakes_damage,omitempty"` // percentage
+	FrontLeftWingDamage  uint32  `protobuf:"varint,4,opt,name=front_left_wing_damage,json=frontLeftWingDamage,proto3" json:"front_left_wing_damage,omitempty"`
+	FrontRightWingDamage uint32  `protobuf:"varint,5,opt,name=front_right_wing_damage,json=frontRightWingDamage,proto3" json:"front_right_wing_damage,omitempty"`
+	RearWingDamage       uint32  `protobuf:"varint,6,opt,name=rear_wing_damage,json=rearWingDamage,proto3" json:"rear_wing_damage,omitempty"`
+	FloorDamage          uint32  `protobuf:"varint,7,opt,name=floor_damage,json=floorDamage,proto3" json:"floor_damage,omitempty"`
+	DiffuserDamage       uint32  `protobuf:"varint,8,opt,name=diffuser_damage,json=diffuserDamage,proto3" json:"diffuser_damage,omitempty"`
+	SidepodDamage        uint32  `protobuf:"varint,9,opt,name=sidepod_damage,json=sidepodDamage,proto3" json:"sidepod_damage,omitempty"`
+	DrsFault             uint32  `protobuf:"varint,10,opt,name=drs_fault,json=drsFault,proto3" json:"drs_fault,omitempty"`
+	ErsFault             uint32  `protobuf:"varint,11,opt,name=ers_fault,json=ersFault,proto3" json:"ers_fault,omitempty"`
+	GearBoxDamage        uint32  `protobuf:"varint,12,opt,name=gear_box_damage,json=gearBoxDamage,proto3" json:"gear_box_damage,omitempty"`
+	EngineDamage         uint32  `protobuf:"varint,13,opt,name=engine_damage,json=engineDamage,proto3" json:"engine_damage,omitempty"`
+	EngineMguhWear       uint32  `protobuf:"varint,14,opt,name=engine_mguh_wear,json=engineMguhWear,proto3" json:"engine_mguh_wear,omitempty"`
+	EngineEsWear         uint32  `protobuf:"varint,15,opt,name=engine_es_wear,json=engineEsWear,proto3" json:"engine_es_wear,omitempty"`
+	EngineCeWear         uint32  `protobuf:"varint,16,opt,name=engine_ce_wear,json=engineCeWear,proto3" json:"engine_ce_wear,omitempty"`
+	EngineIceWear        uint32  `protobuf:"varint,17,opt,name=engine_ice_wear,json=engineIceWear,proto3" json:"engine_ice_wear,omitempty"`
+	EngineMgukWear       uint32  `protobuf:"varint,18,opt,name=engine_mguk_wear,json=engineMgukWear,proto3" json:"engine_mguk_wear,omitempty"`
+	EngineTcWear         uint32  `protobuf:"varint,19,opt,name=engine_tc_wear,json=engineTcWear,proto3" json:"engine_tc_wear,omitempty"`
+	EngineBlown          uint32  `protobuf:"varint,20,opt,name=engine_blown,json=engineBlown,proto3" json:"engine_blown,omitempty"`
+	EngineSeized         uint32  `protobuf:"varint,21,opt,name=engine_seized,json=engineSeized,proto3" json:"engine_seized,omitempty"`
+}
+
+func (x *CarDamageData) Reset() {
+	*x = CarDamageData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CarDamageData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CarDamageData) ProtoMessage() {}
+
+func (x *CarDamageData) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CarDamageData.ProtoReflect.Descriptor instead.
+func (*CarDamageData) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CarDamageData) GetTyresWear() *Wheels {
+	if x != nil {
+		return x.TyresWear
+	}
+	return nil
+}
+
+func (x *CarDamageData) GetTyresDamage() *Wheels {
+	if x != nil {
+		return x.TyresDamage
+	}
+	return nil
+}
+
+func (x *CarDamageData) GetBrakesDamage() *Wheels {
+	if x != nil {
+		return x.BrakesDamage
+	}
+	return nil
+}
+
+func (x *CarDamageData) GetFrontLeftWingDamage() uint32 {
+	if x != nil {
+		return x.FrontLeftWingDamage
+	}
+	return 0
+}
+
+func (x *CarDamageData) GetFrontRightWingDamage() uint32 {
+	if x != nil {
+		return x.FrontRightWingDamage
+	}
+	return 0
+}
+
+func (x *CarDamageData) GetRearWingDamage() uint32 {
+	if x != nil {
+		return x.RearWingDamage
+	}
+	return 0
+}
+
+func (x *CarDamageData) GetFloorDamage() uint32 {
+	if x != nil {
+		return x.FloorDamage
+	}
+	return 0
+}
+
+func (x *CarDamageData) GetDiffuserDamage() uint32 {
+	if x != nil {
+		return x.DiffuserDamage
+	}
+	return 0
+}
+
+func (x *CarDamageData) GetSidepodDamage() uint32 {
+	if x != nil {
+		return x.SidepodDamage
+	}
+	return 0
+}
+
+func (x *CarDamageData) GetDrsFault() uint32 {
+	if x != nil {
+		return x.DrsFault
+	}
+	return 0
+}
+
+func (x *CarDamageData) GetErsFault() uint32 {
+	if x != nil {
+		return x.ErsFault
+	}
+	return 0
+}
+
+func (x *CarDamageData) GetGearBoxDamage() uint32 {
+	if x != nil {
+		return x.GearBoxDamage
+	}
+	return 0
+}
+
+func (x *CarDamageData) GetEngineDamage() uint32 {
+	if x != nil {
+		return x.EngineDamage
+	}
+	return 0
+}
+
+func (x *CarDamageData) GetEngineMguhWear() uint32 {
+	if x != nil {
+		return x.EngineMguhWear
+	}
+	return 0
+}
+
+func (x *CarDamageData) GetEngineEsWear() uint32 {
+	if x != nil {
+		return x.EngineEsWear
+	}
+	return 0
+}
+
+func (x *CarDamageData) GetEngineCeWear() uint32 {
+	if x != nil {
+		return x.EngineCeWear
+	}
+	return 0
+}
+
+func (x *CarDamageData) GetEngineIceWear() uint32 {
+	if x != nil {
+		return x.EngineIceWear
+	}
+	return 0
+}
+
+func (x *CarDamageData) GetEngineMgukWear() uint32 {
+	if x != nil {
+		return x.EngineMgukWear
+	}
+	return 0
+}
+
+func (x *CarDamageData) GetEngineTcWear() uint32 {
+	if x != nil {
+		return x.EngineTcWear
+	}
+	return 0
+}
+
+func (x *CarDamageData) GetEngineBlown() uint32 {
+	if x != nil {
+		return x.EngineBlown
+	}
+	return 0
+}
+
+func (x *CarDamageData) GetEngineSeized() uint32 {
+	if x != nil {
+		return x.EngineSeized
+	}
+	return 0
+}
+
+type PacketSessionHistoryData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header                *PacketHeader           `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	CarIdx                uint32                  `protobuf:"varint,2,opt,name=car_idx,json=carIdx,proto3" json:"car_idx,omitempty"`
+	NumLaps               uint32                  `protobuf:"varint,3,opt,name=num_laps,json=numLaps,proto3" json:"num_laps,omitempty"`
+	NumTyreStints         uint32                  `protobuf:"varint,4,opt,name=num_tyre_stints,json=numTyreStints,proto3" json:"num_tyre_stints,omitempty"`
+	BestLapTimeLapNum     uint32                  `protobuf:"varint,5,opt,name=best_lap_time_lap_num,json=bestLapTimeLapNum,proto3" json:"best_lap_time_lap_num,omitempty"`
+	BestSector1LapNum     uint32                  `protobuf:"varint,6,opt,name=best_sector1_lap_num,json=bestSector1LapNum,proto3" json:"best_sector1_lap_num,omitempty"`
+	BestSector2LapNum     uint32                  `protobuf:"varint,7,opt,name=best_sector2_lap_num,json=bestSector2LapNum,proto3" json:"best_sector2_lap_num,omitempty"`
+	BestSector3LapNum     uint32                  `protobuf:"varint,8,opt,name=best_sector3_lap_num,json=bestSector3LapNum,proto3" json:"best_sector3_lap_num,omitempty"`
+	LapHistoryData        []*LapHistoryData       `protobuf:"bytes,9,rep,name=lap_history_data,json=lapHistoryData,proto3" json:"lap_history_data,omitempty"`                         // only the first num_laps laps
+	TyreStintsHistoryData []*TyreStintHistoryData `protobuf:"bytes,10,rep,name=tyre_stints_history_data,json=tyreStintsHistoryData,proto3" json:"tyre_stints_history_data,omitempty"` // only the first num_tyre_stints stints
+}
+
+func (x *PacketSessionHistoryData) Reset() {
+	*x = PacketSessionHistoryData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PacketSessionHistoryData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PacketSessionHistoryData) ProtoMessage() {}
+
+func (x *PacketSessionHistoryData) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PacketSessionHistoryData.ProtoReflect.Descriptor instead.
+func (*PacketSessionHistoryData) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *PacketSessionHistoryData) GetHeader() *PacketHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *PacketSessionHistoryData) GetCarIdx() uint32 {
+	if x != nil {
+		return x.CarIdx
+	}
+	return 0
+}
+
+func (x *PacketSessionHistoryData) GetNumLaps() uint32 {
+	if x != nil {
+		return x.NumLaps
+	}
+	return 0
+}
+
+func (x *PacketSessionHistoryData) GetNumTyreStints() uint32 {
+	if x != nil {
+		return x.NumTyreStints
+	}
+	return 0
+}
+
+func (x *PacketSessionHistoryData) GetBestLapTimeLapNum() uint32 {
+	if x != nil {
+		return x.BestLapTimeLapNum
+	}
+	return 0
+}
+
+func (x *PacketSessionHistoryData) GetBestSector1LapNum() uint32 {
+	if x != nil {
+		return x.BestSector1LapNum
+	}
+	return 0
+}
+
+func (x *PacketSessionHistoryData) GetBestSector2LapNum() uint32 {
+	if x != nil {
+		return x.BestSector2LapNum
+	}
+	return 0
+}
+
+func (x *PacketSessionHistoryData) GetBestSector3LapNum() uint32 {
+	if x != nil {
+		return x.BestSector3LapNum
+	}
+	return 0
+}
+
+func (x *PacketSessionHistoryData) GetLapHistoryData() []*LapHistoryData {
+	if x != nil {
+		return x.LapHistoryData
+	}
+	return nil
+}
+
+func (x *PacketSessionHistoryData) GetTyreStintsHistoryData() []*TyreStintHistoryData {
+	if x != nil {
+		return x.TyreStintsHistoryData
+	}
+	return nil
+}
+
+type LapHistoryData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LapTimeInMs        uint32 `protobuf:"varint,1,opt,name=lap_time_in_ms,json=lapTimeInMs,proto3" json:"lap_time_in_ms,omitempty"`
+	Sector1TimeInMs    uint32 `protobuf:"varint,2,opt,name=sector1_time_in_ms,json=sector1TimeInMs,proto3" json:"sector1_time_in_ms,omitempty"`
+	Sector1TimeMinutes uint32 `protobuf:"varint,3,opt,name=sector1_time_minutes,json=sector1TimeMinutes,proto3" json:"sector1_time_minutes,omitempty"`
+	Sector2TimeInMs    uint32 `protobuf:"varint,4,opt,name=sector2_time_in_ms,json=sector2TimeInMs,proto3" json:"sector2_time_in_ms,omitempty"`
+	Sector2TimeMinutes uint32 `protobuf:"varint,5,opt,name=sector2_time_minutes,json=sector2TimeMinutes,proto3" json:"sector2_time_minutes,omitempty"`
+	Sector3TimeInMs    uint32 `protobuf:"varint,6,opt,name=sector3_time_in_ms,json=sector3TimeInMs,proto3" json:"sector3_time_in_ms,omitempty"`
+	Sector3TimeMinutes uint32 `protobuf:"varint,7,opt,name=sector3_time_minutes,json=sector3TimeMinutes,proto3" json:"sector3_time_minutes,omitempty"`
+	LapValidBitFlags   uint32 `protobuf:"varint,8,opt,name=lap_valid_bit_flags,json=lapValidBitFlags,proto3" json:"lap_valid_bit_flags,omitempty"`
+}
+
+func (x *LapHistoryData) Reset() {
+	*x = LapHistoryData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LapHistoryData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LapHistoryData) ProtoMessage() {}
+
+func (x *LapHistoryData) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LapHistoryData.ProtoReflect.Descriptor instead.
+func (*LapHistoryData) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *LapHistoryData) GetLapTimeInMs() uint32 {
+	if x != nil {
+		return x.LapTimeInMs
+	}
+	return 0
+}
+
+func (x *LapHistoryData) GetSector1TimeInMs() uint32 {
+	if x != nil {
+		return x.Sector1TimeInMs
+	}
+	return 0
+}
+
+func (x *LapHistoryData) GetSector1TimeMinutes() uint32 {
+	if x != nil {
+		return x.Sector1TimeMinutes
+	}
+	return 0
+}
+
+func (x *LapHistoryData) GetSector2TimeInMs() uint32 {
+	if x != nil {
+		return x.Sector2TimeInMs
+	}
+	return 0
+}
+
+func (x *LapHistoryData) GetSector2TimeMinutes() uint32 {
+	if x != nil {
+		return x.Sector2TimeMinutes
+	}
+	return 0
+}
+
+func (x *LapHistoryData) GetSector3TimeInMs() uint32 {
+	if x != nil {
+		return x.Sector3TimeInMs
+	}
+	return 0
+}
+
+func (x *LapHistoryData) GetSector3TimeMinutes() uint32 {
+	if x != nil {
+		return x.Sector3TimeMinutes
+	}
+	return 0
+}
+
+func (x *LapHistoryData) GetLapValidBitFlags() uint32 {
+	if x != nil {
+		return x.LapValidBitFlags
+	}
+	return 0
+}
+
+type TyreStintHistoryData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EndLap             uint32 `protobuf:"varint,1,opt,name=end_lap,json=endLap,proto3" json:"end_lap,omitempty"` // 255 for the current tyre
+	TyreActualCompound uint32 `protobuf:"varint,2,opt,name=tyre_actual_compound,json=tyreActualCompound,proto3" json:"tyre_actual_compound,omitempty"`
+	TyreVisualCompound uint32 `protobuf:"varint,3,opt,name=tyre_visual_compound,json=tyreVisualCompound,proto3" json:"tyre_visual_compound,omitempty"`
+}
+
+func (x *TyreStintHistoryData) Reset() {
+	*x = TyreStintHistoryData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TyreStintHistoryData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TyreStintHistoryData) ProtoMessage() {}
+
+func (x *TyreStintHistoryData) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TyreStintHistoryData.ProtoReflect.Descriptor instead.
+func (*TyreStintHistoryData) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *TyreStintHistoryData) GetEndLap() uint32 {
+	if x != nil {
+		return x.EndLap
+	}
+	return 0
+}
+
+func (x *TyreStintHistoryData) GetTyreActualCompound() uint32 {
+	if x != nil {
+		return x.TyreActualCompound
+	}
+	return 0
+}
+
+func (x *TyreStintHistoryData) GetTyreVisualCompound() uint32 {
+	if x != nil {
+		return x.TyreVisualCompound
+	}
+	return 0
+}
+
+type PacketTyreSetsData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header      *PacketHeader  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	CarIdx      uint32         `protobuf:"varint,2,opt,name=car_idx,json=carIdx,proto3" json:"car_idx,omitempty"`
+	TyreSetData []*TyreSetData `protobuf:"bytes,3,rep,name=tyre_set_data,json=tyreSetData,proto3" json:"tyre_set_data,omitempty"` // 13 dry and 7 wet sets
+	FittedIdx   uint32         `protobuf:"varint,4,opt,name=fitted_idx,json=fittedIdx,proto3" json:"fitted_idx,omitempty"`
+}
+
+func (x *PacketTyreSetsData) Reset() {
+	*x = PacketTyreSetsData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PacketTyreSetsData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PacketTyreSetsData) ProtoMessage() {}
+
+func (x *PacketTyreSetsData) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PacketTyreSetsData.ProtoReflect.Descriptor instead.
+func (*PacketTyreSetsData) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *PacketTyreSetsData) GetHeader() *PacketHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *PacketTyreSetsData) GetCarIdx() uint32 {
+	if x != nil {
+		return x.CarIdx
+	}
+	return 0
+}
+
+func (x *PacketTyreSetsData) GetTyreSetData() []*TyreSetData {
+	if x != nil {
+		return x.TyreSetData
+	}
+	return nil
+}
+
+func (x *PacketTyreSetsData) GetFittedIdx() uint32 {
+	if x != nil {
+		return x.FittedIdx
+	}
+	return 0
+}
+
+type TyreSetData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActualTyreCompound uint32 `protobuf:"varint,1,opt,name=actual_tyre_compound,json=actualTyreCompound,proto3" json:"actual_tyre_compound,omitempty"`
+	VisualTyreCompound uint32 `protobuf:"varint,2,opt,name=visual_tyre_compound,json=visualTyreCompound,proto3" json:"visual_tyre_compound,omitempty"`
+	Wear               uint32 `protobuf:"varint,3,opt,name=wear,proto3" json:"wear,omitempty"`
+	Available          uint32 `protobuf:"varint,4,opt,name=available,proto3" json:"available,omitempty"`
+	RecommendedSession uint32 `protobuf:"varint,5,opt,name=recommended_session,json=recommendedSession,proto3" json:"recommended_session,omitempty"`
+	LifeSpan           uint32 `protobuf:"varint,6,opt,name=life_span,json=lifeSpan,proto3" json:"life_span,omitempty"`
+	UsableLife         uint32 `protobuf:"varint,7,opt,name=usable_life,json=usableLife,proto3" json:"usable_life,omitempty"`
+	LapDeltaTime       int32  `protobuf:"varint,8,opt,name=lap_delta_time,json=lapDeltaTime,proto3" json:"lap_delta_time,omitempty"` // milliseconds compared to the fitted set
+	Fitted             uint32 `protobuf:"varint,9,opt,name=fitted,proto3" json:"fitted,omitempty"`
+}
+
+func (x *TyreSetData) Reset() {
+	*x = TyreSetData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TyreSetData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TyreSetData) ProtoMessage() {}
+
+func (x *TyreSetData) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TyreSetData.ProtoReflect.Descriptor instead.
+func (*TyreSetData) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *TyreSetData) GetActualTyreCompound() uint32 {
+	if x != nil {
+		return x.ActualTyreCompound
+	}
+	return 0
+}
+
+func (x *TyreSetData) GetVisualTyreCompound() uint32 {
+	if x != nil {
+		return x.VisualTyreCompound
+	}
+	return 0
+}
+
+func (x *TyreSetData) GetWear() uint32 {
+	if x != nil {
+		return x.Wear
+	}
+	return 0
+}
+
+func (x *TyreSetData) GetAvailable() uint32 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+func (x *TyreSetData) GetRecommendedSession() uint32 {
+	if x != nil {
+		return x.RecommendedSession
+	}
+	return 0
+}
+
+func (x *TyreSetData) GetLifeSpan() uint32 {
+	if x != nil {
+		return x.LifeSpan
+	}
+	return 0
+}
+
+func (x *TyreSetData) GetUsableLife() uint32 {
+	if x != nil {
+		return x.UsableLife
+	}
+	return 0
+}
+
+func (x *TyreSetData) GetLapDeltaTime() int32 {
+	if x != nil {
+		return x.LapDeltaTime
+	}
+	return 0
+}
+
+func (x *TyreSetData) GetFitted() uint32 {
+	if x != nil {
+		return x.Fitted
+	}
+	return 0
+}
+
+type PacketMotionExData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header                 *PacketHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	SuspensionPosition     *Wheels       `protobuf:"bytes,2,opt,name=suspension_position,json=suspensionPosition,proto3" json:"suspension_position,omitempty"`
+	SuspensionVelocity     *Wheels       `protobuf:"bytes,3,opt,name=suspension_velocity,json=suspensionVelocity,proto3" json:"suspension_velocity,omitempty"`
+	SuspensionAcceleration *Wheels       `protobuf:"bytes,4,opt,name=suspension_acceleration,json=suspensionAcceleration,proto3" json:"suspension_acceleration,omitempty"`
+	WheelSpeed             *Wheels       `protobuf:"bytes,5,opt,name=wheel_speed,json=wheelSpeed,proto3" json:"wheel_speed,omitempty"`
+	WheelSlipRatio         *Wheels       `protobuf:"bytes,6,opt,name=wheel_slip_ratio,json=wheelSlipRatio,proto3" json:"wheel_slip_ratio,omitempty"`
+	WheelSlipAngle         *Wheels       `protobuf:"bytes,7,opt,name=wheel_slip_angle,json=wheelSlipAngle,proto3" json:"wheel_slip_angle,omitempty"`
+	WheelLatForce          *Wheels       `protobuf:"bytes,8,opt,name=wheel_lat_force,json=wheelLatForce,proto3" json:"wheel_lat_force,omitempty"`
+	WheelLongForce         *Wheels       `protobuf:"bytes,9,opt,name=wheel_long_force,json=wheelLongForce,proto3" json:"wheel_long_force,omitempty"`
+	HeightOfCogAboveGround float32       `protobuf:"fixed32,10,opt,name=height_of_cog_above_ground,json=heightOfCogAboveGround,proto3" json:"height_of_cog_above_ground,omitempty"`
+	LocalVelocityX         float32       `protobuf:"fixed32,11,opt,name=local_velocity_x,json=localVelocityX,proto3" json:"local_velocity_x,omitempty"`
+	LocalVelocityY         float32       `protobuf:"fixed32,12,opt,name=local_velocity_y,json=localVelocityY,proto3" json:"local_velocity_y,omitempty"`
+	LocalVelocityZ         float32       `protobuf:"fixed32,13,opt,name=local_velocity_z,json=localVelocityZ,proto3" json:"local_velocity_z,omitempty"`
+	AngularVelocityX       float32       `protobuf:"fixed32,14,opt,name=angular_velocity_x,json=angularVelocityX,proto3" json:"angular_velocity_x,omitempty"`
+	AngularVelocityY       float32       `protobuf:"fixed32,15,opt,name=angular_velocity_y,json=angularVelocityY,proto3" json:"angular_velocity_y,omitempty"`
+	AngularVelocityZ       float32       `protobuf:"fixed32,16,opt,name=angular_velocity_z,json=angularVelocityZ,proto3" json:"angular_velocity_z,omitempty"`
+	AngularAccelerationX   float32       `protobuf:"fixed32,17,opt,name=angular_acceleration_x,json=angularAccelerationX,proto3" json:"angular_acceleration_x,omitempty"`
+	AngularAccelerationY   float32       `protobuf:"fixed32,18,opt,name=angular_acceleration_y,json=angularAccelerationY,proto3" json:"angular_acceleration_y,omitempty"`
+	AngularAccelerationZ   float32       `protobuf:"fixed32,19,opt,name=angular_acceleration_z,json=angularAccelerationZ,proto3" json:"angular_acceleration_z,omitempty"`
+	FrontWheelsAngle       float32       `protobuf:"fixed32,20,opt,name=front_wheels_angle,json=frontWheelsAngle,proto3" json:"front_wheels_angle,omitempty"`
+	WheelVertForce         *Wheels       `protobuf:"bytes,21,opt,name=wheel_vert_force,json=wheelVertForce,proto3" json:"wheel_vert_force,omitempty"`
+}
+
+func (x *PacketMotionExData) Reset() {
+	*x = PacketMotionExData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_telemetry_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PacketMotionExData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PacketMotionExData) ProtoMessage() {}
+
+func (x *PacketMotionExData) ProtoReflect() protoreflect.Message {
+	mi := &file_telemetry_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PacketMotionExData.ProtoReflect.Descriptor instead.
+func (*PacketMotionExData) Descriptor() ([]byte, []int) {
+	return file_telemetry_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *PacketMotionExData) GetHeader() *PacketHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *PacketMotionExData) GetSuspensionPosition() *Wheels {
+	if x != nil {
+		return x.SuspensionPosition
+	}
+	return nil
+}
+
+func (x *PacketMotionExData) GetSuspensionVelocity() *Wheels {
+	if x != nil {
+		return x.SuspensionVelocity
+	}
+	return nil
+}
+
+func (x *PacketMotionExData) GetSuspensionAcceleration() *Wheels {
+	if x != nil {
+		return x.SuspensionAcceleration
+	}
+	return nil
+}
+
+func (x *PacketMotionExData) GetWheelSpeed() *Wheels {
+	if x != nil {
+		return x.WheelSpeed
+	}
+	return nil
+}
+
+func (x *PacketMotionExData) GetWheelSlipRatio() *Wheels {
+	if x != nil {
+		return x.WheelSlipRatio
+	}
+	return nil
+}
+
+func (x *PacketMotionExData) GetWheelSlipAngle() *Wheels {
+	if x != nil {
+		return x.WheelSlipAngle
+	}
+	return nil
+}
+
+func (x *PacketMotionExData) GetWheelLatForce() *Wheels {
+	if x != nil {
+		return x.WheelLatForce
+	}
+	return nil
+}
+
+func (x *PacketMotionExData) GetWheelLongForce() *Wheels {
+	if x != nil {
+		return x.WheelLongForce
+	}
+	return nil
+}
+
+func (x *PacketMotionExData) GetHeightOfCogAboveGround() float32 {
+	if x != nil {
+		return x.HeightOfCogAboveGround
+	}
+	return 0
+}
+
+func (x *PacketMotionExData) GetLocalVelocityX() float32 {
+	if x != nil {
+		return x.LocalVelocityX
+	}
+	return 0
+}
+
+func (x *PacketMotionExData) GetLocalVelocityY() float32 {
+	if x != nil {
+		return x.LocalVelocityY
+	}
+	return 0
+}
+
+func (x *PacketMotionExData) GetLocalVelocityZ() float32 {
+	if x != nil {
+		return x.LocalVelocityZ
+	}
+	return 0
+}
+
+func (x *PacketMotionExData) GetAngularVelocityX() float32 {
+	if x != nil {
+		return x.AngularVelocityX
+	}
+	return 0
+}
+
+func (x *PacketMotionExData) GetAngularVelocityY() float32 {
+	if x != nil {
+		return x.AngularVelocityY
+	}
+	return 0
+}
+
+func (x *PacketMotionExData) GetAngularVelocityZ() float32 {
+	if x != nil {
+		return x.AngularVelocityZ
+	}
+	return 0
+}
+
+func (x *PacketMotionExData) GetAngularAccelerationX() float32 {
+	if x != nil {
+		return x.AngularAccelerationX
+	}
+	return 0
+}
+
+func (x *PacketMotionExData) GetAngularAccelerationY() float32 {
+	if x != nil {
+		return x.AngularAccelerationY
+	}
+	return 0
+}
+
+func (x *PacketMotionExData) GetAngularAccelerationZ() float32 {
+	if x != nil {
+		return x.AngularAccelerationZ
+	}
+	return 0
+}
+
+func (x *PacketMotionExData) GetFrontWheelsAngle() float32 {
+	if x != nil {
+		return x.FrontWheelsAngle
+	}
+	return 0
+}
+
+func (x *PacketMotionExData) GetWheelVertForce() *Wheels {
+	if x != nil {
+		return x.WheelVertForce
+	}
+	return nil
+}
+
+var File_telemetry_proto protoreflect.FileDescriptor
+
+var file_telemetry_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x0c, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x22,
+	0x7f, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x3c, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x74, 0x65,
+	0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x61, 0x74,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x52, 0x61, 0x74, 0x65,
+	0x22, 0xb1, 0x08, 0x0a, 0x0f, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x38, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x74, 0x65, 0x6c,
+	0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x12, 0x38, 0x0a, 0x06, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61,
+	0x74, 0x61, 0x48, 0x00, 0x52, 0x06, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x07,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00,
+	0x52, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x08, 0x6c, 0x61, 0x70,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x65,
+	0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x4c, 0x61, 0x70, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x07, 0x6c, 0x61, 0x70, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x35, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x48, 0x00, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x4a, 0x0a, 0x0c, 0x70, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x73, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x42, 0x0a, 0x0a, 0x63, 0x61, 0x72, 0x5f, 0x73, 0x65,
+	0x74, 0x75, 0x70, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x74, 0x65, 0x6c,
+	0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x43, 0x61, 0x72, 0x53, 0x65, 0x74, 0x75, 0x70, 0x73, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52,
+	0x09, 0x63, 0x61, 0x72, 0x53, 0x65, 0x74, 0x75, 0x70, 0x73, 0x12, 0x4b, 0x0a, 0x0d, 0x63, 0x61,
+	0x72, 0x5f, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x61, 0x72, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65,
+	0x74, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x61, 0x72, 0x54, 0x65,
+	0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x12, 0x42, 0x0a, 0x0a, 0x63, 0x61, 0x72, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x74, 0x65,
+	0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x43, 0x61, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00,
+	0x52, 0x09, 0x63, 0x61, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x60, 0x0a, 0x14, 0x66,
+	0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x74, 0x65, 0x6c, 0x65,
+	0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x46,
+	0x69, 0x6e, 0x61, 0x6c, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x13, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x43,
+	0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a,
+	0x0a, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x66, 0x6f,
+	0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x09, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x42, 0x0a, 0x0a, 0x63, 0x61, 0x72, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x61, 0x72, 0x44, 0x61,
+	0x6d, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x09, 0x63, 0x61, 0x72, 0x44,
+	0x61, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x51, 0x0a, 0x0f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x3f, 0x0a, 0x09, 0x74, 0x79, 0x72, 0x65,
+	0x5f, 0x73, 0x65, 0x74, 0x73, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x65,
+	0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x54, 0x79, 0x72, 0x65, 0x53, 0x65, 0x74, 0x73, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52,
+	0x08, 0x74, 0x79, 0x72, 0x65, 0x53, 0x65, 0x74, 0x73, 0x12, 0x3f, 0x0a, 0x09, 0x6d, 0x6f, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74,
+	0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x4d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00,
+	0x52, 0x08, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x42, 0x08, 0x0a, 0x06, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x22, 0x80, 0x04, 0x0a, 0x0c, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61,
+	0x6d, 0x65, 0x5f, 0x79, 0x65, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x67,
+	0x61, 0x6d, 0x65, 0x59, 0x65, 0x61, 0x72, 0x12, 0x2c, 0x0a, 0x12, 0x67, 0x61, 0x6d, 0x65, 0x5f,
+	0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x10, 0x67, 0x61, 0x6d, 0x65, 0x4d, 0x61, 0x6a, 0x6f, 0x72, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x69,
+	0x6e, 0x6f, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x10, 0x67, 0x61, 0x6d, 0x65, 0x4d, 0x69, 0x6e, 0x6f, 0x72, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x66,
+	0x72, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x18, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c,
+	0x6c, 0x5f, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c,
+	0x6c, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x12, 0x28, 0x0a, 0x10, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x63, 0x61, 0x72, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x43, 0x61, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x3b, 0x0a, 0x1a, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x63,
+	0x61, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x17,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43,
+	0x61, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x84, 0x01, 0x0a, 0x06, 0x57, 0x68, 0x65, 0x65,
+	0x6c, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x72, 0x65, 0x61, 0x72, 0x4c, 0x65, 0x66, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x61, 0x72, 0x5f, 0x72, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x09, 0x72, 0x65, 0x61, 0x72, 0x52, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x4c, 0x65, 0x66, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x72, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x52, 0x69, 0x67, 0x68, 0x74, 0x22, 0x8b,
+	0x01, 0x0a, 0x10, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x32, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x43, 0x0a, 0x0f, 0x63, 0x61, 0x72, 0x5f, 0x6d,
+	0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x61, 0x72, 0x4d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0d, 0x63,
+	0x61, 0x72, 0x4d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x22, 0xd9, 0x05, 0x0a,
+	0x0d, 0x43, 0x61, 0x72, 0x4d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x28,
+	0x0a, 0x10, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x58, 0x12, 0x28, 0x0a, 0x10, 0x77, 0x6f, 0x72, 0x6c,
+	0x64, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x0e, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x59, 0x12, 0x28, 0x0a, 0x10, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x7a, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x77, 0x6f,
+	0x72, 0x6c, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5a, 0x12, 0x28, 0x0a, 0x10,
+	0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x78,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x56, 0x65, 0x6c,
+	0x6f, 0x63, 0x69, 0x74, 0x79, 0x58, 0x12, 0x28, 0x0a, 0x10, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f,
+	0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x0e, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x56, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x59,
+	0x12, 0x28, 0x0a, 0x10, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69,
+	0x74, 0x79, 0x5f, 0x7a, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x77, 0x6f, 0x72, 0x6c,
+	0x64, 0x56, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x5a, 0x12, 0x2d, 0x0a, 0x13, 0x77, 0x6f,
+	0x72, 0x6c, 0x64, 0x5f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x64, 0x69, 0x72, 0x5f,
+	0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x46, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x44, 0x69, 0x72, 0x58, 0x12, 0x2d, 0x0a, 0x13, 0x77, 0x6f, 0x72,
+	0x6c, 0x64, 0x5f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x64, 0x69, 0x72, 0x5f, 0x79,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x46, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x44, 0x69, 0x72, 0x59, 0x12, 0x2d, 0x0a, 0x13, 0x77, 0x6f, 0x72, 0x6c,
+	0x64, 0x5f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x64, 0x69, 0x72, 0x5f, 0x7a, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x46, 0x6f, 0x72, 0x77,
+	0x61, 0x72, 0x64, 0x44, 0x69, 0x72, 0x5a, 0x12, 0x29, 0x0a, 0x11, 0x77, 0x6f, 0x72, 0x6c, 0x64,
+	0x5f, 0x72, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x64, 0x69, 0x72, 0x5f, 0x78, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0e, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x52, 0x69, 0x67, 0x68, 0x74, 0x44, 0x69,
+	0x72, 0x58, 0x12, 0x29, 0x0a, 0x11, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x72, 0x69, 0x67, 0x68,
+	0x74, 0x5f, 0x64, 0x69, 0x72, 0x5f, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x77,
+	0x6f, 0x72, 0x6c, 0x64, 0x52, 0x69, 0x67, 0x68, 0x74, 0x44, 0x69, 0x72, 0x59, 0x12, 0x29, 0x0a,
+	0x11, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x72, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x64, 0x69, 0x72,
+	0x5f, 0x7a, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x52,
+	0x69, 0x67, 0x68, 0x74, 0x44, 0x69, 0x72, 0x5a, 0x12, 0x26, 0x0a, 0x0f, 0x67, 0x5f, 0x66, 0x6f,
+	0x72, 0x63, 0x65, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x0d, 0x67, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x4c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c,
+	0x12, 0x30, 0x0a, 0x14, 0x67, 0x5f, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x6c, 0x6f, 0x6e, 0x67,
+	0x69, 0x74, 0x75, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x02, 0x52, 0x12,
+	0x67, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x4c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x69, 0x6e,
+	0x61, 0x6c, 0x12, 0x28, 0x0a, 0x10, 0x67, 0x5f, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x76, 0x65,
+	0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x67, 0x46,
+	0x6f, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x12, 0x10, 0x0a, 0x03,
+	0x79, 0x61, 0x77, 0x18, 0x10, 0x20, 0x01, 0x28, 0x02, 0x52, 0x03, 0x79, 0x61, 0x77, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x69, 0x74, 0x63, 0x68, 0x18, 0x11, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x70,
+	0x69, 0x74, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x6c, 0x18, 0x12, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x6c, 0x22, 0x99, 0x12, 0x0a, 0x11, 0x50, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x32,
+	0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x11,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x54, 0x65,
+	0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x69, 0x72,
+	0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0e, 0x61, 0x69, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6c, 0x61, 0x70, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x61, 0x70,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x63, 0x6b,
+	0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x6b,
+	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x12, 0x2a, 0x0a, 0x11,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x65, 0x66,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x54, 0x69, 0x6d, 0x65, 0x4c, 0x65, 0x66, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x69, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64,
+	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x70, 0x69,
+	0x74, 0x53, 0x70, 0x65, 0x65, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x67,
+	0x61, 0x6d, 0x65, 0x5f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x67, 0x61, 0x6d, 0x65, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d,
+	0x69, 0x73, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0c, 0x69, 0x73, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63,
+	0x61, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11,
+	0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x72, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x33, 0x0a, 0x16, 0x73, 0x6c, 0x69, 0x5f, 0x70, 0x72, 0x6f, 0x5f, 0x6e, 0x61, 0x74,
+	0x69, 0x76, 0x65, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x13, 0x73, 0x6c, 0x69, 0x50, 0x72, 0x6f, 0x4e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x53,
+	0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x75, 0x6d, 0x5f, 0x6d, 0x61,
+	0x72, 0x73, 0x68, 0x61, 0x6c, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0f, 0x6e, 0x75, 0x6d, 0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x5a, 0x6f, 0x6e,
+	0x65, 0x73, 0x12, 0x3e, 0x0a, 0x0d, 0x6d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x5f, 0x7a, 0x6f,
+	0x6e, 0x65, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x65, 0x6c, 0x65,
+	0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c,
+	0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x0c, 0x6d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x5a, 0x6f, 0x6e,
+	0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x5f, 0x63, 0x61, 0x72,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x73,
+	0x61, 0x66, 0x65, 0x74, 0x79, 0x43, 0x61, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x47, 0x61, 0x6d,
+	0x65, 0x12, 0x3f, 0x0a, 0x1c, 0x6e, 0x75, 0x6d, 0x5f, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72,
+	0x5f, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x73, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x19, 0x6e, 0x75, 0x6d, 0x57, 0x65, 0x61, 0x74,
+	0x68, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x73, 0x12, 0x5d, 0x0a, 0x18, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x66, 0x6f,
+	0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x16,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x65, 0x63,
+	0x61, 0x73, 0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x16, 0x77, 0x65, 0x61, 0x74, 0x68,
+	0x65, 0x72, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x73, 0x12, 0x2b, 0x0a, 0x11, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x63,
+	0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x66, 0x6f,
+	0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x41, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x12, 0x23,
+	0x0a, 0x0d, 0x61, 0x69, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x18,
+	0x18, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x61, 0x69, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75,
+	0x6c, 0x74, 0x79, 0x12, 0x34, 0x0a, 0x16, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x6c, 0x69,
+	0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x19, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x14, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x17, 0x77, 0x65, 0x65,
+	0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x77, 0x65, 0x65, 0x6b,
+	0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x12, 0x36, 0x0a, 0x17, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x6e,
+	0x6b, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x1b, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x15, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x19, 0x70, 0x69, 0x74,
+	0x5f, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x69, 0x64, 0x65,
+	0x61, 0x6c, 0x5f, 0x6c, 0x61, 0x70, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x70, 0x69,
+	0x74, 0x53, 0x74, 0x6f, 0x70, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x49, 0x64, 0x65, 0x61, 0x6c,
+	0x4c, 0x61, 0x70, 0x12, 0x3a, 0x0a, 0x1a, 0x70, 0x69, 0x74, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x5f,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x6c, 0x61,
+	0x70, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x70, 0x69, 0x74, 0x53, 0x74, 0x6f, 0x70,
+	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x4c, 0x61, 0x70, 0x12,
+	0x37, 0x0a, 0x18, 0x70, 0x69, 0x74, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x72, 0x65, 0x6a, 0x6f,
+	0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x1e, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x15, 0x70, 0x69, 0x74, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x6a, 0x6f, 0x69, 0x6e,
+	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x74, 0x65, 0x65,
+	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x18, 0x1f, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0e, 0x73, 0x74, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x41, 0x73, 0x73, 0x69, 0x73,
+	0x74, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x72, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x73, 0x73,
+	0x69, 0x73, 0x74, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x62, 0x72, 0x61, 0x6b, 0x69,
+	0x6e, 0x67, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x67, 0x65, 0x61, 0x72,
+	0x62, 0x6f, 0x78, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0d, 0x67, 0x65, 0x61, 0x72, 0x62, 0x6f, 0x78, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x69, 0x74, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x18, 0x22, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x09, 0x70, 0x69, 0x74, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x12, 0x2c,
+	0x0a, 0x12, 0x70, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x73,
+	0x73, 0x69, 0x73, 0x74, 0x18, 0x23, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x70, 0x69, 0x74, 0x52,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x65, 0x72, 0x73, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x18, 0x24, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x65, 0x72, 0x73, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64,
+	0x72, 0x73, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x18, 0x25, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x09, 0x64, 0x72, 0x73, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x79,
+	0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x72, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x69, 0x6e,
+	0x65, 0x18, 0x26, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63,
+	0x52, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x37, 0x0a, 0x18, 0x64, 0x79,
+	0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x72, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x69, 0x6e,
+	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x27, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x64, 0x79,
+	0x6e, 0x61, 0x6d, 0x69, 0x63, 0x52, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65,
+	0x18, 0x28, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x67, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x29, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x6f, 0x66, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x44, 0x61, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x2b, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x12, 0x35, 0x0a, 0x17, 0x73, 0x70, 0x65, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x69, 0x74,
+	0x73, 0x5f, 0x6c, 0x65, 0x61, 0x64, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x2c, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x14, 0x73, 0x70, 0x65, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x4c,
+	0x65, 0x61, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x1d, 0x74, 0x65, 0x6d,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x5f, 0x6c,
+	0x65, 0x61, 0x64, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x1a, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x55, 0x6e, 0x69,
+	0x74, 0x73, 0x4c, 0x65, 0x61, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x1c,
+	0x73, 0x70, 0x65, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x61, 0x72, 0x79, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x2e, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x19, 0x73, 0x70, 0x65, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x4b, 0x0a,
+	0x22, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x75, 0x6e, 0x69,
+	0x74, 0x73, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x5f, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1f, 0x74, 0x65, 0x6d, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x61, 0x72, 0x79, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x16, 0x6e, 0x75,
+	0x6d, 0x5f, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x5f, 0x63, 0x61, 0x72, 0x5f, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x73, 0x18, 0x30, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x6e, 0x75, 0x6d, 0x53,
+	0x61, 0x66, 0x65, 0x74, 0x79, 0x43, 0x61, 0x72, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x12,
+	0x42, 0x0a, 0x1e, 0x6e, 0x75, 0x6d, 0x5f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x73,
+	0x61, 0x66, 0x65, 0x74, 0x79, 0x5f, 0x63, 0x61, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x73, 0x18, 0x31, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1a, 0x6e, 0x75, 0x6d, 0x56, 0x69, 0x72, 0x74,
+	0x75, 0x61, 0x6c, 0x53, 0x61, 0x66, 0x65, 0x74, 0x79, 0x43, 0x61, 0x72, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x6e, 0x75, 0x6d, 0x5f, 0x72, 0x65, 0x64, 0x5f, 0x66,
+	0x6c, 0x61, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x32, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x11, 0x6e, 0x75, 0x6d, 0x52, 0x65, 0x64, 0x46, 0x6c, 0x61, 0x67, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x73, 0x22, 0x49, 0x0a, 0x0b, 0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x5a,
+	0x6f, 0x6e, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x7a, 0x6f, 0x6e, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x7a, 0x6f, 0x6e, 0x65, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x7a, 0x6f, 0x6e, 0x65, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x7a, 0x6f, 0x6e, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x22,
+	0xed, 0x02, 0x0a, 0x15, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x65, 0x63,
+	0x61, 0x73, 0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x2b, 0x0a, 0x11, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x38, 0x0a,
+	0x18, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x16, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x69, 0x72, 0x5f, 0x74,
+	0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0e, 0x61, 0x69, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x12, 0x34, 0x0a, 0x16, 0x61, 0x69, 0x72, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x14, 0x61, 0x69, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0e, 0x72, 0x61, 0x69, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x22,
+	0xdf, 0x01, 0x0a, 0x0d, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4c, 0x61, 0x70, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x32, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x61, 0x70, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65,
+	0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x70, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07,
+	0x6c, 0x61, 0x70, 0x44, 0x61, 0x74, 0x61, 0x12, 0x30, 0x0a, 0x15, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x74, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x70, 0x62, 0x5f, 0x63, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x78,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x72, 0x69, 0x61,
+	0x6c, 0x50, 0x62, 0x43, 0x61, 0x72, 0x49, 0x64, 0x78, 0x12, 0x36, 0x0a, 0x18, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x74, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x5f, 0x63, 0x61,
+	0x72, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x74, 0x69, 0x6d,
+	0x65, 0x54, 0x72, 0x69, 0x61, 0x6c, 0x52, 0x69, 0x76, 0x61, 0x6c, 0x43, 0x61, 0x72, 0x49, 0x64,
+	0x78, 0x22, 0xaf, 0x0a, 0x0a, 0x07, 0x4c, 0x61, 0x70, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2c, 0x0a,
+	0x13, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6c, 0x61, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69,
+	0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74,
+	0x4c, 0x61, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x4d, 0x73, 0x12, 0x32, 0x0a, 0x16, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x61, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x69, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x4c, 0x61, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x4d, 0x73, 0x12,
+	0x2b, 0x0a, 0x12, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x31, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x69, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x73, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x31, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x4d, 0x73, 0x12, 0x30, 0x0a, 0x14,
+	0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x31, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x69, 0x6e,
+	0x75, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x73, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x31, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x2b,
+	0x0a, 0x12, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x32, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69,
+	0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x32, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x4d, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x73,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x32, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x75,
+	0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x73, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x32, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x3a, 0x0a,
+	0x1b, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f, 0x74, 0x6f, 0x5f, 0x63, 0x61, 0x72, 0x5f, 0x69, 0x6e,
+	0x5f, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x15, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x54, 0x6f, 0x43, 0x61, 0x72, 0x49, 0x6e,
+	0x46, 0x72, 0x6f, 0x6e, 0x74, 0x49, 0x6e, 0x4d, 0x73, 0x12, 0x39, 0x0a, 0x1a, 0x64, 0x65, 0x6c,
+	0x74, 0x61, 0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x6c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x64,
+	0x65, 0x6c, 0x74, 0x61, 0x54, 0x6f, 0x52, 0x61, 0x63, 0x65, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x49, 0x6e, 0x4d, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x70, 0x5f, 0x64, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x6c, 0x61, 0x70, 0x44,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x28,
+	0x0a, 0x10, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x5f, 0x63, 0x61, 0x72, 0x5f, 0x64, 0x65, 0x6c,
+	0x74, 0x61, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79,
+	0x43, 0x61, 0x72, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x72, 0x5f,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
+	0x63, 0x61, 0x72, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x61, 0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4c, 0x61, 0x70,
+	0x4e, 0x75, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x69, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x70, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x69, 0x74, 0x5f, 0x73, 0x74,
+	0x6f, 0x70, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x50, 0x69,
+	0x74, 0x53, 0x74, 0x6f, 0x70, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2e,
+	0x0a, 0x13, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x61, 0x70, 0x5f, 0x69, 0x6e,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x4c, 0x61, 0x70, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x69, 0x65, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x09, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x69, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x13,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x57, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x63, 0x6f, 0x72, 0x6e, 0x65, 0x72, 0x5f, 0x63, 0x75,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x63, 0x6f, 0x72, 0x6e, 0x65, 0x72, 0x43, 0x75, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x44, 0x0a, 0x1f, 0x6e,
+	0x75, 0x6d, 0x5f, 0x75, 0x6e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x64, 0x72, 0x69, 0x76,
+	0x65, 0x5f, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x5f, 0x70, 0x65, 0x6e, 0x73, 0x18, 0x15,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x1b, 0x6e, 0x75, 0x6d, 0x55, 0x6e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x64, 0x44, 0x72, 0x69, 0x76, 0x65, 0x54, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x50, 0x65, 0x6e,
+	0x73, 0x12, 0x38, 0x0a, 0x19, 0x6e, 0x75, 0x6d, 0x5f, 0x75, 0x6e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x64, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x67, 0x6f, 0x5f, 0x70, 0x65, 0x6e, 0x73, 0x18, 0x16,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x6e, 0x75, 0x6d, 0x55, 0x6e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x64, 0x53, 0x74, 0x6f, 0x70, 0x47, 0x6f, 0x50, 0x65, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x67,
+	0x72, 0x69, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x17, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0c, 0x67, 0x72, 0x69, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x23, 0x0a, 0x0d, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x31, 0x0a, 0x15, 0x70, 0x69,
+	0x74, 0x5f, 0x6c, 0x61, 0x6e, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x70, 0x69, 0x74, 0x4c, 0x61,
+	0x6e, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x3a, 0x0a,
+	0x1b, 0x70, 0x69, 0x74, 0x5f, 0x6c, 0x61, 0x6e, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69,
+	0x6e, 0x5f, 0x6c, 0x61, 0x6e, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x1b, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x15, 0x70, 0x69, 0x74, 0x4c, 0x61, 0x6e, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x49,
+	0x6e, 0x4c, 0x61, 0x6e, 0x65, 0x49, 0x6e, 0x4d, 0x73, 0x12, 0x2e, 0x0a, 0x14, 0x70, 0x69, 0x74,
+	0x5f, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x5f, 0x6d,
+	0x73, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x70, 0x69, 0x74, 0x53, 0x74, 0x6f, 0x70,
+	0x54, 0x69, 0x6d, 0x65, 0x72, 0x49, 0x6e, 0x4d, 0x73, 0x12, 0x38, 0x0a, 0x19, 0x70, 0x69, 0x74,
+	0x5f, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x5f, 0x70, 0x65, 0x6e, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x70, 0x69,
+	0x74, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x50, 0x65, 0x6e, 0x22, 0xf3, 0x07, 0x0a, 0x0f, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x32, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65,
+	0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x66, 0x61, 0x73, 0x74, 0x65,
+	0x73, 0x74, 0x5f, 0x6c, 0x61, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74,
+	0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x73, 0x74,
+	0x65, 0x73, 0x74, 0x4c, 0x61, 0x70, 0x48, 0x00, 0x52, 0x0a, 0x66, 0x61, 0x73, 0x74, 0x65, 0x73,
+	0x74, 0x4c, 0x61, 0x70, 0x12, 0x3c, 0x0a, 0x0a, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d,
+	0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x47, 0x0a, 0x11, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x6d, 0x61, 0x74, 0x65, 0x5f,
+	0x69, 0x6e, 0x5f, 0x70, 0x69, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x68,
+	0x69, 0x63, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x74, 0x65, 0x61,
+	0x6d, 0x4d, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x50, 0x69, 0x74, 0x73, 0x12, 0x3d, 0x0a, 0x0b, 0x72,
+	0x61, 0x63, 0x65, 0x5f, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0a,
+	0x72, 0x61, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x07, 0x70, 0x65,
+	0x6e, 0x61, 0x6c, 0x74, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x65,
+	0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x6e, 0x61, 0x6c,
+	0x74, 0x79, 0x48, 0x00, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12, 0x38, 0x0a,
+	0x0a, 0x73, 0x70, 0x65, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x70, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x70, 0x65, 0x65, 0x64, 0x54, 0x72, 0x61, 0x70, 0x48, 0x00, 0x52, 0x09, 0x73, 0x70,
+	0x65, 0x65, 0x64, 0x54, 0x72, 0x61, 0x70, 0x12, 0x3e, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x5d, 0x0a, 0x1c, 0x64, 0x72, 0x69, 0x76, 0x65,
+	0x5f, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x68,
+	0x69, 0x63, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x19, 0x64, 0x72, 0x69,
+	0x76, 0x65, 0x54, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x64, 0x12, 0x51, 0x0a, 0x16, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x67,
+	0x6f, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x48, 0x00, 0x52, 0x13, 0x73, 0x74, 0x6f, 0x70, 0x47, 0x6f, 0x50, 0x65, 0x6e, 0x61,
+	0x6c, 0x74, 0x79, 0x53, 0x65, 0x72, 0x76, 0x65, 0x64, 0x12, 0x37, 0x0a, 0x09, 0x66, 0x6c, 0x61,
+	0x73, 0x68, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74,
+	0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x61, 0x73,
+	0x68, 0x62, 0x61, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x09, 0x66, 0x6c, 0x61, 0x73, 0x68, 0x62, 0x61,
+	0x63, 0x6b, 0x12, 0x31, 0x0a, 0x07, 0x62, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x73, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x07, 0x62, 0x75,
+	0x74, 0x74, 0x6f, 0x6e, 0x73, 0x12, 0x34, 0x0a, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x74, 0x61, 0x6b,
+	0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65,
+	0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x74, 0x61, 0x6b, 0x65, 0x48,
+	0x00, 0x52, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x38, 0x0a, 0x0a, 0x73,
+	0x61, 0x66, 0x65, 0x74, 0x79, 0x5f, 0x63, 0x61, 0x72, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x61, 0x66, 0x65, 0x74, 0x79, 0x43, 0x61, 0x72, 0x48, 0x00, 0x52, 0x09, 0x73, 0x61, 0x66, 0x65,
+	0x74, 0x79, 0x43, 0x61, 0x72, 0x12, 0x37, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d,
+	0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x09,
+	0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x2f, 0x0a, 0x0c, 0x56, 0x65, 0x68,
+	0x69, 0x63, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x65, 0x68,
+	0x69, 0x63, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
+	0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x64, 0x78, 0x22, 0x48, 0x0a, 0x0a, 0x46, 0x61,
+	0x73, 0x74, 0x65, 0x73, 0x74, 0x4c, 0x61, 0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x65, 0x68, 0x69,
+	0x63, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x76,
+	0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x64, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x70,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x6c, 0x61, 0x70,
+	0x54, 0x69, 0x6d, 0x65, 0x22, 0xf8, 0x01, 0x0a, 0x07, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79,
+	0x12, 0x21, 0x0a, 0x0c, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x66, 0x72, 0x69, 0x6e, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10,
+	0x69, 0x6e, 0x66, 0x72, 0x69, 0x6e, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x64,
+	0x78, 0x12, 0x2a, 0x0a, 0x11, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x76, 0x65, 0x68, 0x69, 0x63,
+	0x6c, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6f, 0x74,
+	0x68, 0x65, 0x72, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x64, 0x78, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x69, 0x6d,
+	0x65, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x61, 0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x06, 0x6c, 0x61, 0x70, 0x4e, 0x75, 0x6d, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x73, 0x5f, 0x67, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x47, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x22,
+	0xc1, 0x02, 0x0a, 0x09, 0x53, 0x70, 0x65, 0x65, 0x64, 0x54, 0x72, 0x61, 0x70, 0x12, 0x1f, 0x0a,
+	0x0b, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0a, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x64, 0x78, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x73,
+	0x70, 0x65, 0x65, 0x64, 0x12, 0x40, 0x0a, 0x1d, 0x69, 0x73, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x61,
+	0x6c, 0x6c, 0x5f, 0x66, 0x61, 0x73, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x19, 0x69, 0x73, 0x4f,
+	0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x46, 0x61, 0x73, 0x74, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x1c, 0x69, 0x73, 0x5f, 0x64, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x5f, 0x66, 0x61, 0x73, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x18, 0x69, 0x73,
+	0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x46, 0x61, 0x73, 0x74, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x1e, 0x66, 0x61, 0x73, 0x74, 0x65, 0x73,
+	0x74, 0x5f, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x5f, 0x69, 0x6e,
+	0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1a,
+	0x66, 0x61, 0x73, 0x74, 0x65, 0x73, 0x74, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x64,
+	0x78, 0x49, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x18, 0x66, 0x61,
+	0x73, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x5f, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x15, 0x66, 0x61,
+	0x73, 0x74, 0x65, 0x73, 0x74, 0x53, 0x70, 0x65, 0x65, 0x64, 0x49, 0x6e, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0x2c, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4c, 0x69, 0x67, 0x68,
+	0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x4c, 0x69, 0x67, 0x68, 0x74,
+	0x73, 0x22, 0x7f, 0x0a, 0x09, 0x46, 0x6c, 0x61, 0x73, 0x68, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x3c,
+	0x0a, 0x1a, 0x66, 0x6c, 0x61, 0x73, 0x68, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x66, 0x72, 0x61, 0x6d,
+	0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x18, 0x66, 0x6c, 0x61, 0x73, 0x68, 0x62, 0x61, 0x63, 0x6b, 0x46, 0x72, 0x61,
+	0x6d, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x16,
+	0x66, 0x6c, 0x61, 0x73, 0x68, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x14, 0x66, 0x6c,
+	0x61, 0x73, 0x68, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x69,
+	0x6d, 0x65, 0x22, 0x2e, 0x0a, 0x07, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x62, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x62, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x7f, 0x0a, 0x08, 0x4f, 0x76, 0x65, 0x72, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x34,
+	0x0a, 0x16, 0x6f, 0x76, 0x65, 0x72, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x65, 0x68,
+	0x69, 0x63, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14,
+	0x6f, 0x76, 0x65, 0x72, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c,
+	0x65, 0x49, 0x64, 0x78, 0x12, 0x3d, 0x0a, 0x1b, 0x62, 0x65, 0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x76,
+	0x65, 0x72, 0x74, 0x61, 0x6b, 0x65, 0x6e, 0x5f, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x5f,
+	0x69, 0x64, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x18, 0x62, 0x65, 0x69, 0x6e, 0x67,
+	0x4f, 0x76, 0x65, 0x72, 0x74, 0x61, 0x6b, 0x65, 0x6e, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65,
+	0x49, 0x64, 0x78, 0x22, 0x52, 0x0a, 0x09, 0x53, 0x61, 0x66, 0x65, 0x74, 0x79, 0x43, 0x61, 0x72,
+	0x12, 0x26, 0x0a, 0x0f, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x5f, 0x63, 0x61, 0x72, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x73, 0x61, 0x66, 0x65, 0x74,
+	0x79, 0x43, 0x61, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x51, 0x0a, 0x09, 0x43, 0x6f, 0x6c, 0x6c, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x31,
+	0x5f, 0x69, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x76, 0x65, 0x68, 0x69,
+	0x63, 0x6c, 0x65, 0x31, 0x49, 0x64, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x76, 0x65, 0x68, 0x69, 0x63,
+	0x6c, 0x65, 0x32, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x76,
+	0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x32, 0x49, 0x64, 0x78, 0x22, 0xb7, 0x01, 0x0a, 0x16, 0x50,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x73, 0x44, 0x61, 0x74, 0x61, 0x12, 0x32, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x75, 0x6d,
+	0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x63, 0x61, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0d, 0x6e, 0x75, 0x6d, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x43, 0x61, 0x72,
+	0x73, 0x12, 0x41, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65,
+	0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x73, 0x22, 0xea, 0x02, 0x0a, 0x0f, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x69, 0x5f, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0c, 0x61, 0x69, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x65, 0x61,
+	0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d,
+	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x79, 0x5f, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x6d, 0x79, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x72,
+	0x61, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x72, 0x61, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0b, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x79, 0x6f, 0x75, 0x72, 0x5f, 0x74, 0x65, 0x6c, 0x65, 0x6d,
+	0x65, 0x74, 0x72, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x79, 0x6f, 0x75, 0x72,
+	0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x68, 0x6f,
+	0x77, 0x5f, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x73, 0x68, 0x6f, 0x77, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
+	0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
+	0x6d, 0x22, 0x84, 0x01, 0x0a, 0x13, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x61, 0x72, 0x53,
+	0x65, 0x74, 0x75, 0x70, 0x73, 0x44, 0x61, 0x74, 0x61, 0x12, 0x32, 0x0a, 0x06, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x65, 0x6c, 0x65,
+	0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x61, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x75, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x61, 0x72, 0x53, 0x65, 0x74, 0x75, 0x70, 0x44, 0x61, 0x74, 0x61, 0x52, 0x09, 0x63,
+	0x61, 0x72, 0x53, 0x65, 0x74, 0x75, 0x70, 0x73, 0x22, 0x89, 0x07, 0x0a, 0x0c, 0x43, 0x61, 0x72,
+	0x53, 0x65, 0x74, 0x75, 0x70, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x6f,
+	0x6e, 0x74, 0x5f, 0x77, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x66,
+	0x72, 0x6f, 0x6e, 0x74, 0x57, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x72,
+	0x5f, 0x77, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x65, 0x61,
+	0x72, 0x57, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x6e, 0x5f, 0x74, 0x68, 0x72, 0x6f,
+	0x74, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6f, 0x6e, 0x54, 0x68,
+	0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x66, 0x66, 0x5f, 0x74, 0x68,
+	0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6f, 0x66,
+	0x66, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x72, 0x6f,
+	0x6e, 0x74, 0x5f, 0x63, 0x61, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x0b, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x43, 0x61, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b,
+	0x72, 0x65, 0x61, 0x72, 0x5f, 0x63, 0x61, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x0a, 0x72, 0x65, 0x61, 0x72, 0x43, 0x61, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1b, 0x0a,
+	0x09, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x74, 0x6f, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x08, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x54, 0x6f, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65,
+	0x61, 0x72, 0x5f, 0x74, 0x6f, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x72, 0x65,
+	0x61, 0x72, 0x54, 0x6f, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x73,
+	0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0f, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x61, 0x72, 0x5f, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x72, 0x65, 0x61, 0x72, 0x53,
+	0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x13, 0x66, 0x72, 0x6f,
+	0x6e, 0x74, 0x5f, 0x61, 0x6e, 0x74, 0x69, 0x5f, 0x72, 0x6f, 0x6c, 0x6c, 0x5f, 0x62, 0x61, 0x72,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x41, 0x6e, 0x74,
+	0x69, 0x52, 0x6f, 0x6c, 0x6c, 0x42, 0x61, 0x72, 0x12, 0x2b, 0x0a, 0x12, 0x72, 0x65, 0x61, 0x72,
+	0x5f, 0x61, 0x6e, 0x74, 0x69, 0x5f, 0x72, 0x6f, 0x6c, 0x6c, 0x5f, 0x62, 0x61, 0x72, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x72, 0x65, 0x61, 0x72, 0x41, 0x6e, 0x74, 0x69, 0x52, 0x6f,
+	0x6c, 0x6c, 0x42, 0x61, 0x72, 0x12, 0x36, 0x0a, 0x17, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x73,
+	0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x53, 0x75, 0x73,
+	0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x34, 0x0a,
+	0x16, 0x72, 0x65, 0x61, 0x72, 0x5f, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x72,
+	0x65, 0x61, 0x72, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x72, 0x61, 0x6b, 0x65, 0x5f, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x75, 0x72, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x62, 0x72, 0x61,
+	0x6b, 0x65, 0x50, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x72,
+	0x61, 0x6b, 0x65, 0x5f, 0x62, 0x69, 0x61, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x62, 0x72, 0x61, 0x6b, 0x65, 0x42, 0x69, 0x61, 0x73, 0x12, 0x35, 0x0a, 0x17, 0x72, 0x65, 0x61,
+	0x72, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x74, 0x79, 0x72, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x75, 0x72, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x02, 0x52, 0x14, 0x72, 0x65, 0x61, 0x72,
+	0x4c, 0x65, 0x66, 0x74, 0x54, 0x79, 0x72, 0x65, 0x50, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65,
+	0x12, 0x37, 0x0a, 0x18, 0x72, 0x65, 0x61, 0x72, 0x5f, 0x72, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x74,
+	0x79, 0x72, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x18, 0x12, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x15, 0x72, 0x65, 0x61, 0x72, 0x52, 0x69, 0x67, 0x68, 0x74, 0x54, 0x79, 0x72,
+	0x65, 0x50, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x12, 0x37, 0x0a, 0x18, 0x66, 0x72, 0x6f,
+	0x6e, 0x74, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x74, 0x79, 0x72, 0x65, 0x5f, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x75, 0x72, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x02, 0x52, 0x15, 0x66, 0x72, 0x6f,
+	0x6e, 0x74, 0x4c, 0x65, 0x66, 0x74, 0x54, 0x79, 0x72, 0x65, 0x50, 0x72, 0x65, 0x73, 0x73, 0x75,
+	0x72, 0x65, 0x12, 0x39, 0x0a, 0x19, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x72, 0x69, 0x67, 0x68,
+	0x74, 0x5f, 0x74, 0x79, 0x72, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x02, 0x52, 0x16, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x52, 0x69, 0x67, 0x68,
+	0x74, 0x54, 0x79, 0x72, 0x65, 0x50, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x62, 0x61, 0x6c, 0x6c, 0x61, 0x73, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x62, 0x61, 0x6c, 0x6c, 0x61, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x75, 0x65, 0x6c, 0x5f,
+	0x6c, 0x6f, 0x61, 0x64, 0x18, 0x16, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x66, 0x75, 0x65, 0x6c,
+	0x4c, 0x6f, 0x61, 0x64, 0x22, 0xb1, 0x02, 0x0a, 0x16, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x43,
+	0x61, 0x72, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x32, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x12, 0x4c, 0x0a, 0x12, 0x63, 0x61, 0x72, 0x5f, 0x74, 0x65, 0x6c, 0x65, 0x6d,
+	0x65, 0x74, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x61, 0x72, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x10, 0x63, 0x61, 0x72, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x66, 0x64, 0x5f, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6d, 0x66, 0x64, 0x50,
+	0x61, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x46, 0x0a, 0x20, 0x6d, 0x66, 0x64,
+	0x5f, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x1c, 0x6d, 0x66, 0x64, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x67,
+	0x65, 0x61, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x73, 0x75, 0x67, 0x67, 0x65,
+	0x73, 0x74, 0x65, 0x64, 0x47, 0x65, 0x61, 0x72, 0x22, 0xb6, 0x05, 0x0a, 0x10, 0x43, 0x61, 0x72,
+	0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x70,
+	0x65, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05,
+	0x73, 0x74, 0x65, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x72, 0x61, 0x6b, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x62, 0x72, 0x61, 0x6b, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x6c, 0x75, 0x74, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x63, 0x6c, 0x75,
+	0x74, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x65, 0x61, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x67, 0x65, 0x61, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x5f, 0x72, 0x70, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x65, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x52, 0x70, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x72, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x03, 0x64, 0x72, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x76, 0x5f,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x72, 0x65, 0x76, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x50,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x14, 0x72, 0x65, 0x76, 0x5f, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x73, 0x5f, 0x62, 0x69, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x72, 0x65, 0x76, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x42,
+	0x69, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x43, 0x0a, 0x12, 0x62, 0x72, 0x61, 0x6b, 0x65,
+	0x73, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x57, 0x68, 0x65, 0x65, 0x6c, 0x73, 0x52, 0x11, 0x62, 0x72, 0x61, 0x6b, 0x65,
+	0x73, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x50, 0x0a, 0x19,
+	0x74, 0x79, 0x72, 0x65, 0x73, 0x5f, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x74, 0x65,
+	0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x57,
+	0x68, 0x65, 0x65, 0x6c, 0x73, 0x52, 0x17, 0x74, 0x79, 0x72, 0x65, 0x73, 0x53, 0x75, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x4c,
+	0x0a, 0x17, 0x74, 0x79, 0x72, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x74, 0x65,
+	0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x57,
+	0x68, 0x65, 0x65, 0x6c, 0x73, 0x52, 0x15, 0x74, 0x79, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x6e, 0x65,
+	0x72, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2d, 0x0a, 0x12,
+	0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x3b, 0x0a, 0x0e, 0x74,
+	0x79, 0x72, 0x65, 0x73, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x57, 0x68, 0x65, 0x65, 0x6c, 0x73, 0x52, 0x0d, 0x74, 0x79, 0x72, 0x65, 0x73,
+	0x50, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x73, 0x75, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x68,
+	0x65, 0x65, 0x6c, 0x73, 0x52, 0x0b, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x22, 0x8e, 0x01, 0x0a, 0x13, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x61, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x44, 0x61, 0x74, 0x61, 0x12, 0x32, 0x0a, 0x06, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x65, 0x6c, 0x65,
+	0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x43, 0x0a,
+	0x0f, 0x63, 0x61, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x0d, 0x63, 0x61, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x44, 0x61,
+	0x74, 0x61, 0x22, 0xac, 0x08, 0x0a, 0x0d, 0x43, 0x61, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12,
+	0x28, 0x0a, 0x10, 0x61, 0x6e, 0x74, 0x69, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x62, 0x72, 0x61,
+	0x6b, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x61, 0x6e, 0x74, 0x69, 0x4c,
+	0x6f, 0x63, 0x6b, 0x42, 0x72, 0x61, 0x6b, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x75, 0x65,
+	0x6c, 0x5f, 0x6d, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x66, 0x75, 0x65,
+	0x6c, 0x4d, 0x69, 0x78, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x62, 0x72,
+	0x61, 0x6b, 0x65, 0x5f, 0x62, 0x69, 0x61, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e,
+	0x66, 0x72, 0x6f, 0x6e, 0x74, 0x42, 0x72, 0x61, 0x6b, 0x65, 0x42, 0x69, 0x61, 0x73, 0x12, 0x2c,
+	0x0a, 0x12, 0x70, 0x69, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x70, 0x69, 0x74, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0c,
+	0x66, 0x75, 0x65, 0x6c, 0x5f, 0x69, 0x6e, 0x5f, 0x74, 0x61, 0x6e, 0x6b, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x0a, 0x66, 0x75, 0x65, 0x6c, 0x49, 0x6e, 0x54, 0x61, 0x6e, 0x6b, 0x12, 0x23,
+	0x0a, 0x0d, 0x66, 0x75, 0x65, 0x6c, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x66, 0x75, 0x65, 0x6c, 0x43, 0x61, 0x70, 0x61, 0x63,
+	0x69, 0x74, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x66, 0x75, 0x65, 0x6c, 0x5f, 0x72, 0x65, 0x6d, 0x61,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x61, 0x70, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x11, 0x66, 0x75, 0x65, 0x6c, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x4c,
+	0x61, 0x70, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x70, 0x6d, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x52, 0x70, 0x6d, 0x12, 0x19, 0x0a, 0x08,
+	0x69, 0x64, 0x6c, 0x65, 0x5f, 0x72, 0x70, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x69, 0x64, 0x6c, 0x65, 0x52, 0x70, 0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x67,
+	0x65, 0x61, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x47,
+	0x65, 0x61, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x72, 0x73, 0x5f, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x72, 0x73, 0x41, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x17, 0x64, 0x72, 0x73, 0x5f, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x64, 0x72, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x30, 0x0a,
+	0x14, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x74, 0x79, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x61, 0x63, 0x74,
+	0x75, 0x61, 0x6c, 0x54, 0x79, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x12,
+	0x30, 0x0a, 0x14, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x5f, 0x74, 0x79, 0x72, 0x65, 0x5f, 0x63,
+	0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x76,
+	0x69, 0x73, 0x75, 0x61, 0x6c, 0x54, 0x79, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e,
+	0x64, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x79, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x67, 0x65, 0x5f, 0x6c,
+	0x61, 0x70, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x74, 0x79, 0x72, 0x65, 0x73,
+	0x41, 0x67, 0x65, 0x4c, 0x61, 0x70, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x76, 0x65, 0x68, 0x69, 0x63,
+	0x6c, 0x65, 0x5f, 0x66, 0x69, 0x61, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0f, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x46, 0x69, 0x61, 0x46, 0x6c,
+	0x61, 0x67, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x70, 0x6f,
+	0x77, 0x65, 0x72, 0x5f, 0x69, 0x63, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x65,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x49, 0x63, 0x65, 0x12, 0x2a, 0x0a,
+	0x11, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x6d, 0x67,
+	0x75, 0x6b, 0x18, 0x13, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x50, 0x6f, 0x77, 0x65, 0x72, 0x4d, 0x67, 0x75, 0x6b, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x72, 0x73,
+	0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x0e, 0x65, 0x72, 0x73, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x45, 0x6e, 0x65,
+	0x72, 0x67, 0x79, 0x12, 0x26, 0x0a, 0x0f, 0x65, 0x72, 0x73, 0x5f, 0x64, 0x65, 0x70, 0x6c, 0x6f,
+	0x79, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x65, 0x72,
+	0x73, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x3c, 0x0a, 0x1b, 0x65,
+	0x72, 0x73, 0x5f, 0x68, 0x61, 0x72, 0x76, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x68, 0x69,
+	0x73, 0x5f, 0x6c, 0x61, 0x70, 0x5f, 0x6d, 0x67, 0x75, 0x6b, 0x18, 0x16, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x17, 0x65, 0x72, 0x73, 0x48, 0x61, 0x72, 0x76, 0x65, 0x73, 0x74, 0x65, 0x64, 0x54, 0x68,
+	0x69, 0x73, 0x4c, 0x61, 0x70, 0x4d, 0x67, 0x75, 0x6b, 0x12, 0x3c, 0x0a, 0x1b, 0x65, 0x72, 0x73,
+	0x5f, 0x68, 0x61, 0x72, 0x76, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x68, 0x69, 0x73, 0x5f,
+	0x6c, 0x61, 0x70, 0x5f, 0x6d, 0x67, 0x75, 0x68, 0x18, 0x17, 0x20, 0x01, 0x28, 0x02, 0x52, 0x17,
+	0x65, 0x72, 0x73, 0x48, 0x61, 0x72, 0x76, 0x65, 0x73, 0x74, 0x65, 0x64, 0x54, 0x68, 0x69, 0x73,
+	0x4c, 0x61, 0x70, 0x4d, 0x67, 0x75, 0x68, 0x12, 0x31, 0x0a, 0x15, 0x65, 0x72, 0x73, 0x5f, 0x64,
+	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x5f, 0x74, 0x68, 0x69, 0x73, 0x5f, 0x6c, 0x61, 0x70,
+	0x18, 0x18, 0x20, 0x01, 0x28, 0x02, 0x52, 0x12, 0x65, 0x72, 0x73, 0x44, 0x65, 0x70, 0x6c, 0x6f,
+	0x79, 0x65, 0x64, 0x54, 0x68, 0x69, 0x73, 0x4c, 0x61, 0x70, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x19, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x50, 0x61, 0x75, 0x73, 0x65,
+	0x64, 0x22, 0xc6, 0x01, 0x0a, 0x1d, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x46, 0x69, 0x6e, 0x61,
+	0x6c, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x32, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x75, 0x6d, 0x5f, 0x63,
+	0x61, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6e, 0x75, 0x6d, 0x43, 0x61,
+	0x72, 0x73, 0x12, 0x56, 0x0a, 0x13, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x69, 0x6e, 0x61, 0x6c, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x12, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x22, 0xad, 0x04, 0x0a, 0x17, 0x46,
+	0x69, 0x6e, 0x61, 0x6c, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x75, 0x6d, 0x5f, 0x6c, 0x61, 0x70, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6e, 0x75, 0x6d, 0x4c, 0x61, 0x70, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x67, 0x72, 0x69, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x67, 0x72, 0x69, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x6e, 0x75,
+	0x6d, 0x5f, 0x70, 0x69, 0x74, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x50, 0x69, 0x74, 0x53, 0x74, 0x6f, 0x70, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x2c, 0x0a, 0x13, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x6c, 0x61, 0x70, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0f, 0x62, 0x65, 0x73, 0x74, 0x4c, 0x61, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x4d,
+	0x73, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x61, 0x63, 0x65, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x52, 0x61, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x65, 0x6e,
+	0x61, 0x6c, 0x74, 0x69, 0x65, 0x73, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0d, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x69, 0x65, 0x73, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x69, 0x65,
+	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6e, 0x75, 0x6d, 0x50, 0x65, 0x6e, 0x61,
+	0x6c, 0x74, 0x69, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x75, 0x6d, 0x5f, 0x74, 0x79, 0x72,
+	0x65, 0x5f, 0x73, 0x74, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d,
+	0x6e, 0x75, 0x6d, 0x54, 0x79, 0x72, 0x65, 0x53, 0x74, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x2c, 0x0a,
+	0x12, 0x74, 0x79, 0x72, 0x65, 0x5f, 0x73, 0x74, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x61, 0x63, 0x74,
+	0x75, 0x61, 0x6c, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x10, 0x74, 0x79, 0x72, 0x65, 0x53,
+	0x74, 0x69, 0x6e, 0x74, 0x73, 0x41, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x12, 0x2c, 0x0a, 0x12, 0x74,
+	0x79, 0x72, 0x65, 0x5f, 0x73, 0x74, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x76, 0x69, 0x73, 0x75, 0x61,
+	0x6c, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x10, 0x74, 0x79, 0x72, 0x65, 0x53, 0x74, 0x69,
+	0x6e, 0x74, 0x73, 0x56, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x12, 0x2f, 0x0a, 0x14, 0x74, 0x79, 0x72,
+	0x65, 0x5f, 0x73, 0x74, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x61, 0x70,
+	0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x11, 0x74, 0x79, 0x72, 0x65, 0x53, 0x74, 0x69,
+	0x6e, 0x74, 0x73, 0x45, 0x6e, 0x64, 0x4c, 0x61, 0x70, 0x73, 0x22, 0xac, 0x01, 0x0a, 0x13, 0x50,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x32, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6e, 0x75, 0x6d,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x40, 0x0a, 0x0d, 0x6c, 0x6f, 0x62, 0x62, 0x79,
+	0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f,
+	0x62, 0x62, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x6c, 0x6f, 0x62,
+	0x62, 0x79, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x22, 0xe1, 0x01, 0x0a, 0x0d, 0x4c, 0x6f,
+	0x62, 0x62, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x44, 0x61, 0x74, 0x61, 0x12, 0x23, 0x0a, 0x0d, 0x61,
+	0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0c, 0x61, 0x69, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70,
+	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x61, 0x72, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x09, 0x63, 0x61, 0x72, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65,
+	0x61, 0x64, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0b, 0x72, 0x65, 0x61, 0x64, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x8e, 0x01,
+	0x0a, 0x13, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x61, 0x72, 0x44, 0x61, 0x6d, 0x61, 0x67,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x32, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x43, 0x0a, 0x0f, 0x63, 0x61, 0x72,
+	0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x61, 0x72, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x0d, 0x63, 0x61, 0x72, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0xfe,
+	0x06, 0x0a, 0x0d, 0x43, 0x61, 0x72, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x33, 0x0a, 0x0a, 0x74, 0x79, 0x72, 0x65, 0x73, 0x5f, 0x77, 0x65, 0x61, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x57, 0x68, 0x65, 0x65, 0x6c, 0x73, 0x52, 0x09, 0x74, 0x79, 0x72, 0x65,
+	0x73, 0x57, 0x65, 0x61, 0x72, 0x12, 0x37, 0x0a, 0x0c, 0x74, 0x79, 0x72, 0x65, 0x73, 0x5f, 0x64,
+	0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x65,
+	0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x68, 0x65, 0x65, 0x6c,
+	0x73, 0x52, 0x0b, 0x74, 0x79, 0x72, 0x65, 0x73, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x39,
+	0x0a, 0x0d, 0x62, 0x72, 0x61, 0x6b, 0x65, 0x73, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x68, 0x65, 0x65, 0x6c, 0x73, 0x52, 0x0c, 0x62, 0x72, 0x61,
+	0x6b, 0x65, 0x73, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x33, 0x0a, 0x16, 0x66, 0x72, 0x6f,
+	0x6e, 0x74, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x77, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x6d,
+	0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x66, 0x72, 0x6f, 0x6e, 0x74,
+	0x4c, 0x65, 0x66, 0x74, 0x57, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x35,
+	0x0a, 0x17, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x72, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x77, 0x69,
+	0x6e, 0x67, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x14, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x52, 0x69, 0x67, 0x68, 0x74, 0x57, 0x69, 0x6e, 0x67, 0x44,
+	0x61, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x61, 0x72, 0x5f, 0x77, 0x69,
+	0x6e, 0x67, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0e, 0x72, 0x65, 0x61, 0x72, 0x57, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x44, 0x61, 0x6d, 0x61,
+	0x67, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x69, 0x66, 0x66, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x64,
+	0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x64, 0x69, 0x66,
+	0x66, 0x75, 0x73, 0x65, 0x72, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73,
+	0x69, 0x64, 0x65, 0x70, 0x6f, 0x64, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0d, 0x73, 0x69, 0x64, 0x65, 0x70, 0x6f, 0x64, 0x44, 0x61, 0x6d, 0x61,
+	0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x72, 0x73, 0x5f, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x64, 0x72, 0x73, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x65, 0x72, 0x73, 0x5f, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x65, 0x72, 0x73, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x26, 0x0a, 0x0f,
+	0x67, 0x65, 0x61, 0x72, 0x5f, 0x62, 0x6f, 0x78, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x67, 0x65, 0x61, 0x72, 0x42, 0x6f, 0x78, 0x44, 0x61,
+	0x6d, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x64,
+	0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x65, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x5f, 0x6d, 0x67, 0x75, 0x68, 0x5f, 0x77, 0x65, 0x61, 0x72, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x4d, 0x67, 0x75, 0x68, 0x57,
+	0x65, 0x61, 0x72, 0x12, 0x24, 0x0a, 0x0e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x65, 0x73,
+	0x5f, 0x77, 0x65, 0x61, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x65, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x45, 0x73, 0x57, 0x65, 0x61, 0x72, 0x12, 0x24, 0x0a, 0x0e, 0x65, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x5f, 0x63, 0x65, 0x5f, 0x77, 0x65, 0x61, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0c, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x43, 0x65, 0x57, 0x65, 0x61, 0x72, 0x12,
+	0x26, 0x0a, 0x0f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x63, 0x65, 0x5f, 0x77, 0x65,
+	0x61, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x49, 0x63, 0x65, 0x57, 0x65, 0x61, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x5f, 0x6d, 0x67, 0x75, 0x6b, 0x5f, 0x77, 0x65, 0x61, 0x72, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x4d, 0x67, 0x75, 0x6b, 0x57, 0x65, 0x61,
+	0x72, 0x12, 0x24, 0x0a, 0x0e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x63, 0x5f, 0x77,
+	0x65, 0x61, 0x72, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x54, 0x63, 0x57, 0x65, 0x61, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x77, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x65,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x42, 0x6c, 0x6f, 0x77, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x5f, 0x73, 0x65, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x15, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0c, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x53, 0x65, 0x69, 0x7a, 0x65, 0x64, 0x22,
+	0x94, 0x04, 0x0a, 0x18, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x12, 0x32, 0x0a, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74,
+	0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x17, 0x0a, 0x07, 0x63, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x06, 0x63, 0x61, 0x72, 0x49, 0x64, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x75, 0x6d,
+	0x5f, 0x6c, 0x61, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6e, 0x75, 0x6d,
+	0x4c, 0x61, 0x70, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x75, 0x6d, 0x5f, 0x74, 0x79, 0x72, 0x65,
+	0x5f, 0x73, 0x74, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6e,
+	0x75, 0x6d, 0x54, 0x79, 0x72, 0x65, 0x53, 0x74, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x15,
+	0x62, 0x65, 0x73, 0x74, 0x5f, 0x6c, 0x61, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x61,
+	0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x62, 0x65, 0x73,
+	0x74, 0x4c, 0x61, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x61, 0x70, 0x4e, 0x75, 0x6d, 0x12, 0x2f,
+	0x0a, 0x14, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x31, 0x5f, 0x6c,
+	0x61, 0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x62, 0x65,
+	0x73, 0x74, 0x53, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x31, 0x4c, 0x61, 0x70, 0x4e, 0x75, 0x6d, 0x12,
+	0x2f, 0x0a, 0x14, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x32, 0x5f,
+	0x6c, 0x61, 0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x62,
+	0x65, 0x73, 0x74, 0x53, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x32, 0x4c, 0x61, 0x70, 0x4e, 0x75, 0x6d,
+	0x12, 0x2f, 0x0a, 0x14, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x33,
+	0x5f, 0x6c, 0x61, 0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11,
+	0x62, 0x65, 0x73, 0x74, 0x53, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x33, 0x4c, 0x61, 0x70, 0x4e, 0x75,
+	0x6d, 0x12, 0x46, 0x0a, 0x10, 0x6c, 0x61, 0x70, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x65,
+	0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x70, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0e, 0x6c, 0x61, 0x70, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x12, 0x5b, 0x0a, 0x18, 0x74, 0x79, 0x72,
+	0x65, 0x5f, 0x73, 0x74, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x65,
+	0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x79, 0x72, 0x65, 0x53,
+	0x74, 0x69, 0x6e, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x15, 0x74, 0x79, 0x72, 0x65, 0x53, 0x74, 0x69, 0x6e, 0x74, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x22, 0x81, 0x03, 0x0a, 0x0e, 0x4c, 0x61, 0x70, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x12, 0x23, 0x0a, 0x0e, 0x6c, 0x61, 0x70,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0b, 0x6c, 0x61, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x4d, 0x73, 0x12, 0x2b,
+	0x0a, 0x12, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x31, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69,
+	0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x31, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x4d, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x73,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x31, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x75,
+	0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x73, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x31, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x2b, 0x0a,
+	0x12, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x32, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x6e,
+	0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x32, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x4d, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x32, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x32, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x12,
+	0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x33, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x5f,
+	0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x33, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x4d, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x33, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x33,
+	0x54, 0x69, 0x6d, 0x65, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x13, 0x6c,
+	0x61, 0x70, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x62, 0x69, 0x74, 0x5f, 0x66, 0x6c, 0x61,
+	0x67, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6c, 0x61, 0x70, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x42, 0x69, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x93, 0x01, 0x0a, 0x14, 0x54,
+	0x79, 0x72, 0x65, 0x53, 0x74, 0x69, 0x6e, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x61, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x65, 0x6e, 0x64, 0x4c, 0x61, 0x70, 0x12, 0x30, 0x0a, 0x14,
+	0x74, 0x79, 0x72, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x6d, 0x70,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x74, 0x79, 0x72, 0x65,
+	0x41, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x30,
+	0x0a, 0x14, 0x74, 0x79, 0x72, 0x65, 0x5f, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x5f, 0x63, 0x6f,
+	0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x74, 0x79,
+	0x72, 0x65, 0x56, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64,
+	0x22, 0xbf, 0x01, 0x0a, 0x12, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x54, 0x79, 0x72, 0x65, 0x53,
+	0x65, 0x74, 0x73, 0x44, 0x61, 0x74, 0x61, 0x12, 0x32, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65,
+	0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x63,
+	0x61, 0x72, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x63, 0x61,
+	0x72, 0x49, 0x64, 0x78, 0x12, 0x3d, 0x0a, 0x0d, 0x74, 0x79, 0x72, 0x65, 0x5f, 0x73, 0x65, 0x74,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x65,
+	0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x79, 0x72, 0x65, 0x53,
+	0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0b, 0x74, 0x79, 0x72, 0x65, 0x53, 0x65, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x74, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x64,
+	0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x66, 0x69, 0x74, 0x74, 0x65, 0x64, 0x49,
+	0x64, 0x78, 0x22, 0xd0, 0x02, 0x0a, 0x0b, 0x54, 0x79, 0x72, 0x65, 0x53, 0x65, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x74, 0x79, 0x72,
+	0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x12, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x54, 0x79, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x70,
+	0x6f, 0x75, 0x6e, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x5f, 0x74,
+	0x79, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x12, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x54, 0x79, 0x72, 0x65, 0x43, 0x6f,
+	0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x65, 0x61, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x77, 0x65, 0x61, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x61,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x72, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x65, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x69, 0x66,
+	0x65, 0x5f, 0x73, 0x70, 0x61, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6c, 0x69,
+	0x66, 0x65, 0x53, 0x70, 0x61, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x6c, 0x69, 0x66, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x75, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x61, 0x70, 0x5f, 0x64,
+	0x65, 0x6c, 0x74, 0x61, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0c, 0x6c, 0x61, 0x70, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x66, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x66,
+	0x69, 0x74, 0x74, 0x65, 0x64, 0x22, 0xae, 0x09, 0x0a, 0x12, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x4d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x44, 0x61, 0x74, 0x61, 0x12, 0x32, 0x0a, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74,
+	0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x45, 0x0a, 0x13, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x68, 0x65,
+	0x65, 0x6c, 0x73, 0x52, 0x12, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x13, 0x73, 0x75, 0x73, 0x70, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x57, 0x68, 0x65, 0x65, 0x6c, 0x73, 0x52, 0x12, 0x73, 0x75, 0x73, 0x70,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x12, 0x4d,
+	0x0a, 0x17, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x63, 0x63,
+	0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x57,
+	0x68, 0x65, 0x65, 0x6c, 0x73, 0x52, 0x16, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x41, 0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a,
+	0x0b, 0x77, 0x68, 0x65, 0x65, 0x6c, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x57, 0x68, 0x65, 0x65, 0x6c, 0x73, 0x52, 0x0a, 0x77, 0x68, 0x65, 0x65, 0x6c, 0x53,
+	0x70, 0x65, 0x65, 0x64, 0x12, 0x3e, 0x0a, 0x10, 0x77, 0x68, 0x65, 0x65, 0x6c, 0x5f, 0x73, 0x6c,
+	0x69, 0x70, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x68,
+	0x65, 0x65, 0x6c, 0x73, 0x52, 0x0e, 0x77, 0x68, 0x65, 0x65, 0x6c, 0x53, 0x6c, 0x69, 0x70, 0x52,
+	0x61, 0x74, 0x69, 0x6f, 0x12, 0x3e, 0x0a, 0x10, 0x77, 0x68, 0x65, 0x65, 0x6c, 0x5f, 0x73, 0x6c,
+	0x69, 0x70, 0x5f, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x68,
+	0x65, 0x65, 0x6c, 0x73, 0x52, 0x0e, 0x77, 0x68, 0x65, 0x65, 0x6c, 0x53, 0x6c, 0x69, 0x70, 0x41,
+	0x6e, 0x67, 0x6c, 0x65, 0x12, 0x3c, 0x0a, 0x0f, 0x77, 0x68, 0x65, 0x65, 0x6c, 0x5f, 0x6c, 0x61,
+	0x74, 0x5f, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x68, 0x65,
+	0x65, 0x6c, 0x73, 0x52, 0x0d, 0x77, 0x68, 0x65, 0x65, 0x6c, 0x4c, 0x61, 0x74, 0x46, 0x6f, 0x72,
+	0x63, 0x65, 0x12, 0x3e, 0x0a, 0x10, 0x77, 0x68, 0x65, 0x65, 0x6c, 0x5f, 0x6c, 0x6f, 0x6e, 0x67,
+	0x5f, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74,
+	0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x68, 0x65, 0x65,
+	0x6c, 0x73, 0x52, 0x0e, 0x77, 0x68, 0x65, 0x65, 0x6c, 0x4c, 0x6f, 0x6e, 0x67, 0x46, 0x6f, 0x72,
+	0x63, 0x65, 0x12, 0x3a, 0x0a, 0x1a, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x6f, 0x66, 0x5f,
+	0x63, 0x6f, 0x67, 0x5f, 0x61, 0x62, 0x6f, 0x76, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x52, 0x16, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x66,
+	0x43, 0x6f, 0x67, 0x41, 0x62, 0x6f, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x28,
+	0x0a, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79,
+	0x5f, 0x78, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x56,
+	0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x58, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x5f, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x79, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x56, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74,
+	0x79, 0x59, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x76, 0x65, 0x6c, 0x6f,
+	0x63, 0x69, 0x74, 0x79, 0x5f, 0x7a, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x56, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x5a, 0x12, 0x2c, 0x0a, 0x12,
+	0x61, 0x6e, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x5f, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79,
+	0x5f, 0x78, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x02, 0x52, 0x10, 0x61, 0x6e, 0x67, 0x75, 0x6c, 0x61,
+	0x72, 0x56, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x58, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x6e,
+	0x67, 0x75, 0x6c, 0x61, 0x72, 0x5f, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x79,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x02, 0x52, 0x10, 0x61, 0x6e, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x56,
+	0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x59, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x6e, 0x67, 0x75,
+	0x6c, 0x61, 0x72, 0x5f, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x7a, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x10, 0x61, 0x6e, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x56, 0x65, 0x6c,
+	0x6f, 0x63, 0x69, 0x74, 0x79, 0x5a, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x6e, 0x67, 0x75, 0x6c, 0x61,
+	0x72, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x78,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x02, 0x52, 0x14, 0x61, 0x6e, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x41,
+	0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x58, 0x12, 0x34, 0x0a, 0x16,
+	0x61, 0x6e, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x79, 0x18, 0x12, 0x20, 0x01, 0x28, 0x02, 0x52, 0x14, 0x61, 0x6e,
+	0x67, 0x75, 0x6c, 0x61, 0x72, 0x41, 0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x59, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x6e, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x5f, 0x61, 0x63,
+	0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x7a, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x14, 0x61, 0x6e, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x41, 0x63, 0x63, 0x65, 0x6c,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5a, 0x12, 0x2c, 0x0a, 0x12, 0x66, 0x72, 0x6f, 0x6e,
+	0x74, 0x5f, 0x77, 0x68, 0x65, 0x65, 0x6c, 0x73, 0x5f, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x10, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x57, 0x68, 0x65, 0x65, 0x6c,
+	0x73, 0x41, 0x6e, 0x67, 0x6c, 0x65, 0x12, 0x3e, 0x0a, 0x10, 0x77, 0x68, 0x65, 0x65, 0x6c, 0x5f,
+	0x76, 0x65, 0x72, 0x74, 0x5f, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x57, 0x68, 0x65, 0x65, 0x6c, 0x73, 0x52, 0x0e, 0x77, 0x68, 0x65, 0x65, 0x6c, 0x56, 0x65, 0x72,
+	0x74, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x2a, 0xf5, 0x03, 0x0a, 0x0e, 0x50, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x41, 0x43,
+	0x4b, 0x45, 0x54, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x41,
+	0x43, 0x4b, 0x45, 0x54, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x4d, 0x4f,
+	0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54,
+	0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f,
+	0x4e, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x43, 0x41,
+	0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x4c, 0x41, 0x50, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x10,
+	0x03, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x43, 0x41, 0x54, 0x45,
+	0x47, 0x4f, 0x52, 0x59, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x04, 0x12, 0x20, 0x0a, 0x1c,
+	0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f,
+	0x50, 0x41, 0x52, 0x54, 0x49, 0x43, 0x49, 0x50, 0x41, 0x4e, 0x54, 0x53, 0x10, 0x05, 0x12, 0x1e,
+	0x0a, 0x1a, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52,
+	0x59, 0x5f, 0x43, 0x41, 0x52, 0x5f, 0x53, 0x45, 0x54, 0x55, 0x50, 0x53, 0x10, 0x06, 0x12, 0x21,
+	0x0a, 0x1d, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52,
+	0x59, 0x5f, 0x43, 0x41, 0x52, 0x5f, 0x54, 0x45, 0x4c, 0x45, 0x4d, 0x45, 0x54, 0x52, 0x59, 0x10,
+	0x07, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x43, 0x41, 0x54, 0x45,
+	0x47, 0x4f, 0x52, 0x59, 0x5f, 0x43, 0x41, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x10,
+	0x08, 0x12, 0x28, 0x0a, 0x24, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x43, 0x41, 0x54, 0x45,
+	0x47, 0x4f, 0x52, 0x59, 0x5f, 0x46, 0x49, 0x4e, 0x41, 0x4c, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53,
+	0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x09, 0x12, 0x1e, 0x0a, 0x1a, 0x50,
+	0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x4c,
+	0x4f, 0x42, 0x42, 0x59, 0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x0a, 0x12, 0x1e, 0x0a, 0x1a, 0x50,
+	0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x43,
+	0x41, 0x52, 0x5f, 0x44, 0x41, 0x4d, 0x41, 0x47, 0x45, 0x10, 0x0b, 0x12, 0x23, 0x0a, 0x1f, 0x50,
+	0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x53,
+	0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x10, 0x0c,
+	0x12, 0x1d, 0x0a, 0x19, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47,
+	0x4f, 0x52, 0x59, 0x5f, 0x54, 0x59, 0x52, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x53, 0x10, 0x0d, 0x12,
+	0x1d, 0x0a, 0x19, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f,
+	0x52, 0x59, 0x5f, 0x4d, 0x4f, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x10, 0x0e, 0x32, 0x60,
+	0x0a, 0x10, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x4c, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12,
+	0x1e, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x30, 0x01,
+	0x42, 0x0c, 0x5a, 0x0a, 0x2e, 0x3b, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_telemetry_proto_rawDescOnce sync.Once
+	file_telemetry_proto_rawDescData = file_telemetry_proto_rawDesc
+)
+
+func file_telemetry_proto_rawDescGZIP() []byte {
+	file_telemetry_proto_rawDescOnce.Do(func() {
+		file_telemetry_proto_rawDescData = protoimpl.X.CompressGZIP(file_telemetry_proto_rawDescData)
+	})
+	return file_telemetry_proto_rawDescData
+}
+
+var file_telemetry_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_telemetry_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
+var file_telemetry_proto_goTypes = []interface{}{
+	(PacketCategory)(0),                   // 0: telemetry.v1.PacketCategory
+	(*SubscribeRequest)(nil),              // 1: telemetry.v1.SubscribeRequest
+	(*TelemetryUpdate)(nil),               // 2: telemetry.v1.TelemetryUpdate
+	(*PacketHeader)(nil),                  // 3: telemetry.v1.PacketHeader
+	(*Wheels)(nil),                        // 4: telemetry.v1.Wheels
+	(*PacketMotionData)(nil),              // 5: telemetry.v1.PacketMotionData
+	(*CarMotionData)(nil),                 // 6: telemetry.v1.CarMotionData
+	(*PacketSessionData)(nil),             // 7: telemetry.v1.PacketSessionData
+	(*MarshalZone)(nil),                   // 8: telemetry.v1.MarshalZone
+	(*WeatherForecastSample)(nil),         // 9: telemetry.v1.WeatherForecastSample
+	(*PacketLapData)(nil),                 // 10: telemetry.v1.PacketLapData
+	(*LapData)(nil),                       // 11: telemetry.v1.LapData
+	(*PacketEventData)(nil),               // 12: telemetry.v1.PacketEventData
+	(*VehicleEvent)(nil),                  // 13: telemetry.v1.VehicleEvent
+	(*FastestLap)(nil),                    // 14: telemetry.v1.FastestLap
+	(*Penalty)(nil),                       // 15: telemetry.v1.Penalty
+	(*SpeedTrap)(nil),                     // 16: telemetry.v1.SpeedTrap
+	(*StartLights)(nil),                   // 17: telemetry.v1.StartLights
+	(*Flashback)(nil),                     // 18: telemetry.v1.Flashback
+	(*Buttons)(nil),                       // 19: telemetry.v1.Buttons
+	(*Overtake)(nil),                      // 20: telemetry.v1.Overtake
+	(*SafetyCar)(nil),                     // 21: telemetry.v1.SafetyCar
+	(*Collision)(nil),                     // 22: telemetry.v1.Collision
+	(*PacketParticipantsData)(nil),        // 23: telemetry.v1.PacketParticipantsData
+	(*ParticipantData)(nil),               // 24: telemetry.v1.ParticipantData
+	(*PacketCarSetupsData)(nil),           // 25: telemetry.v1.PacketCarSetupsData
+	(*CarSetupData)(nil),                  // 26: telemetry.v1.CarSetupData
+	(*PacketCarTelemetryData)(nil),        // 27: telemetry.v1.PacketCarTelemetryData
+	(*CarTelemetryData)(nil),              // 28: telemetry.v1.CarTelemetryData
+	(*PacketCarStatusData)(nil),           // 29: telemetry.v1.PacketCarStatusData
+	(*CarStatusData)(nil),                 // 30: telemetry.v1.CarStatusData
+	(*PacketFinalClassificationData)(nil), // 31: telemetry.v1.PacketFinalClassificationData
+	(*FinalClassificationData)(nil),       // 32: telemetry.v1.FinalClassificationData
+	(*PacketLobbyInfoData)(nil),           // 33: telemetry.v1.PacketLobbyInfoData
+	(*LobbyInfoData)(nil),                 // 34: telemetry.v1.LobbyInfoData
+	(*PacketCarDamageData)(nil),           // 35: telemetry.v1.PacketCarDamageData
+	(*CarDamageData)(nil),                 // 36: telemetry.v1.CarDamageData
+	(*PacketSessionHistoryData)(nil),      // 37: telemetry.v1.PacketSessionHistoryData
+	(*LapHistoryData)(nil),                // 38: telemetry.v1.LapHistoryData
+	(*TyreStintHistoryData)(nil),          // 39: telemetry.v1.TyreStintHistoryData
+	(*PacketTyreSetsData)(nil),            // 40: telemetry.v1.PacketTyreSetsData
+	(*TyreSetData)(nil),                   // 41: telemetry.v1.TyreSetData
+	(*PacketMotionExData)(nil),            // 42: telemetry.v1.PacketMotionExData
+}
+var file_telemetry_proto_depIdxs = []int32{
+	0,  // 0: telemetry.v1.SubscribeRequest.categories:type_name -> telemetry.v1.PacketCategory
+	0,  // 1: telemetry.v1.TelemetryUpdate.category:type_name -> telemetry.v1.PacketCategory
+	5,  // 2: telemetry.v1.TelemetryUpdate.motion:type_name -> telemetry.v1.PacketMotionData
+	7,  // 3: telemetry.v1.TelemetryUpdate.session:type_name -> telemetry.v1.PacketSessionData
+	10, // 4: telemetry.v1.TelemetryUpdate.lap_data:type_name -> telemetry.v1.PacketLapData
+	12, // 5: telemetry.v1.TelemetryUpdate.event:type_name -> telemetry.v1.PacketEventData
+	23, // 6: telemetry.v1.TelemetryUpdate.participants:type_name -> telemetry.v1.PacketParticipantsData
+	25, // 7: telemetry.v1.TelemetryUpdate.car_setups:type_name -> telemetry.v1.PacketCarSetupsData
+	27, // 8: telemetry.v1.TelemetryUpdate.car_telemetry:type_name -> telemetry.v1.PacketCarTelemetryData
+	29, // 9: telemetry.v1.TelemetryUpdate.car_status:type_name -> telemetry.v1.PacketCarStatusData
+	31, // 10: telemetry.v1.TelemetryUpdate.final_classification:type_name -> telemetry.v1.PacketFinalClassificationData
+	33, // 11: telemetry.v1.TelemetryUpdate.lobby_info:type_name -> telemetry.v1.PacketLobbyInfoData
+	35, // 12: telemetry.v1.TelemetryUpdate.car_damage:type_name -> telemetry.v1.PacketCarDamageData
+	37, // 13: telemetry.v1.TelemetryUpdate.session_history:type_name -> telemetry.v1.PacketSessionHistoryData
+	40, // 14: telemetry.v1.TelemetryUpdate.tyre_sets:type_name -> telemetry.v1.PacketTyreSetsData
+	42, // 15: telemetry.v1.TelemetryUpdate.motion_ex:type_name -> telemetry.v1.PacketMotionExData
+	3,  // 16: telemetry.v1.PacketMotionData.header:type_name -> telemetry.v1.PacketHeader
+	6,  // 17: telemetry.v1.PacketMotionData.car_motion_data:type_name -> telemetry.v1.CarMotionData
+	3,  // 18: telemetry.v1.PacketSessionData.header:type_name -> telemetry.v1.PacketHeader
+	8,  // 19: telemetry.v1.PacketSessionData.marshal_zones:type_name -> telemetry.v1.MarshalZone
+	9,  // 20: telemetry.v1.PacketSessionData.weather_forecast_samples:type_name -> telemetry.v1.WeatherForecastSample
+	3,  // 21: telemetry.v1.PacketLapData.header:type_name -> telemetry.v1.PacketHeader
+	11, // 22: telemetry.v1.PacketLapData.lap_data:type_name -> telemetry.v1.LapData
+	3,  // 23: telemetry.v1.PacketEventData.header:type_name -> telemetry.v1.PacketHeader
+	14, // 24: telemetry.v1.PacketEventData.fastest_lap:type_name -> telemetry.v1.FastestLap
+	13, // 25: telemetry.v1.PacketEventData.retirement:type_name -> telemetry.v1.VehicleEvent
+	13, // 26: telemetry.v1.PacketEventData.team_mate_in_pits:type_name -> telemetry.v1.VehicleEvent
+	13, // 27: telemetry.v1.PacketEventData.race_winner:type_name -> telemetry.v1.VehicleEvent
+	15, // 28: telemetry.v1.PacketEventData.penalty:type_name -> telemetry.v1.Penalty
+	16, // 29: telemetry.v1.PacketEventData.speed_trap:type_name -> telemetry.v1.SpeedTrap
+	17, // 30: telemetry.v1.PacketEventData.start_lights:type_name -> telemetry.v1.StartLights
+	13, // 31: telemetry.v1.PacketEventData.drive_through_penalty_served:type_name -> telemetry.v1.VehicleEvent
+	13, // 32: telemetry.v1.PacketEventData.stop_go_penalty_served:type_name -> telemetry.v1.VehicleEvent
+	18, // 33: telemetry.v1.PacketEventData.flashback:type_name -> telemetry.v1.Flashback
+	19, // 34: telemetry.v1.PacketEventData.buttons:type_name -> telemetry.v1.Buttons
+	20, // 35: telemetry.v1.PacketEventData.overtake:type_name -> telemetry.v1.Overtake
+	21, // 36: telemetry.v1.PacketEventData.safety_car:type_name -> telemetry.v1.SafetyCar
+	22, // 37: telemetry.v1.PacketEventData.collision:type_name -> telemetry.v1.Collision
+	3,  // 38: telemetry.v1.PacketParticipantsData.header:type_name -> telemetry.v1.PacketHeader
+	24, // 39: telemetry.v1.PacketParticipantsData.participants:type_name -> telemetry.v1.ParticipantData
+	3,  // 40: telemetry.v1.PacketCarSetupsData.header:type_name -> telemetry.v1.PacketHeader
+	26, // 41: telemetry.v1.PacketCarSetupsData.car_setups:type_name -> telemetry.v1.CarSetupData
+	3,  // 42: telemetry.v1.PacketCarTelemetryData.header:type_name -> telemetry.v1.PacketHeader
+	28, // 43: telemetry.v1.PacketCarTelemetryData.car_telemetry_data:type_name -> telemetry.v1.CarTelemetryData
+	4,  // 44: telemetry.v1.CarTelemetryData.brakes_temperature:type_name -> telemetry.v1.Wheels
+	4,  // 45: telemetry.v1.CarTelemetryData.tyres_surface_temperature:type_name -> telemetry.v1.Wheels
+	4,  // 46: telemetry.v1.CarTelemetryData.tyres_inner_temperature:type_name -> telemetry.v1.Wheels
+	4,  // 47: telemetry.v1.CarTelemetryData.tyres_pressure:type_name -> telemetry.v1.Wheels
+	4,  // 48: telemetry.v1.CarTelemetryData.surface_type:type_name -> telemetry.v1.Wheels
+	3,  // 49: telemetry.v1.PacketCarStatusData.header:type_name -> telemetry.v1.PacketHeader
+	30, // 50: telemetry.v1.PacketCarStatusData.car_status_data:type_name -> telemetry.v1.CarStatusData
+	3,  // 51: telemetry.v1.PacketFinalClassificationData.header:type_name -> telemetry.v1.PacketHeader
+	32, // 52: telemetry.v1.PacketFinalClassificationData.classification_data:type_name -> telemetry.v1.FinalClassificationData
+	3,  // 53: telemetry.v1.PacketLobbyInfoData.header:type_name -> telemetry.v1.PacketHeader
+	34, // 54: telemetry.v1.PacketLobbyInfoData.lobby_players:type_name -> telemetry.v1.LobbyInfoData
+	3,  // 55: telemetry.v1.PacketCarDamageData.header:type_name -> telemetry.v1.PacketHeader
+	36, // 56: telemetry.v1.PacketCarDamageData.car_damage_data:type_name -> telemetry.v1.CarDamageData
+	4,  // 57: telemetry.v1.CarDamageData.tyres_wear:type_name -> telemetry.v1.Wheels
+	4,  // 58: telemetry.v1.CarDamageData.tyres_damage:type_name -> telemetry.v1.Wheels
+	4,  // 59: telemetry.v1.CarDamageData.brakes_damage:type_name -> telemetry.v1.Wheels
+	3,  // 60: telemetry.v1.PacketSessionHistoryData.header:type_name -> telemetry.v1.PacketHeader
+	38, // 61: telemetry.v1.PacketSessionHistoryData.lap_history_data:type_name -> telemetry.v1.LapHistoryData
+	39, // 62: telemetry.v1.PacketSessionHistoryData.tyre_stints_history_data:type_name -> telemetry.v1.TyreStintHistoryData
+	3,  // 63: telemetry.v1.PacketTyreSetsData.header:type_name -> telemetry.v1.PacketHeader
+	41, // 64: telemetry.v1.PacketTyreSetsData.tyre_set_data:type_name -> telemetry.v1.TyreSetData
+	3,  // 65: telemetry.v1.PacketMotionExData.header:type_name -> telemetry.v1.PacketHeader
+	4,  // 66: telemetry.v1.PacketMotionExData.suspension_position:type_name -> telemetry.v1.Wheels
+	4,  // 67: telemetry.v1.PacketMotionExData.suspension_velocity:type_name -> telemetry.v1.Wheels
+	4,  // 68: telemetry.v1.PacketMotionExData.suspension_acceleration:type_name -> telemetry.v1.Wheels
+	4,  // 69: telemetry.v1.PacketMotionExData.wheel_speed:type_name -> telemetry.v1.Wheels
+	4,  // 70: telemetry.v1.PacketMotionExData.wheel_slip_ratio:type_name -> telemetry.v1.Wheels
+	4,  // 71: telemetry.v1.PacketMotionExData.wheel_slip_angle:type_name -> telemetry.v1.Wheels
+	4,  // 72: telemetry.v1.PacketMotionExData.wheel_lat_force:type_name -> telemetry.v1.Wheels
+	4,  // 73: telemetry.v1.PacketMotionExData.wheel_long_force:type_name -> telemetry.v1.Wheels
+	4,  // 74: telemetry.v1.PacketMotionExData.wheel_vert_force:type_name -> telemetry.v1.Wheels
+	1,  // 75: telemetry.v1.TelemetryService.Subscribe:input_type -> telemetry.v1.SubscribeRequest
+	2,  // 76: telemetry.v1.TelemetryService.Subscribe:output_type -> telemetry.v1.TelemetryUpdate
+	76, // [76:77] is the sub-list for method output_type
+	75, // [75:76] is the sub-list for method input_type
+	75, // [75:75] is the sub-list for extension type_name
+	75, // [75:75] is the sub-list for extension extendee
+	0,  // [0:75] is the sub-list for field type_name
+}
+
+func init() { file_telemetry_proto_init() }
+func file_telemetry_proto_init() {
+	if File_telemetry_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_telemetry_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TelemetryUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PacketHeader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Wheels); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PacketMotionData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CarMotionData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PacketSessionData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarshalZone); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WeatherForecastSample); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PacketLapData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LapData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PacketEventData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VehicleEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FastestLap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Penalty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpeedTrap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartLights); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Flashback); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Buttons); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Overtake); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SafetyCar); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Collision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PacketParticipantsData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParticipantData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PacketCarSetupsData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CarSetupData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PacketCarTelemetryData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CarTelemetryData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PacketCarStatusData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CarStatusData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PacketFinalClassificationData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FinalClassificationData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PacketLobbyInfoData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LobbyInfoData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PacketCarDamageData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CarDamageData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PacketSessionHistoryData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LapHistoryData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TyreStintHistoryData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PacketTyreSetsData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TyreSetData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_telemetry_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PacketMotionExData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_telemetry_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*TelemetryUpdate_Motion)(nil),
+		(*TelemetryUpdate_Session)(nil),
+		(*TelemetryUpdate_LapData)(nil),
+		(*TelemetryUpdate_Event)(nil),
+		(*TelemetryUpdate_Participants)(nil),
+		(*TelemetryUpdate_CarSetups)(nil),
+		(*TelemetryUpdate_CarTelemetry)(nil),
+		(*TelemetryUpdate_CarStatus)(nil),
+		(*TelemetryUpdate_FinalClassification)(nil),
+		(*TelemetryUpdate_LobbyInfo)(nil),
+		(*TelemetryUpdate_CarDamage)(nil),
+		(*TelemetryUpdate_SessionHistory)(nil),
+		(*TelemetryUpdate_TyreSets)(nil),
+		(*TelemetryUpdate_MotionEx)(nil),
+	}
+	file_telemetry_proto_msgTypes[11].OneofWrappers = []interface{}{
+		(*PacketEventData_FastestLap)(nil),
+		(*PacketEventData_Retirement)(nil),
+		(*PacketEventData_TeamMateInPits)(nil),
+		(*PacketEventData_RaceWinner)(nil),
+		(*PacketEventData_Penalty)(nil),
+		(*PacketEventData_SpeedTrap)(nil),
+		(*PacketEventData_StartLights)(nil),
+		(*PacketEventData_DriveThroughPenaltyServed)(nil),
+		(*PacketEventData_StopGoPenaltyServed)(nil),
+		(*PacketEventData_Flashback)(nil),
+		(*PacketEventData_Buttons)(nil),
+		(*PacketEventData_Overtake)(nil),
+		(*PacketEventData_SafetyCar)(nil),
+		(*PacketEventData_Collision)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_telemetry_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   42,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_telemetry_proto_goTypes,
+		DependencyIndexes: file_telemetry_proto_depIdxs,
+		EnumInfos:         file_telemetry_proto_enumTypes,
+		MessageInfos:      file_telemetry_proto_msgTypes,
+	}.Build()
+	File_telemetry_proto = out.File
+	file_telemetry_proto_rawDesc = nil
+	file_telemetry_proto_goTypes = nil
+	file_telemetry_proto_depIdxs = nil
+}
@@ -0,0 +1,856 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v4.24.1
+// source: trackmap.proto
+
+package grpc_gen
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ListTrackMapsRequest is a request to list the track maps
+type ListTrackMapsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListTrackMapsRequest) Reset() {
+	*x = ListTrackMapsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trackmap_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTrackMapsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrackMapsRequest) ProtoMessage() {}
+
+func (x *ListTrackMapsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trackmap_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrackMapsRequest.ProtoReflect.Descriptor instead.
+func (*ListTrackMapsRequest) Descriptor() ([]byte, []int) {
+	return file_trackmap_proto_rawDescGZIP(), []int{0}
+}
+
+// ListTrackMapsResponse is a response to a ListTrackMapsRequest
+type ListTrackMapsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Maps []*TrackMapSummary `protobuf:"bytes,1,rep,name=maps,proto3" json:"maps,omitempty"`
+}
+
+func (x *ListTrackMapsResponse) Reset() {
+	*x = ListTrackMapsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trackmap_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTrackMapsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrackMapsResponse) ProtoMessage() {}
+
+func (x *ListTrackMapsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trackmap_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrackMapsResponse.ProtoReflect.Descriptor instead.
+func (*ListTrackMapsResponse) Descriptor() ([]byte, []int) {
+	return file_trackmap_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListTrackMapsResponse) GetMaps() []*TrackMapSummary {
+	if x != nil {
+		return x.Maps
+	}
+	return nil
+}
+
+// GetTrackMapRequest is a request to get the map of a track
+type GetTrackMapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrackId int32 `protobuf:"varint,1,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
+}
+
+func (x *GetTrackMapRequest) Reset() {
+	*x = GetTrackMapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trackmap_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTrackMapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrackMapRequest) ProtoMessage() {}
+
+func (x *GetTrackMapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trackmap_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrackMapRequest.ProtoReflect.Descriptor instead.
+func (*GetTrackMapRequest) Descriptor() ([]byte, []int) {
+	return file_trackmap_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetTrackMapRequest) GetTrackId() int32 {
+	if x != nil {
+		return x.TrackId
+	}
+	return 0
+}
+
+// GetTrackMapResponse is a response to a GetTrackMapRequest
+type GetTrackMapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Map *TrackMap `protobuf:"bytes,1,opt,name=map,proto3" json:"map,omitempty"`
+}
+
+func (x *GetTrackMapResponse) Reset() {
+	*x = GetTrackMapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trackmap_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTrackMapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrackMapResponse) ProtoMessage() {}
+
+func (x *GetTrackMapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trackmap_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrackMapResponse.ProtoReflect.Descriptor instead.
+func (*GetTrackMapResponse) Descriptor() ([]byte, []int) {
+	return file_trackmap_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetTrackMapResponse) GetMap() *TrackMap {
+	if x != nil {
+		return x.Map
+	}
+	return nil
+}
+
+// DeleteTrackMapRequest is a request to delete the map of a track
+type DeleteTrackMapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrackId int32 `protobuf:"varint,1,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
+}
+
+func (x *DeleteTrackMapRequest) Reset() {
+	*x = DeleteTrackMapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trackmap_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteTrackMapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTrackMapRequest) ProtoMessage() {}
+
+func (x *DeleteTrackMapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trackmap_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTrackMapRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTrackMapRequest) Descriptor() ([]byte, []int) {
+	return file_trackmap_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteTrackMapRequest) GetTrackId() int32 {
+	if x != nil {
+		return x.TrackId
+	}
+	return 0
+}
+
+// DeleteTrackMapResponse is a response to a DeleteTrackMapRequest
+type DeleteTrackMapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteTrackMapResponse) Reset() {
+	*x = DeleteTrackMapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trackmap_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteTrackMapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTrackMapResponse) ProtoMessage() {}
+
+func (x *DeleteTrackMapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trackmap_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTrackMapResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTrackMapResponse) Descriptor() ([]byte, []int) {
+	return file_trackmap_proto_rawDescGZIP(), []int{5}
+}
+
+// TrackMapSummary describes the map of a track
+type TrackMapSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrackId     int32  `protobuf:"varint,1,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
+	TrackLength uint32 `protobuf:"varint,2,opt,name=track_length,json=trackLength,proto3" json:"track_length,omitempty"` // meters
+	Laps        uint32 `protobuf:"varint,3,opt,name=laps,proto3" json:"laps,omitempty"`                                  // the amount of clean laps the outline is made of
+	PitStops    uint32 `protobuf:"varint,4,opt,name=pit_stops,json=pitStops,proto3" json:"pit_stops,omitempty"`          // the amount of drives through the pit lane it is made of
+	Updated     int64  `protobuf:"varint,5,opt,name=updated,proto3" json:"updated,omitempty"`                            // unix seconds
+}
+
+func (x *TrackMapSummary) Reset() {
+	*x = TrackMapSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trackmap_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrackMapSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackMapSummary) ProtoMessage() {}
+
+func (x *TrackMapSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_trackmap_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackMapSummary.ProtoReflect.Descriptor instead.
+func (*TrackMapSummary) Descriptor() ([]byte, []int) {
+	return file_trackmap_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TrackMapSummary) GetTrackId() int32 {
+	if x != nil {
+		return x.TrackId
+	}
+	return 0
+}
+
+func (x *TrackMapSummary) GetTrackLength() uint32 {
+	if x != nil {
+		return x.TrackLength
+	}
+	return 0
+}
+
+func (x *TrackMapSummary) GetLaps() uint32 {
+	if x != nil {
+		return x.Laps
+	}
+	return 0
+}
+
+func (x *TrackMapSummary) GetPitStops() uint32 {
+	if x != nil {
+		return x.PitStops
+	}
+	return 0
+}
+
+func (x *TrackMapSummary) GetUpdated() int64 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+// TrackMap is the map of a track. The points are normalized to a square of 0 to 1 keeping the aspect ratio,
+// x is the world x axis and y the world z axis
+type TrackMap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Summary         *TrackMapSummary `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
+	Outline         []*MapPoint      `protobuf:"bytes,2,rep,name=outline,proto3" json:"outline,omitempty"`                                                 // evenly spread over the lap distance, the first on the line
+	SectorDistances []float32        `protobuf:"fixed32,3,rep,packed,name=sector_distances,json=sectorDistances,proto3" json:"sector_distances,omitempty"` // the lap distance in meters sector 2 and 3 start at, empty if unknown
+	Sectors         []*MapPoint      `protobuf:"bytes,4,rep,name=sectors,proto3" json:"sectors,omitempty"`                                                 // the positions sector 2 and 3 start at, empty if unknown
+	PitLane         []*MapPoint      `protobuf:"bytes,5,rep,name=pit_lane,json=pitLane,proto3" json:"pit_lane,omitempty"`                                  // from the pit entry to the exit, empty if no pit stop was seen
+	Transform       *MapTransform    `protobuf:"bytes,6,opt,name=transform,proto3" json:"transform,omitempty"`
+}
+
+func (x *TrackMap) Reset() {
+	*x = TrackMap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trackmap_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrackMap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackMap) ProtoMessage() {}
+
+func (x *TrackMap) ProtoReflect() protoreflect.Message {
+	mi := &file_trackmap_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackMap.ProtoReflect.Descriptor instead.
+func (*TrackMap) Descriptor() ([]byte, []int) {
+	return file_trackmap_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TrackMap) GetSummary() *TrackMapSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *TrackMap) GetOutline() []*MapPoint {
+	if x != nil {
+		return x.Outline
+	}
+	return nil
+}
+
+func (x *TrackMap) GetSectorDistances() []float32 {
+	if x != nil {
+		return x.SectorDistances
+	}
+	return nil
+}
+
+func (x *TrackMap) GetSectors() []*MapPoint {
+	if x != nil {
+		return x.Sectors
+	}
+	return nil
+}
+
+func (x *TrackMap) GetPitLane() []*MapPoint {
+	if x != nil {
+		return x.PitLane
+	}
+	return nil
+}
+
+func (x *TrackMap) GetTransform() *MapTransform {
+	if x != nil {
+		return x.Transform
+	}
+	return nil
+}
+
+// MapPoint is a position on a track map
+type MapPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	X float32 `protobuf:"fixed32,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y float32 `protobuf:"fixed32,2,opt,name=y,proto3" json:"y,omitempty"`
+}
+
+func (x *MapPoint) Reset() {
+	*x = MapPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trackmap_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MapPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapPoint) ProtoMessage() {}
+
+func (x *MapPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_trackmap_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapPoint.ProtoReflect.Descriptor instead.
+func (*MapPoint) Descriptor() ([]byte, []int) {
+	return file_trackmap_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MapPoint) GetX() float32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *MapPoint) GetY() float32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+// MapTransform converts world positions to positions on the map: x = (world_position_x - origin_x) * scale,
+// y = (world_position_z - origin_y) * scale
+type MapTransform struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OriginX float32 `protobuf:"fixed32,1,opt,name=origin_x,json=originX,proto3" json:"origin_x,omitempty"`
+	OriginY float32 `protobuf:"fixed32,2,opt,name=origin_y,json=originY,proto3" json:"origin_y,omitempty"`
+	Scale   float32 `protobuf:"fixed32,3,opt,name=scale,proto3" json:"scale,omitempty"`
+}
+
+func (x *MapTransform) Reset() {
+	*x = MapTransform{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trackmap_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MapTransform) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapTransform) ProtoMessage() {}
+
+func (x *MapTransform) ProtoReflect() protoreflect.Message {
+	mi := &file_trackmap_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapTransform.ProtoReflect.Descriptor instead.
+func (*MapTransform) Descriptor() ([]byte, []int) {
+	return file_trackmap_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MapTransform) GetOriginX() float32 {
+	if x != nil {
+		return x.OriginX
+	}
+	return 0
+}
+
+func (x *MapTransform) GetOriginY() float32 {
+	if x != nil {
+		return x.OriginY
+	}
+	return 0
+}
+
+func (x *MapTransform) GetScale() float32 {
+	if x != nil {
+		return x.Scale
+	}
+	return 0
+}
+
+var File_trackmap_proto protoreflect.FileDescriptor
+
+var file_trackmap_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x6d, 0x61, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x22, 0x16, 0x0a,
+	0x14, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4d, 0x61, 0x70, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x49, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x4d, 0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30,
+	0x0a, 0x04, 0x6d, 0x61, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74,
+	0x72, 0x61, 0x63, 0x6b, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x4d, 0x61, 0x70, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x04, 0x6d, 0x61, 0x70, 0x73,
+	0x22, 0x2f, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4d, 0x61, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x49,
+	0x64, 0x22, 0x3e, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4d, 0x61, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x03, 0x6d, 0x61, 0x70, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x6d, 0x61, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4d, 0x61, 0x70, 0x52, 0x03, 0x6d, 0x61,
+	0x70, 0x22, 0x32, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x4d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72,
+	0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x72,
+	0x61, 0x63, 0x6b, 0x49, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x9a, 0x01, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4d, 0x61, 0x70, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x04, 0x6c, 0x61, 0x70, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x69, 0x74, 0x5f, 0x73, 0x74, 0x6f,
+	0x70, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x69, 0x74, 0x53, 0x74, 0x6f,
+	0x70, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0xba, 0x02, 0x0a,
+	0x08, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4d, 0x61, 0x70, 0x12, 0x36, 0x0a, 0x07, 0x73, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4d, 0x61,
+	0x70, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x12, 0x2f, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x61, 0x70, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x6c, 0x69,
+	0x6e, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x64, 0x69, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x02, 0x52, 0x0f, 0x73, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x2f, 0x0a,
+	0x07, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x30,
+	0x0a, 0x08, 0x70, 0x69, 0x74, 0x5f, 0x6c, 0x61, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x61, 0x70, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x70, 0x69, 0x74, 0x4c, 0x61, 0x6e, 0x65,
+	0x12, 0x37, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x6d, 0x61, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x61, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x09,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x22, 0x26, 0x0a, 0x08, 0x4d, 0x61, 0x70,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01,
+	0x79, 0x22, 0x5a, 0x0a, 0x0c, 0x4d, 0x61, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72,
+	0x6d, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x07, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x58, 0x12, 0x19, 0x0a, 0x08,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x59, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x32, 0x96, 0x02,
+	0x0a, 0x0f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4d, 0x61, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x56, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4d, 0x61,
+	0x70, 0x73, 0x12, 0x21, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4d, 0x61, 0x70, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x6d, 0x61, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4d, 0x61, 0x70,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0b, 0x47, 0x65, 0x74,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x4d, 0x61, 0x70, 0x12, 0x1f, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b,
+	0x6d, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4d,
+	0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x72, 0x61, 0x63,
+	0x6b, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4d, 0x61, 0x70, 0x12, 0x22, 0x2e,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4d, 0x61, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0c, 0x5a, 0x0a, 0x2e, 0x3b, 0x67, 0x72, 0x70, 0x63,
+	0x5f, 0x67, 0x65, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_trackmap_proto_rawDescOnce sync.Once
+	file_trackmap_proto_rawDescData = file_trackmap_proto_rawDesc
+)
+
+func file_trackmap_proto_rawDescGZIP() []byte {
+	file_trackmap_proto_rawDescOnce.Do(func() {
+		file_trackmap_proto_rawDescData = protoimpl.X.CompressGZIP(file_trackmap_proto_rawDescData)
+	})
+	return file_trackmap_proto_rawDescData
+}
+
+var file_trackmap_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_trackmap_proto_goTypes = []interface{}{
+	(*ListTrackMapsRequest)(nil),   // 0: trackmap.v1.ListTrackMapsRequest
+	(*ListTrackMapsResponse)(nil),  // 1: trackmap.v1.ListTrackMapsResponse
+	(*GetTrackMapRequest)(nil),     // 2: trackmap.v1.GetTrackMapRequest
+	(*GetTrackMapResponse)(nil),    // 3: trackmap.v1.GetTrackMapResponse
+	(*DeleteTrackMapRequest)(nil),  // 4: trackmap.v1.DeleteTrackMapRequest
+	(*DeleteTrackMapResponse)(nil), // 5: trackmap.v1.DeleteTrackMapResponse
+	(*TrackMapSummary)(nil),        // 6: trackmap.v1.TrackMapSummary
+	(*TrackMap)(nil),               // 7: trackmap.v1.TrackMap
+	(*MapPoint)(nil),               // 8: trackmap.v1.MapPoint
+	(*MapTransform)(nil),           // 9: trackmap.v1.MapTransform
+}
+var file_trackmap_proto_depIdxs = []int32{
+	6,  // 0: trackmap.v1.ListTrackMapsResponse.maps:type_name -> trackmap.v1.TrackMapSummary
+	7,  // 1: trackmap.v1.GetTrackMapResponse.map:type_name -> trackmap.v1.TrackMap
+	6,  // 2: trackmap.v1.TrackMap.summary:type_name -> trackmap.v1.TrackMapSummary
+	8,  // 3: trackmap.v1.TrackMap.outline:type_name -> trackmap.v1.MapPoint
+	8,  // 4: trackmap.v1.TrackMap.sectors:type_name -> trackmap.v1.MapPoint
+	8,  // 5: trackmap.v1.TrackMap.pit_lane:type_name -> trackmap.v1.MapPoint
+	9,  // 6: trackmap.v1.TrackMap.transform:type_name -> trackmap.v1.MapTransform
+	0,  // 7: trackmap.v1.TrackMapService.ListTrackMaps:input_type -> trackmap.v1.ListTrackMapsRequest
+	2,  // 8: trackmap.v1.TrackMapService.GetTrackMap:input_type -> trackmap.v1.GetTrackMapRequest
+	4,  // 9: trackmap.v1.TrackMapService.DeleteTrackMap:input_type -> trackmap.v1.DeleteTrackMapRequest
+	1,  // 10: trackmap.v1.TrackMapService.ListTrackMaps:output_type -> trackmap.v1.ListTrackMapsResponse
+	3,  // 11: trackmap.v1.TrackMapService.GetTrackMap:output_type -> trackmap.v1.GetTrackMapResponse
+	5,  // 12: trackmap.v1.TrackMapService.DeleteTrackMap:output_type -> trackmap.v1.DeleteTrackMapResponse
+	10, // [10:13] is the sub-list for method output_type
+	7,  // [7:10] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_trackmap_proto_init() }
+func file_trackmap_proto_init() {
+	if File_trackmap_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_trackmap_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTrackMapsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trackmap_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTrackMapsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trackmap_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTrackMapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trackmap_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTrackMapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trackmap_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteTrackMapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trackmap_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteTrackMapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trackmap_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackMapSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trackmap_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackMap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trackmap_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MapPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trackmap_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MapTransform); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_trackmap_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_trackmap_proto_goTypes,
+		DependencyIndexes: file_trackmap_proto_depIdxs,
+		MessageInfos:      file_trackmap_proto_msgTypes,
+	}.Build()
+	File_trackmap_proto = out.File
+	file_trackmap_proto_rawDesc = nil
+	file_trackmap_proto_goTypes = nil
+	file_trackmap_proto_depIdxs = nil
+}
@@ -0,0 +1,183 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v4.24.1
+// source: trackmap.proto
+
+package grpc_gen
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// TrackMapServiceClient is the client API for TrackMapService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TrackMapServiceClient interface {
+	// ListTrackMaps lists the tracks that have a map, without their outlines
+	ListTrackMaps(ctx context.Context, in *ListTrackMapsRequest, opts ...grpc.CallOption) (*ListTrackMapsResponse, error)
+	// GetTrackMap gets the map of a track, normalized to a square of 0 to 1
+	GetTrackMap(ctx context.Context, in *GetTrackMapRequest, opts ...grpc.CallOption) (*GetTrackMapResponse, error)
+	// DeleteTrackMap deletes the map of a track, so it is drawn again from the next clean laps
+	DeleteTrackMap(ctx context.Context, in *DeleteTrackMapRequest, opts ...grpc.CallOption) (*DeleteTrackMapResponse, error)
+}
+
+type trackMapServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTrackMapServiceClient(cc grpc.ClientConnInterface) TrackMapServiceClient {
+	return &trackMapServiceClient{cc}
+}
+
+func (c *trackMapServiceClient) ListTrackMaps(ctx context.Context, in *ListTrackMapsRequest, opts ...grpc.CallOption) (*ListTrackMapsResponse, error) {
+	out := new(ListTrackMapsResponse)
+	err := c.cc.Invoke(ctx, "/trackmap.v1.TrackMapService/ListTrackMaps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackMapServiceClient) GetTrackMap(ctx context.Context, in *GetTrackMapRequest, opts ...grpc.CallOption) (*GetTrackMapResponse, error) {
+	out := new(GetTrackMapResponse)
+	err := c.cc.Invoke(ctx, "/trackmap.v1.TrackMapService/GetTrackMap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackMapServiceClient) DeleteTrackMap(ctx context.Context, in *DeleteTrackMapRequest, opts ...grpc.CallOption) (*DeleteTrackMapResponse, error) {
+	out := new(DeleteTrackMapResponse)
+	err := c.cc.Invoke(ctx, "/trackmap.v1.TrackMapService/DeleteTrackMap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TrackMapServiceServer is the server API for TrackMapService service.
+// All implementations must embed UnimplementedTrackMapServiceServer
+// for forward compatibility
+type TrackMapServiceServer interface {
+	// ListTrackMaps lists the tracks that have a map, without their outlines
+	ListTrackMaps(context.Context, *ListTrackMapsRequest) (*ListTrackMapsResponse, error)
+	// GetTrackMap gets the map of a track, normalized to a square of 0 to 1
+	GetTrackMap(context.Context, *GetTrackMapRequest) (*GetTrackMapResponse, error)
+	// DeleteTrackMap deletes the map of a track, so it is drawn again from the next clean laps
+	DeleteTrackMap(context.Context, *DeleteTrackMapRequest) (*DeleteTrackMapResponse, error)
+	mustEmbedUnimplementedTrackMapServiceServer()
+}
+
+// UnimplementedTrackMapServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTrackMapServiceServer struct {
+}
+
+func (UnimplementedTrackMapServiceServer) ListTrackMaps(context.Context, *ListTrackMapsRequest) (*ListTrackMapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrackMaps not implemented")
+}
+func (UnimplementedTrackMapServiceServer) GetTrackMap(context.Context, *GetTrackMapRequest) (*GetTrackMapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrackMap not implemented")
+}
+func (UnimplementedTrackMapServiceServer) DeleteTrackMap(context.Context, *DeleteTrackMapRequest) (*DeleteTrackMapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTrackMap not implemented")
+}
+func (UnimplementedTrackMapServiceServer) mustEmbedUnimplementedTrackMapServiceServer() {}
+
+// UnsafeTrackMapServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TrackMapServiceServer will
+// result in compilation errors.
+type UnsafeTrackMapServiceServer interface {
+	mustEmbedUnimplementedTrackMapServiceServer()
+}
+
+func RegisterTrackMapServiceServer(s grpc.ServiceRegistrar, srv TrackMapServiceServer) {
+	s.RegisterService(&TrackMapService_ServiceDesc, srv)
+}
+
+func _TrackMapService_ListTrackMaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrackMapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackMapServiceServer).ListTrackMaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/trackmap.v1.TrackMapService/ListTrackMaps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackMapServiceServer).ListTrackMaps(ctx, req.(*ListTrackMapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackMapService_GetTrackMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrackMapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackMapServiceServer).GetTrackMap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/trackmap.v1.TrackMapService/GetTrackMap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackMapServiceServer).GetTrackMap(ctx, req.(*GetTrackMapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackMapService_DeleteTrackMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTrackMapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackMapServiceServer).DeleteTrackMap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/trackmap.v1.TrackMapService/DeleteTrackMap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackMapServiceServer).DeleteTrackMap(ctx, req.(*DeleteTrackMapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TrackMapService_ServiceDesc is the grpc.ServiceDesc for TrackMapService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TrackMapService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "trackmap.v1.TrackMapService",
+	HandlerType: (*TrackMapServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListTrackMaps",
+			Handler:    _TrackMapService_ListTrackMaps_Handler,
+		},
+		{
+			MethodName: "GetTrackMap",
+			Handler:    _TrackMapService_GetTrackMap_Handler,
+		},
+		{
+			MethodName: "DeleteTrackMap",
+			Handler:    _TrackMapService_DeleteTrackMap_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "trackmap.proto",
+}
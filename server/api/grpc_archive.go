@@ -0,0 +1,206 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	grpc_gen "github.com/DaanV2/f1-game-dashboards/server/api/grpc"
+	"github.com/DaanV2/f1-game-dashboards/server/archive"
+	"github.com/charmbracelet/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ grpc_gen.TelemetryArchiveServiceServer = &grpcServer{}
+
+// ListArchivedSessions implements grpc_gen.TelemetryArchiveServiceServer.
+func (s *grpcServer) ListArchivedSessions(ctx context.Context, req *grpc_gen.ListArchivedSessionsRequest) (*grpc_gen.ListArchivedSessionsResponse, error) {
+	if err := s.mustHaveArchive(ctx); err != nil {
+		return nil, err
+	}
+
+	list := s.archive.Sessions(req.GetPort())
+	result := make([]*grpc_gen.ArchivedSession, 0, len(list))
+	for _, session := range list {
+		result = append(result, &grpc_gen.ArchivedSession{
+			Id:         session.Id,
+			Port:       session.ChairId,
+			SessionUid: session.SessionUID,
+			Start:      session.Start.UnixMilli(),
+			Updated:    session.Updated.UnixMilli(),
+			Tiers:      session.Tiers,
+		})
+	}
+
+	return &grpc_gen.ListArchivedSessionsResponse{Sessions: result}, nil
+}
+
+// QueryTelemetry implements grpc_gen.TelemetryArchiveServiceServer.
+func (s *grpcServer) QueryTelemetry(ctx context.Context, req *grpc_gen.QueryTelemetryRequest) (*grpc_gen.QueryTelemetryResponse, error) {
+	response := grpc_gen.QueryTelemetryResponse{}
+	if err := s.mustHaveArchive(ctx); err != nil {
+		return nil, err
+	}
+	if req.GetSessionId() == "" {
+		return &response, status.Error(codes.InvalidArgument, "session_id is required")
+	}
+	if req.GetLap() > math.MaxUint8 {
+		return &response, status.Error(codes.InvalidArgument, "lap is out of range")
+	}
+
+	channels, err := channelsFromProto(req.GetChannels())
+	if err != nil {
+		return &response, err
+	}
+
+	query := archive.Query{
+		SessionId:  req.GetSessionId(),
+		Channels:   channels,
+		Resolution: time.Duration(req.GetResolutionMs()) * time.Millisecond,
+		Lap:        uint8(req.GetLap()),
+		From:       unixTime(req.GetFrom()),
+		To:         unixTime(req.GetTo()),
+	}
+
+	result, err := s.archive.Query(query)
+	if err != nil {
+		return &response, archiveError(ctx, err)
+	}
+
+	response.SessionId = result.SessionId
+	response.Tier = result.Tier
+	response.Times = make([]int64, 0, len(result.Times))
+	for _, t := range result.Times {
+		response.Times = append(response.Times, t.UnixMilli())
+	}
+	response.Series = seriesToProto(result.Channels, result.Values)
+
+	return &response, nil
+}
+
+// CompareLaps implements grpc_gen.TelemetryArchiveServiceServer.
+func (s *grpcServer) CompareLaps(ctx context.Context, req *grpc_gen.CompareLapsRequest) (*grpc_gen.CompareLapsResponse, error) {
+	response := grpc_gen.CompareLapsResponse{}
+	if err := s.mustHaveArchive(ctx); err != nil {
+		return nil, err
+	}
+	reference, err := lapRefFromProto("reference", req.GetReference())
+	if err != nil {
+		return &response, err
+	}
+	lap, err := lapRefFromProto("lap", req.GetLap())
+	if err != nil {
+		return &response, err
+	}
+	channels, err := channelsFromProto(req.GetChannels())
+	if err != nil {
+		return &response, err
+	}
+	if err := s.mustBeSameTrack(reference.SessionId, lap.SessionId); err != nil {
+		return &response, err
+	}
+
+	comparison, err := s.archive.Compare(reference, lap, channels, req.GetStep())
+	if err != nil {
+		return &response, archiveError(ctx, err)
+	}
+
+	response.Distances = comparison.Distances
+	response.Reference = lapTraceToProto(comparison.Reference, comparison.Channels)
+	response.Lap = lapTraceToProto(comparison.Lap, comparison.Channels)
+	response.Delta = comparison.Delta
+	return &response, nil
+}
+
+// mustHaveArchive checks if the user can read the telemetry archive, and if it is enabled
+func (s *grpcServer) mustHaveArchive(ctx context.Context) error {
+	if _, err := atleastGuest(ctx); err != nil {
+		return err
+	}
+	if s.archive == nil {
+		return status.Error(codes.Unavailable, "telemetry archive is not enabled")
+	}
+
+	return nil
+}
+
+// mustBeSameTrack checks that the sessions were driven on the same track, if the lap history knows both of them
+func (s *grpcServer) mustBeSameTrack(a, b string) error {
+	if s.history == nil {
+		return nil
+	}
+	first, ok := s.history.Session(a)
+	if !ok {
+		return nil
+	}
+	second, ok := s.history.Session(b)
+	if !ok {
+		return nil
+	}
+	if first.TrackId != second.TrackId {
+		return status.Error(codes.FailedPrecondition, "laps were not driven on the same track")
+	}
+
+	return nil
+}
+
+// archiveError converts the errors of the telemetry archive into grpc status errors
+func archiveError(ctx context.Context, err error) error {
+	switch {
+	case errors.Is(err, archive.ErrSessionNotFound), errors.Is(err, archive.ErrLapNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, archive.ErrInvalidStep), errors.Is(err, archive.ErrTooManyPoints):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, archive.ErrLapsDoNotOverlap), errors.Is(err, archive.ErrDifferentTracks):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		log.FromContext(ctx).Error("could not read telemetry archive", "error", err)
+		return status.Error(codes.Internal, "internal error")
+	}
+}
+
+func channelsFromProto(names []string) ([]archive.Channel, error) {
+	channels := make([]archive.Channel, 0, len(names))
+	for _, name := range names {
+		channel, ok := archive.ParseChannel(name)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown channel %q", name)
+		}
+		channels = append(channels, channel)
+	}
+
+	return channels, nil
+}
+
+func lapRefFromProto(field string, ref *grpc_gen.LapReference) (archive.LapRef, error) {
+	if ref.GetSessionId() == "" {
+		return archive.LapRef{}, status.Errorf(codes.InvalidArgument, "%s.session_id is required", field)
+	}
+	if ref.GetLap() == 0 || ref.GetLap() > math.MaxUint8 {
+		return archive.LapRef{}, status.Errorf(codes.InvalidArgument, "%s.lap is out of range", field)
+	}
+
+	return archive.LapRef{SessionId: ref.GetSessionId(), Lap: uint8(ref.GetLap())}, nil
+}
+
+func lapTraceToProto(trace archive.LapTrace, channels []archive.Channel) *grpc_gen.LapTrace {
+	return &grpc_gen.LapTrace{
+		Lap:    &grpc_gen.LapReference{SessionId: trace.SessionId, Lap: uint32(trace.Lap)},
+		Times:  trace.Times,
+		Series: seriesToProto(channels, trace.Values),
+	}
+}
+
+func seriesToProto(channels []archive.Channel, values [][]float32) []*grpc_gen.ChannelSeries {
+	series := make([]*grpc_gen.ChannelSeries, 0, len(channels))
+	for i, channel := range channels {
+		series = append(series, &grpc_gen.ChannelSeries{
+			Channel: channel.String(),
+			Values:  values[i],
+		})
+	}
+
+	return series
+}
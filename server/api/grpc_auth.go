@@ -0,0 +1,99 @@
+package api
+
+import (
+	"context"
+
+	grpc_gen "github.com/DaanV2/f1-game-dashboards/server/api/grpc"
+	"github.com/charmbracelet/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ grpc_gen.AuthServiceServer = &grpcServer{}
+
+// Login implements grpc_gen.AuthServiceServer.
+func (s *grpcServer) Login(ctx context.Context, req *grpc_gen.LoginRequest) (*grpc_gen.LoginResponse, error) {
+	response := grpc_gen.LoginResponse{}
+	logger := log.FromContext(ctx).With("email", req.GetEmail())
+
+	if req.GetEmail() == "" || req.GetPassword() == "" {
+		return &response, status.Error(codes.InvalidArgument, "email and password are required")
+	}
+
+	logger.Info("logging in")
+	token, err := s.authenicator.Login(ctx, req.GetEmail(), req.GetPassword())
+	if err != nil {
+		logger.Warn("failed to login", "error", err)
+		// Don't tell the client if the user exists
+		return &response, status.Error(codes.Unauthenticated, "invalid email or password")
+	}
+
+	response.Token = token
+	return &response, nil
+}
+
+// Refresh implements grpc_gen.AuthServiceServer.
+func (s *grpcServer) Refresh(ctx context.Context, req *grpc_gen.RefreshRequest) (*grpc_gen.RefreshResponse, error) {
+	response := grpc_gen.RefreshResponse{}
+	logger := log.FromContext(ctx)
+
+	if req.GetToken() == "" {
+		return &response, status.Error(codes.InvalidArgument, "token is required")
+	}
+
+	logger.Info("refreshing token")
+	token, err := s.authenicator.Refresh(ctx, req.GetToken())
+	if err != nil {
+		logger.Warn("failed to refresh token", "error", err)
+		return &response, status.Error(codes.Unauthenticated, "invalid token")
+	}
+
+	response.Token = token
+	return &response, nil
+}
+
+// GuestLogin implements grpc_gen.AuthServiceServer.
+func (s *grpcServer) GuestLogin(ctx context.Context, req *grpc_gen.GuestLoginRequest) (*grpc_gen.GuestLoginResponse, error) {
+	response := grpc_gen.GuestLoginResponse{}
+	logger := log.FromContext(ctx).With("name", req.GetName())
+
+	if req.GetName() == "" {
+		return &response, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	logger.Info("logging in as guest")
+	token, err := s.authenicator.Guest(req.GetName())
+	if err != nil {
+		logger.Error("failed to create guest token", "error", err)
+		return &response, status.Error(codes.Internal, "failed to create token")
+	}
+
+	response.Token = token
+	return &response, nil
+}
+
+// WhoAmI implements grpc_gen.AuthServiceServer.
+func (s *grpcServer) WhoAmI(ctx context.Context, req *grpc_gen.WhoAmIRequest) (*grpc_gen.WhoAmIResponse, error) {
+	response := grpc_gen.WhoAmIResponse{}
+	auth := getAuth(ctx)
+	if auth.Error != nil {
+		return nil, auth.Error
+	}
+	if auth.User == nil {
+		return nil, status.Error(codes.Unauthenticated, "user is not authenicated")
+	}
+
+	response.Identity = &grpc_gen.Identity{
+		Id:    auth.User.Id,
+		Email: auth.User.Email,
+		Admin: auth.User.Admin,
+		Guest: auth.User.Guest,
+	}
+	if auth.Token != nil {
+		if expires, err := auth.Token.Claims.GetExpirationTime(); err == nil && expires != nil {
+			response.Identity.ExpiresAt = expires.Unix()
+		}
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,240 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	grpc_gen "github.com/DaanV2/f1-game-dashboards/server/api/grpc"
+	"github.com/DaanV2/f1-game-dashboards/server/booking"
+	"github.com/charmbracelet/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ grpc_gen.BookingServiceServer = &grpcServer{}
+
+// CreateReservation implements grpc_gen.BookingServiceServer.
+func (s *grpcServer) CreateReservation(ctx context.Context, req *grpc_gen.CreateReservationRequest) (*grpc_gen.CreateReservationResponse, error) {
+	response := grpc_gen.CreateReservationResponse{}
+	if err := s.mustManageBookings(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.mustBeChair(req.GetPort()); err != nil {
+		return &response, err
+	}
+	if err := s.mustBeDriver(req.GetDriverId()); err != nil {
+		return &response, err
+	}
+
+	reservation, err := s.booking.Reserve(req.GetPort(), req.GetName(), req.GetDriverId(), unixTime(req.GetStart()), unixTime(req.GetEnd()))
+	if err != nil {
+		return &response, bookingError(err)
+	}
+
+	log.FromContext(ctx).Info("reserved chair", "port", reservation.ChairId, "name", reservation.Name, "start", reservation.Start, "end", reservation.End)
+	response.Reservation = reservationToProto(reservation)
+	return &response, nil
+}
+
+// GetReservation implements grpc_gen.BookingServiceServer.
+func (s *grpcServer) GetReservation(ctx context.Context, req *grpc_gen.GetReservationRequest) (*grpc_gen.GetReservationResponse, error) {
+	response := grpc_gen.GetReservationResponse{}
+	if err := s.mustHaveBookings(ctx); err != nil {
+		return nil, err
+	}
+
+	reservation, err := s.booking.Get(req.GetId())
+	if err != nil {
+		return &response, bookingError(err)
+	}
+
+	response.Reservation = reservationToProto(reservation)
+	return &response, nil
+}
+
+// ListReservations implements grpc_gen.BookingServiceServer.
+func (s *grpcServer) ListReservations(ctx context.Context, req *grpc_gen.ListReservationsRequest) (*grpc_gen.ListReservationsResponse, error) {
+	if err := s.mustHaveBookings(ctx); err != nil {
+		return nil, err
+	}
+
+	list := s.booking.Reservations(booking.ReservationQuery{
+		ChairId: req.GetPort(),
+		From:    unixTime(req.GetFrom()),
+		To:      unixTime(req.GetTo()),
+		Limit:   int(req.GetLimit()),
+	})
+	result := make([]*grpc_gen.Reservation, 0, len(list))
+	for _, reservation := range list {
+		result = append(result, reservationToProto(reservation))
+	}
+
+	return &grpc_gen.ListReservationsResponse{Reservations: result}, nil
+}
+
+// CancelReservation implements grpc_gen.BookingServiceServer.
+func (s *grpcServer) CancelReservation(ctx context.Context, req *grpc_gen.CancelReservationRequest) (*grpc_gen.CancelReservationResponse, error) {
+	response := grpc_gen.CancelReservationResponse{}
+	if err := s.mustManageBookings(ctx); err != nil {
+		return nil, err
+	}
+
+	reservation, err := s.booking.Cancel(req.GetId())
+	if err != nil {
+		return &response, bookingError(err)
+	}
+
+	log.FromContext(ctx).Info("cancelled reservation", "id", reservation.Id, "port", reservation.ChairId)
+	response.Reservation = reservationToProto(reservation)
+	return &response, nil
+}
+
+// JoinQueue implements grpc_gen.BookingServiceServer.
+func (s *grpcServer) JoinQueue(ctx context.Context, req *grpc_gen.JoinQueueRequest) (*grpc_gen.JoinQueueResponse, error) {
+	response := grpc_gen.JoinQueueResponse{}
+	if err := s.mustManageBookings(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.mustBeDriver(req.GetDriverId()); err != nil {
+		return &response, err
+	}
+
+	entry, err := s.booking.Join(req.GetName(), req.GetDriverId())
+	if err != nil {
+		return &response, bookingError(err)
+	}
+
+	position := slices.IndexFunc(s.booking.Queue(), func(e booking.QueueEntry) bool { return e.Id == entry.Id }) + 1
+	response.Entry = queueEntryToProto(entry, position)
+	return &response, nil
+}
+
+// LeaveQueue implements grpc_gen.BookingServiceServer.
+func (s *grpcServer) LeaveQueue(ctx context.Context, req *grpc_gen.LeaveQueueRequest) (*grpc_gen.LeaveQueueResponse, error) {
+	response := grpc_gen.LeaveQueueResponse{}
+	if err := s.mustManageBookings(ctx); err != nil {
+		return nil, err
+	}
+
+	entry, err := s.booking.Leave(req.GetId())
+	if err != nil {
+		return &response, bookingError(err)
+	}
+
+	response.Entry = queueEntryToProto(entry, 0)
+	return &response, nil
+}
+
+// ListQueue implements grpc_gen.BookingServiceServer.
+func (s *grpcServer) ListQueue(ctx context.Context, req *grpc_gen.ListQueueRequest) (*grpc_gen.ListQueueResponse, error) {
+	if err := s.mustHaveBookings(ctx); err != nil {
+		return nil, err
+	}
+
+	queue := s.booking.Queue()
+	result := make([]*grpc_gen.QueueEntry, 0, len(queue))
+	for i, entry := range queue {
+		result = append(result, queueEntryToProto(entry, i+1))
+	}
+
+	return &grpc_gen.ListQueueResponse{Entries: result}, nil
+}
+
+// ServeNext implements grpc_gen.BookingServiceServer.
+func (s *grpcServer) ServeNext(ctx context.Context, req *grpc_gen.ServeNextRequest) (*grpc_gen.ServeNextResponse, error) {
+	response := grpc_gen.ServeNextResponse{}
+	if err := s.mustManageBookings(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.mustBeChair(req.GetPort()); err != nil {
+		return &response, err
+	}
+	if req.GetDuration() == 0 {
+		return &response, status.Error(codes.InvalidArgument, "duration is required")
+	}
+
+	reservation, entry, err := s.booking.ServeNext(req.GetPort(), time.Duration(req.GetDuration())*time.Second)
+	if err != nil {
+		return &response, bookingError(err)
+	}
+
+	log.FromContext(ctx).Info("served walk-in", "port", reservation.ChairId, "name", entry.Name, "end", reservation.End)
+	response.Reservation = reservationToProto(reservation)
+	response.Entry = queueEntryToProto(entry, 0)
+	return &response, nil
+}
+
+// mustHaveBookings checks if the user can read the bookings, and if they are enabled
+func (s *grpcServer) mustHaveBookings(ctx context.Context) error {
+	if _, err := atleastGuest(ctx); err != nil {
+		return err
+	}
+	if s.booking == nil {
+		return status.Error(codes.Unavailable, "bookings are not enabled")
+	}
+
+	return nil
+}
+
+// mustManageBookings checks if the user is an admin, and if the bookings are enabled
+func (s *grpcServer) mustManageBookings(ctx context.Context) error {
+	if _, err := mustBeAdmin(ctx); err != nil {
+		return err
+	}
+	if s.booking == nil {
+		return status.Error(codes.Unavailable, "bookings are not enabled")
+	}
+
+	return nil
+}
+
+// mustBeDriver checks if the driver id exists, an empty id is allowed
+func (s *grpcServer) mustBeDriver(driverId string) error {
+	if driverId == "" || s.drivers == nil {
+		return nil
+	}
+	if _, err := s.drivers.Get(driverId); err != nil {
+		return status.Error(codes.InvalidArgument, "driver_id is not a driver")
+	}
+
+	return nil
+}
+
+// bookingError converts the errors of the booking manager into grpc status errors
+func bookingError(err error) error {
+	switch {
+	case errors.Is(err, booking.ErrReservationNotFound), errors.Is(err, booking.ErrQueueEntryNotFound), errors.Is(err, booking.ErrChairNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, booking.ErrNameRequired), errors.Is(err, booking.ErrInvalidSlot):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, booking.ErrSlotTaken), errors.Is(err, booking.ErrQueueEmpty):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, "internal error")
+	}
+}
+
+func reservationToProto(reservation booking.Reservation) *grpc_gen.Reservation {
+	return &grpc_gen.Reservation{
+		Id:       reservation.Id,
+		Port:     reservation.ChairId,
+		Name:     reservation.Name,
+		DriverId: reservation.DriverId,
+		Start:    reservation.Start.UnixMilli(),
+		End:      reservation.End.UnixMilli(),
+		Created:  reservation.Created.UnixMilli(),
+	}
+}
+
+// queueEntryToProto converts the walk-in, a position of 0 means it is no longer in line
+func queueEntryToProto(entry booking.QueueEntry, position int) *grpc_gen.QueueEntry {
+	return &grpc_gen.QueueEntry{
+		Id:       entry.Id,
+		Name:     entry.Name,
+		DriverId: entry.DriverId,
+		Joined:   entry.Joined.UnixMilli(),
+		Position: uint32(position),
+	}
+}
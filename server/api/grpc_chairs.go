@@ -2,21 +2,32 @@ package api
 
 import (
 	"context"
+	"math"
+	"net"
+	"slices"
+	"strconv"
 
 	grpc_gen "github.com/DaanV2/f1-game-dashboards/server/api/grpc"
+	"github.com/DaanV2/f1-game-dashboards/server/game"
 	"github.com/DaanV2/f1-game-dashboards/server/sessions"
 	"github.com/charmbracelet/log"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// maxForwards is the most destinations a chair forwards its datagrams to
+const maxForwards = 8
+
+// reservedIds can not be the id of a chair, they are paths of the http api next to the chairs
+var reservedIds = []string{"pending", "health"}
+
 var _ grpc_gen.ChairServiceServer = &grpcServer{}
 
 // CreateChair implements grpc_gen.ChairServiceServer.
 func (s *grpcServer) CreateChair(ctx context.Context, req *grpc_gen.CreateChairRequest) (*grpc_gen.CreateChairResponse, error) {
 	response := grpc_gen.CreateChairResponse{}
 	logger := log.FromContext(ctx)
-	if _, err := s.mustBeAdmin(ctx); err != nil {
+	if _, err := mustBeAdmin(ctx); err != nil {
 		return nil, err
 	}
 
@@ -30,6 +41,29 @@ func (s *grpcServer) CreateChair(ctx context.Context, req *grpc_gen.CreateChairR
 		"active", c.GetActive(),
 	)
 	requestChair := chairFromProto(c)
+	if requestChair.Port < 0 || requestChair.Port > math.MaxUint16 {
+		return &response, status.Error(codes.InvalidArgument, "port must be a udp port")
+	}
+	if requestChair.Port == 0 && requestChair.Key == "" {
+		return &response, status.Error(codes.InvalidArgument, "a chair without a port needs an id")
+	}
+	if !sessions.IsChairId(requestChair.Id()) {
+		return &response, status.Error(codes.InvalidArgument, "id can only contain letters, digits, dashes and underscores")
+	}
+	if _, err := strconv.Atoi(requestChair.Key); err == nil {
+		return &response, status.Error(codes.InvalidArgument, "id can not be a number, those are the ports of chairs without an id")
+	}
+	if slices.Contains(reservedIds, requestChair.Key) {
+		return &response, status.Errorf(codes.InvalidArgument, "id %q is reserved", requestChair.Key)
+	}
+	if err := s.mustBeFreePort(requestChair.Id(), requestChair.Port); err != nil {
+		return &response, err
+	}
+	sources, err := s.sourcesFromProto(requestChair.Id(), c.GetSources())
+	if err != nil {
+		return &response, err
+	}
+	requestChair = requestChair.WithSources(sources)
 
 	logger.Info("checking if chair exists")
 	if _, exists := s.chairs.Get(requestChair.Id()); exists {
@@ -48,7 +82,7 @@ func (s *grpcServer) DeleteChair(ctx context.Context, req *grpc_gen.DeleteChairR
 	response := grpc_gen.DeleteChairResponse{}
 	port := req.GetPort()
 	logger := log.FromContext(ctx).With("port", port)
-	if _, err := s.mustBeAdmin(ctx); err != nil {
+	if _, err := mustBeAdmin(ctx); err != nil {
 		return nil, err
 	}
 
@@ -74,7 +108,7 @@ func (s *grpcServer) GetChair(ctx context.Context, req *grpc_gen.GetChairRequest
 	response := grpc_gen.GetChairResponse{}
 	port := req.GetPort()
 	logger := log.FromContext(ctx).With("port", port)
-	if _, err := s.atleastGuest(ctx); err != nil {
+	if _, err := atleastGuest(ctx); err != nil {
 		return nil, err
 	}
 
@@ -97,7 +131,7 @@ func (s *grpcServer) GetChair(ctx context.Context, req *grpc_gen.GetChairRequest
 func (s *grpcServer) UpdateChair(ctx context.Context, req *grpc_gen.UpdateChairRequest) (*grpc_gen.UpdateChairResponse, error) {
 	logger := log.FromContext(ctx)
 	response := grpc_gen.UpdateChairResponse{}
-	if _, err := s.atleastGuest(ctx); err != nil {
+	if _, err := atleastGuest(ctx); err != nil {
 		return nil, err
 	}
 
@@ -106,28 +140,37 @@ func (s *grpcServer) UpdateChair(ctx context.Context, req *grpc_gen.UpdateChairR
 		return &response, status.Error(codes.InvalidArgument, "chair is required")
 	}
 	requestChair := chairFromProto(c)
-	logger = logger.With("port", requestChair.Port)
+	logger = logger.With("id", requestChair.Id())
+	var err error
 
 	oldChair, exists := s.chairs.Get(requestChair.Id())
 	if !exists {
 		logger.Info("chair not found")
 		return &response, status.Error(codes.NotFound, "chair not found")
 	}
-	if oldChair.Port != requestChair.Port {
+	// A chair with a stable id can be updated without giving its port
+	if requestChair.Port != 0 && oldChair.Port != requestChair.Port {
 		logger.Info("ports do not match")
 		return &response, status.Error(codes.InvalidArgument, "ports do not match")
 	}
+	// Clients that do not know about the shared port leave the sources as they are
+	sources := oldChair.Sources
+	if len(c.GetSources()) > 0 {
+		if sources, err = s.sourcesFromProto(oldChair.Id(), c.GetSources()); err != nil {
+			return &response, err
+		}
+	}
 
 	updateChair := sessions.NewChair(
 		requestChair.Name,
 		oldChair.Port,
 		requestChair.Active,
-	)
+	).WithId(oldChair.Key).WithSources(sources).WithForwards(oldChair.Forwards)
 	response.Chair = chairToProto(updateChair)
 
-	// Must be admin to change name
-	if oldChair.Name != updateChair.Name {
-		if _, err := s.mustBeAdmin(ctx); err != nil {
+	// Must be admin to change name or the routing of the shared port
+	if oldChair.Name != updateChair.Name || !slices.Equal(oldChair.Sources, updateChair.Sources) {
+		if _, err := mustBeAdmin(ctx); err != nil {
 			return nil, err
 		}
 	}
@@ -139,31 +182,184 @@ func (s *grpcServer) UpdateChair(ctx context.Context, req *grpc_gen.UpdateChairR
 
 // ListChairs implements grpc_gen.ChairServiceServer.
 func (s *grpcServer) ListChairs(ctx context.Context, req *grpc_gen.ListChairsRequest) (*grpc_gen.ListChairsResponse, error) {
-	if _, err := s.atleastGuest(ctx); err != nil {
+	if _, err := atleastGuest(ctx); err != nil {
 		return nil, err
 	}
 	chairs := s.chairs.All()
 
 	chrs := make([]*grpc_gen.Chair, 0, len(chairs))
+	for _, chair := range chairs {
+		chrs = append(chrs, chairToProto(chair))
+	}
+
 	response := grpc_gen.ListChairsResponse{
 		Chairs: chrs,
 	}
 
-	for _, chair := range chairs {
-		chrs = append(chrs, chairToProto(chair))
+	return &response, nil
+}
+
+// GetChairForwards implements grpc_gen.ChairServiceServer.
+func (s *grpcServer) GetChairForwards(ctx context.Context, req *grpc_gen.GetChairForwardsRequest) (*grpc_gen.GetChairForwardsResponse, error) {
+	response := grpc_gen.GetChairForwardsResponse{}
+	if _, err := atleastGuest(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.mustBeChair(req.GetPort()); err != nil {
+		return &response, err
+	}
+
+	chair, _ := s.chairs.Get(req.GetPort())
+	response.Forwards = forwardsToProto(chair.Forwards)
+	return &response, nil
+}
+
+// SetChairForwards implements grpc_gen.ChairServiceServer.
+func (s *grpcServer) SetChairForwards(ctx context.Context, req *grpc_gen.SetChairForwardsRequest) (*grpc_gen.SetChairForwardsResponse, error) {
+	response := grpc_gen.SetChairForwardsResponse{}
+	logger := log.FromContext(ctx).With("port", req.GetPort())
+	if _, err := mustBeAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.mustBeChair(req.GetPort()); err != nil {
+		return &response, err
+	}
+	if len(req.GetForwards()) > maxForwards {
+		return &response, status.Errorf(codes.InvalidArgument, "a chair can forward to at most %d destinations", maxForwards)
 	}
 
+	forwards := make([]sessions.Forward, 0, len(req.GetForwards()))
+	for _, f := range req.GetForwards() {
+		forward, err := s.forwardFromProto(f)
+		if err != nil {
+			return &response, err
+		}
+		forwards = append(forwards, forward)
+	}
+
+	chair, _ := s.chairs.Get(req.GetPort())
+	chair = chair.WithForwards(forwards)
+	logger.Info("updating forwards", "forwards", len(forwards))
+	s.chairs.Update(chair)
+
+	response.Forwards = forwardsToProto(chair.Forwards)
 	return &response, nil
 }
 
+// mustBeFreePort checks that no other chair, nor the shared or discovery port, uses the port of the chair
+func (s *grpcServer) mustBeFreePort(id string, port int) error {
+	if port == 0 {
+		return nil
+	}
+	if slices.Contains(s.sharedPorts, port) {
+		return status.Errorf(codes.AlreadyExists, "port %d is the shared or discovery port", port)
+	}
+	for _, chair := range s.chairs.All() {
+		if chair.Id() != id && chair.Port == port {
+			return status.Errorf(codes.AlreadyExists, "port %d is used by chair %s", port, chair.Id())
+		}
+	}
+
+	return nil
+}
+
+// isServerPort returns true if a chair, the shared or the discovery port listens on the port
+func (s *grpcServer) isServerPort(port int) bool {
+	if slices.Contains(s.sharedPorts, port) {
+		return true
+	}
+	for _, chair := range s.chairs.All() {
+		if chair.Port == port {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sourcesFromProto normalizes the source addresses of the chair, they must be an ip or ip:port that is not routed to
+// another chair
+func (s *grpcServer) sourcesFromProto(id string, sources []string) ([]string, error) {
+	result := make([]string, 0, len(sources))
+	for _, source := range sources {
+		normalized, ok := game.NormalizeSource(source)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "source %q must be an ip, or an ip:port to tell rigs behind the same ip apart", source)
+		}
+		if slices.Contains(result, normalized) {
+			continue
+		}
+		for _, chair := range s.chairs.All() {
+			if chair.Id() != id && slices.Contains(chair.Sources, normalized) {
+				return nil, status.Errorf(codes.AlreadyExists, "source %q is routed to chair %s", source, chair.Id())
+			}
+		}
+		result = append(result, normalized)
+	}
+
+	return result, nil
+}
+
+// forwardFromProto validates the forward, the address must resolve and can not point back at a chair
+func (s *grpcServer) forwardFromProto(forward *grpc_gen.ChairForward) (sessions.Forward, error) {
+	address, err := net.ResolveUDPAddr("udp", forward.GetAddress())
+	if err != nil || address.Port == 0 {
+		return sessions.Forward{}, status.Errorf(codes.InvalidArgument, "address %q must be a host:port", forward.GetAddress())
+	}
+	if address.IP == nil || address.IP.IsLoopback() || address.IP.IsUnspecified() {
+		if s.isServerPort(address.Port) {
+			return sessions.Forward{}, status.Errorf(codes.InvalidArgument, "address %q is a port of this server", forward.GetAddress())
+		}
+	}
+
+	result := sessions.Forward{
+		Address:   forward.GetAddress(),
+		PacketIds: make([]uint8, 0, len(forward.GetPacketIds())),
+		MaxRate:   int(forward.GetMaxRate()),
+	}
+	for _, id := range forward.GetPacketIds() {
+		if id > math.MaxUint8 {
+			return sessions.Forward{}, status.Errorf(codes.InvalidArgument, "packet id %d is not a packet id", id)
+		}
+		result.PacketIds = append(result.PacketIds, uint8(id))
+	}
+
+	return result, nil
+}
+
+func forwardsToProto(forwards []sessions.Forward) []*grpc_gen.ChairForward {
+	result := make([]*grpc_gen.ChairForward, len(forwards))
+	for i, forward := range forwards {
+		ids := make([]uint32, len(forward.PacketIds))
+		for j, id := range forward.PacketIds {
+			ids[j] = uint32(id)
+		}
+		result[i] = &grpc_gen.ChairForward{
+			Address:   forward.Address,
+			PacketIds: ids,
+			MaxRate:   uint32(forward.MaxRate),
+		}
+	}
+
+	return result
+}
+
 func chairToProto(chair sessions.Chair) *grpc_gen.Chair {
 	return &grpc_gen.Chair{
-		Name:   chair.Name,
-		Active: chair.Active,
-		Port:   int32(chair.Port),
+		Name:    chair.Name,
+		Active:  chair.Active,
+		Port:    int32(chair.Port),
+		Id:      chair.Id(),
+		Sources: chair.Sources,
 	}
 }
 
 func chairFromProto(chair *grpc_gen.Chair) sessions.Chair {
-	return sessions.NewChair(chair.GetName(), int(chair.GetPort()), chair.GetActive())
+	result := sessions.NewChair(chair.GetName(), int(chair.GetPort()), chair.GetActive())
+	// The port is the id of a chair without a stable id
+	if id := chair.GetId(); id != "" && id != strconv.Itoa(result.Port) {
+		result = result.WithId(id)
+	}
+
+	return result
 }
@@ -0,0 +1,108 @@
+package api
+
+import (
+	"context"
+
+	grpc_gen "github.com/DaanV2/f1-game-dashboards/server/api/grpc"
+	"github.com/DaanV2/f1-game-dashboards/server/game"
+	"github.com/charmbracelet/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListPendingChairs implements grpc_gen.ChairServiceServer.
+func (s *grpcServer) ListPendingChairs(ctx context.Context, req *grpc_gen.ListPendingChairsRequest) (*grpc_gen.ListPendingChairsResponse, error) {
+	response := grpc_gen.ListPendingChairsResponse{}
+	if err := s.mustHaveDiscovery(ctx); err != nil {
+		return nil, err
+	}
+
+	pending := s.discovery.Pending()
+	response.Pending = make([]*grpc_gen.PendingChair, len(pending))
+	for i, p := range pending {
+		response.Pending[i] = pendingChairToProto(p)
+	}
+
+	return &response, nil
+}
+
+// AdoptPendingChair implements grpc_gen.ChairServiceServer.
+func (s *grpcServer) AdoptPendingChair(ctx context.Context, req *grpc_gen.AdoptPendingChairRequest) (*grpc_gen.AdoptPendingChairResponse, error) {
+	response := grpc_gen.AdoptPendingChairResponse{}
+	logger := log.FromContext(ctx).With("source", req.GetSource())
+	if err := s.mustHaveDiscovery(ctx); err != nil {
+		return nil, err
+	}
+
+	pending, ok := s.discovery.Get(req.GetSource())
+	if !ok {
+		return &response, status.Error(codes.NotFound, "pending chair not found")
+	}
+
+	chair := &grpc_gen.Chair{
+		Name:    req.GetName(),
+		Active:  req.GetActive(),
+		Port:    req.GetPort(),
+		Id:      req.GetId(),
+		Sources: []string{pending.Source},
+	}
+	if chair.Name == "" {
+		chair.Name = pending.PlayerName
+	}
+	if chair.Name == "" {
+		chair.Name = pending.Source
+	}
+	if req.GetByIp() {
+		chair.Sources = []string{pending.IP}
+	}
+
+	logger.Info("adopting pending chair", "id", chair.GetId(), "name", chair.GetName())
+	created, err := s.CreateChair(ctx, &grpc_gen.CreateChairRequest{Chair: chair})
+	if err != nil {
+		return &response, err
+	}
+	s.discovery.Dismiss(pending.Source)
+
+	response.Chair = created.GetChair()
+	return &response, nil
+}
+
+// DismissPendingChair implements grpc_gen.ChairServiceServer.
+func (s *grpcServer) DismissPendingChair(ctx context.Context, req *grpc_gen.DismissPendingChairRequest) (*grpc_gen.DismissPendingChairResponse, error) {
+	response := grpc_gen.DismissPendingChairResponse{}
+	if err := s.mustHaveDiscovery(ctx); err != nil {
+		return nil, err
+	}
+
+	if !s.discovery.Dismiss(req.GetSource()) {
+		return &response, status.Error(codes.NotFound, "pending chair not found")
+	}
+
+	return &response, nil
+}
+
+func (s *grpcServer) mustHaveDiscovery(ctx context.Context) error {
+	if _, err := mustBeAdmin(ctx); err != nil {
+		return err
+	}
+	if s.discovery == nil {
+		return status.Error(codes.Unavailable, "discovery is not enabled")
+	}
+
+	return nil
+}
+
+func pendingChairToProto(pending game.PendingChair) *grpc_gen.PendingChair {
+	return &grpc_gen.PendingChair{
+		Source:       pending.Source,
+		Ip:           pending.IP,
+		Port:         int32(pending.Port),
+		PacketFormat: uint32(pending.PacketFormat),
+		GameYear:     uint32(pending.GameYear),
+		PlayerName:   pending.PlayerName,
+		SessionUid:   pending.SessionUID,
+		Packets:      pending.Packets,
+		FirstSeen:    pending.FirstSeen.UnixMilli(),
+		LastSeen:     pending.LastSeen.UnixMilli(),
+	}
+}
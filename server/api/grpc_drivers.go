@@ -0,0 +1,317 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	grpc_gen "github.com/DaanV2/f1-game-dashboards/server/api/grpc"
+	"github.com/DaanV2/f1-game-dashboards/server/drivers"
+	"github.com/DaanV2/f1-game-dashboards/server/sessions"
+	"github.com/DaanV2/f1-game-dashboards/server/users"
+	"github.com/charmbracelet/log"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ grpc_gen.DriverServiceServer = &grpcServer{}
+
+// CreateDriver implements grpc_gen.DriverServiceServer.
+func (s *grpcServer) CreateDriver(ctx context.Context, req *grpc_gen.CreateDriverRequest) (*grpc_gen.CreateDriverResponse, error) {
+	response := grpc_gen.CreateDriverResponse{}
+	if err := s.mustManageDrivers(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.mustBeUser(req.GetUserId()); err != nil {
+		return &response, err
+	}
+
+	driver, err := s.drivers.Create(req.GetDisplayName(), req.GetNickname(), req.GetUserId())
+	if err != nil {
+		return &response, driverError(err)
+	}
+
+	log.FromContext(ctx).Info("created driver", "id", driver.Id, "name", driver.DisplayName)
+	response.Driver = driverToProto(driver)
+	return &response, nil
+}
+
+// GetDriver implements grpc_gen.DriverServiceServer.
+func (s *grpcServer) GetDriver(ctx context.Context, req *grpc_gen.GetDriverRequest) (*grpc_gen.GetDriverResponse, error) {
+	response := grpc_gen.GetDriverResponse{}
+	if _, err := s.mustHaveDrivers(ctx); err != nil {
+		return nil, err
+	}
+
+	driver, err := s.drivers.Get(req.GetId())
+	if err != nil {
+		return &response, driverError(err)
+	}
+
+	response.Driver = driverToProto(driver)
+	return &response, nil
+}
+
+// ListDrivers implements grpc_gen.DriverServiceServer.
+func (s *grpcServer) ListDrivers(ctx context.Context, req *grpc_gen.ListDriversRequest) (*grpc_gen.ListDriversResponse, error) {
+	if _, err := s.mustHaveDrivers(ctx); err != nil {
+		return nil, err
+	}
+
+	list := s.drivers.List()
+	result := make([]*grpc_gen.Driver, 0, len(list))
+	for _, driver := range list {
+		result = append(result, driverToProto(driver))
+	}
+
+	return &grpc_gen.ListDriversResponse{Drivers: result}, nil
+}
+
+// UpdateDriver implements grpc_gen.DriverServiceServer.
+func (s *grpcServer) UpdateDriver(ctx context.Context, req *grpc_gen.UpdateDriverRequest) (*grpc_gen.UpdateDriverResponse, error) {
+	response := grpc_gen.UpdateDriverResponse{}
+	if err := s.mustManageDrivers(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.mustBeUser(req.GetUserId()); err != nil {
+		return &response, err
+	}
+
+	driver, err := s.drivers.Update(req.GetId(), req.GetDisplayName(), req.GetNickname(), req.GetUserId())
+	if err != nil {
+		return &response, driverError(err)
+	}
+
+	response.Driver = driverToProto(driver)
+	return &response, nil
+}
+
+// DeleteDriver implements grpc_gen.DriverServiceServer.
+func (s *grpcServer) DeleteDriver(ctx context.Context, req *grpc_gen.DeleteDriverRequest) (*grpc_gen.DeleteDriverResponse, error) {
+	response := grpc_gen.DeleteDriverResponse{}
+	if err := s.mustManageDrivers(ctx); err != nil {
+		return nil, err
+	}
+
+	driver, err := s.drivers.Delete(req.GetId())
+	if err != nil {
+		return &response, driverError(err)
+	}
+
+	log.FromContext(ctx).Info("deleted driver", "id", driver.Id, "name", driver.DisplayName)
+	response.Driver = driverToProto(driver)
+	return &response, nil
+}
+
+// CheckIn implements grpc_gen.DriverServiceServer.
+func (s *grpcServer) CheckIn(ctx context.Context, req *grpc_gen.CheckInRequest) (*grpc_gen.CheckInResponse, error) {
+	response := grpc_gen.CheckInResponse{}
+	user, err := s.mustHaveDrivers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.mustBeChair(req.GetPort()); err != nil {
+		return &response, err
+	}
+
+	driverId := req.GetDriverId()
+	if driverId == "" && user != nil {
+		if driver, ok := s.drivers.ByUser(user.Id); ok {
+			driverId = driver.Id
+		}
+	}
+	if driverId == "" {
+		return &response, status.Error(codes.InvalidArgument, "driver_id is required")
+	}
+	if err := s.mustControlDriver(user, driverId); err != nil {
+		return &response, err
+	}
+
+	checkIn, err := s.drivers.CheckIn(req.GetPort(), driverId, unixTime(req.GetUntil()))
+	if err != nil {
+		return &response, driverError(err)
+	}
+
+	log.FromContext(ctx).Info("checked in driver", "port", checkIn.ChairId, "driver", checkIn.DriverId, "until", checkIn.End)
+	response.CheckIn = checkInToProto(checkIn)
+	return &response, nil
+}
+
+// CheckOut implements grpc_gen.DriverServiceServer.
+func (s *grpcServer) CheckOut(ctx context.Context, req *grpc_gen.CheckOutRequest) (*grpc_gen.CheckOutResponse, error) {
+	response := grpc_gen.CheckOutResponse{}
+	user, err := s.mustHaveDrivers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.mustBeChair(req.GetPort()); err != nil {
+		return &response, err
+	}
+
+	current, ok := s.drivers.Current(req.GetPort())
+	if !ok {
+		return &response, driverError(drivers.ErrNotCheckedIn)
+	}
+	if err := s.mustControlDriver(user, current.DriverId); err != nil {
+		return &response, err
+	}
+
+	checkIn, err := s.drivers.CheckOut(req.GetPort())
+	if err != nil {
+		return &response, driverError(err)
+	}
+
+	log.FromContext(ctx).Info("checked out driver", "port", checkIn.ChairId, "driver", checkIn.DriverId)
+	response.CheckIn = checkInToProto(checkIn)
+	return &response, nil
+}
+
+// GetChairDriver implements grpc_gen.DriverServiceServer.
+func (s *grpcServer) GetChairDriver(ctx context.Context, req *grpc_gen.GetChairDriverRequest) (*grpc_gen.GetChairDriverResponse, error) {
+	response := grpc_gen.GetChairDriverResponse{}
+	if _, err := s.mustHaveDrivers(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.mustBeChair(req.GetPort()); err != nil {
+		return &response, err
+	}
+
+	checkIn, ok := s.drivers.Current(req.GetPort())
+	if !ok {
+		return &response, nil
+	}
+	response.CheckIn = checkInToProto(checkIn)
+	if driver, err := s.drivers.Get(checkIn.DriverId); err == nil {
+		response.Driver = driverToProto(driver)
+	}
+
+	return &response, nil
+}
+
+// ListCheckIns implements grpc_gen.DriverServiceServer.
+func (s *grpcServer) ListCheckIns(ctx context.Context, req *grpc_gen.ListCheckInsRequest) (*grpc_gen.ListCheckInsResponse, error) {
+	if _, err := s.mustHaveDrivers(ctx); err != nil {
+		return nil, err
+	}
+
+	list := s.drivers.CheckIns(drivers.CheckInQuery{
+		ChairId:  req.GetPort(),
+		DriverId: req.GetDriverId(),
+		From:     unixTime(req.GetFrom()),
+		To:       unixTime(req.GetTo()),
+		Limit:    int(req.GetLimit()),
+	})
+	result := make([]*grpc_gen.ChairCheckIn, 0, len(list))
+	for _, checkIn := range list {
+		result = append(result, checkInToProto(checkIn))
+	}
+
+	return &grpc_gen.ListCheckInsResponse{CheckIns: result}, nil
+}
+
+// mustHaveDrivers returns the user if it can read the drivers, and if they are enabled
+func (s *grpcServer) mustHaveDrivers(ctx context.Context) (*users.User, error) {
+	user, err := atleastGuest(ctx)
+	if err != nil {
+		return user, err
+	}
+	if s.drivers == nil {
+		return user, status.Error(codes.Unavailable, "driver profiles are not enabled")
+	}
+
+	return user, nil
+}
+
+// mustManageDrivers checks if the user is an admin, and if the drivers are enabled
+func (s *grpcServer) mustManageDrivers(ctx context.Context) error {
+	if _, err := mustBeAdmin(ctx); err != nil {
+		return err
+	}
+	if s.drivers == nil {
+		return status.Error(codes.Unavailable, "driver profiles are not enabled")
+	}
+
+	return nil
+}
+
+// mustControlDriver checks if the user can check the driver in or out: admins can do so for every driver, users only for their own
+func (s *grpcServer) mustControlDriver(user *users.User, driverId string) error {
+	if user == nil {
+		return status.Error(codes.Unauthenticated, "user is not authenicated")
+	}
+	if user.Admin && !user.Guest {
+		return nil
+	}
+	if driver, ok := s.drivers.ByUser(user.Id); !user.Guest && ok && driver.Id == driverId {
+		return nil
+	}
+
+	return status.Error(codes.PermissionDenied, "only admins can check in other drivers")
+}
+
+// mustBeUser checks if the user id to link exists, an empty id is allowed
+func (s *grpcServer) mustBeUser(userId string) error {
+	if userId == "" || s.users == nil {
+		return nil
+	}
+	if _, err := uuid.Parse(userId); err != nil {
+		return status.Error(codes.InvalidArgument, "user_id is not a valid user id")
+	}
+	if _, err := s.users.Get(userId); err != nil {
+		return status.Error(codes.InvalidArgument, "user_id is not a user")
+	}
+
+	return nil
+}
+
+// mustBeChair checks if the port is a known chair
+func (s *grpcServer) mustBeChair(port string) error {
+	if port == "" || !sessions.IsChairId(port) {
+		return status.Error(codes.InvalidArgument, "port is required")
+	}
+	if _, ok := s.chairs.Get(port); !ok {
+		return status.Error(codes.NotFound, "chair not found")
+	}
+
+	return nil
+}
+
+// driverError converts the errors of the driver registry into grpc status errors
+func driverError(err error) error {
+	switch {
+	case errors.Is(err, drivers.ErrDriverNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, drivers.ErrDisplayNameRequired), errors.Is(err, drivers.ErrInvalidWindow):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, drivers.ErrUserLinked):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, drivers.ErrChairOccupied), errors.Is(err, drivers.ErrDriverCheckedIn), errors.Is(err, drivers.ErrNotCheckedIn):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, "internal error")
+	}
+}
+
+func driverToProto(driver drivers.Driver) *grpc_gen.Driver {
+	return &grpc_gen.Driver{
+		Id:          driver.Id,
+		DisplayName: driver.DisplayName,
+		Nickname:    driver.Nickname,
+		UserId:      driver.UserId,
+		Created:     driver.Created.UnixMilli(),
+	}
+}
+
+func checkInToProto(checkIn drivers.CheckIn) *grpc_gen.ChairCheckIn {
+	result := &grpc_gen.ChairCheckIn{
+		Id:       checkIn.Id,
+		DriverId: checkIn.DriverId,
+		Port:     checkIn.ChairId,
+		Start:    checkIn.Start.UnixMilli(),
+	}
+	if !checkIn.End.IsZero() {
+		result.End = checkIn.End.UnixMilli()
+	}
+
+	return result
+}
@@ -0,0 +1,97 @@
+package api
+
+import (
+	"context"
+
+	grpc_gen "github.com/DaanV2/f1-game-dashboards/server/api/grpc"
+	"github.com/DaanV2/f1-game-dashboards/server/ghost"
+	"github.com/charmbracelet/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ grpc_gen.GhostServiceServer = &grpcServer{}
+
+// GetGhostReference implements grpc_gen.GhostServiceServer.
+func (s *grpcServer) GetGhostReference(ctx context.Context, req *grpc_gen.GetGhostReferenceRequest) (*grpc_gen.GetGhostReferenceResponse, error) {
+	response := grpc_gen.GetGhostReferenceResponse{}
+	if err := s.mustHaveGhosts(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.mustBeChair(req.GetPort()); err != nil {
+		return &response, err
+	}
+
+	reference, ok := s.ghosts.Reference(req.GetPort())
+	if !ok {
+		return &response, status.Error(codes.NotFound, "chair has no reference lap")
+	}
+
+	response.Reference = ghostReferenceToProto(reference)
+	return &response, nil
+}
+
+// WatchDeltas implements grpc_gen.GhostServiceServer.
+func (s *grpcServer) WatchDeltas(req *grpc_gen.WatchDeltasRequest, stream grpc_gen.GhostService_WatchDeltasServer) error {
+	ctx := stream.Context()
+	if err := s.mustHaveGhosts(ctx); err != nil {
+		return err
+	}
+	if req.GetPort() != "" {
+		if err := s.mustBeChair(req.GetPort()); err != nil {
+			return err
+		}
+	}
+
+	watcher := s.ghosts.Watch(req.GetPort())
+	defer watcher.Close()
+
+	return watcher.Run(ctx, func(delta ghost.Delta) error {
+		err := stream.Send(ghostDeltaToProto(delta))
+		if err != nil {
+			log.FromContext(ctx).Error("failed to send ghost delta", "error", err)
+		}
+		return err
+	})
+}
+
+// mustHaveGhosts checks if the user can read the live deltas, and if they are enabled
+func (s *grpcServer) mustHaveGhosts(ctx context.Context) error {
+	if _, err := atleastGuest(ctx); err != nil {
+		return err
+	}
+	if s.ghosts == nil {
+		return status.Error(codes.Unavailable, "live deltas are not enabled")
+	}
+
+	return nil
+}
+
+func ghostReferenceToProto(reference ghost.Reference) *grpc_gen.GhostReference {
+	kind := grpc_gen.GhostReferenceKind_GHOST_REFERENCE_KIND_PERSONAL_BEST
+	if reference.Kind == ghost.ReferenceTrackRecord {
+		kind = grpc_gen.GhostReferenceKind_GHOST_REFERENCE_KIND_TRACK_RECORD
+	}
+
+	return &grpc_gen.GhostReference{
+		Kind:        kind,
+		SessionId:   reference.SessionId,
+		LapNumber:   uint32(reference.LapNumber),
+		LapTimeInMs: reference.LapTimeInMS,
+		DriverId:    reference.DriverId,
+		Driver:      reference.Driver,
+	}
+}
+
+func ghostDeltaToProto(delta ghost.Delta) *grpc_gen.GhostDelta {
+	return &grpc_gen.GhostDelta{
+		Port:               delta.ChairId,
+		SessionUid:         delta.SessionUID,
+		LapNumber:          uint32(delta.LapNumber),
+		LapDistance:        delta.LapDistance,
+		CurrentLapTimeInMs: delta.CurrentLapTimeInMS,
+		Delta:              delta.Delta,
+		Reference:          ghostReferenceToProto(delta.Reference),
+		Received:           delta.Received.UnixMilli(),
+	}
+}
@@ -0,0 +1,92 @@
+package api
+
+import (
+	"context"
+
+	grpc_gen "github.com/DaanV2/f1-game-dashboards/server/api/grpc"
+	"github.com/DaanV2/f1-game-dashboards/server/game"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListChairHealth implements grpc_gen.ChairServiceServer.
+func (s *grpcServer) ListChairHealth(ctx context.Context, req *grpc_gen.ListChairHealthRequest) (*grpc_gen.ListChairHealthResponse, error) {
+	response := grpc_gen.ListChairHealthResponse{}
+	if err := s.mustHaveHealth(ctx); err != nil {
+		return nil, err
+	}
+
+	health := s.health.Health()
+	response.Health = make([]*grpc_gen.ChairHealth, len(health))
+	for i, h := range health {
+		response.Health[i] = chairHealthToProto(h)
+	}
+
+	return &response, nil
+}
+
+// GetChairHealth implements grpc_gen.ChairServiceServer.
+func (s *grpcServer) GetChairHealth(ctx context.Context, req *grpc_gen.GetChairHealthRequest) (*grpc_gen.GetChairHealthResponse, error) {
+	response := grpc_gen.GetChairHealthResponse{}
+	if err := s.mustHaveHealth(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.mustBeChair(req.GetPort()); err != nil {
+		return &response, err
+	}
+
+	health, ok := s.health.ChairHealth(req.GetPort())
+	if !ok {
+		return &response, status.Error(codes.NotFound, "chair has no listener")
+	}
+
+	response.Health = chairHealthToProto(health)
+	return &response, nil
+}
+
+func (s *grpcServer) mustHaveHealth(ctx context.Context) error {
+	if _, err := atleastGuest(ctx); err != nil {
+		return err
+	}
+	if s.health == nil {
+		return status.Error(codes.Unavailable, "health is not enabled")
+	}
+
+	return nil
+}
+
+func chairHealthToProto(health game.ChairHealth) *grpc_gen.ChairHealth {
+	result := &grpc_gen.ChairHealth{
+		Id:       health.ChairId,
+		Port:     int32(health.Port),
+		State:    listenerStateToProto(health.State),
+		Error:    health.Error,
+		Restarts: uint32(health.Restarts),
+		Packets:  health.Packets,
+	}
+	if !health.NextRetry.IsZero() {
+		result.NextRetry = health.NextRetry.UnixMilli()
+	}
+	if !health.LastPacket.IsZero() {
+		result.LastPacket = health.LastPacket.UnixMilli()
+	}
+
+	return result
+}
+
+func listenerStateToProto(state game.ListenerState) grpc_gen.ChairListenerState {
+	switch state {
+	case game.ListenerStarting:
+		return grpc_gen.ChairListenerState_CHAIR_LISTENER_STATE_STARTING
+	case game.ListenerListening:
+		return grpc_gen.ChairListenerState_CHAIR_LISTENER_STATE_LISTENING
+	case game.ListenerReceiving:
+		return grpc_gen.ChairListenerState_CHAIR_LISTENER_STATE_RECEIVING
+	case game.ListenerIdle:
+		return grpc_gen.ChairListenerState_CHAIR_LISTENER_STATE_IDLE
+	case game.ListenerFailed:
+		return grpc_gen.ChairListenerState_CHAIR_LISTENER_STATE_FAILED
+	}
+
+	return grpc_gen.ChairListenerState_CHAIR_LISTENER_STATE_UNSPECIFIED
+}
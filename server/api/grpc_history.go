@@ -0,0 +1,168 @@
+package api
+
+import (
+	"context"
+	"math"
+
+	grpc_gen "github.com/DaanV2/f1-game-dashboards/server/api/grpc"
+	"github.com/DaanV2/f1-game-dashboards/server/history"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ grpc_gen.LapHistoryServiceServer = &grpcServer{}
+
+// ListLaps implements grpc_gen.LapHistoryServiceServer.
+func (s *grpcServer) ListLaps(ctx context.Context, req *grpc_gen.ListLapsRequest) (*grpc_gen.ListLapsResponse, error) {
+	if err := s.mustHaveHistory(ctx); err != nil {
+		return nil, err
+	}
+	query, err := queryFromProto(req.GetQuery())
+	if err != nil {
+		return nil, err
+	}
+
+	records := s.history.Laps(query)
+	laps := make([]*grpc_gen.LapRecord, 0, len(records))
+	for _, record := range records {
+		laps = append(laps, &grpc_gen.LapRecord{
+			SessionId:   record.Session.Id,
+			Port:        record.Session.ChairId,
+			Driver:      s.lapDriver(record),
+			TrackId:     int32(record.Session.TrackId),
+			SessionType: uint32(record.Session.SessionType),
+			Lap:         lapToProto(record.Lap),
+		})
+	}
+
+	return &grpc_gen.ListLapsResponse{Laps: laps}, nil
+}
+
+// ListSessions implements grpc_gen.LapHistoryServiceServer.
+func (s *grpcServer) ListSessions(ctx context.Context, req *grpc_gen.ListSessionsRequest) (*grpc_gen.ListSessionsResponse, error) {
+	if err := s.mustHaveHistory(ctx); err != nil {
+		return nil, err
+	}
+	query, err := queryFromProto(req.GetQuery())
+	if err != nil {
+		return nil, err
+	}
+
+	list := s.history.Sessions(query)
+	result := make([]*grpc_gen.SessionRecord, 0, len(list))
+	for _, session := range list {
+		result = append(result, sessionRecordToProto(session, false))
+	}
+
+	return &grpc_gen.ListSessionsResponse{Sessions: result}, nil
+}
+
+// GetSession implements grpc_gen.LapHistoryServiceServer.
+func (s *grpcServer) GetSession(ctx context.Context, req *grpc_gen.GetSessionRequest) (*grpc_gen.GetSessionResponse, error) {
+	response := grpc_gen.GetSessionResponse{}
+	if err := s.mustHaveHistory(ctx); err != nil {
+		return nil, err
+	}
+	if req.GetId() == "" {
+		return &response, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	session, ok := s.history.Session(req.GetId())
+	if !ok {
+		return &response, status.Error(codes.NotFound, "session not found")
+	}
+
+	response.Session = sessionRecordToProto(session, true)
+	return &response, nil
+}
+
+// mustHaveHistory checks if the user can read the lap history, and if it is enabled
+func (s *grpcServer) mustHaveHistory(ctx context.Context) error {
+	if _, err := atleastGuest(ctx); err != nil {
+		return err
+	}
+	if s.history == nil {
+		return status.Error(codes.Unavailable, "lap history is not enabled")
+	}
+
+	return nil
+}
+
+// lapDriver returns the name of the driver of the lap, the session is named after the driver of its last lap
+func (s *grpcServer) lapDriver(record history.LapRecord) string {
+	if record.Lap.DriverId == record.Session.DriverId {
+		return record.Session.Driver
+	}
+	if s.drivers != nil {
+		if driver, err := s.drivers.Get(record.Lap.DriverId); err == nil {
+			return driver.DisplayName
+		}
+	}
+
+	return ""
+}
+
+func queryFromProto(q *grpc_gen.LapQuery) (history.Query, error) {
+	query := history.Query{
+		ChairId:   q.GetPort(),
+		Driver:    q.GetDriver(),
+		DriverId:  q.GetDriverId(),
+		ValidOnly: q.GetValidOnly(),
+		Limit:     int(q.GetLimit()),
+	}
+	if q.TrackId != nil {
+		if q.GetTrackId() < math.MinInt8 || q.GetTrackId() > math.MaxInt8 {
+			return query, status.Error(codes.InvalidArgument, "track_id is out of range")
+		}
+		track := int8(q.GetTrackId())
+		query.TrackId = &track
+	}
+	query.From = unixTime(q.GetFrom())
+	query.To = unixTime(q.GetTo())
+	if !query.From.IsZero() && !query.To.IsZero() && query.To.Before(query.From) {
+		return query, status.Error(codes.InvalidArgument, "to must be after from")
+	}
+
+	return query, nil
+}
+
+func sessionRecordToProto(session *history.Session, withLaps bool) *grpc_gen.SessionRecord {
+	result := &grpc_gen.SessionRecord{
+		Id:              session.Id,
+		Port:            session.ChairId,
+		SessionUid:      session.SessionUID,
+		Driver:          session.Driver,
+		TrackId:         int32(session.TrackId),
+		SessionType:     uint32(session.SessionType),
+		Started:         session.Started.UnixMilli(),
+		Updated:         session.Updated.UnixMilli(),
+		Finished:        session.Finished,
+		Position:        uint32(session.Position),
+		BestLapTimeInMs: session.BestLapTimeInMS,
+		TotalRaceTime:   session.TotalRaceTime,
+		DriverId:        session.DriverId,
+	}
+	if withLaps {
+		result.Laps = make([]*grpc_gen.Lap, 0, len(session.Laps))
+		for _, lap := range session.Laps {
+			result.Laps = append(result.Laps, lapToProto(lap))
+		}
+	}
+
+	return result
+}
+
+func lapToProto(lap history.Lap) *grpc_gen.Lap {
+	return &grpc_gen.Lap{
+		LapNumber:       uint32(lap.LapNumber),
+		LapTimeInMs:     lap.LapTimeInMS,
+		Sector1TimeInMs: lap.Sector1TimeInMS,
+		Sector2TimeInMs: lap.Sector2TimeInMS,
+		Sector3TimeInMs: lap.Sector3TimeInMS,
+		Valid:           lap.Valid,
+		TyreCompound:    uint32(lap.TyreCompound),
+		Completed:       lap.Completed.UnixMilli(),
+		Assists:         grpc_gen.AssistLevel(lap.Assists),
+		DriverId:        lap.DriverId,
+	}
+}
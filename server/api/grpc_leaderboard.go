@@ -0,0 +1,187 @@
+package api
+
+import (
+	"context"
+	"math"
+	"time"
+
+	grpc_gen "github.com/DaanV2/f1-game-dashboards/server/api/grpc"
+	"github.com/DaanV2/f1-game-dashboards/server/history"
+	"github.com/DaanV2/f1-game-dashboards/server/leaderboard"
+	"github.com/charmbracelet/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ grpc_gen.LeaderboardServiceServer = &grpcServer{}
+
+// ListLeaderboards implements grpc_gen.LeaderboardServiceServer.
+func (s *grpcServer) ListLeaderboards(ctx context.Context, req *grpc_gen.ListLeaderboardsRequest) (*grpc_gen.ListLeaderboardsResponse, error) {
+	if err := s.mustHaveLeaderboard(ctx); err != nil {
+		return nil, err
+	}
+
+	keys := s.leaderboard.Keys()
+	result := make([]*grpc_gen.LeaderboardKey, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, leaderboardKeyToProto(key))
+	}
+
+	return &grpc_gen.ListLeaderboardsResponse{Keys: result}, nil
+}
+
+// GetLeaderboard implements grpc_gen.LeaderboardServiceServer.
+func (s *grpcServer) GetLeaderboard(ctx context.Context, req *grpc_gen.GetLeaderboardRequest) (*grpc_gen.GetLeaderboardResponse, error) {
+	if err := s.mustHaveLeaderboard(ctx); err != nil {
+		return nil, err
+	}
+	key, err := leaderboardKeyFromProto(req.GetKey())
+	if err != nil {
+		return nil, err
+	}
+
+	board := s.leaderboard.Board(key, unixTime(req.GetSince()), int(req.GetLimit()))
+	return &grpc_gen.GetLeaderboardResponse{Leaderboard: boardToProto(board)}, nil
+}
+
+// WatchLeaderboards implements grpc_gen.LeaderboardServiceServer.
+func (s *grpcServer) WatchLeaderboards(req *grpc_gen.WatchLeaderboardsRequest, stream grpc_gen.LeaderboardService_WatchLeaderboardsServer) error {
+	ctx := stream.Context()
+	if err := s.mustHaveLeaderboard(ctx); err != nil {
+		return err
+	}
+	filter, err := leaderboardFilterFromProto(req)
+	if err != nil {
+		return err
+	}
+
+	watcher := s.leaderboard.Watch(filter)
+	defer watcher.Close()
+
+	return watcher.Run(ctx, func(update leaderboard.Update) error {
+		err := stream.Send(leaderboardUpdateToProto(update))
+		if err != nil {
+			log.FromContext(ctx).Error("failed to send leaderboard update", "error", err)
+		}
+		return err
+	})
+}
+
+// mustHaveLeaderboard checks if the user can read the leaderboards, and if they are enabled
+func (s *grpcServer) mustHaveLeaderboard(ctx context.Context) error {
+	if _, err := atleastGuest(ctx); err != nil {
+		return err
+	}
+	if s.leaderboard == nil {
+		return status.Error(codes.Unavailable, "leaderboards are not enabled")
+	}
+
+	return nil
+}
+
+func leaderboardKeyFromProto(key *grpc_gen.LeaderboardKey) (leaderboard.Key, error) {
+	if key == nil {
+		return leaderboard.Key{}, status.Error(codes.InvalidArgument, "key is required")
+	}
+	if key.GetTrackId() < 0 || key.GetTrackId() > math.MaxInt8 {
+		return leaderboard.Key{}, status.Error(codes.InvalidArgument, "track_id is out of range")
+	}
+	if key.GetSessionType() > math.MaxUint8 {
+		return leaderboard.Key{}, status.Error(codes.InvalidArgument, "session_type is out of range")
+	}
+	if key.GetAssists() == grpc_gen.AssistLevel_ASSIST_LEVEL_UNSPECIFIED {
+		return leaderboard.Key{}, status.Error(codes.InvalidArgument, "assists is required")
+	}
+
+	return leaderboard.Key{
+		TrackId:     int8(key.GetTrackId()),
+		SessionType: uint8(key.GetSessionType()),
+		Assists:     history.AssistLevel(key.GetAssists()),
+	}, nil
+}
+
+func leaderboardFilterFromProto(req *grpc_gen.WatchLeaderboardsRequest) (leaderboard.Filter, error) {
+	filter := leaderboard.Filter{
+		Since: unixTime(req.GetSince()),
+		Limit: int(req.GetLimit()),
+	}
+	if req.TrackId != nil {
+		if req.GetTrackId() < 0 || req.GetTrackId() > math.MaxInt8 {
+			return filter, status.Error(codes.InvalidArgument, "track_id is out of range")
+		}
+		track := int8(req.GetTrackId())
+		filter.TrackId = &track
+	}
+	if req.SessionType != nil {
+		if req.GetSessionType() > math.MaxUint8 {
+			return filter, status.Error(codes.InvalidArgument, "session_type is out of range")
+		}
+		sessionType := uint8(req.GetSessionType())
+		filter.SessionType = &sessionType
+	}
+	if req.GetAssists() != grpc_gen.AssistLevel_ASSIST_LEVEL_UNSPECIFIED {
+		assists := history.AssistLevel(req.GetAssists())
+		filter.Assists = &assists
+	}
+
+	return filter, nil
+}
+
+func leaderboardKeyToProto(key leaderboard.Key) *grpc_gen.LeaderboardKey {
+	return &grpc_gen.LeaderboardKey{
+		TrackId:     int32(key.TrackId),
+		SessionType: uint32(key.SessionType),
+		Assists:     grpc_gen.AssistLevel(key.Assists),
+	}
+}
+
+func boardToProto(board leaderboard.Board) *grpc_gen.Leaderboard {
+	entries := make([]*grpc_gen.LeaderboardEntry, 0, len(board.Entries))
+	for _, entry := range board.Entries {
+		entries = append(entries, leaderboardEntryToProto(entry))
+	}
+
+	return &grpc_gen.Leaderboard{
+		Key:     leaderboardKeyToProto(board.Key),
+		Entries: entries,
+	}
+}
+
+func leaderboardEntryToProto(entry leaderboard.Entry) *grpc_gen.LeaderboardEntry {
+	return &grpc_gen.LeaderboardEntry{
+		Position:  uint32(entry.Position),
+		Driver:    entry.Driver,
+		Port:      entry.ChairId,
+		SessionId: entry.SessionId,
+		Lap:       lapToProto(entry.Lap),
+		DriverId:  entry.DriverId,
+		Nickname:  entry.Nickname,
+	}
+}
+
+func leaderboardUpdateToProto(update leaderboard.Update) *grpc_gen.LeaderboardUpdate {
+	result := &grpc_gen.LeaderboardUpdate{
+		Leaderboard: boardToProto(update.Board),
+	}
+	switch update.Kind {
+	case leaderboard.UpdateSnapshot:
+		result.Kind = grpc_gen.LeaderboardUpdateKind_LEADERBOARD_UPDATE_KIND_SNAPSHOT
+	case leaderboard.UpdatePersonalBest:
+		result.Kind = grpc_gen.LeaderboardUpdateKind_LEADERBOARD_UPDATE_KIND_PERSONAL_BEST
+		result.Entry = leaderboardEntryToProto(update.Entry)
+	case leaderboard.UpdateOverallBest:
+		result.Kind = grpc_gen.LeaderboardUpdateKind_LEADERBOARD_UPDATE_KIND_OVERALL_BEST
+		result.Entry = leaderboardEntryToProto(update.Entry)
+	}
+
+	return result
+}
+
+// unixTime converts unix seconds, 0 is the zero time
+func unixTime(seconds int64) time.Time {
+	if seconds == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(seconds, 0)
+}
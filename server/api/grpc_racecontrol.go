@@ -0,0 +1,177 @@
+package api
+
+import (
+	"context"
+
+	grpc_gen "github.com/DaanV2/f1-game-dashboards/server/api/grpc"
+	"github.com/DaanV2/f1-game-dashboards/server/racecontrol"
+	"github.com/charmbracelet/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ grpc_gen.RaceControlServiceServer = &grpcServer{}
+
+// ListRaceControlLogs implements grpc_gen.RaceControlServiceServer.
+func (s *grpcServer) ListRaceControlLogs(ctx context.Context, req *grpc_gen.ListRaceControlLogsRequest) (*grpc_gen.ListRaceControlLogsResponse, error) {
+	response := grpc_gen.ListRaceControlLogsResponse{}
+	if err := s.mustHaveRaceControl(ctx); err != nil {
+		return nil, err
+	}
+
+	logs := s.raceControl.Logs(req.GetPort())
+	response.Logs = make([]*grpc_gen.RaceControlLog, len(logs))
+	for i, l := range logs {
+		response.Logs[i] = raceControlLogToProto(l, nil)
+	}
+
+	return &response, nil
+}
+
+// GetRaceControlLog implements grpc_gen.RaceControlServiceServer.
+func (s *grpcServer) GetRaceControlLog(ctx context.Context, req *grpc_gen.GetRaceControlLogRequest) (*grpc_gen.GetRaceControlLogResponse, error) {
+	response := grpc_gen.GetRaceControlLogResponse{}
+	if err := s.mustHaveRaceControl(ctx); err != nil {
+		return nil, err
+	}
+	kinds, err := kindsFromProto(req.GetKinds())
+	if err != nil {
+		return &response, err
+	}
+
+	l, ok := s.raceControl.Log(req.GetSessionId())
+	if !ok {
+		return &response, status.Error(codes.NotFound, racecontrol.ErrLogNotFound.Error())
+	}
+
+	events := make([]*grpc_gen.RaceControlEvent, 0, len(l.Events))
+	for _, event := range l.Events {
+		if len(kinds) == 0 || kinds[event.Kind] {
+			events = append(events, raceControlEventToProto(event))
+		}
+	}
+	response.Log = raceControlLogToProto(l, events)
+	return &response, nil
+}
+
+// WatchRaceControl implements grpc_gen.RaceControlServiceServer.
+func (s *grpcServer) WatchRaceControl(req *grpc_gen.WatchRaceControlRequest, stream grpc_gen.RaceControlService_WatchRaceControlServer) error {
+	ctx := stream.Context()
+	if err := s.mustHaveRaceControl(ctx); err != nil {
+		return err
+	}
+	watcher, err := s.watchRaceControl(req.GetPort(), req.GetKinds())
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+
+	return watcher.Run(ctx, func(message racecontrol.Message) error {
+		err := stream.Send(raceControlMessageToProto(message))
+		if err != nil {
+			log.FromContext(ctx).Error("failed to send race control event", "error", err)
+		}
+		return err
+	})
+}
+
+// watchRaceControl checks the chair and kinds, and starts watching the events
+func (s *grpcServer) watchRaceControl(port string, names []string) (*racecontrol.Watcher, error) {
+	if port != "" {
+		if err := s.mustBeChair(port); err != nil {
+			return nil, err
+		}
+	}
+	kinds, err := kindsFromProto(names)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]racecontrol.Kind, 0, len(kinds))
+	for kind := range kinds {
+		list = append(list, kind)
+	}
+	return s.raceControl.Watch(port, list), nil
+}
+
+// mustHaveRaceControl checks if the user can read the race control events, and if they are enabled
+func (s *grpcServer) mustHaveRaceControl(ctx context.Context) error {
+	if _, err := atleastGuest(ctx); err != nil {
+		return err
+	}
+	if s.raceControl == nil {
+		return status.Error(codes.Unavailable, "race control events are not enabled")
+	}
+
+	return nil
+}
+
+func kindsFromProto(names []string) (map[racecontrol.Kind]bool, error) {
+	kinds := make(map[racecontrol.Kind]bool, len(names))
+	for _, name := range names {
+		kind, ok := racecontrol.ParseKind(name)
+		if !ok || kind == racecontrol.KindUnknown {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown event kind %q", name)
+		}
+		kinds[kind] = true
+	}
+
+	return kinds, nil
+}
+
+func raceControlLogToProto(l *racecontrol.Log, events []*grpc_gen.RaceControlEvent) *grpc_gen.RaceControlLog {
+	return &grpc_gen.RaceControlLog{
+		SessionId:   l.Id,
+		Port:        l.ChairId,
+		SessionUid:  l.SessionUID,
+		TrackId:     int32(l.TrackId),
+		SessionType: uint32(l.SessionType),
+		Started:     l.Started.Unix(),
+		Updated:     l.Updated.Unix(),
+		EventCount:  uint32(len(l.Events)),
+		Events:      events,
+	}
+}
+
+func raceControlMessageToProto(message racecontrol.Message) *grpc_gen.RaceControlMessage {
+	return &grpc_gen.RaceControlMessage{
+		SessionId:  message.LogId,
+		Port:       message.ChairId,
+		SessionUid: message.SessionUID,
+		Event:      raceControlEventToProto(message.Event),
+	}
+}
+
+func raceControlEventToProto(event racecontrol.Event) *grpc_gen.RaceControlEvent {
+	result := &grpc_gen.RaceControlEvent{
+		Sequence:    event.Sequence,
+		Kind:        event.Kind.String(),
+		SessionTime: event.SessionTime,
+		Cars:        make([]*grpc_gen.RaceControlCar, len(event.Cars)),
+		LapTimeInMs: event.LapTimeInMS,
+		SafetyCar:   event.SafetyCar,
+		Phase:       event.Phase,
+		Message:     event.Message,
+		Received:    event.Received.UnixMilli(),
+	}
+	for i, car := range event.Cars {
+		result.Cars[i] = &grpc_gen.RaceControlCar{
+			Index:      uint32(car.Index),
+			Name:       car.Name,
+			RaceNumber: uint32(car.RaceNumber),
+			TeamId:     uint32(car.TeamId),
+			LapNumber:  uint32(car.LapNumber),
+		}
+	}
+	if event.Penalty != nil {
+		result.Penalty = &grpc_gen.RaceControlPenalty{
+			Type:         event.Penalty.Type,
+			Infringement: event.Penalty.Infringement,
+			Time:         uint32(event.Penalty.Time),
+			LapNumber:    uint32(event.Penalty.LapNumber),
+			PlacesGained: uint32(event.Penalty.PlacesGained),
+		}
+	}
+
+	return result
+}
@@ -6,8 +6,20 @@ import (
 	"net"
 
 	grpc_gen "github.com/DaanV2/f1-game-dashboards/server/api/grpc"
+	"github.com/DaanV2/f1-game-dashboards/server/archive"
 	"github.com/DaanV2/f1-game-dashboards/server/authenication"
+	"github.com/DaanV2/f1-game-dashboards/server/booking"
+	"github.com/DaanV2/f1-game-dashboards/server/drivers"
+	"github.com/DaanV2/f1-game-dashboards/server/game"
+	"github.com/DaanV2/f1-game-dashboards/server/ghost"
+	"github.com/DaanV2/f1-game-dashboards/server/history"
+	"github.com/DaanV2/f1-game-dashboards/server/leaderboard"
+	"github.com/DaanV2/f1-game-dashboards/server/racecontrol"
 	"github.com/DaanV2/f1-game-dashboards/server/sessions"
+	"github.com/DaanV2/f1-game-dashboards/server/standings"
+	"github.com/DaanV2/f1-game-dashboards/server/strategy"
+	"github.com/DaanV2/f1-game-dashboards/server/trackmap"
+	"github.com/DaanV2/f1-game-dashboards/server/users"
 	"github.com/charmbracelet/log"
 	grpc "google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
@@ -20,21 +32,77 @@ type grpcServerOptions struct {
 
 type grpcServer struct {
 	grpc_gen.UnimplementedChairServiceServer
+	grpc_gen.UnimplementedTelemetryServiceServer
+	grpc_gen.UnimplementedAuthServiceServer
+	grpc_gen.UnimplementedUserServiceServer
+	grpc_gen.UnimplementedLapHistoryServiceServer
+	grpc_gen.UnimplementedLeaderboardServiceServer
+	grpc_gen.UnimplementedDriverServiceServer
+	grpc_gen.UnimplementedBookingServiceServer
+	grpc_gen.UnimplementedTelemetryArchiveServiceServer
+	grpc_gen.UnimplementedGhostServiceServer
+	grpc_gen.UnimplementedTrackMapServiceServer
+	grpc_gen.UnimplementedStandingsServiceServer
+	grpc_gen.UnimplementedRaceControlServiceServer
+	grpc_gen.UnimplementedStrategyServiceServer
 
 	chairs       *sessions.ChairManager
 	authenicator *authenication.Authenticator
+	users        *users.UserManagement
+	telemetry    *telemetryFeed
+	history      *history.Store
+	leaderboard  *leaderboard.Leaderboard
+	drivers      *drivers.Registry
+	booking      *booking.Manager
+	archive      *archive.Archive
+	ghosts       *ghost.Tracker
+	trackMaps    *trackmap.Builder
+	standings    *standings.Engine
+	raceControl  *racecontrol.Feed
+	strategy     *strategy.Planner
+	discovery    *game.Discovery
+	health       *game.PacketProcessor
+	sharedPorts  []int
 	grpc         *grpc.Server
 
 	options grpcServerOptions
 }
 
-func newGrpcServer(chairs *sessions.ChairManager, authenicator *authenication.Authenticator, options grpcServerOptions) *grpcServer {
+func newGrpcServer(chairs *sessions.ChairManager, authenicator *authenication.Authenticator, telemetry *telemetryFeed, options apiServerOptions) *grpcServer {
 	return &grpcServer{
-		UnimplementedChairServiceServer: grpc_gen.UnimplementedChairServiceServer{},
+		UnimplementedChairServiceServer:            grpc_gen.UnimplementedChairServiceServer{},
+		UnimplementedTelemetryServiceServer:        grpc_gen.UnimplementedTelemetryServiceServer{},
+		UnimplementedAuthServiceServer:             grpc_gen.UnimplementedAuthServiceServer{},
+		UnimplementedUserServiceServer:             grpc_gen.UnimplementedUserServiceServer{},
+		UnimplementedLapHistoryServiceServer:       grpc_gen.UnimplementedLapHistoryServiceServer{},
+		UnimplementedLeaderboardServiceServer:      grpc_gen.UnimplementedLeaderboardServiceServer{},
+		UnimplementedDriverServiceServer:           grpc_gen.UnimplementedDriverServiceServer{},
+		UnimplementedBookingServiceServer:          grpc_gen.UnimplementedBookingServiceServer{},
+		UnimplementedTelemetryArchiveServiceServer: grpc_gen.UnimplementedTelemetryArchiveServiceServer{},
+		UnimplementedGhostServiceServer:            grpc_gen.UnimplementedGhostServiceServer{},
+		UnimplementedTrackMapServiceServer:         grpc_gen.UnimplementedTrackMapServiceServer{},
+		UnimplementedStandingsServiceServer:        grpc_gen.UnimplementedStandingsServiceServer{},
+		UnimplementedRaceControlServiceServer:      grpc_gen.UnimplementedRaceControlServiceServer{},
+		UnimplementedStrategyServiceServer:         grpc_gen.UnimplementedStrategyServiceServer{},
 
 		chairs:       chairs,
 		authenicator: authenicator,
-		options:      options,
+		users:        options.users,
+		telemetry:    telemetry,
+		history:      options.history,
+		leaderboard:  options.leaderboard,
+		drivers:      options.drivers,
+		booking:      options.booking,
+		archive:      options.archive,
+		ghosts:       options.ghosts,
+		trackMaps:    options.trackMaps,
+		standings:    options.standings,
+		raceControl:  options.raceControl,
+		strategy:     options.strategy,
+		discovery:    options.discovery,
+		health:       options.health,
+		sharedPorts:  options.sharedPorts,
+		options:      options.grpc,
 		grpc:         nil,
 	}
 }
@@ -50,6 +118,7 @@ func (s *grpcServer) Start() error {
 
 	opts := []grpc.ServerOption{
 		grpc.ChainUnaryInterceptor(s.interceptor),
+		grpc.ChainStreamInterceptor(s.streamInterceptor),
 	}
 
 	// TODO add health checking
@@ -57,6 +126,19 @@ func (s *grpcServer) Start() error {
 	s.grpc = grpc.NewServer(opts...)
 
 	grpc_gen.RegisterChairServiceServer(s.grpc, s)
+	grpc_gen.RegisterTelemetryServiceServer(s.grpc, s)
+	grpc_gen.RegisterAuthServiceServer(s.grpc, s)
+	grpc_gen.RegisterUserServiceServer(s.grpc, s)
+	grpc_gen.RegisterLapHistoryServiceServer(s.grpc, s)
+	grpc_gen.RegisterLeaderboardServiceServer(s.grpc, s)
+	grpc_gen.RegisterDriverServiceServer(s.grpc, s)
+	grpc_gen.RegisterBookingServiceServer(s.grpc, s)
+	grpc_gen.RegisterTelemetryArchiveServiceServer(s.grpc, s)
+	grpc_gen.RegisterGhostServiceServer(s.grpc, s)
+	grpc_gen.RegisterTrackMapServiceServer(s.grpc, s)
+	grpc_gen.RegisterStandingsServiceServer(s.grpc, s)
+	grpc_gen.RegisterRaceControlServiceServer(s.grpc, s)
+	grpc_gen.RegisterStrategyServiceServer(s.grpc, s)
 	reflection.Register(s.grpc)
 
 	go func() {
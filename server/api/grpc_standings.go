@@ -0,0 +1,104 @@
+package api
+
+import (
+	"context"
+
+	grpc_gen "github.com/DaanV2/f1-game-dashboards/server/api/grpc"
+	"github.com/DaanV2/f1-game-dashboards/server/standings"
+	"github.com/charmbracelet/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ grpc_gen.StandingsServiceServer = &grpcServer{}
+
+// GetStandings implements grpc_gen.StandingsServiceServer.
+func (s *grpcServer) GetStandings(ctx context.Context, req *grpc_gen.GetStandingsRequest) (*grpc_gen.GetStandingsResponse, error) {
+	response := grpc_gen.GetStandingsResponse{}
+	if err := s.mustHaveStandings(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.mustBeChair(req.GetPort()); err != nil {
+		return &response, err
+	}
+
+	result, ok := s.standings.Standings(req.GetPort())
+	if !ok {
+		return &response, status.Error(codes.NotFound, "chair has no standings")
+	}
+
+	response.Standings = standingsToProto(result)
+	return &response, nil
+}
+
+// WatchStandings implements grpc_gen.StandingsServiceServer.
+func (s *grpcServer) WatchStandings(req *grpc_gen.WatchStandingsRequest, stream grpc_gen.StandingsService_WatchStandingsServer) error {
+	ctx := stream.Context()
+	if err := s.mustHaveStandings(ctx); err != nil {
+		return err
+	}
+	if req.GetPort() != "" {
+		if err := s.mustBeChair(req.GetPort()); err != nil {
+			return err
+		}
+	}
+
+	watcher := s.standings.Watch(req.GetPort())
+	defer watcher.Close()
+
+	return watcher.Run(ctx, func(result standings.Standings) error {
+		err := stream.Send(standingsToProto(result))
+		if err != nil {
+			log.FromContext(ctx).Error("failed to send standings", "error", err)
+		}
+		return err
+	})
+}
+
+// mustHaveStandings checks if the user can read the standings, and if they are enabled
+func (s *grpcServer) mustHaveStandings(ctx context.Context) error {
+	if _, err := atleastGuest(ctx); err != nil {
+		return err
+	}
+	if s.standings == nil {
+		return status.Error(codes.Unavailable, "standings are not enabled")
+	}
+
+	return nil
+}
+
+func standingsToProto(result standings.Standings) *grpc_gen.Standings {
+	entries := make([]*grpc_gen.StandingsEntry, len(result.Entries))
+	for i, entry := range result.Entries {
+		entries[i] = &grpc_gen.StandingsEntry{
+			CarIndex:        uint32(entry.CarIndex),
+			Position:        uint32(entry.Position),
+			Name:            entry.Name,
+			TeamId:          uint32(entry.TeamId),
+			RaceNumber:      uint32(entry.RaceNumber),
+			Player:          entry.Player,
+			LapNumber:       uint32(entry.LapNumber),
+			LapDistance:     entry.LapDistance,
+			TotalDistance:   entry.TotalDistance,
+			GapToLeader:     entry.GapToLeader,
+			Interval:        entry.Interval,
+			LapsDown:        uint32(entry.LapsDown),
+			PitStops:        uint32(entry.PitStops),
+			PitStatus:       uint32(entry.PitStatus),
+			TyreCompound:    uint32(entry.TyreCompound),
+			TyreAgeLaps:     uint32(entry.TyreAgeLaps),
+			LastLapTimeInMs: entry.LastLapTimeInMS,
+			BestLapTimeInMs: entry.BestLapTimeInMS,
+			ResultStatus:    uint32(entry.ResultStatus),
+		}
+	}
+
+	return &grpc_gen.Standings{
+		Port:        result.ChairId,
+		SessionUid:  result.SessionUID,
+		Race:        result.Race,
+		SessionTime: result.SessionTime,
+		Entries:     entries,
+		Updated:     result.Updated.UnixMilli(),
+	}
+}
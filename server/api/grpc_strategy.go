@@ -0,0 +1,118 @@
+package api
+
+import (
+	"context"
+
+	grpc_gen "github.com/DaanV2/f1-game-dashboards/server/api/grpc"
+	"github.com/DaanV2/f1-game-dashboards/server/state"
+	"github.com/DaanV2/f1-game-dashboards/server/strategy"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ grpc_gen.StrategyServiceServer = &grpcServer{}
+
+// ListStrategies implements grpc_gen.StrategyServiceServer.
+func (s *grpcServer) ListStrategies(ctx context.Context, req *grpc_gen.ListStrategiesRequest) (*grpc_gen.ListStrategiesResponse, error) {
+	response := grpc_gen.ListStrategiesResponse{}
+	if err := s.mustHaveStrategy(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.mustBeChair(req.GetPort()); err != nil {
+		return &response, err
+	}
+
+	strategies := s.strategy.Strategies(req.GetPort())
+	response.Strategies = make([]*grpc_gen.Strategy, len(strategies))
+	for i, result := range strategies {
+		response.Strategies[i] = strategyToProto(result)
+	}
+
+	return &response, nil
+}
+
+// GetStrategy implements grpc_gen.StrategyServiceServer.
+func (s *grpcServer) GetStrategy(ctx context.Context, req *grpc_gen.GetStrategyRequest) (*grpc_gen.GetStrategyResponse, error) {
+	response := grpc_gen.GetStrategyResponse{}
+	if err := s.mustHaveStrategy(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.mustBeChair(req.GetPort()); err != nil {
+		return &response, err
+	}
+	if req.GetCarIndex() >= state.MaxCars {
+		return &response, status.Errorf(codes.InvalidArgument, "car index must be below %d", state.MaxCars)
+	}
+
+	result, ok := s.strategy.Strategy(req.GetPort(), uint8(req.GetCarIndex()))
+	if !ok {
+		return &response, status.Error(codes.NotFound, "car has no strategy")
+	}
+
+	response.Strategy = strategyToProto(result)
+	return &response, nil
+}
+
+// mustHaveStrategy checks if the user can read the strategies, and if they are enabled
+func (s *grpcServer) mustHaveStrategy(ctx context.Context) error {
+	if _, err := atleastGuest(ctx); err != nil {
+		return err
+	}
+	if s.strategy == nil {
+		return status.Error(codes.Unavailable, "strategy is not enabled")
+	}
+
+	return nil
+}
+
+func strategyToProto(result strategy.Strategy) *grpc_gen.Strategy {
+	projected := make([]*grpc_gen.LapWear, len(result.ProjectedWear))
+	for i, lap := range result.ProjectedWear {
+		projected[i] = &grpc_gen.LapWear{Lap: uint32(lap.Lap), Wear: lap.Wear[:]}
+	}
+	sets := make([]*grpc_gen.StrategyTyreSet, len(result.TyreSets))
+	for i, set := range result.TyreSets {
+		sets[i] = &grpc_gen.StrategyTyreSet{
+			Index:          uint32(set.Index),
+			ActualCompound: uint32(set.ActualCompound),
+			VisualCompound: uint32(set.VisualCompound),
+			Wear:           set.Wear,
+			Fitted:         set.Fitted,
+			LifeLaps:       uint32(set.LifeLaps),
+			CliffLap:       uint32(set.CliffLap),
+		}
+	}
+	windows := make([]*grpc_gen.PitWindow, len(result.PitWindows))
+	for i, window := range result.PitWindows {
+		windows[i] = &grpc_gen.PitWindow{
+			Stop:           uint32(window.Stop),
+			Opens:          uint32(window.Opens),
+			Closes:         uint32(window.Closes),
+			TyreSet:        uint32(window.TyreSet),
+			VisualCompound: uint32(window.VisualCompound),
+		}
+	}
+
+	return &grpc_gen.Strategy{
+		Port:              result.ChairId,
+		SessionUid:        result.SessionUID,
+		CarIndex:          uint32(result.CarIndex),
+		Race:              result.Race,
+		LapNumber:         uint32(result.LapNumber),
+		TotalLaps:         uint32(result.TotalLaps),
+		FuelInTank:        result.FuelInTank,
+		FuelRemainingLaps: result.FuelRemainingLaps,
+		FuelPerLap:        result.FuelPerLap,
+		FuelAtFinish:      result.FuelAtFinish,
+		TyreCompound:      uint32(result.TyreCompound),
+		TyreAgeLaps:       uint32(result.TyreAgeLaps),
+		TyreWear:          result.TyreWear[:],
+		WearPerLap:        result.WearPerLap[:],
+		ProjectedWear:     projected,
+		CliffLap:          uint32(result.CliffLap),
+		TyreSets:          sets,
+		PitWindows:        windows,
+		ReachesFinish:     result.ReachesFinish,
+		Updated:           result.Updated.UnixMilli(),
+	}
+}
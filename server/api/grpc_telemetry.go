@@ -0,0 +1,23 @@
+package api
+
+import (
+	grpc_gen "github.com/DaanV2/f1-game-dashboards/server/api/grpc"
+)
+
+var _ grpc_gen.TelemetryServiceServer = &grpcServer{}
+
+// Subscribe implements grpc_gen.TelemetryServiceServer.
+func (s *grpcServer) Subscribe(req *grpc_gen.SubscribeRequest, stream grpc_gen.TelemetryService_SubscribeServer) error {
+	ctx := stream.Context()
+	if _, err := atleastGuest(ctx); err != nil {
+		return err
+	}
+
+	telemetry, err := openTelemetryStream(ctx, s.chairs, s.telemetry, req)
+	if err != nil {
+		return err
+	}
+	defer telemetry.Close()
+
+	return telemetry.Run(ctx, stream.Send)
+}
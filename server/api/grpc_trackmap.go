@@ -0,0 +1,148 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	grpc_gen "github.com/DaanV2/f1-game-dashboards/server/api/grpc"
+	"github.com/DaanV2/f1-game-dashboards/server/trackmap"
+	"github.com/charmbracelet/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ grpc_gen.TrackMapServiceServer = &grpcServer{}
+
+// ListTrackMaps implements grpc_gen.TrackMapServiceServer.
+func (s *grpcServer) ListTrackMaps(ctx context.Context, req *grpc_gen.ListTrackMapsRequest) (*grpc_gen.ListTrackMapsResponse, error) {
+	response := grpc_gen.ListTrackMapsResponse{}
+	if err := s.mustHaveTrackMaps(ctx); err != nil {
+		return nil, err
+	}
+
+	maps := s.trackMaps.Maps()
+	response.Maps = make([]*grpc_gen.TrackMapSummary, len(maps))
+	for i, m := range maps {
+		response.Maps[i] = trackMapSummaryToProto(m)
+	}
+
+	return &response, nil
+}
+
+// GetTrackMap implements grpc_gen.TrackMapServiceServer.
+func (s *grpcServer) GetTrackMap(ctx context.Context, req *grpc_gen.GetTrackMapRequest) (*grpc_gen.GetTrackMapResponse, error) {
+	response := grpc_gen.GetTrackMapResponse{}
+	if err := s.mustHaveTrackMaps(ctx); err != nil {
+		return nil, err
+	}
+	track, err := trackIdFromProto(req.GetTrackId())
+	if err != nil {
+		return &response, err
+	}
+
+	m, ok := s.trackMaps.Map(track)
+	if !ok {
+		return &response, status.Error(codes.NotFound, trackmap.ErrMapNotFound.Error())
+	}
+
+	response.Map = trackMapToProto(m)
+	return &response, nil
+}
+
+// DeleteTrackMap implements grpc_gen.TrackMapServiceServer.
+func (s *grpcServer) DeleteTrackMap(ctx context.Context, req *grpc_gen.DeleteTrackMapRequest) (*grpc_gen.DeleteTrackMapResponse, error) {
+	response := grpc_gen.DeleteTrackMapResponse{}
+	if err := s.mustManageTrackMaps(ctx); err != nil {
+		return nil, err
+	}
+	track, err := trackIdFromProto(req.GetTrackId())
+	if err != nil {
+		return &response, err
+	}
+
+	err = s.trackMaps.Delete(track)
+	switch {
+	case errors.Is(err, trackmap.ErrMapNotFound):
+		return &response, status.Error(codes.NotFound, err.Error())
+	case err != nil:
+		log.FromContext(ctx).Error("failed to delete track map", "track", track, "error", err)
+		return &response, status.Error(codes.Internal, "failed to delete track map")
+	}
+
+	return &response, nil
+}
+
+// mustHaveTrackMaps checks if the user can read the track maps, and if they are enabled
+func (s *grpcServer) mustHaveTrackMaps(ctx context.Context) error {
+	if _, err := atleastGuest(ctx); err != nil {
+		return err
+	}
+	if s.trackMaps == nil {
+		return status.Error(codes.Unavailable, "track maps are not enabled")
+	}
+
+	return nil
+}
+
+// mustManageTrackMaps checks if the user is an admin, and if the track maps are enabled
+func (s *grpcServer) mustManageTrackMaps(ctx context.Context) error {
+	if _, err := mustBeAdmin(ctx); err != nil {
+		return err
+	}
+	if s.trackMaps == nil {
+		return status.Error(codes.Unavailable, "track maps are not enabled")
+	}
+
+	return nil
+}
+
+func trackIdFromProto(id int32) (int8, error) {
+	if id < math.MinInt8 || id > math.MaxInt8 {
+		return 0, status.Error(codes.InvalidArgument, "track_id is out of range")
+	}
+
+	return int8(id), nil
+}
+
+func trackMapSummaryToProto(m trackmap.Map) *grpc_gen.TrackMapSummary {
+	return &grpc_gen.TrackMapSummary{
+		TrackId:     int32(m.TrackId),
+		TrackLength: uint32(m.TrackLength),
+		Laps:        uint32(m.Laps),
+		PitStops:    uint32(m.PitStops),
+		Updated:     m.Updated.Unix(),
+	}
+}
+
+func trackMapToProto(m trackmap.Map) *grpc_gen.TrackMap {
+	normalized := m.Normalize()
+	result := &grpc_gen.TrackMap{
+		Summary:         trackMapSummaryToProto(m),
+		Outline:         mapPointsToProto(normalized.Outline),
+		SectorDistances: make([]float32, 0, len(m.Sectors)),
+		Sectors:         mapPointsToProto(normalized.Sectors),
+		PitLane:         mapPointsToProto(normalized.PitLane),
+		Transform: &grpc_gen.MapTransform{
+			OriginX: normalized.Transform.Origin.X,
+			OriginY: normalized.Transform.Origin.Y,
+			Scale:   normalized.Transform.Scale,
+		},
+	}
+	for _, distance := range m.Sectors {
+		if distance > 0 {
+			result.SectorDistances = append(result.SectorDistances, distance)
+		}
+	}
+
+	return result
+}
+
+func mapPointsToProto(points []trackmap.Point) []*grpc_gen.MapPoint {
+	result := make([]*grpc_gen.MapPoint, len(points))
+	for i, p := range points {
+		result[i] = &grpc_gen.MapPoint{X: p.X, Y: p.Y}
+	}
+
+	return result
+}
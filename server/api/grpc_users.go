@@ -0,0 +1,192 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	grpc_gen "github.com/DaanV2/f1-game-dashboards/server/api/grpc"
+	"github.com/DaanV2/f1-game-dashboards/server/pkg/data"
+	"github.com/DaanV2/f1-game-dashboards/server/users"
+	"github.com/charmbracelet/log"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ grpc_gen.UserServiceServer = &grpcServer{}
+
+// CreateUser implements grpc_gen.UserServiceServer.
+func (s *grpcServer) CreateUser(ctx context.Context, req *grpc_gen.CreateUserRequest) (*grpc_gen.CreateUserResponse, error) {
+	response := grpc_gen.CreateUserResponse{}
+	logger := log.FromContext(ctx).With("email", req.GetEmail(), "admin", req.GetAdmin())
+	if _, err := s.mustManageUsers(ctx); err != nil {
+		return nil, err
+	}
+
+	logger.Info("creating user")
+	user, err := s.users.Create(req.GetEmail(), req.GetPassword(), req.GetAdmin())
+	if err != nil {
+		logger.Warn("failed to create user", "error", err)
+		return &response, userError(err)
+	}
+
+	response.User = userToProto(user)
+	return &response, nil
+}
+
+// GetUser implements grpc_gen.UserServiceServer.
+func (s *grpcServer) GetUser(ctx context.Context, req *grpc_gen.GetUserRequest) (*grpc_gen.GetUserResponse, error) {
+	response := grpc_gen.GetUserResponse{}
+	if _, err := s.mustManageUsers(ctx); err != nil {
+		return nil, err
+	}
+	if err := mustBeUserId(req.GetId()); err != nil {
+		return &response, err
+	}
+
+	user, err := s.users.Get(req.GetId())
+	if err != nil {
+		return &response, userError(err)
+	}
+
+	response.User = userToProto(user)
+	return &response, nil
+}
+
+// ListUsers implements grpc_gen.UserServiceServer.
+func (s *grpcServer) ListUsers(ctx context.Context, req *grpc_gen.ListUsersRequest) (*grpc_gen.ListUsersResponse, error) {
+	if _, err := s.mustManageUsers(ctx); err != nil {
+		return nil, err
+	}
+
+	list, err := s.users.List()
+	if err != nil {
+		log.FromContext(ctx).Error("failed to list users", "error", err)
+		return nil, userError(err)
+	}
+
+	usrs := make([]*grpc_gen.User, 0, len(list))
+	for _, user := range list {
+		usrs = append(usrs, userToProto(user))
+	}
+
+	return &grpc_gen.ListUsersResponse{Users: usrs}, nil
+}
+
+// UpdateUserRoles implements grpc_gen.UserServiceServer.
+func (s *grpcServer) UpdateUserRoles(ctx context.Context, req *grpc_gen.UpdateUserRolesRequest) (*grpc_gen.UpdateUserRolesResponse, error) {
+	response := grpc_gen.UpdateUserRolesResponse{}
+	admin, err := s.mustManageUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := mustBeUserId(req.GetId()); err != nil {
+		return &response, err
+	}
+	// Otherwise the last admin could lock everyone out
+	if admin.Id == req.GetId() && !req.GetAdmin() {
+		return &response, status.Error(codes.FailedPrecondition, "cannot remove your own admin role")
+	}
+
+	user, err := s.users.SetAdmin(ctx, req.GetId(), req.GetAdmin())
+	if err != nil {
+		return &response, userError(err)
+	}
+
+	response.User = userToProto(user)
+	return &response, nil
+}
+
+// ResetPassword implements grpc_gen.UserServiceServer.
+func (s *grpcServer) ResetPassword(ctx context.Context, req *grpc_gen.ResetPasswordRequest) (*grpc_gen.ResetPasswordResponse, error) {
+	response := grpc_gen.ResetPasswordResponse{}
+	if _, err := s.mustManageUsers(ctx); err != nil {
+		return nil, err
+	}
+	if err := mustBeUserId(req.GetId()); err != nil {
+		return &response, err
+	}
+
+	user, err := s.users.Get(req.GetId())
+	if err != nil {
+		return &response, userError(err)
+	}
+	if err := s.users.UpdatePassword(ctx, user.Email, req.GetPassword()); err != nil {
+		return &response, userError(err)
+	}
+
+	return &response, nil
+}
+
+// DeleteUser implements grpc_gen.UserServiceServer.
+func (s *grpcServer) DeleteUser(ctx context.Context, req *grpc_gen.DeleteUserRequest) (*grpc_gen.DeleteUserResponse, error) {
+	response := grpc_gen.DeleteUserResponse{}
+	admin, err := s.mustManageUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := mustBeUserId(req.GetId()); err != nil {
+		return &response, err
+	}
+	if admin.Id == req.GetId() {
+		return &response, status.Error(codes.FailedPrecondition, "cannot delete yourself")
+	}
+
+	user, err := s.users.Get(req.GetId())
+	if err != nil {
+		return &response, userError(err)
+	}
+	if err := s.users.Delete(ctx, user.Id); err != nil {
+		return &response, userError(err)
+	}
+
+	response.User = userToProto(user)
+	return &response, nil
+}
+
+// mustManageUsers returns the admin if the user service can be used
+func (s *grpcServer) mustManageUsers(ctx context.Context) (*users.User, error) {
+	admin, err := mustBeAdmin(ctx)
+	if err != nil {
+		return admin, err
+	}
+	if s.users == nil {
+		return admin, status.Error(codes.Unavailable, "user management is not enabled")
+	}
+
+	return admin, nil
+}
+
+// mustBeUserId checks if the id can be the id of a user, so malformed ids never reach the storage
+func mustBeUserId(id string) error {
+	if id == "" {
+		return status.Error(codes.InvalidArgument, "id is required")
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return status.Error(codes.InvalidArgument, "id is not a valid user id")
+	}
+
+	return nil
+}
+
+// userError converts the errors of the user management into grpc status errors
+func userError(err error) error {
+	switch {
+	case errors.Is(err, data.ErrNotFound):
+		return status.Error(codes.NotFound, "user not found")
+	case errors.Is(err, users.ErrUserExists), errors.Is(err, data.ErrAlreadyExists):
+		return status.Error(codes.AlreadyExists, "user already exists")
+	case errors.Is(err, users.ErrEmailRequired), errors.Is(err, users.ErrPasswordRequired):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, "internal error")
+	}
+}
+
+func userToProto(user *users.User) *grpc_gen.User {
+	return &grpc_gen.User{
+		Id:    user.Id,
+		Email: user.Email,
+		Admin: user.Admin,
+	}
+}
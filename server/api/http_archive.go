@@ -0,0 +1,124 @@
+package api
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	grpc_gen "github.com/DaanV2/f1-game-dashboards/server/api/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// listArchivedSessions lists the sessions with archived telemetry, with the query parameter port
+func (s *httpServer) listArchivedSessions(w http.ResponseWriter, r *http.Request) {
+	req := &grpc_gen.ListArchivedSessionsRequest{Port: r.URL.Query().Get("port")}
+	response, err := s.grpc.ListArchivedSessions(r.Context(), req)
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+// queryTelemetry reads the archived telemetry of a session, with the query parameters channels, resolution, lap, from and to
+func (s *httpServer) queryTelemetry(w http.ResponseWriter, r *http.Request) {
+	req, err := queryTelemetryRequest(r.PathValue("id"), r.URL.Query())
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	response, err := s.grpc.QueryTelemetry(r.Context(), req)
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+// compareLaps compares two archived laps, with the query parameters reference_session, reference_lap, session, lap, channels and step
+func (s *httpServer) compareLaps(w http.ResponseWriter, r *http.Request) {
+	req, err := compareLapsRequest(r.URL.Query())
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	response, err := s.grpc.CompareLaps(r.Context(), req)
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+func queryTelemetryRequest(id string, values url.Values) (*grpc_gen.QueryTelemetryRequest, error) {
+	req := &grpc_gen.QueryTelemetryRequest{
+		SessionId: id,
+		Channels:  listParam(values, "channels"),
+	}
+
+	if v := values.Get("resolution"); v != "" {
+		resolution, err := time.ParseDuration(v)
+		if err != nil || resolution < 0 {
+			return nil, status.Error(codes.InvalidArgument, "resolution must be a duration, e.g. 100ms")
+		}
+		req.ResolutionMs = uint32(resolution.Milliseconds())
+	}
+	var err error
+	if req.Lap, err = lapParam(values, "lap"); err != nil {
+		return nil, err
+	}
+	if req.From, err = unixParam(values, "from"); err != nil {
+		return nil, err
+	}
+	if req.To, err = unixParam(values, "to"); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func compareLapsRequest(values url.Values) (*grpc_gen.CompareLapsRequest, error) {
+	req := &grpc_gen.CompareLapsRequest{
+		Reference: &grpc_gen.LapReference{SessionId: values.Get("reference_session")},
+		Lap:       &grpc_gen.LapReference{SessionId: values.Get("session")},
+		Channels:  listParam(values, "channels"),
+	}
+
+	var err error
+	if req.Reference.Lap, err = lapParam(values, "reference_lap"); err != nil {
+		return nil, err
+	}
+	if req.Lap.Lap, err = lapParam(values, "lap"); err != nil {
+		return nil, err
+	}
+	if v := values.Get("step"); v != "" {
+		step, err := strconv.ParseFloat(v, 32)
+		if err != nil || step < 0 {
+			return nil, status.Error(codes.InvalidArgument, "step must be a distance in meters")
+		}
+		req.Step = float32(step)
+	}
+
+	return req, nil
+}
+
+// listParam reads a list parameter, which can be repeated or separated by commas
+func listParam(values url.Values, name string) []string {
+	result := make([]string, 0)
+	for _, v := range values[name] {
+		for _, item := range strings.Split(v, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				result = append(result, item)
+			}
+		}
+	}
+
+	return result
+}
+
+// lapParam reads a lap number parameter, 0 if it is not set
+func lapParam(values url.Values, name string) (uint32, error) {
+	v := values.Get(name)
+	if v == "" {
+		return 0, nil
+	}
+	lap, err := strconv.ParseUint(v, 10, 8)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "%s must be a lap number", name)
+	}
+
+	return uint32(lap), nil
+}
@@ -0,0 +1,45 @@
+package api
+
+import (
+	"net/http"
+
+	grpc_gen "github.com/DaanV2/f1-game-dashboards/server/api/grpc"
+)
+
+func (s *httpServer) login(w http.ResponseWriter, r *http.Request) {
+	req := &grpc_gen.LoginRequest{}
+	if err := readRequest(r, req); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	response, err := s.grpc.Login(r.Context(), req)
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+func (s *httpServer) refresh(w http.ResponseWriter, r *http.Request) {
+	req := &grpc_gen.RefreshRequest{}
+	if err := readRequest(r, req); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	response, err := s.grpc.Refresh(r.Context(), req)
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+func (s *httpServer) guestLogin(w http.ResponseWriter, r *http.Request) {
+	req := &grpc_gen.GuestLoginRequest{}
+	if err := readRequest(r, req); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	response, err := s.grpc.GuestLogin(r.Context(), req)
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+func (s *httpServer) whoAmI(w http.ResponseWriter, r *http.Request) {
+	response, err := s.grpc.WhoAmI(r.Context(), &grpc_gen.WhoAmIRequest{})
+	writeResponse(w, r, http.StatusOK, response, err)
+}
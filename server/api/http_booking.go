@@ -0,0 +1,94 @@
+package api
+
+import (
+	"net/http"
+	"net/url"
+
+	grpc_gen "github.com/DaanV2/f1-game-dashboards/server/api/grpc"
+)
+
+// listReservations lists the reservations, with the query parameters port, from, to and limit
+func (s *httpServer) listReservations(w http.ResponseWriter, r *http.Request) {
+	req, err := listReservationsRequest(r.URL.Query())
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	response, err := s.grpc.ListReservations(r.Context(), req)
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+func (s *httpServer) createReservation(w http.ResponseWriter, r *http.Request) {
+	req := &grpc_gen.CreateReservationRequest{}
+	if err := readRequest(r, req); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	response, err := s.grpc.CreateReservation(r.Context(), req)
+	writeResponse(w, r, http.StatusCreated, response, err)
+}
+
+func (s *httpServer) getReservation(w http.ResponseWriter, r *http.Request) {
+	response, err := s.grpc.GetReservation(r.Context(), &grpc_gen.GetReservationRequest{Id: r.PathValue("id")})
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+func (s *httpServer) cancelReservation(w http.ResponseWriter, r *http.Request) {
+	response, err := s.grpc.CancelReservation(r.Context(), &grpc_gen.CancelReservationRequest{Id: r.PathValue("id")})
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+func (s *httpServer) listQueue(w http.ResponseWriter, r *http.Request) {
+	response, err := s.grpc.ListQueue(r.Context(), &grpc_gen.ListQueueRequest{})
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+func (s *httpServer) joinQueue(w http.ResponseWriter, r *http.Request) {
+	req := &grpc_gen.JoinQueueRequest{}
+	if err := readRequest(r, req); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	response, err := s.grpc.JoinQueue(r.Context(), req)
+	writeResponse(w, r, http.StatusCreated, response, err)
+}
+
+func (s *httpServer) leaveQueue(w http.ResponseWriter, r *http.Request) {
+	response, err := s.grpc.LeaveQueue(r.Context(), &grpc_gen.LeaveQueueRequest{Id: r.PathValue("id")})
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+func (s *httpServer) serveNext(w http.ResponseWriter, r *http.Request) {
+	req := &grpc_gen.ServeNextRequest{}
+	if err := readRequest(r, req); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	// The path decides which chair is given away
+	req.Port = r.PathValue("port")
+
+	response, err := s.grpc.ServeNext(r.Context(), req)
+	writeResponse(w, r, http.StatusCreated, response, err)
+}
+
+func listReservationsRequest(values url.Values) (*grpc_gen.ListReservationsRequest, error) {
+	req := &grpc_gen.ListReservationsRequest{
+		Port: values.Get("port"),
+	}
+
+	var err error
+	if req.From, err = unixParam(values, "from"); err != nil {
+		return nil, err
+	}
+	if req.To, err = unixParam(values, "to"); err != nil {
+		return nil, err
+	}
+	if req.Limit, err = limitParam(values); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
@@ -0,0 +1,190 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+
+	grpc_gen "github.com/DaanV2/f1-game-dashboards/server/api/grpc"
+	"github.com/DaanV2/f1-game-dashboards/server/sessions"
+	"github.com/charmbracelet/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// maxBodySize is the maximum size of a JSON request body
+const maxBodySize = 1 << 20
+
+func (s *httpServer) listChairs(w http.ResponseWriter, r *http.Request) {
+	response, err := s.grpc.ListChairs(r.Context(), &grpc_gen.ListChairsRequest{})
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+func (s *httpServer) createChair(w http.ResponseWriter, r *http.Request) {
+	chair := &grpc_gen.Chair{}
+	if err := readRequest(r, chair); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	response, err := s.grpc.CreateChair(r.Context(), &grpc_gen.CreateChairRequest{Chair: chair})
+	writeResponse(w, r, http.StatusCreated, response, err)
+}
+
+func (s *httpServer) getChair(w http.ResponseWriter, r *http.Request) {
+	response, err := s.grpc.GetChair(r.Context(), &grpc_gen.GetChairRequest{Port: r.PathValue("port")})
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+func (s *httpServer) updateChair(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("port")
+	if !sessions.IsChairId(id) {
+		writeError(w, r, status.Error(codes.InvalidArgument, "port is required"))
+		return
+	}
+	chair := &grpc_gen.Chair{}
+	if err := readRequest(r, chair); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	// The path decides which chair is updated, it is the port of chairs without a stable id
+	chair.Id = id
+	if port, err := strconv.Atoi(id); err == nil {
+		chair.Port = int32(port)
+	}
+
+	response, err := s.grpc.UpdateChair(r.Context(), &grpc_gen.UpdateChairRequest{Chair: chair})
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+func (s *httpServer) deleteChair(w http.ResponseWriter, r *http.Request) {
+	response, err := s.grpc.DeleteChair(r.Context(), &grpc_gen.DeleteChairRequest{Port: r.PathValue("port")})
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+func (s *httpServer) getChairForwards(w http.ResponseWriter, r *http.Request) {
+	response, err := s.grpc.GetChairForwards(r.Context(), &grpc_gen.GetChairForwardsRequest{Port: r.PathValue("port")})
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+func (s *httpServer) setChairForwards(w http.ResponseWriter, r *http.Request) {
+	req := &grpc_gen.SetChairForwardsRequest{}
+	if err := readRequest(r, req); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	// The path decides which chair is updated
+	req.Port = r.PathValue("port")
+
+	response, err := s.grpc.SetChairForwards(r.Context(), req)
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+func (s *httpServer) listPendingChairs(w http.ResponseWriter, r *http.Request) {
+	response, err := s.grpc.ListPendingChairs(r.Context(), &grpc_gen.ListPendingChairsRequest{})
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+func (s *httpServer) adoptPendingChair(w http.ResponseWriter, r *http.Request) {
+	req := &grpc_gen.AdoptPendingChairRequest{}
+	if err := readRequest(r, req); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	// The path decides which pending chair is adopted
+	req.Source = r.PathValue("source")
+
+	response, err := s.grpc.AdoptPendingChair(r.Context(), req)
+	writeResponse(w, r, http.StatusCreated, response, err)
+}
+
+func (s *httpServer) dismissPendingChair(w http.ResponseWriter, r *http.Request) {
+	response, err := s.grpc.DismissPendingChair(r.Context(), &grpc_gen.DismissPendingChairRequest{Source: r.PathValue("source")})
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+func (s *httpServer) listChairHealth(w http.ResponseWriter, r *http.Request) {
+	response, err := s.grpc.ListChairHealth(r.Context(), &grpc_gen.ListChairHealthRequest{})
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+func (s *httpServer) getChairHealth(w http.ResponseWriter, r *http.Request) {
+	response, err := s.grpc.GetChairHealth(r.Context(), &grpc_gen.GetChairHealthRequest{Port: r.PathValue("port")})
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+// readRequest reads the JSON body into the message
+func readRequest(r *http.Request, message proto.Message) error {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize))
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := protojson.Unmarshal(body, message); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return nil
+}
+
+// writeResponse writes the message as JSON, or the error if there is one
+func writeResponse(w http.ResponseWriter, r *http.Request, code int, message proto.Message, err error) {
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	writeJSON(w, r, code, message)
+}
+
+// writeError writes the grpc status of the error as JSON, with the matching http status code
+func writeError(w http.ResponseWriter, r *http.Request, err error) {
+	s := status.Convert(err)
+	writeJSON(w, r, httpStatus(s.Code()), s.Proto())
+}
+
+func writeJSON(w http.ResponseWriter, r *http.Request, code int, message proto.Message) {
+	data, err := protojson.Marshal(message)
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to marshal response", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if _, err := w.Write(data); err != nil {
+		log.FromContext(r.Context()).Error("failed to write response", "error", err)
+	}
+}
+
+// httpStatus converts the grpc status code into the http status code
+func httpStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.InvalidArgument, codes.OutOfRange, codes.FailedPrecondition:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Canceled:
+		return 499 // Client closed request
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,105 @@
+package api
+
+import (
+	"net/http"
+	"net/url"
+
+	grpc_gen "github.com/DaanV2/f1-game-dashboards/server/api/grpc"
+)
+
+func (s *httpServer) listDrivers(w http.ResponseWriter, r *http.Request) {
+	response, err := s.grpc.ListDrivers(r.Context(), &grpc_gen.ListDriversRequest{})
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+func (s *httpServer) createDriver(w http.ResponseWriter, r *http.Request) {
+	req := &grpc_gen.CreateDriverRequest{}
+	if err := readRequest(r, req); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	response, err := s.grpc.CreateDriver(r.Context(), req)
+	writeResponse(w, r, http.StatusCreated, response, err)
+}
+
+func (s *httpServer) getDriver(w http.ResponseWriter, r *http.Request) {
+	response, err := s.grpc.GetDriver(r.Context(), &grpc_gen.GetDriverRequest{Id: r.PathValue("id")})
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+func (s *httpServer) updateDriver(w http.ResponseWriter, r *http.Request) {
+	req := &grpc_gen.UpdateDriverRequest{}
+	if err := readRequest(r, req); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	// The path decides which driver is updated
+	req.Id = r.PathValue("id")
+
+	response, err := s.grpc.UpdateDriver(r.Context(), req)
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+func (s *httpServer) deleteDriver(w http.ResponseWriter, r *http.Request) {
+	response, err := s.grpc.DeleteDriver(r.Context(), &grpc_gen.DeleteDriverRequest{Id: r.PathValue("id")})
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+// checkIn checks a driver in to the chair of the path, the body is optional for users with a linked driver
+func (s *httpServer) checkIn(w http.ResponseWriter, r *http.Request) {
+	req := &grpc_gen.CheckInRequest{}
+	if r.ContentLength != 0 {
+		if err := readRequest(r, req); err != nil {
+			writeError(w, r, err)
+			return
+		}
+	}
+	// The path decides which chair is checked in to
+	req.Port = r.PathValue("port")
+
+	response, err := s.grpc.CheckIn(r.Context(), req)
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+func (s *httpServer) checkOut(w http.ResponseWriter, r *http.Request) {
+	response, err := s.grpc.CheckOut(r.Context(), &grpc_gen.CheckOutRequest{Port: r.PathValue("port")})
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+func (s *httpServer) getChairDriver(w http.ResponseWriter, r *http.Request) {
+	response, err := s.grpc.GetChairDriver(r.Context(), &grpc_gen.GetChairDriverRequest{Port: r.PathValue("port")})
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+// listCheckIns lists the check-ins, with the query parameters port, driver_id, from, to and limit
+func (s *httpServer) listCheckIns(w http.ResponseWriter, r *http.Request) {
+	req, err := listCheckInsRequest(r.URL.Query())
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	response, err := s.grpc.ListCheckIns(r.Context(), req)
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+func listCheckInsRequest(values url.Values) (*grpc_gen.ListCheckInsRequest, error) {
+	req := &grpc_gen.ListCheckInsRequest{
+		Port:     values.Get("port"),
+		DriverId: values.Get("driver_id"),
+	}
+
+	var err error
+	if req.From, err = unixParam(values, "from"); err != nil {
+		return nil, err
+	}
+	if req.To, err = unixParam(values, "to"); err != nil {
+		return nil, err
+	}
+	if req.Limit, err = limitParam(values); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
@@ -0,0 +1,60 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// eventWriter writes server-sent events with JSON encoded messages
+type eventWriter struct {
+	w          http.ResponseWriter
+	flusher    http.Flusher
+	controller *http.ResponseController
+}
+
+// newEventWriter returns an error if the response can't be streamed
+func newEventWriter(w http.ResponseWriter) (*eventWriter, error) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "streaming is not supported")
+	}
+
+	return &eventWriter{
+		w:          w,
+		flusher:    flusher,
+		controller: http.NewResponseController(w),
+	}, nil
+}
+
+// Start writes the headers of the event stream
+func (e *eventWriter) Start() {
+	header := e.w.Header()
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	header.Set("Connection", "keep-alive")
+	e.w.WriteHeader(http.StatusOK)
+	e.flusher.Flush()
+}
+
+// Send writes the message as an event with the given name
+func (e *eventWriter) Send(event string, message proto.Message) error {
+	data, err := protojson.Marshal(message)
+	if err != nil {
+		return err
+	}
+	if err := e.controller.SetWriteDeadline(time.Now().Add(writeTimeout)); err != nil && err != http.ErrNotSupported {
+		return err
+	}
+	if _, err := fmt.Fprintf(e.w, "event: %s\ndata: %s\n\n", event, data); err != nil {
+		return err
+	}
+	e.flusher.Flush()
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package api
+
+import (
+	"net/http"
+
+	grpc_gen "github.com/DaanV2/f1-game-dashboards/server/api/grpc"
+	"github.com/DaanV2/f1-game-dashboards/server/ghost"
+)
+
+func (s *httpServer) getGhostReference(w http.ResponseWriter, r *http.Request) {
+	response, err := s.grpc.GetGhostReference(r.Context(), &grpc_gen.GetGhostReferenceRequest{Port: r.PathValue("port")})
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+// ghostEvents streams the live deltas as server-sent events named delta, with the query parameter port
+func (s *httpServer) ghostEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	events, err := newEventWriter(w)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if err := s.grpc.mustHaveGhosts(ctx); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	port := r.URL.Query().Get("port")
+	if port != "" {
+		if err := s.grpc.mustBeChair(port); err != nil {
+			writeError(w, r, err)
+			return
+		}
+	}
+
+	watcher := s.grpc.ghosts.Watch(port)
+	defer watcher.Close()
+
+	events.Start()
+	_ = watcher.Run(ctx, func(delta ghost.Delta) error {
+		return events.Send("delta", ghostDeltaToProto(delta))
+	})
+}
@@ -0,0 +1,106 @@
+package api
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	grpc_gen "github.com/DaanV2/f1-game-dashboards/server/api/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *httpServer) listLaps(w http.ResponseWriter, r *http.Request) {
+	query, err := lapQuery(r.URL.Query())
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	response, err := s.grpc.ListLaps(r.Context(), &grpc_gen.ListLapsRequest{Query: query})
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+func (s *httpServer) listSessions(w http.ResponseWriter, r *http.Request) {
+	query, err := lapQuery(r.URL.Query())
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	response, err := s.grpc.ListSessions(r.Context(), &grpc_gen.ListSessionsRequest{Query: query})
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+func (s *httpServer) getSession(w http.ResponseWriter, r *http.Request) {
+	response, err := s.grpc.GetSession(r.Context(), &grpc_gen.GetSessionRequest{Id: r.PathValue("id")})
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+// lapQuery reads the query parameters: port, track_id, driver, driver_id, from and to (unix seconds or RFC 3339), valid_only and limit
+func lapQuery(values url.Values) (*grpc_gen.LapQuery, error) {
+	query := &grpc_gen.LapQuery{
+		Port:     values.Get("port"),
+		Driver:   values.Get("driver"),
+		DriverId: values.Get("driver_id"),
+	}
+
+	if v := values.Get("track_id"); v != "" {
+		track, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "track_id must be a number")
+		}
+		t := int32(track)
+		query.TrackId = &t
+	}
+	var err error
+	if query.From, err = unixParam(values, "from"); err != nil {
+		return nil, err
+	}
+	if query.To, err = unixParam(values, "to"); err != nil {
+		return nil, err
+	}
+	if v := values.Get("valid_only"); v != "" {
+		validOnly, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "valid_only must be a boolean")
+		}
+		query.ValidOnly = validOnly
+	}
+	if query.Limit, err = limitParam(values); err != nil {
+		return nil, err
+	}
+
+	return query, nil
+}
+
+// unixParam reads a moment as unix seconds or a RFC 3339 time, 0 if it is not set
+func unixParam(values url.Values, name string) (int64, error) {
+	v := values.Get(name)
+	if v == "" {
+		return 0, nil
+	}
+	if unix, err := strconv.ParseInt(v, 10, 64); err == nil {
+		return unix, nil
+	}
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return t.Unix(), nil
+	}
+
+	return 0, status.Errorf(codes.InvalidArgument, "%s must be unix seconds or a RFC 3339 time", name)
+}
+
+// limitParam reads the limit parameter, 0 if it is not set
+func limitParam(values url.Values) (uint32, error) {
+	v := values.Get("limit")
+	if v == "" {
+		return 0, nil
+	}
+	limit, err := strconv.ParseUint(v, 10, 32)
+	if err != nil {
+		return 0, status.Error(codes.InvalidArgument, "limit must be a positive number")
+	}
+
+	return uint32(limit), nil
+}
@@ -0,0 +1,140 @@
+package api
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	grpc_gen "github.com/DaanV2/f1-game-dashboards/server/api/grpc"
+	"github.com/DaanV2/f1-game-dashboards/server/leaderboard"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	assistLevelPrefix = "ASSIST_LEVEL_"
+	updateKindPrefix  = "LEADERBOARD_UPDATE_KIND_"
+)
+
+func (s *httpServer) listLeaderboards(w http.ResponseWriter, r *http.Request) {
+	response, err := s.grpc.ListLeaderboards(r.Context(), &grpc_gen.ListLeaderboardsRequest{})
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+// getLeaderboard gets the leaderboard of the path /{track_id}/{session_type}/{assists}, with the query parameters since and limit
+func (s *httpServer) getLeaderboard(w http.ResponseWriter, r *http.Request) {
+	req := &grpc_gen.GetLeaderboardRequest{Key: &grpc_gen.LeaderboardKey{}}
+	track, err := strconv.ParseInt(r.PathValue("track_id"), 10, 32)
+	if err != nil {
+		writeError(w, r, status.Error(codes.InvalidArgument, "track_id must be a number"))
+		return
+	}
+	req.Key.TrackId = int32(track)
+	sessionType, err := strconv.ParseUint(r.PathValue("session_type"), 10, 32)
+	if err != nil {
+		writeError(w, r, status.Error(codes.InvalidArgument, "session_type must be a number"))
+		return
+	}
+	req.Key.SessionType = uint32(sessionType)
+	if req.Key.Assists, err = parseAssistLevel(r.PathValue("assists")); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	query := r.URL.Query()
+	if req.Since, err = unixParam(query, "since"); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if req.Limit, err = limitParam(query); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	response, err := s.grpc.GetLeaderboard(r.Context(), req)
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+// leaderboardEvents streams the leaderboard updates as server-sent events, the event name is the kind of the update.
+// Uses the query parameters track_id, session_type, assists, since and limit
+func (s *httpServer) leaderboardEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	events, err := newEventWriter(w)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if err := s.grpc.mustHaveLeaderboard(ctx); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	req, err := watchLeaderboardsRequest(r.URL.Query())
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	filter, err := leaderboardFilterFromProto(req)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	watcher := s.grpc.leaderboard.Watch(filter)
+	defer watcher.Close()
+
+	events.Start()
+	_ = watcher.Run(ctx, func(update leaderboard.Update) error {
+		message := leaderboardUpdateToProto(update)
+		return events.Send(strings.ToLower(strings.TrimPrefix(message.GetKind().String(), updateKindPrefix)), message)
+	})
+}
+
+func watchLeaderboardsRequest(values url.Values) (*grpc_gen.WatchLeaderboardsRequest, error) {
+	req := &grpc_gen.WatchLeaderboardsRequest{}
+	if v := values.Get("track_id"); v != "" {
+		track, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "track_id must be a number")
+		}
+		t := int32(track)
+		req.TrackId = &t
+	}
+	if v := values.Get("session_type"); v != "" {
+		sessionType, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "session_type must be a number")
+		}
+		t := uint32(sessionType)
+		req.SessionType = &t
+	}
+
+	var err error
+	if v := values.Get("assists"); v != "" {
+		if req.Assists, err = parseAssistLevel(v); err != nil {
+			return nil, err
+		}
+	}
+	if req.Since, err = unixParam(values, "since"); err != nil {
+		return nil, err
+	}
+	if req.Limit, err = limitParam(values); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// parseAssistLevel parses the short or full name of an assist level, e.g. none
+func parseAssistLevel(name string) (grpc_gen.AssistLevel, error) {
+	name = strings.ToUpper(name)
+	if !strings.HasPrefix(name, assistLevelPrefix) {
+		name = assistLevelPrefix + name
+	}
+
+	level, ok := grpc_gen.AssistLevel_value[name]
+	if !ok || level == int32(grpc_gen.AssistLevel_ASSIST_LEVEL_UNSPECIFIED) {
+		return 0, status.Errorf(codes.InvalidArgument, "unknown assist level: %s", name)
+	}
+
+	return grpc_gen.AssistLevel(level), nil
+}
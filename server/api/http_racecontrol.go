@@ -0,0 +1,47 @@
+package api
+
+import (
+	"net/http"
+
+	grpc_gen "github.com/DaanV2/f1-game-dashboards/server/api/grpc"
+	"github.com/DaanV2/f1-game-dashboards/server/racecontrol"
+)
+
+func (s *httpServer) listRaceControlLogs(w http.ResponseWriter, r *http.Request) {
+	response, err := s.grpc.ListRaceControlLogs(r.Context(), &grpc_gen.ListRaceControlLogsRequest{Port: r.URL.Query().Get("port")})
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+// getRaceControlLog reads the query parameter kinds, which can be repeated or separated by commas
+func (s *httpServer) getRaceControlLog(w http.ResponseWriter, r *http.Request) {
+	response, err := s.grpc.GetRaceControlLog(r.Context(), &grpc_gen.GetRaceControlLogRequest{
+		SessionId: r.PathValue("id"),
+		Kinds:     listParam(r.URL.Query(), "kinds"),
+	})
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+// raceControlEvents streams the new events as server-sent events named race_control, with the query parameters port and kinds
+func (s *httpServer) raceControlEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	events, err := newEventWriter(w)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if err := s.grpc.mustHaveRaceControl(ctx); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	watcher, err := s.grpc.watchRaceControl(r.URL.Query().Get("port"), listParam(r.URL.Query(), "kinds"))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	defer watcher.Close()
+
+	events.Start()
+	_ = watcher.Run(ctx, func(message racecontrol.Message) error {
+		return events.Send("race_control", raceControlMessageToProto(message))
+	})
+}
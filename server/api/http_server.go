@@ -0,0 +1,189 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/charmbracelet/log"
+)
+
+type httpServerOptions struct {
+	port string
+	host string
+}
+
+// httpServer is the gateway for browsers, it serves the grpc services as JSON and streams the telemetry over websocket and server-sent events
+type httpServer struct {
+	grpc   *grpcServer
+	server *http.Server
+
+	// ctx is the base context of all requests, canceled on stop so the long running streams end
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	options httpServerOptions
+}
+
+func newHttpServer(grpc *grpcServer, options httpServerOptions) *httpServer {
+	return &httpServer{
+		grpc:    grpc,
+		server:  nil,
+		options: options,
+	}
+}
+
+func (s *httpServer) Start() error {
+	address := fmt.Sprintf("%s:%s", s.options.host, s.options.port)
+	log.Info("starting http server...", "address", address)
+
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		return err
+	}
+
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	s.server = &http.Server{
+		Handler:           s.routes(),
+		ReadHeaderTimeout: 10 * time.Second,
+		BaseContext: func(net.Listener) context.Context {
+			return s.ctx
+		},
+	}
+
+	go func() {
+		err := s.server.Serve(lis)
+		if errors.Is(err, http.ErrServerClosed) {
+			log.Info("http server stopped")
+		} else {
+			log.Error("http server stopped with error", "error", err)
+		}
+	}()
+
+	return nil
+}
+
+func (s *httpServer) Stop() error {
+	if s.server == nil {
+		return nil
+	}
+	log.Info("stopping http server...")
+	defer log.Info("http server stopped")
+
+	// End the streams first, otherwise the shutdown waits on them
+	s.cancel()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	return s.server.Shutdown(ctx)
+}
+
+func (s *httpServer) routes() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("POST /api/v1/auth/login", s.login)
+	mux.HandleFunc("POST /api/v1/auth/refresh", s.refresh)
+	mux.HandleFunc("POST /api/v1/auth/guest", s.guestLogin)
+	mux.HandleFunc("GET /api/v1/auth/whoami", s.whoAmI)
+
+	mux.HandleFunc("GET /api/v1/users", s.listUsers)
+	mux.HandleFunc("POST /api/v1/users", s.createUser)
+	mux.HandleFunc("GET /api/v1/users/{id}", s.getUser)
+	mux.HandleFunc("DELETE /api/v1/users/{id}", s.deleteUser)
+	mux.HandleFunc("PUT /api/v1/users/{id}/roles", s.updateUserRoles)
+	mux.HandleFunc("PUT /api/v1/users/{id}/password", s.resetPassword)
+
+	mux.HandleFunc("GET /api/v1/chairs", s.listChairs)
+	mux.HandleFunc("POST /api/v1/chairs", s.createChair)
+	mux.HandleFunc("GET /api/v1/chairs/{port}", s.getChair)
+	mux.HandleFunc("PUT /api/v1/chairs/{port}", s.updateChair)
+	mux.HandleFunc("DELETE /api/v1/chairs/{port}", s.deleteChair)
+	mux.HandleFunc("GET /api/v1/chairs/{port}/forwards", s.getChairForwards)
+	mux.HandleFunc("PUT /api/v1/chairs/{port}/forwards", s.setChairForwards)
+	mux.HandleFunc("GET /api/v1/chairs/pending", s.listPendingChairs)
+	mux.HandleFunc("POST /api/v1/chairs/pending/{source}/adopt", s.adoptPendingChair)
+	mux.HandleFunc("DELETE /api/v1/chairs/pending/{source}", s.dismissPendingChair)
+	mux.HandleFunc("GET /api/v1/chairs/health", s.listChairHealth)
+	mux.HandleFunc("GET /api/v1/chairs/{port}/health", s.getChairHealth)
+
+	mux.HandleFunc("GET /api/v1/chairs/{port}/telemetry/websocket", s.telemetryWebsocket)
+	mux.HandleFunc("GET /api/v1/chairs/{port}/telemetry/events", s.telemetryEvents)
+
+	mux.HandleFunc("GET /api/v1/laps", s.listLaps)
+	mux.HandleFunc("GET /api/v1/sessions", s.listSessions)
+	mux.HandleFunc("GET /api/v1/sessions/{id}", s.getSession)
+
+	mux.HandleFunc("GET /api/v1/leaderboards", s.listLeaderboards)
+	mux.HandleFunc("GET /api/v1/leaderboards/events", s.leaderboardEvents)
+	mux.HandleFunc("GET /api/v1/leaderboards/{track_id}/{session_type}/{assists}", s.getLeaderboard)
+
+	mux.HandleFunc("GET /api/v1/drivers", s.listDrivers)
+	mux.HandleFunc("POST /api/v1/drivers", s.createDriver)
+	mux.HandleFunc("GET /api/v1/drivers/{id}", s.getDriver)
+	mux.HandleFunc("PUT /api/v1/drivers/{id}", s.updateDriver)
+	mux.HandleFunc("DELETE /api/v1/drivers/{id}", s.deleteDriver)
+	mux.HandleFunc("GET /api/v1/chairs/{port}/driver", s.getChairDriver)
+	mux.HandleFunc("POST /api/v1/chairs/{port}/checkin", s.checkIn)
+	mux.HandleFunc("POST /api/v1/chairs/{port}/checkout", s.checkOut)
+	mux.HandleFunc("GET /api/v1/checkins", s.listCheckIns)
+
+	mux.HandleFunc("GET /api/v1/reservations", s.listReservations)
+	mux.HandleFunc("POST /api/v1/reservations", s.createReservation)
+	mux.HandleFunc("GET /api/v1/reservations/{id}", s.getReservation)
+	mux.HandleFunc("DELETE /api/v1/reservations/{id}", s.cancelReservation)
+	mux.HandleFunc("GET /api/v1/queue", s.listQueue)
+	mux.HandleFunc("POST /api/v1/queue", s.joinQueue)
+	mux.HandleFunc("DELETE /api/v1/queue/{id}", s.leaveQueue)
+	mux.HandleFunc("POST /api/v1/chairs/{port}/serve", s.serveNext)
+	mux.HandleFunc("GET /api/v1/archive/sessions", s.listArchivedSessions)
+	mux.HandleFunc("GET /api/v1/archive/sessions/{id}/telemetry", s.queryTelemetry)
+	mux.HandleFunc("GET /api/v1/archive/compare", s.compareLaps)
+	mux.HandleFunc("GET /api/v1/chairs/{port}/ghost", s.getGhostReference)
+	mux.HandleFunc("GET /api/v1/ghost/events", s.ghostEvents)
+	mux.HandleFunc("GET /api/v1/chairs/{port}/standings", s.getStandings)
+	mux.HandleFunc("GET /api/v1/standings/events", s.standingsEvents)
+	mux.HandleFunc("GET /api/v1/chairs/{port}/strategy", s.listStrategies)
+	mux.HandleFunc("GET /api/v1/chairs/{port}/strategy/{car}", s.getStrategy)
+	mux.HandleFunc("GET /api/v1/racecontrol/sessions", s.listRaceControlLogs)
+	mux.HandleFunc("GET /api/v1/racecontrol/sessions/{id}", s.getRaceControlLog)
+	mux.HandleFunc("GET /api/v1/racecontrol/events", s.raceControlEvents)
+	mux.HandleFunc("GET /api/v1/trackmaps", s.listTrackMaps)
+	mux.HandleFunc("GET /api/v1/trackmaps/{track_id}", s.getTrackMap)
+	mux.HandleFunc("DELETE /api/v1/trackmaps/{track_id}", s.deleteTrackMap)
+
+	return s.cors(s.authenicate(mux))
+}
+
+// authenicate verifies the JWT of the request the same way the grpc interceptor does.
+// Browsers can't set headers on websockets and event sources, so the token can also be given with the token query parameter
+func (s *httpServer) authenicate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := r.Header.Get("Authorization")
+		if token == "" {
+			token = r.URL.Query().Get("token")
+		}
+
+		ctx := authenicate(r.Context(), s.grpc.authenicator, r.Method+" "+r.URL.Path, token)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// cors allows the dashboards to be hosted on a different origin, the token is send explicitly so no credentials are allowed
+func (s *httpServer) cors(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header := w.Header()
+		header.Set("Access-Control-Allow-Origin", "*")
+		header.Set("Access-Control-Allow-Headers", "Authorization, Content-Type")
+		header.Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
+
+		if r.Method == http.MethodOptions {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
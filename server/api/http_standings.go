@@ -0,0 +1,42 @@
+package api
+
+import (
+	"net/http"
+
+	grpc_gen "github.com/DaanV2/f1-game-dashboards/server/api/grpc"
+	"github.com/DaanV2/f1-game-dashboards/server/standings"
+)
+
+func (s *httpServer) getStandings(w http.ResponseWriter, r *http.Request) {
+	response, err := s.grpc.GetStandings(r.Context(), &grpc_gen.GetStandingsRequest{Port: r.PathValue("port")})
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+// standingsEvents streams the standings as server-sent events named standings, with the query parameter port
+func (s *httpServer) standingsEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	events, err := newEventWriter(w)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if err := s.grpc.mustHaveStandings(ctx); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	port := r.URL.Query().Get("port")
+	if port != "" {
+		if err := s.grpc.mustBeChair(port); err != nil {
+			writeError(w, r, err)
+			return
+		}
+	}
+
+	watcher := s.grpc.standings.Watch(port)
+	defer watcher.Close()
+
+	events.Start()
+	_ = watcher.Run(ctx, func(result standings.Standings) error {
+		return events.Send("standings", standingsToProto(result))
+	})
+}
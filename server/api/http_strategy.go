@@ -0,0 +1,26 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	grpc_gen "github.com/DaanV2/f1-game-dashboards/server/api/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *httpServer) listStrategies(w http.ResponseWriter, r *http.Request) {
+	response, err := s.grpc.ListStrategies(r.Context(), &grpc_gen.ListStrategiesRequest{Port: r.PathValue("port")})
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+func (s *httpServer) getStrategy(w http.ResponseWriter, r *http.Request) {
+	car, err := strconv.ParseUint(r.PathValue("car"), 10, 8)
+	if err != nil {
+		writeError(w, r, status.Error(codes.InvalidArgument, "car must be a car index"))
+		return
+	}
+
+	response, err := s.grpc.GetStrategy(r.Context(), &grpc_gen.GetStrategyRequest{Port: r.PathValue("port"), CarIndex: uint32(car)})
+	writeResponse(w, r, http.StatusOK, response, err)
+}
@@ -0,0 +1,147 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	grpc_gen "github.com/DaanV2/f1-game-dashboards/server/api/grpc"
+	"github.com/charmbracelet/log"
+	"github.com/gorilla/websocket"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	categoryPrefix = "PACKET_CATEGORY_"
+	// writeTimeout is the maximum time a single telemetry update may take to be written
+	writeTimeout = 10 * time.Second
+)
+
+var upgrader = websocket.Upgrader{
+	// The dashboards can be hosted on any origin, the token is send explicitly
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+// telemetryWebsocket streams the telemetry of a chair as JSON text messages
+func (s *httpServer) telemetryWebsocket(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	telemetry, err := s.openTelemetry(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	defer telemetry.Close()
+
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// The upgrader already responded with the error
+		log.FromContext(ctx).Error("failed to upgrade to websocket", "error", err)
+		return
+	}
+	defer conn.Close()
+
+	// Reading is required to process the control messages, it also detects the client closing the connection
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	err = telemetry.Run(ctx, func(update *grpc_gen.TelemetryUpdate) error {
+		data, err := protojson.Marshal(update)
+		if err != nil {
+			return err
+		}
+		if err := conn.SetWriteDeadline(time.Now().Add(writeTimeout)); err != nil {
+			return err
+		}
+
+		return conn.WriteMessage(websocket.TextMessage, data)
+	})
+
+	message := websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")
+	if err != nil {
+		message = websocket.FormatCloseMessage(websocket.CloseInternalServerErr, "")
+	}
+	_ = conn.WriteControl(websocket.CloseMessage, message, time.Now().Add(time.Second))
+}
+
+// telemetryEvents streams the telemetry of a chair as server-sent events, the event name is the category of the packet
+func (s *httpServer) telemetryEvents(w http.ResponseWriter, r *http.Request) {
+	events, err := newEventWriter(w)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	telemetry, err := s.openTelemetry(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	defer telemetry.Close()
+
+	events.Start()
+	_ = telemetry.Run(r.Context(), func(update *grpc_gen.TelemetryUpdate) error {
+		return events.Send(categoryName(update.GetCategory()), update)
+	})
+}
+
+// openTelemetry checks the authenication and subscribes using the query parameters:
+// category (repeated, e.g. lap_data) and max_rate
+func (s *httpServer) openTelemetry(r *http.Request) (*telemetryStream, error) {
+	ctx := r.Context()
+	if _, err := atleastGuest(ctx); err != nil {
+		return nil, err
+	}
+
+	query := r.URL.Query()
+	req := &grpc_gen.SubscribeRequest{
+		Port: r.PathValue("port"),
+	}
+	for _, name := range query["category"] {
+		category, ok := parseCategory(name)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown category: %s", name)
+		}
+		req.Categories = append(req.Categories, category)
+	}
+	if rate := query.Get("max_rate"); rate != "" {
+		maxRate, err := strconv.ParseUint(rate, 10, 32)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "max_rate must be a positive number")
+		}
+		req.MaxRate = uint32(maxRate)
+	}
+
+	return openTelemetryStream(ctx, s.grpc.chairs, s.grpc.telemetry, req)
+}
+
+// categoryName returns the short name of the category, e.g. lap_data
+func categoryName(category grpc_gen.PacketCategory) string {
+	return strings.ToLower(strings.TrimPrefix(category.String(), categoryPrefix))
+}
+
+// parseCategory parses the short or full name of a category
+func parseCategory(name string) (grpc_gen.PacketCategory, bool) {
+	name = strings.ToUpper(name)
+	if !strings.HasPrefix(name, categoryPrefix) {
+		name = categoryPrefix + name
+	}
+
+	v, ok := grpc_gen.PacketCategory_value[name]
+	if !ok || v == int32(grpc_gen.PacketCategory_PACKET_CATEGORY_UNSPECIFIED) {
+		return 0, false
+	}
+
+	return grpc_gen.PacketCategory(v), true
+}
@@ -0,0 +1,47 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	grpc_gen "github.com/DaanV2/f1-game-dashboards/server/api/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *httpServer) listTrackMaps(w http.ResponseWriter, r *http.Request) {
+	response, err := s.grpc.ListTrackMaps(r.Context(), &grpc_gen.ListTrackMapsRequest{})
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+func (s *httpServer) getTrackMap(w http.ResponseWriter, r *http.Request) {
+	track, err := trackIdParam(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	response, err := s.grpc.GetTrackMap(r.Context(), &grpc_gen.GetTrackMapRequest{TrackId: track})
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+func (s *httpServer) deleteTrackMap(w http.ResponseWriter, r *http.Request) {
+	track, err := trackIdParam(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	response, err := s.grpc.DeleteTrackMap(r.Context(), &grpc_gen.DeleteTrackMapRequest{TrackId: track})
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+// trackIdParam reads the track id from the path
+func trackIdParam(r *http.Request) (int32, error) {
+	track, err := strconv.ParseInt(r.PathValue("track_id"), 10, 32)
+	if err != nil {
+		return 0, status.Error(codes.InvalidArgument, "track_id must be a number")
+	}
+
+	return int32(track), nil
+}
@@ -0,0 +1,58 @@
+package api
+
+import (
+	"net/http"
+
+	grpc_gen "github.com/DaanV2/f1-game-dashboards/server/api/grpc"
+)
+
+func (s *httpServer) listUsers(w http.ResponseWriter, r *http.Request) {
+	response, err := s.grpc.ListUsers(r.Context(), &grpc_gen.ListUsersRequest{})
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+func (s *httpServer) createUser(w http.ResponseWriter, r *http.Request) {
+	req := &grpc_gen.CreateUserRequest{}
+	if err := readRequest(r, req); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	response, err := s.grpc.CreateUser(r.Context(), req)
+	writeResponse(w, r, http.StatusCreated, response, err)
+}
+
+func (s *httpServer) getUser(w http.ResponseWriter, r *http.Request) {
+	response, err := s.grpc.GetUser(r.Context(), &grpc_gen.GetUserRequest{Id: r.PathValue("id")})
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+func (s *httpServer) deleteUser(w http.ResponseWriter, r *http.Request) {
+	response, err := s.grpc.DeleteUser(r.Context(), &grpc_gen.DeleteUserRequest{Id: r.PathValue("id")})
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+func (s *httpServer) updateUserRoles(w http.ResponseWriter, r *http.Request) {
+	req := &grpc_gen.UpdateUserRolesRequest{}
+	if err := readRequest(r, req); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	// The path decides which user is updated
+	req.Id = r.PathValue("id")
+
+	response, err := s.grpc.UpdateUserRoles(r.Context(), req)
+	writeResponse(w, r, http.StatusOK, response, err)
+}
+
+func (s *httpServer) resetPassword(w http.ResponseWriter, r *http.Request) {
+	req := &grpc_gen.ResetPasswordRequest{}
+	if err := readRequest(r, req); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	req.Id = r.PathValue("id")
+
+	response, err := s.grpc.ResetPassword(r.Context(), req)
+	writeResponse(w, r, http.StatusOK, response, err)
+}
@@ -3,43 +3,198 @@ package api
 import (
 	"errors"
 
+	"github.com/DaanV2/f1-game-dashboards/server/archive"
 	"github.com/DaanV2/f1-game-dashboards/server/authenication"
+	"github.com/DaanV2/f1-game-dashboards/server/booking"
+	"github.com/DaanV2/f1-game-dashboards/server/drivers"
+	"github.com/DaanV2/f1-game-dashboards/server/game"
+	"github.com/DaanV2/f1-game-dashboards/server/ghost"
+	"github.com/DaanV2/f1-game-dashboards/server/history"
+	"github.com/DaanV2/f1-game-dashboards/server/leaderboard"
+	"github.com/DaanV2/f1-game-dashboards/server/racecontrol"
 	"github.com/DaanV2/f1-game-dashboards/server/sessions"
+	"github.com/DaanV2/f1-game-dashboards/server/standings"
+	"github.com/DaanV2/f1-game-dashboards/server/strategy"
+	"github.com/DaanV2/f1-game-dashboards/server/trackmap"
+	"github.com/DaanV2/f1-game-dashboards/server/users"
 )
 
-type apiServerOptions struct {
-	grpc grpcServerOptions
+type (
+	apiServerOptions struct {
+		grpc        grpcServerOptions
+		http        httpServerOptions
+		pipeline    *game.PacketPipeline
+		users       *users.UserManagement
+		history     *history.Store
+		leaderboard *leaderboard.Leaderboard
+		drivers     *drivers.Registry
+		booking     *booking.Manager
+		archive     *archive.Archive
+		ghosts      *ghost.Tracker
+		trackMaps   *trackmap.Builder
+		standings   *standings.Engine
+		raceControl *racecontrol.Feed
+		strategy    *strategy.Planner
+		discovery   *game.Discovery
+		health      *game.PacketProcessor
+		sharedPorts []int
+	}
+
+	ApiOption = func(o *apiServerOptions)
+)
+
+// WithPipeline enables the telemetry streams, fed by the packets of the given pipeline
+func WithPipeline(pipeline *game.PacketPipeline) ApiOption {
+	return func(o *apiServerOptions) {
+		o.pipeline = pipeline
+	}
+}
+
+// WithUsers enables the user management service
+func WithUsers(users *users.UserManagement) ApiOption {
+	return func(o *apiServerOptions) {
+		o.users = users
+	}
+}
+
+// WithLapHistory enables the lap history service
+func WithLapHistory(store *history.Store) ApiOption {
+	return func(o *apiServerOptions) {
+		o.history = store
+	}
+}
+
+// WithLeaderboard enables the leaderboard service
+func WithLeaderboard(board *leaderboard.Leaderboard) ApiOption {
+	return func(o *apiServerOptions) {
+		o.leaderboard = board
+	}
+}
+
+// WithDrivers enables the driver service
+func WithDrivers(registry *drivers.Registry) ApiOption {
+	return func(o *apiServerOptions) {
+		o.drivers = registry
+	}
+}
+
+// WithTelemetryArchive enables the telemetry archive service
+func WithTelemetryArchive(a *archive.Archive) ApiOption {
+	return func(o *apiServerOptions) {
+		o.archive = a
+	}
+}
+
+// WithGhosts enables the live delta service
+func WithGhosts(tracker *ghost.Tracker) ApiOption {
+	return func(o *apiServerOptions) {
+		o.ghosts = tracker
+	}
+}
+
+// WithTrackMaps enables the track map service
+func WithTrackMaps(builder *trackmap.Builder) ApiOption {
+	return func(o *apiServerOptions) {
+		o.trackMaps = builder
+	}
+}
+
+// WithStandings enables the live standings service
+func WithStandings(engine *standings.Engine) ApiOption {
+	return func(o *apiServerOptions) {
+		o.standings = engine
+	}
+}
+
+// WithRaceControl enables the race control event service
+func WithRaceControl(feed *racecontrol.Feed) ApiOption {
+	return func(o *apiServerOptions) {
+		o.raceControl = feed
+	}
+}
+
+// WithStrategy enables the fuel and tyre strategy service
+func WithStrategy(planner *strategy.Planner) ApiOption {
+	return func(o *apiServerOptions) {
+		o.strategy = planner
+	}
+}
+
+// WithDiscovery enables adopting the rigs that send datagrams that are not routed to a chair
+func WithDiscovery(discovery *game.Discovery) ApiOption {
+	return func(o *apiServerOptions) {
+		o.discovery = discovery
+	}
+}
+
+// WithHealth enables the health of the listeners of the chairs
+func WithHealth(processor *game.PacketProcessor) ApiOption {
+	return func(o *apiServerOptions) {
+		o.health = processor
+	}
+}
+
+// WithSharedPorts reserves the ports the packet processor listens on besides the ports of the chairs
+func WithSharedPorts(ports ...int) ApiOption {
+	return func(o *apiServerOptions) {
+		o.sharedPorts = append(o.sharedPorts, ports...)
+	}
+}
+
+// WithBooking enables the booking service
+func WithBooking(manager *booking.Manager) ApiOption {
+	return func(o *apiServerOptions) {
+		o.booking = manager
+	}
 }
 
 type ApiServer struct {
 	options apiServerOptions
 
 	grpcServer *grpcServer
+	httpServer *httpServer
 }
 
-func NewApiServer(chairs *sessions.ChairManager, authenicator *authenication.Authenticator) *ApiServer {
+func NewApiServer(chairs *sessions.ChairManager, authenicator *authenication.Authenticator, opts ...ApiOption) *ApiServer {
 	options := apiServerOptions{
 		grpc: grpcServerOptions{
 			port: "50051",
 			host: "0.0.0.0",
 		},
+		http: httpServerOptions{
+			port: "8080",
+			host: "0.0.0.0",
+		},
+	}
+	for _, o := range opts {
+		o(&options)
+	}
+
+	// Shared between the servers, so the pipeline hooks are only added once
+	var telemetry *telemetryFeed
+	if options.pipeline != nil {
+		telemetry = newTelemetryFeed(options.pipeline)
 	}
+	grpcServer := newGrpcServer(chairs, authenicator, telemetry, options)
 
 	return &ApiServer{
 		options: options,
 
-		grpcServer: newGrpcServer(chairs, authenicator, options.grpc),
+		grpcServer: grpcServer,
+		httpServer: newHttpServer(grpcServer, options.http),
 	}
 }
 
 func (server *ApiServer) Start() error {
 	return errors.Join(
 		server.grpcServer.Start(),
+		server.httpServer.Start(),
 	)
 }
 
 func (server *ApiServer) Stop() error {
 	return errors.Join(
+		server.httpServer.Stop(),
 		server.grpcServer.Stop(),
 	)
 }
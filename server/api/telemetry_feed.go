@@ -0,0 +1,216 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	grpc_gen "github.com/DaanV2/f1-game-dashboards/server/api/grpc"
+	"github.com/DaanV2/f1-game-dashboards/server/game"
+	"github.com/DaanV2/f1-game-dashboards/server/pkg/hooks"
+	"github.com/DaanV2/f1-game-dashboards/server/pkg/pubsub"
+	"github.com/DaanV2/f1-game-dashboards/server/sessions"
+	f1_2023 "github.com/DaanV2/go-f1-library/packets/2023"
+	"github.com/charmbracelet/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// telemetryBuffer is the amount of packets a stream can fall behind before packets are dropped
+const telemetryBuffer = 256
+
+type (
+	// telemetryFeed republishes the packets of the pipeline on a topic, so streams can subscribe and unsubscribe
+	telemetryFeed struct {
+		topic *pubsub.Topic[telemetryPacket]
+	}
+
+	// telemetryPacket is a decoded packet of a chair
+	telemetryPacket struct {
+		Chair    sessions.Chair
+		Category grpc_gen.PacketCategory
+		Car      int // The car index of per car packets, -1 for packets of all cars
+		Packet   any // One of the f1_2023 packet structs
+	}
+
+	// telemetryFilter decides which packets of the feed a subscriber receives
+	telemetryFilter struct {
+		chairId    string
+		categories map[grpc_gen.PacketCategory]bool // All categories if empty
+		interval   time.Duration                    // Minimum time between packets of the same category, 0 for no limit
+		last       map[telemetryRateKey]time.Time
+	}
+
+	telemetryRateKey struct {
+		category grpc_gen.PacketCategory
+		car      int
+	}
+
+	// telemetryStream is the subscription of a single client on the feed, shared by the grpc, websocket and sse streams
+	telemetryStream struct {
+		subscription *pubsub.Subscription[telemetryPacket]
+		filter       *telemetryFilter
+		logger       *log.Logger
+	}
+)
+
+func newTelemetryFeed(pipeline *game.PacketPipeline) *telemetryFeed {
+	feed := &telemetryFeed{
+		topic: pubsub.NewTopic[telemetryPacket](),
+	}
+
+	publish(feed, &pipeline.Motion, grpc_gen.PacketCategory_PACKET_CATEGORY_MOTION)
+	publish(feed, &pipeline.Session, grpc_gen.PacketCategory_PACKET_CATEGORY_SESSION)
+	publish(feed, &pipeline.LapData, grpc_gen.PacketCategory_PACKET_CATEGORY_LAP_DATA)
+	publish(feed, &pipeline.Event, grpc_gen.PacketCategory_PACKET_CATEGORY_EVENT)
+	publish(feed, &pipeline.Participants, grpc_gen.PacketCategory_PACKET_CATEGORY_PARTICIPANTS)
+	publish(feed, &pipeline.CarSetups, grpc_gen.PacketCategory_PACKET_CATEGORY_CAR_SETUPS)
+	publish(feed, &pipeline.CarTelemetry, grpc_gen.PacketCategory_PACKET_CATEGORY_CAR_TELEMETRY)
+	publish(feed, &pipeline.CarStatus, grpc_gen.PacketCategory_PACKET_CATEGORY_CAR_STATUS)
+	publish(feed, &pipeline.FinalClassification, grpc_gen.PacketCategory_PACKET_CATEGORY_FINAL_CLASSIFICATION)
+	publish(feed, &pipeline.LobbyInfo, grpc_gen.PacketCategory_PACKET_CATEGORY_LOBBY_INFO)
+	publish(feed, &pipeline.CarDamage, grpc_gen.PacketCategory_PACKET_CATEGORY_CAR_DAMAGE)
+	publish(feed, &pipeline.MotionEx, grpc_gen.PacketCategory_PACKET_CATEGORY_MOTION_EX)
+
+	// Per car packets, so rate limits don't drop other cars
+	pipeline.SessionHistory.Add(func(p game.PacketWithChair[f1_2023.PacketSessionHistoryData]) {
+		feed.publish(p.Chair, grpc_gen.PacketCategory_PACKET_CATEGORY_SESSION_HISTORY, int(p.Packet.CarIdx), p.Packet)
+	})
+	pipeline.TyreSets.Add(func(p game.PacketWithChair[f1_2023.PacketTyreSetsData]) {
+		feed.publish(p.Chair, grpc_gen.PacketCategory_PACKET_CATEGORY_TYRE_SETS, int(p.Packet.CarIdx), p.Packet)
+	})
+
+	return feed
+}
+
+// Subscribe creates a new subscription on the packets of the feed that match the filter
+func (f *telemetryFeed) Subscribe(buffer int, filter *telemetryFilter) *pubsub.Subscription[telemetryPacket] {
+	return f.topic.SubscribeFunc(buffer, filter.Match)
+}
+
+func (f *telemetryFeed) publish(chair sessions.Chair, category grpc_gen.PacketCategory, car int, packet any) {
+	// Skip the allocations if nobody is listening
+	if !f.topic.Active() {
+		return
+	}
+
+	f.topic.Publish(telemetryPacket{
+		Chair:    chair,
+		Category: category,
+		Car:      car,
+		Packet:   packet,
+	})
+}
+
+func publish[T any](feed *telemetryFeed, hook *hooks.Hook[game.PacketWithChair[T]], category grpc_gen.PacketCategory) {
+	hook.Add(func(p game.PacketWithChair[T]) {
+		feed.publish(p.Chair, category, -1, p.Packet)
+	})
+}
+
+func newTelemetryFilter(chairId string, categories []grpc_gen.PacketCategory, maxRate uint32) *telemetryFilter {
+	filter := &telemetryFilter{
+		chairId:    chairId,
+		categories: make(map[grpc_gen.PacketCategory]bool, len(categories)),
+		last:       make(map[telemetryRateKey]time.Time),
+	}
+	for _, c := range categories {
+		filter.categories[c] = true
+	}
+	if maxRate > 0 {
+		filter.interval = time.Second / time.Duration(maxRate)
+	}
+
+	return filter
+}
+
+// Match returns true if the packet is of the chair and categories of the subscriber. It is called by the publishers
+// before the packet is buffered, so the packets of other chairs can not fill the buffer
+func (f *telemetryFilter) Match(packet telemetryPacket) bool {
+	if packet.Chair.Id() != f.chairId {
+		return false
+	}
+
+	return len(f.categories) == 0 || f.categories[packet.Category]
+}
+
+// Allow returns true if the matched packet should be send to the subscriber at the given moment, applying the rate limit
+func (f *telemetryFilter) Allow(packet telemetryPacket, now time.Time) bool {
+	switch packet.Category {
+	case grpc_gen.PacketCategory_PACKET_CATEGORY_EVENT, grpc_gen.PacketCategory_PACKET_CATEGORY_FINAL_CLASSIFICATION:
+		// Never dropped, these only happen once
+		return true
+	}
+	if f.interval <= 0 {
+		return true
+	}
+
+	key := telemetryRateKey{packet.Category, packet.Car}
+	if last, ok := f.last[key]; ok && now.Sub(last) < f.interval {
+		return false
+	}
+	f.last[key] = now
+
+	return true
+}
+
+// openTelemetryStream validates the request and subscribes on the feed, errors are grpc status errors
+func openTelemetryStream(ctx context.Context, chairs *sessions.ChairManager, feed *telemetryFeed, req *grpc_gen.SubscribeRequest) (*telemetryStream, error) {
+	port := req.GetPort()
+	logger := log.FromContext(ctx).With(
+		"port", port,
+		"categories", req.GetCategories(),
+		"max_rate", req.GetMaxRate(),
+	)
+
+	if port == "" || !sessions.IsChairId(port) {
+		logger.Error("port is required")
+		return nil, status.Error(codes.InvalidArgument, "port is required")
+	}
+	if _, exists := chairs.Get(port); !exists {
+		logger.Warn("chair not found")
+		return nil, status.Error(codes.NotFound, "chair not found")
+	}
+	if feed == nil {
+		logger.Error("telemetry is not enabled")
+		return nil, status.Error(codes.Unavailable, "telemetry is not enabled")
+	}
+
+	logger.Info("subscribed to telemetry")
+	filter := newTelemetryFilter(port, req.GetCategories(), req.GetMaxRate())
+	return &telemetryStream{
+		subscription: feed.Subscribe(telemetryBuffer, filter),
+		filter:       filter,
+		logger:       logger,
+	}, nil
+}
+
+// Run sends the allowed packets until the context is done or sending fails
+func (t *telemetryStream) Run(ctx context.Context, send func(*grpc_gen.TelemetryUpdate) error) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case packet, ok := <-t.subscription.Values():
+			if !ok {
+				return nil
+			}
+			if !t.filter.Allow(packet, time.Now()) {
+				continue
+			}
+			update := telemetryToProto(packet)
+			if update == nil {
+				continue
+			}
+			if err := send(update); err != nil {
+				t.logger.Error("failed to send telemetry", "error", err)
+				return err
+			}
+		}
+	}
+}
+
+// Close unsubscribes from the feed
+func (t *telemetryStream) Close() {
+	t.subscription.Close()
+	t.logger.Info("unsubscribed from telemetry", "dropped", t.subscription.Dropped())
+}
@@ -0,0 +1,619 @@
+package api
+
+import (
+	"bytes"
+
+	grpc_gen "github.com/DaanV2/f1-game-dashboards/server/api/grpc"
+	"github.com/DaanV2/f1-game-dashboards/server/game/f1_2024"
+	f1_2023 "github.com/DaanV2/go-f1-library/packets/2023"
+)
+
+// telemetryToProto converts the packet into the update send to the clients, returns nil for unknown packets
+func telemetryToProto(p telemetryPacket) *grpc_gen.TelemetryUpdate {
+	update := &grpc_gen.TelemetryUpdate{
+		Port:     p.Chair.Id(),
+		Category: p.Category,
+	}
+
+	switch packet := p.Packet.(type) {
+	case f1_2023.PacketMotionData:
+		update.Packet = &grpc_gen.TelemetryUpdate_Motion{Motion: motionToProto(packet)}
+	case f1_2023.PacketSessionData:
+		update.Packet = &grpc_gen.TelemetryUpdate_Session{Session: sessionToProto(packet)}
+	case f1_2023.PacketLapData:
+		update.Packet = &grpc_gen.TelemetryUpdate_LapData{LapData: lapDataToProto(packet)}
+	case f1_2023.PacketEventData:
+		update.Packet = &grpc_gen.TelemetryUpdate_Event{Event: eventToProto(packet)}
+	case f1_2023.PacketParticipantsData:
+		update.Packet = &grpc_gen.TelemetryUpdate_Participants{Participants: participantsToProto(packet)}
+	case f1_2023.PacketCarSetupsData:
+		update.Packet = &grpc_gen.TelemetryUpdate_CarSetups{CarSetups: carSetupsToProto(packet)}
+	case f1_2023.PacketCarTelemetryData:
+		update.Packet = &grpc_gen.TelemetryUpdate_CarTelemetry{CarTelemetry: carTelemetryToProto(packet)}
+	case f1_2023.PacketCarStatusData:
+		update.Packet = &grpc_gen.TelemetryUpdate_CarStatus{CarStatus: carStatusToProto(packet)}
+	case f1_2023.PacketFinalClassificationData:
+		update.Packet = &grpc_gen.TelemetryUpdate_FinalClassification{FinalClassification: finalClassificationToProto(packet)}
+	case f1_2023.PacketLobbyInfoData:
+		update.Packet = &grpc_gen.TelemetryUpdate_LobbyInfo{LobbyInfo: lobbyInfoToProto(packet)}
+	case f1_2023.PacketCarDamageData:
+		update.Packet = &grpc_gen.TelemetryUpdate_CarDamage{CarDamage: carDamageToProto(packet)}
+	case f1_2023.PacketSessionHistoryData:
+		update.Packet = &grpc_gen.TelemetryUpdate_SessionHistory{SessionHistory: sessionHistoryToProto(packet)}
+	case f1_2023.PacketTyreSetsData:
+		update.Packet = &grpc_gen.TelemetryUpdate_TyreSets{TyreSets: tyreSetsToProto(packet)}
+	case f1_2023.PacketMotionExData:
+		update.Packet = &grpc_gen.TelemetryUpdate_MotionEx{MotionEx: motionExToProto(packet)}
+	default:
+		return nil
+	}
+
+	return update
+}
+
+func headerToProto(h f1_2023.PacketHeader) *grpc_gen.PacketHeader {
+	return &grpc_gen.PacketHeader{
+		PacketFormat:            uint32(h.PacketFormat),
+		GameYear:                uint32(h.GameYear),
+		GameMajorVersion:        uint32(h.GameMajorVersion),
+		GameMinorVersion:        uint32(h.GameMinorVersion),
+		PacketVersion:           uint32(h.PacketVersion),
+		PacketId:                uint32(h.PacketId),
+		SessionUid:              h.SessionUID,
+		SessionTime:             h.SessionTime,
+		FrameIdentifier:         h.FrameIdentifier,
+		OverallFrameIdentifier:  h.OverallFrameIdentifier,
+		PlayerCarIndex:          uint32(h.PlayerCarIndex),
+		SecondaryPlayerCarIndex: uint32(h.SecondaryPlayerCarIndex),
+	}
+}
+
+func motionToProto(p f1_2023.PacketMotionData) *grpc_gen.PacketMotionData {
+	cars := make([]*grpc_gen.CarMotionData, len(p.CarMotionData))
+	for i, c := range p.CarMotionData {
+		cars[i] = &grpc_gen.CarMotionData{
+			WorldPositionX:     c.WorldPositionX,
+			WorldPositionY:     c.WorldPositionY,
+			WorldPositionZ:     c.WorldPositionZ,
+			WorldVelocityX:     c.WorldVelocityX,
+			WorldVelocityY:     c.WorldVelocityY,
+			WorldVelocityZ:     c.WorldVelocityZ,
+			WorldForwardDirX:   int32(c.WorldForwardDirX),
+			WorldForwardDirY:   int32(c.WorldForwardDirY),
+			WorldForwardDirZ:   int32(c.WorldForwardDirZ),
+			WorldRightDirX:     int32(c.WorldRightDirX),
+			WorldRightDirY:     int32(c.WorldRightDirY),
+			WorldRightDirZ:     int32(c.WorldRightDirZ),
+			GForceLateral:      c.GForceLateral,
+			GForceLongitudinal: c.GForceLongitudinal,
+			GForceVertical:     c.GForceVertical,
+			Yaw:                c.Yaw,
+			Pitch:              c.Pitch,
+			Roll:               c.Roll,
+		}
+	}
+
+	return &grpc_gen.PacketMotionData{
+		Header:        headerToProto(p.Header),
+		CarMotionData: cars,
+	}
+}
+
+func sessionToProto(p f1_2023.PacketSessionData) *grpc_gen.PacketSessionData {
+	zones := make([]*grpc_gen.MarshalZone, 0, p.NumMarshalZones)
+	for _, z := range p.MarshalZones[:min(int(p.NumMarshalZones), len(p.MarshalZones))] {
+		zones = append(zones, &grpc_gen.MarshalZone{
+			ZoneStart: z.ZoneStart,
+			ZoneFlag:  int32(z.ZoneFlag),
+		})
+	}
+	samples := make([]*grpc_gen.WeatherForecastSample, 0, p.NumWeatherForecastSamples)
+	for _, s := range p.WeatherForecastSamples[:min(int(p.NumWeatherForecastSamples), len(p.WeatherForecastSamples))] {
+		samples = append(samples, &grpc_gen.WeatherForecastSample{
+			SessionType:            uint32(s.SessionType),
+			TimeOffset:             uint32(s.TimeOffset),
+			WeatherType:            uint32(s.WeatherType),
+			TrackTemperature:       int32(s.TrackTemperature),
+			TrackTemperatureChange: int32(s.TrackTemperatureChange),
+			AirTemperature:         int32(s.AirTemperature),
+			AirTemperatureChange:   int32(s.AirTemperatureChange),
+			RainPercentage:         uint32(s.RainPercentage),
+		})
+	}
+
+	return &grpc_gen.PacketSessionData{
+		Header:                          headerToProto(p.Header),
+		Weather:                         uint32(p.Weather),
+		TrackTemperature:                int32(p.TrackTemperature),
+		AirTemperature:                  int32(p.AirTemperature),
+		TotalLaps:                       uint32(p.TotalLaps),
+		TrackLength:                     uint32(p.TrackLength),
+		SessionType:                     uint32(p.SessionType),
+		TrackId:                         int32(p.TrackId),
+		Formula:                         uint32(p.Formula),
+		SessionTimeLeft:                 uint32(p.SessionTimeLeft),
+		SessionDuration:                 uint32(p.SessionDuration),
+		PitSpeedLimit:                   uint32(p.PitSpeedLimit),
+		GamePaused:                      uint32(p.GamePaused),
+		IsSpectating:                    uint32(p.IsSpectating),
+		SpectatorCarIndex:               uint32(p.SpectatorCarIndex),
+		SliProNativeSupport:             uint32(p.SliProNativeSupport),
+		NumMarshalZones:                 uint32(p.NumMarshalZones),
+		MarshalZones:                    zones,
+		SafetyCarStatus:                 uint32(p.SafetyCarStatus),
+		NetworkGame:                     uint32(p.NetworkGame),
+		NumWeatherForecastSamples:       uint32(p.NumWeatherForecastSamples),
+		WeatherForecastSamples:          samples,
+		ForecastAccuracy:                uint32(p.ForecastAccuracy),
+		AiDifficulty:                    uint32(p.AiDifficulty),
+		SeasonLinkIdentifier:            p.SeasonLinkIdentifier,
+		WeekendLinkIdentifier:           p.WeekendLinkIdentifier,
+		SessionLinkIdentifier:           p.SessionLinkIdentifier,
+		PitStopWindowIdealLap:           uint32(p.PitStopWindowIdealLap),
+		PitStopWindowLatestLap:          uint32(p.PitStopWindowLatestLap),
+		PitStopRejoinPosition:           uint32(p.PitStopRejoinPosition),
+		SteeringAssist:                  uint32(p.SteeringAssist),
+		BrakingAssist:                   uint32(p.BrakingAssist),
+		GearboxAssist:                   uint32(p.GearboxAssist),
+		PitAssist:                       uint32(p.PitAssist),
+		PitReleaseAssist:                uint32(p.PitReleaseAssist),
+		ErsAssist:                       uint32(p.ERSAssist),
+		DrsAssist:                       uint32(p.DRSAssist),
+		DynamicRacingLine:               uint32(p.DynamicRacingLine),
+		DynamicRacingLineType:           uint32(p.DynamicRacingLineType),
+		GameMode:                        uint32(p.GameMode),
+		RuleSet:                         uint32(p.RuleSet),
+		TimeOfDay:                       p.TimeOfDay,
+		SessionLength:                   uint32(p.SessionLength),
+		SpeedUnitsLeadPlayer:            uint32(p.SpeedUnitsLeadPlayer),
+		TemperatureUnitsLeadPlayer:      uint32(p.TemperatureUnitsLeadPlayer),
+		SpeedUnitsSecondaryPlayer:       uint32(p.SpeedUnitsSecondaryPlayer),
+		TemperatureUnitsSecondaryPlayer: uint32(p.TemperatureUnitsSecondaryPlayer),
+		NumSafetyCarPeriods:             uint32(p.NumSafetyCarPeriods),
+		NumVirtualSafetyCarPeriods:      uint32(p.NumVirtualSafetyCarPeriods),
+		NumRedFlagPeriods:               uint32(p.NumRedFlagPeriods),
+	}
+}
+
+func lapDataToProto(p f1_2023.PacketLapData) *grpc_gen.PacketLapData {
+	laps := make([]*grpc_gen.LapData, len(p.LapData))
+	for i, l := range p.LapData {
+		laps[i] = &grpc_gen.LapData{
+			LastLapTimeInMs:             l.LastLapTimeInMS,
+			CurrentLapTimeInMs:          l.CurrentLapTimeInMS,
+			Sector1TimeInMs:             uint32(l.Sector1TimeInMS),
+			Sector1TimeMinutes:          uint32(l.Sector1TimeMinutes),
+			Sector2TimeInMs:             uint32(l.Sector2TimeInMS),
+			Sector2TimeMinutes:          uint32(l.Sector2TimeMinutes),
+			DeltaToCarInFrontInMs:       uint32(l.DeltaToCarInFrontInMS),
+			DeltaToRaceLeaderInMs:       uint32(l.DeltaToRaceLeaderInMS),
+			LapDistance:                 l.LapDistance,
+			TotalDistance:               l.TotalDistance,
+			SafetyCarDelta:              l.SafetyCarDelta,
+			CarPosition:                 uint32(l.CarPosition),
+			CurrentLapNum:               uint32(l.CurrentLapNum),
+			PitStatus:                   uint32(l.PitStatus),
+			NumPitStops:                 uint32(l.NumPitStops),
+			Sector:                      uint32(l.Sector),
+			CurrentLapInvalid:           uint32(l.CurrentLapInvalid),
+			Penalties:                   uint32(l.Penalties),
+			TotalWarnings:               uint32(l.TotalWarnings),
+			CornerCuttingWarnings:       uint32(l.CornerCuttingWarnings),
+			NumUnservedDriveThroughPens: uint32(l.NumUnservedDriveThroughPens),
+			NumUnservedStopGoPens:       uint32(l.NumUnservedStopGoPens),
+			GridPosition:                uint32(l.GridPosition),
+			DriverStatus:                uint32(l.DriverStatus),
+			ResultStatus:                uint32(l.ResultStatus),
+			PitLaneTimerActive:          uint32(l.PitLaneTimerActive),
+			PitLaneTimeInLaneInMs:       uint32(l.PitLaneTimeInLaneInMS),
+			PitStopTimerInMs:            uint32(l.PitStopTimerInMS),
+			PitStopShouldServePen:       uint32(l.PitStopShouldServePen),
+		}
+	}
+
+	return &grpc_gen.PacketLapData{
+		Header:               headerToProto(p.Header),
+		LapData:              laps,
+		TimeTrialPbCarIdx:    uint32(p.TimeTrialPBCarIdx),
+		TimeTrialRivalCarIdx: uint32(p.TimeTrialRivalCarIdx),
+	}
+}
+
+func eventToProto(p f1_2023.PacketEventData) *grpc_gen.PacketEventData {
+	event := &grpc_gen.PacketEventData{
+		Header:          headerToProto(p.Header),
+		EventStringCode: string(p.EventStringCode),
+	}
+
+	switch d := p.EventDetails.(type) {
+	case f1_2023.FastestLap:
+		event.Details = &grpc_gen.PacketEventData_FastestLap{FastestLap: &grpc_gen.FastestLap{VehicleIdx: uint32(d.VehicleIdx), LapTime: d.LapTime}}
+	case f1_2023.Retirement:
+		event.Details = &grpc_gen.PacketEventData_Retirement{Retirement: vehicleEvent(d.VehicleIdx)}
+	case f1_2023.TeamMateInPits:
+		event.Details = &grpc_gen.PacketEventData_TeamMateInPits{TeamMateInPits: vehicleEvent(d.VehicleIdx)}
+	case f1_2023.RaceWinner:
+		event.Details = &grpc_gen.PacketEventData_RaceWinner{RaceWinner: vehicleEvent(d.VehicleIdx)}
+	case f1_2023.Penalty:
+		event.Details = &grpc_gen.PacketEventData_Penalty{Penalty: &grpc_gen.Penalty{
+			PenaltyType:      uint32(d.PenaltyType),
+			InfringementType: uint32(d.InfringementType),
+			VehicleIdx:       uint32(d.VehicleIdx),
+			OtherVehicleIdx:  uint32(d.OtherVehicleIdx),
+			Time:             uint32(d.Time),
+			LapNum:           uint32(d.LapNum),
+			PlacesGained:     uint32(d.PlacesGained),
+		}}
+	case f1_2023.SpeedTrap:
+		event.Details = &grpc_gen.PacketEventData_SpeedTrap{SpeedTrap: &grpc_gen.SpeedTrap{
+			VehicleIdx:                 uint32(d.VehicleIdx),
+			Speed:                      d.Speed,
+			IsOverallFastestInSession:  uint32(d.IsOverallFastestInSession),
+			IsDriverFastestInSession:   uint32(d.IsDriverFastestInSession),
+			FastestVehicleIdxInSession: uint32(d.FastestVehicleIdxInSession),
+			FastestSpeedInSession:      d.FastestSpeedInSession,
+		}}
+	case f1_2023.StartLights:
+		event.Details = &grpc_gen.PacketEventData_StartLights{StartLights: &grpc_gen.StartLights{NumLights: uint32(d.NumLights)}}
+	case f1_2023.DriveThroughPenaltyServed:
+		event.Details = &grpc_gen.PacketEventData_DriveThroughPenaltyServed{DriveThroughPenaltyServed: vehicleEvent(d.VehicleIdx)}
+	case f1_2023.StopGoPenaltyServed:
+		event.Details = &grpc_gen.PacketEventData_StopGoPenaltyServed{StopGoPenaltyServed: vehicleEvent(d.VehicleIdx)}
+	case f1_2023.Flashback:
+		event.Details = &grpc_gen.PacketEventData_Flashback{Flashback: &grpc_gen.Flashback{
+			FlashbackFrameIdentifier: d.FlashbackFrameIdentifier,
+			FlashbackSessionTime:     d.FlashbackSessionTime,
+		}}
+	case f1_2023.Buttons:
+		event.Details = &grpc_gen.PacketEventData_Buttons{Buttons: &grpc_gen.Buttons{ButtonStatus: uint32(d.ButtonStatus)}}
+	case f1_2023.Overtake:
+		event.Details = &grpc_gen.PacketEventData_Overtake{Overtake: &grpc_gen.Overtake{
+			OvertakingVehicleIdx:     uint32(d.OvertakingVehicleIdx),
+			BeingOvertakenVehicleIdx: uint32(d.BeingOvertakenVehicleIdx),
+		}}
+	case f1_2024.SafetyCar:
+		event.Details = &grpc_gen.PacketEventData_SafetyCar{SafetyCar: &grpc_gen.SafetyCar{
+			SafetyCarType: uint32(d.SafetyCarType),
+			EventType:     uint32(d.EventType),
+		}}
+	case f1_2024.Collision:
+		event.Details = &grpc_gen.PacketEventData_Collision{Collision: &grpc_gen.Collision{
+			Vehicle1Idx: uint32(d.Vehicle1Idx),
+			Vehicle2Idx: uint32(d.Vehicle2Idx),
+		}}
+	}
+
+	return event
+}
+
+func vehicleEvent(idx uint8) *grpc_gen.VehicleEvent {
+	return &grpc_gen.VehicleEvent{VehicleIdx: uint32(idx)}
+}
+
+func participantsToProto(p f1_2023.PacketParticipantsData) *grpc_gen.PacketParticipantsData {
+	participants := make([]*grpc_gen.ParticipantData, len(p.Participants))
+	for i, d := range p.Participants {
+		participants[i] = &grpc_gen.ParticipantData{
+			AiControlled:    uint32(d.AiControlled),
+			DriverId:        uint32(d.DriverId),
+			NetworkId:       uint32(d.NetworkId),
+			TeamId:          uint32(d.TeamId),
+			MyTeam:          uint32(d.MyTeam),
+			RaceNumber:      uint32(d.RaceNumber),
+			Nationality:     uint32(d.Nationality),
+			Name:            nameToString(d.Name),
+			YourTelemetry:   uint32(d.YourTelemetry),
+			ShowOnlineNames: uint32(d.ShowOnlineNames),
+			Platform:        uint32(d.Platform),
+		}
+	}
+
+	return &grpc_gen.PacketParticipantsData{
+		Header:        headerToProto(p.Header),
+		NumActiveCars: uint32(p.NumActiveCars),
+		Participants:  participants,
+	}
+}
+
+func carSetupsToProto(p f1_2023.PacketCarSetupsData) *grpc_gen.PacketCarSetupsData {
+	setups := make([]*grpc_gen.CarSetupData, len(p.CarSetups))
+	for i, s := range p.CarSetups {
+		setups[i] = &grpc_gen.CarSetupData{
+			FrontWing:              uint32(s.FrontWing),
+			RearWing:               uint32(s.RearWing),
+			OnThrottle:             uint32(s.OnThrottle),
+			OffThrottle:            uint32(s.OffThrottle),
+			FrontCamber:            s.FrontCamber,
+			RearCamber:             s.RearCamber,
+			FrontToe:               s.FrontToe,
+			RearToe:                s.RearToe,
+			FrontSuspension:        uint32(s.FrontSuspension),
+			RearSuspension:         uint32(s.RearSuspension),
+			FrontAntiRollBar:       uint32(s.FrontAntiRollBar),
+			RearAntiRollBar:        uint32(s.RearAntiRollBar),
+			FrontSuspensionHeight:  uint32(s.FrontSuspensionHeight),
+			RearSuspensionHeight:   uint32(s.RearSuspensionHeight),
+			BrakePressure:          uint32(s.BrakePressure),
+			BrakeBias:              uint32(s.BrakeBias),
+			RearLeftTyrePressure:   s.RearLeftTyrePressure,
+			RearRightTyrePressure:  s.RearRightTyrePressure,
+			FrontLeftTyrePressure:  s.FrontLeftTyrePressure,
+			FrontRightTyrePressure: s.FrontRightTyrePressure,
+			Ballast:                uint32(s.Ballast),
+			FuelLoad:               s.FuelLoad,
+		}
+	}
+
+	return &grpc_gen.PacketCarSetupsData{
+		Header:    headerToProto(p.Header),
+		CarSetups: setups,
+	}
+}
+
+func carTelemetryToProto(p f1_2023.PacketCarTelemetryData) *grpc_gen.PacketCarTelemetryData {
+	cars := make([]*grpc_gen.CarTelemetryData, len(p.CarTelemetryData))
+	for i, c := range p.CarTelemetryData {
+		cars[i] = &grpc_gen.CarTelemetryData{
+			Speed:                   uint32(c.Speed),
+			Throttle:                c.Throttle,
+			Steer:                   c.Steer,
+			Brake:                   c.Brake,
+			Clutch:                  uint32(c.Clutch),
+			Gear:                    int32(c.Gear),
+			EngineRpm:               uint32(c.EngineRPM),
+			Drs:                     uint32(c.Drs),
+			RevLightsPercent:        uint32(c.RevLightsPercent),
+			RevLightsBitValue:       uint32(c.RevLightsBitValue),
+			BrakesTemperature:       wheelsToProto(c.BrakesTemperature),
+			TyresSurfaceTemperature: wheelsToProto(c.TyresSurfaceTemperature),
+			TyresInnerTemperature:   wheelsToProto(c.TyresInnerTemperature),
+			EngineTemperature:       uint32(c.EngineTemperature),
+			TyresPressure:           wheelsToProto(c.TyresPressure),
+			SurfaceType:             wheelsToProto(c.SurfaceType),
+		}
+	}
+
+	return &grpc_gen.PacketCarTelemetryData{
+		Header:                       headerToProto(p.Header),
+		CarTelemetryData:             cars,
+		MfdPanelIndex:                uint32(p.MfdPanelIndex),
+		MfdPanelIndexSecondaryPlayer: uint32(p.MfdPanelIndexSecondaryPlayer),
+		SuggestedGear:                int32(p.SuggestedGear),
+	}
+}
+
+func carStatusToProto(p f1_2023.PacketCarStatusData) *grpc_gen.PacketCarStatusData {
+	cars := make([]*grpc_gen.CarStatusData, len(p.CarStatusData))
+	for i, c := range p.CarStatusData {
+		cars[i] = &grpc_gen.CarStatusData{
+			TractionControl:         uint32(c.TractionControl),
+			AntiLockBrakes:          uint32(c.AntiLockBrakes),
+			FuelMix:                 uint32(c.FuelMix),
+			FrontBrakeBias:          uint32(c.FrontBrakeBias),
+			PitLimiterStatus:        uint32(c.PitLimiterStatus),
+			FuelInTank:              c.FuelInTank,
+			FuelCapacity:            c.FuelCapacity,
+			FuelRemainingLaps:       c.FuelRemainingLaps,
+			MaxRpm:                  uint32(c.MaxRPM),
+			IdleRpm:                 uint32(c.IdleRPM),
+			MaxGears:                uint32(c.MaxGears),
+			DrsAllowed:              uint32(c.DrsAllowed),
+			DrsActivationDistance:   uint32(c.DrsActivationDistance),
+			ActualTyreCompound:      uint32(c.ActualTyreCompound),
+			VisualTyreCompound:      uint32(c.VisualTyreCompound),
+			TyresAgeLaps:            uint32(c.TyresAgeLaps),
+			VehicleFiaFlags:         int32(c.VehicleFiaFlags),
+			EnginePowerIce:          c.EnginePowerICE,
+			EnginePowerMguk:         c.EnginePowerMGUK,
+			ErsStoreEnergy:          c.ErsStoreEnergy,
+			ErsDeployMode:           uint32(c.ErsDeployMode),
+			ErsHarvestedThisLapMguk: c.ErsHarvestedThisLapMGUK,
+			ErsHarvestedThisLapMguh: c.ErsHarvestedThisLapMGUH,
+			ErsDeployedThisLap:      c.ErsDeployedThisLap,
+			NetworkPaused:           uint32(c.NetworkPaused),
+		}
+	}
+
+	return &grpc_gen.PacketCarStatusData{
+		Header:        headerToProto(p.Header),
+		CarStatusData: cars,
+	}
+}
+
+func finalClassificationToProto(p f1_2023.PacketFinalClassificationData) *grpc_gen.PacketFinalClassificationData {
+	cars := make([]*grpc_gen.FinalClassificationData, len(p.ClassificationData))
+	for i, c := range p.ClassificationData {
+		cars[i] = &grpc_gen.FinalClassificationData{
+			Position:          uint32(c.Position),
+			NumLaps:           uint32(c.NumLaps),
+			GridPosition:      uint32(c.GridPosition),
+			Points:            uint32(c.Points),
+			NumPitStops:       uint32(c.NumPitStops),
+			ResultStatus:      uint32(c.ResultStatus),
+			BestLapTimeInMs:   c.BestLapTimeInMS,
+			TotalRaceTime:     c.TotalRaceTime,
+			PenaltiesTime:     uint32(c.PenaltiesTime),
+			NumPenalties:      uint32(c.NumPenalties),
+			NumTyreStints:     uint32(c.NumTyreStints),
+			TyreStintsActual:  uint32s(c.TyreStintsActual[:]),
+			TyreStintsVisual:  uint32s(c.TyreStintsVisual[:]),
+			TyreStintsEndLaps: uint32s(c.TyreStintsEndLaps[:]),
+		}
+	}
+
+	return &grpc_gen.PacketFinalClassificationData{
+		Header:             headerToProto(p.Header),
+		NumCars:            uint32(p.NumCars),
+		ClassificationData: cars,
+	}
+}
+
+func lobbyInfoToProto(p f1_2023.PacketLobbyInfoData) *grpc_gen.PacketLobbyInfoData {
+	players := make([]*grpc_gen.LobbyInfoData, len(p.LobbyPlayers))
+	for i, l := range p.LobbyPlayers {
+		players[i] = &grpc_gen.LobbyInfoData{
+			AiControlled: uint32(l.AiControlled),
+			TeamId:       uint32(l.TeamId),
+			Nationality:  uint32(l.Nationality),
+			Platform:     uint32(l.Platform),
+			Name:         nameToString(l.Name),
+			CarNumber:    uint32(l.CarNumber),
+			ReadyStatus:  uint32(l.ReadyStatus),
+		}
+	}
+
+	return &grpc_gen.PacketLobbyInfoData{
+		Header:       headerToProto(p.Header),
+		NumPlayers:   uint32(p.NumPlayers),
+		LobbyPlayers: players,
+	}
+}
+
+func carDamageToProto(p f1_2023.PacketCarDamageData) *grpc_gen.PacketCarDamageData {
+	cars := make([]*grpc_gen.CarDamageData, len(p.CarDamageData))
+	for i, c := range p.CarDamageData {
+		cars[i] = &grpc_gen.CarDamageData{
+			TyresWear:            wheelsToProto(c.TyresWear),
+			TyresDamage:          wheelsToProto(c.TyresDamage),
+			BrakesDamage:         wheelsToProto(c.BrakesDamage),
+			FrontLeftWingDamage:  uint32(c.FrontLeftWingDamage),
+			FrontRightWingDamage: uint32(c.FrontRightWingDamage),
+			RearWingDamage:       uint32(c.RearWingDamage),
+			FloorDamage:          uint32(c.FloorDamage),
+			DiffuserDamage:       uint32(c.DiffuserDamage),
+			SidepodDamage:        uint32(c.SidepodDamage),
+			DrsFault:             uint32(c.DrsFault),
+			ErsFault:             uint32(c.ErsFault),
+			GearBoxDamage:        uint32(c.GearBoxDamage),
+			EngineDamage:         uint32(c.EngineDamage),
+			EngineMguhWear:       uint32(c.EngineMGUHWear),
+			EngineEsWear:         uint32(c.EngineESWear),
+			EngineCeWear:         uint32(c.EngineCEWear),
+			EngineIceWear:        uint32(c.EngineICEWear),
+			EngineMgukWear:       uint32(c.EngineMGUKWear),
+			EngineTcWear:         uint32(c.EngineTCWear),
+			EngineBlown:          uint32(c.EngineBlown),
+			EngineSeized:         uint32(c.EngineSeized),
+		}
+	}
+
+	return &grpc_gen.PacketCarDamageData{
+		Header:        headerToProto(p.Header),
+		CarDamageData: cars,
+	}
+}
+
+func sessionHistoryToProto(p f1_2023.PacketSessionHistoryData) *grpc_gen.PacketSessionHistoryData {
+	laps := make([]*grpc_gen.LapHistoryData, 0, p.NumLaps)
+	for _, l := range p.LapHistoryData[:min(int(p.NumLaps), len(p.LapHistoryData))] {
+		laps = append(laps, &grpc_gen.LapHistoryData{
+			LapTimeInMs:        l.LapTimeInMS,
+			Sector1TimeInMs:    uint32(l.Sector1TimeInMS),
+			Sector1TimeMinutes: uint32(l.Sector1TimeMinutes),
+			Sector2TimeInMs:    uint32(l.Sector2TimeInMS),
+			Sector2TimeMinutes: uint32(l.Sector2TimeMinutes),
+			Sector3TimeInMs:    uint32(l.Sector3TimeInMS),
+			Sector3TimeMinutes: uint32(l.Sector3TimeMinutes),
+			LapValidBitFlags:   uint32(l.LapValidBitFlags),
+		})
+	}
+	stints := make([]*grpc_gen.TyreStintHistoryData, 0, p.NumTyreStints)
+	for _, s := range p.TyreStintsHistoryData[:min(int(p.NumTyreStints), len(p.TyreStintsHistoryData))] {
+		stints = append(stints, &grpc_gen.TyreStintHistoryData{
+			EndLap:             uint32(s.EndLap),
+			TyreActualCompound: uint32(s.TyreActualCompound),
+			TyreVisualCompound: uint32(s.TyreVisualCompound),
+		})
+	}
+
+	return &grpc_gen.PacketSessionHistoryData{
+		Header:                headerToProto(p.Header),
+		CarIdx:                uint32(p.CarIdx),
+		NumLaps:               uint32(p.NumLaps),
+		NumTyreStints:         uint32(p.NumTyreStints),
+		BestLapTimeLapNum:     uint32(p.BestLapTimeLapNum),
+		BestSector1LapNum:     uint32(p.BestSector1LapNum),
+		BestSector2LapNum:     uint32(p.BestSector2LapNum),
+		BestSector3LapNum:     uint32(p.BestSector3LapNum),
+		LapHistoryData:        laps,
+		TyreStintsHistoryData: stints,
+	}
+}
+
+func tyreSetsToProto(p f1_2023.PacketTyreSetsData) *grpc_gen.PacketTyreSetsData {
+	sets := make([]*grpc_gen.TyreSetData, len(p.TyreSetData))
+	for i, s := range p.TyreSetData {
+		sets[i] = &grpc_gen.TyreSetData{
+			ActualTyreCompound: uint32(s.ActualTyreCompound),
+			VisualTyreCompound: uint32(s.VisualTyreCompound),
+			Wear:               uint32(s.Wear),
+			Available:          uint32(s.Available),
+			RecommendedSession: uint32(s.RecommendedSession),
+			LifeSpan:           uint32(s.LifeSpan),
+			UsableLife:         uint32(s.UsableLife),
+			LapDeltaTime:       int32(s.LapDeltaTime),
+			Fitted:             uint32(s.Fitted),
+		}
+	}
+
+	return &grpc_gen.PacketTyreSetsData{
+		Header:      headerToProto(p.Header),
+		CarIdx:      uint32(p.CarIdx),
+		TyreSetData: sets,
+		FittedIdx:   uint32(p.FittedIdx),
+	}
+}
+
+func motionExToProto(p f1_2023.PacketMotionExData) *grpc_gen.PacketMotionExData {
+	return &grpc_gen.PacketMotionExData{
+		Header:                 headerToProto(p.Header),
+		SuspensionPosition:     wheelsToProto(p.SuspensionPosition),
+		SuspensionVelocity:     wheelsToProto(p.SuspensionVelocity),
+		SuspensionAcceleration: wheelsToProto(p.SuspensionAcceleration),
+		WheelSpeed:             wheelsToProto(p.WheelSpeed),
+		WheelSlipRatio:         wheelsToProto(p.WheelSlipRatio),
+		WheelSlipAngle:         wheelsToProto(p.WheelSlipAngle),
+		WheelLatForce:          wheelsToProto(p.WheelLatForce),
+		WheelLongForce:         wheelsToProto(p.WheelLongForce),
+		HeightOfCogAboveGround: p.HeightOfCOGAboveGround,
+		LocalVelocityX:         p.LocalVelocityX,
+		LocalVelocityY:         p.LocalVelocityY,
+		LocalVelocityZ:         p.LocalVelocityZ,
+		AngularVelocityX:       p.AngularVelocityX,
+		AngularVelocityY:       p.AngularVelocityY,
+		AngularVelocityZ:       p.AngularVelocityZ,
+		AngularAccelerationX:   p.AngularAccelerationX,
+		AngularAccelerationY:   p.AngularAccelerationY,
+		AngularAccelerationZ:   p.AngularAccelerationZ,
+		FrontWheelsAngle:       p.FrontWheelsAngle,
+		WheelVertForce:         wheelsToProto(p.WheelVertForce),
+	}
+}
+
+// wheelsToProto converts a per wheel array, which is in the order rear left, rear right, front left, front right
+func wheelsToProto[T uint8 | uint16 | float32](v [4]T) *grpc_gen.Wheels {
+	return &grpc_gen.Wheels{
+		RearLeft:   float32(v[0]),
+		RearRight:  float32(v[1]),
+		FrontLeft:  float32(v[2]),
+		FrontRight: float32(v[3]),
+	}
+}
+
+func uint32s(v []uint8) []uint32 {
+	result := make([]uint32, len(v))
+	for i, b := range v {
+		result[i] = uint32(b)
+	}
+
+	return result
+}
+
+// nameToString converts a null terminated name into a string
+func nameToString(name [48]uint8) string {
+	if i := bytes.IndexByte(name[:], 0); i >= 0 {
+		return string(name[:i])
+	}
+
+	return string(name[:])
+}
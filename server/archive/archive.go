@@ -0,0 +1,410 @@
+package archive
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/DaanV2/f1-game-dashboards/server/game"
+	f1_2023 "github.com/DaanV2/go-f1-library/packets/2023"
+	"github.com/charmbracelet/log"
+)
+
+const (
+	archiveBuffer = 4096
+	flushInterval = 10 * time.Second
+	pruneInterval = time.Hour
+	idleTimeout   = time.Minute
+)
+
+type (
+	// Options configure where and at which resolutions the telemetry is archived
+	Options struct {
+		Directory string
+		Tiers     []Tier
+	}
+
+	// Archive stores the telemetry of the player car of every chair per session, at the resolution of each tier.
+	// The files are kept in <directory>/<chair id>/<session uid>/<tier name>.f1ta
+	Archive struct {
+		options  Options
+		samples  chan chairSample
+		done     chan struct{}
+		lock     sync.RWMutex
+		closed   bool
+		closeErr error
+
+		latestLock sync.Mutex
+		latest     map[string]*latestValues
+
+		recordings map[string]*recording // Only used by run
+	}
+
+	chairSample struct {
+		chairId string
+		session uint64
+		sample  Sample
+	}
+
+	// latestValues merges the packets of a chair, every telemetry packet completes a sample
+	latestValues struct {
+		session uint64
+		frame   uint32
+		values  [channelCount]float32
+	}
+
+	// recording holds the open files of the session a chair is driving
+	recording struct {
+		session uint64
+		updated time.Time
+		files   []*tierFile
+	}
+
+	tierFile struct {
+		tier        Tier
+		writer      *Writer
+		downsampler *downsampler // nil for the raw tier
+	}
+)
+
+// New creates the archive, removes the files that are past their retention and starts writing
+func New(options Options) (*Archive, error) {
+	if len(options.Tiers) == 0 {
+		options.Tiers = DefaultTiers()
+	}
+	names := make(map[string]bool, len(options.Tiers))
+	for _, tier := range options.Tiers {
+		if names[tier.Name()] {
+			return nil, fmt.Errorf("tier %s is configured more than once", tier.Name())
+		}
+		names[tier.Name()] = true
+	}
+	if err := os.MkdirAll(options.Directory, os.ModePerm); err != nil {
+		return nil, err
+	}
+
+	a := &Archive{
+		options:    options,
+		samples:    make(chan chairSample, archiveBuffer),
+		done:       make(chan struct{}),
+		latest:     make(map[string]*latestValues),
+		recordings: make(map[string]*recording),
+	}
+	a.prune(time.Now())
+	go a.run()
+
+	return a, nil
+}
+
+// Subscribe archives the packets of the pipeline
+func (a *Archive) Subscribe(pipeline *game.PacketPipeline) {
+	pipeline.Session.Add(a.handleSession)
+	pipeline.Motion.Add(a.handleMotion)
+	pipeline.LapData.Add(a.handleLapData)
+	pipeline.CarStatus.Add(a.handleCarStatus)
+	pipeline.CarTelemetry.Add(a.handleCarTelemetry)
+}
+
+// Close stops the archive, and flushes and closes all the open files.
+// Packets received after Close are ignored
+func (a *Archive) Close() error {
+	a.lock.Lock()
+	if !a.closed {
+		a.closed = true
+		close(a.samples)
+	}
+	a.lock.Unlock()
+	<-a.done
+
+	return a.closeErr
+}
+
+func (a *Archive) handleSession(p game.PacketWithChair[f1_2023.PacketSessionData]) {
+	a.update(p.Chair.Id(), p.Packet.Header, func(values *[channelCount]float32) {
+		values[TrackId] = float32(p.Packet.TrackId)
+	})
+}
+
+func (a *Archive) handleMotion(p game.PacketWithChair[f1_2023.PacketMotionData]) {
+	index := int(p.Packet.Header.PlayerCarIndex)
+	if index >= len(p.Packet.CarMotionData) {
+		return
+	}
+	car := p.Packet.CarMotionData[index]
+
+	a.update(p.Chair.Id(), p.Packet.Header, func(values *[channelCount]float32) {
+		values[PositionX] = car.WorldPositionX
+		values[PositionY] = car.WorldPositionY
+		values[PositionZ] = car.WorldPositionZ
+		values[GForceLateral] = car.GForceLateral
+		values[GForceLongitudinal] = car.GForceLongitudinal
+	})
+}
+
+func (a *Archive) handleLapData(p game.PacketWithChair[f1_2023.PacketLapData]) {
+	index := int(p.Packet.Header.PlayerCarIndex)
+	if index >= len(p.Packet.LapData) {
+		return
+	}
+	lap := p.Packet.LapData[index]
+
+	a.update(p.Chair.Id(), p.Packet.Header, func(values *[channelCount]float32) {
+		values[LapNumber] = float32(lap.CurrentLapNum)
+		values[LapDistance] = lap.LapDistance
+		values[CurrentLapTime] = float32(lap.CurrentLapTimeInMS) / 1000
+	})
+}
+
+func (a *Archive) handleCarStatus(p game.PacketWithChair[f1_2023.PacketCarStatusData]) {
+	index := int(p.Packet.Header.PlayerCarIndex)
+	if index >= len(p.Packet.CarStatusData) {
+		return
+	}
+	status := p.Packet.CarStatusData[index]
+
+	a.update(p.Chair.Id(), p.Packet.Header, func(values *[channelCount]float32) {
+		values[FuelInTank] = status.FuelInTank
+		values[ErsStoreEnergy] = status.ErsStoreEnergy
+	})
+}
+
+// handleCarTelemetry completes the sample of the chair, and queues it for writing
+func (a *Archive) handleCarTelemetry(p game.PacketWithChair[f1_2023.PacketCarTelemetryData]) {
+	header := p.Packet.Header
+	index := int(header.PlayerCarIndex)
+	if index >= len(p.Packet.CarTelemetryData) {
+		return
+	}
+	car := p.Packet.CarTelemetryData[index]
+	chairId := p.Chair.Id()
+
+	a.latestLock.Lock()
+	latest := a.latestOf(chairId, header.SessionUID)
+	// The hooks are called concurrently, so an older frame can arrive after a newer one
+	if latest.frame > header.OverallFrameIdentifier {
+		a.latestLock.Unlock()
+		return
+	}
+	latest.frame = header.OverallFrameIdentifier
+	latest.values[Speed] = float32(car.Speed)
+	latest.values[Throttle] = car.Throttle
+	latest.values[Brake] = car.Brake
+	latest.values[Steer] = car.Steer
+	latest.values[Gear] = float32(car.Gear)
+	latest.values[EngineRPM] = float32(car.EngineRPM)
+	latest.values[Drs] = float32(car.Drs)
+	sample := chairSample{
+		chairId: chairId,
+		session: header.SessionUID,
+		sample:  Sample{Time: time.Now(), Values: latest.values},
+	}
+	a.latestLock.Unlock()
+
+	a.record(sample)
+}
+
+// update applies the values of a packet to the latest values of the chair
+func (a *Archive) update(chairId string, header f1_2023.PacketHeader, apply func(values *[channelCount]float32)) {
+	a.latestLock.Lock()
+	defer a.latestLock.Unlock()
+
+	apply(&a.latestOf(chairId, header.SessionUID).values)
+}
+
+// latestOf returns the latest values of the chair, starting over when the session changes. The lock must be held
+func (a *Archive) latestOf(chairId string, session uint64) *latestValues {
+	latest, ok := a.latest[chairId]
+	if !ok || latest.session != session {
+		latest = &latestValues{session: session}
+		latest.values[TrackId] = -1
+		a.latest[chairId] = latest
+	}
+
+	return latest
+}
+
+// record queues the sample for writing, if the archive can't keep up the sample is dropped
+func (a *Archive) record(sample chairSample) {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+	if a.closed {
+		return
+	}
+
+	select {
+	case a.samples <- sample:
+	default:
+		log.Warn("telemetry archive is falling behind, dropping sample", "chair", sample.chairId)
+	}
+}
+
+func (a *Archive) run() {
+	defer close(a.done)
+	flush := time.NewTicker(flushInterval)
+	defer flush.Stop()
+	prune := time.NewTicker(pruneInterval)
+	defer prune.Stop()
+
+	for {
+		select {
+		case sample, ok := <-a.samples:
+			if !ok {
+				a.closeErr = a.closeAll()
+				return
+			}
+			if err := a.write(sample); err != nil {
+				log.Error("could not archive sample", "chair", sample.chairId, "error", err)
+			}
+		case now := <-flush.C:
+			a.flush(now)
+		case now := <-prune.C:
+			a.prune(now)
+		}
+	}
+}
+
+func (a *Archive) write(sample chairSample) error {
+	rec, ok := a.recordings[sample.chairId]
+	if !ok || rec.session != sample.session {
+		var err error
+		if rec, err = a.rotate(sample.chairId, sample.session, sample.sample.Time); err != nil {
+			return err
+		}
+	}
+	rec.updated = sample.sample.Time
+
+	var err error
+	for _, file := range rec.files {
+		if file.downsampler == nil {
+			err = errors.Join(err, file.writer.Write(sample.sample))
+		} else if downsampled, ok := file.downsampler.Add(sample.sample); ok {
+			err = errors.Join(err, file.writer.Write(downsampled))
+		}
+	}
+
+	return err
+}
+
+// rotate closes the current files of the chair and opens the ones of the given session
+func (a *Archive) rotate(chairId string, session uint64, start time.Time) (*recording, error) {
+	if current, ok := a.recordings[chairId]; ok {
+		delete(a.recordings, chairId)
+		if err := current.close(); err != nil {
+			log.Error("could not close archive", "chair", chairId, "error", err)
+		}
+	}
+
+	dir := filepath.Join(a.options.Directory, chairId, strconv.FormatUint(session, 10))
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, err
+	}
+
+	rec := &recording{session: session, files: make([]*tierFile, 0, len(a.options.Tiers))}
+	for _, tier := range a.options.Tiers {
+		writer, err := Create(filepath.Join(dir, tier.Name()+FileExtension), start, tier.Interval)
+		if err != nil {
+			rec.close()
+			return nil, err
+		}
+
+		file := &tierFile{tier: tier, writer: writer}
+		if tier.Interval > 0 {
+			file.downsampler = newDownsampler(tier.Interval)
+		}
+		rec.files = append(rec.files, file)
+	}
+	log.Debug("archiving session", "chair", chairId, "session", session, "directory", dir)
+	a.recordings[chairId] = rec
+
+	return rec, nil
+}
+
+// flush writes the pending samples of all chairs, and closes the sessions that have not been driven for a while
+func (a *Archive) flush(now time.Time) {
+	for chairId, rec := range a.recordings {
+		if now.Sub(rec.updated) < idleTimeout {
+			for _, file := range rec.files {
+				if err := file.writer.Flush(); err != nil {
+					log.Error("could not flush archive", "chair", chairId, "tier", file.tier.Name(), "error", err)
+				}
+			}
+			continue
+		}
+
+		delete(a.recordings, chairId)
+		if err := rec.close(); err != nil {
+			log.Error("could not close archive", "chair", chairId, "error", err)
+		}
+	}
+}
+
+// prune removes the files that are older than the retention of their tier, and the sessions without files
+func (a *Archive) prune(now time.Time) {
+	chairs, err := os.ReadDir(a.options.Directory)
+	if err != nil {
+		log.Error("could not read archive directory", "error", err)
+		return
+	}
+
+	for _, chair := range chairs {
+		if !chair.IsDir() {
+			continue
+		}
+		sessions, err := os.ReadDir(filepath.Join(a.options.Directory, chair.Name()))
+		if err != nil {
+			log.Error("could not read archive directory", "chair", chair.Name(), "error", err)
+			continue
+		}
+
+		for _, session := range sessions {
+			dir := filepath.Join(a.options.Directory, chair.Name(), session.Name())
+			if rec, ok := a.recordings[chair.Name()]; ok && strconv.FormatUint(rec.session, 10) == session.Name() {
+				continue
+			}
+
+			for _, tier := range a.options.Tiers {
+				path := filepath.Join(dir, tier.Name()+FileExtension)
+				info, err := os.Stat(path)
+				if err != nil || tier.Retention == 0 || now.Sub(info.ModTime()) < tier.Retention {
+					continue
+				}
+				if err := os.Remove(path); err != nil {
+					log.Error("could not remove expired archive", "file", path, "error", err)
+				}
+			}
+			// Only removes the directory if it is empty
+			if files, err := os.ReadDir(dir); err == nil && len(files) == 0 {
+				os.Remove(dir)
+			}
+		}
+	}
+}
+
+func (a *Archive) closeAll() error {
+	var err error
+	for chairId, rec := range a.recordings {
+		err = errors.Join(err, rec.close())
+		delete(a.recordings, chairId)
+	}
+
+	return err
+}
+
+// close writes the last downsampled interval of every tier and closes the files
+func (r *recording) close() error {
+	var err error
+	for _, file := range r.files {
+		if file.downsampler != nil {
+			if sample, ok := file.downsampler.Flush(); ok {
+				err = errors.Join(err, file.writer.Write(sample))
+			}
+		}
+		err = errors.Join(err, file.writer.Close())
+	}
+
+	return err
+}
@@ -0,0 +1,163 @@
+package archive_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/DaanV2/f1-game-dashboards/server/archive"
+	"github.com/DaanV2/f1-game-dashboards/server/game"
+	"github.com/DaanV2/f1-game-dashboards/server/history"
+	"github.com/DaanV2/f1-game-dashboards/server/sessions"
+	"github.com/DaanV2/f1-game-dashboards/server/simulator"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Writer_RoundTrip(t *testing.T) {
+	start := time.Unix(1_700_000_000, 0)
+	buf := &bytes.Buffer{}
+	writer, err := archive.NewWriter(buf, start, 0)
+	require.NoError(t, err)
+
+	// More than one block
+	samples := make([]archive.Sample, 1500)
+	for i := range samples {
+		samples[i].Time = start.Add(time.Duration(i) * 50 * time.Millisecond)
+		samples[i].Values[archive.Speed] = float32(i)
+		samples[i].Values[archive.Throttle] = float32(i%100) / 100
+		samples[i].Values[archive.LapNumber] = float32(1 + i/1000)
+		require.NoError(t, writer.Write(samples[i]))
+	}
+	require.NoError(t, writer.Close())
+
+	reader, err := archive.NewReader(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, start.UnixNano(), reader.Start().UnixNano())
+	result, err := reader.ReadAll()
+	require.NoError(t, err)
+	require.Len(t, result, len(samples))
+	for i, sample := range result {
+		require.Equal(t, samples[i].Time.UnixMilli(), sample.Time.UnixMilli())
+		require.Equal(t, samples[i].Values, sample.Values)
+	}
+
+	_, err = archive.NewReader(bytes.NewReader([]byte("not an archive")))
+	require.ErrorIs(t, err, archive.ErrInvalidArchive)
+}
+
+func Test_Archive_QueriesAndComparesLaps(t *testing.T) {
+	processor := game.NewPacketProcessor()
+	a, err := archive.New(archive.Options{
+		Directory: t.TempDir(),
+		Tiers:     []archive.Tier{{Interval: 0}, {Interval: 10 * time.Millisecond}},
+	})
+	require.NoError(t, err)
+	a.Subscribe(processor.Pipeline())
+
+	chair := sessions.NewChair("test", 20777, true)
+	sim := simulator.NewSimulator(simulator.Options{Cars: 2, Laps: 2, TrackLength: 500, Seed: 1})
+	// Driven at the same time on another track
+	other := sessions.NewChair("other", 20778, true)
+	otherSim := simulator.NewSimulator(simulator.Options{Cars: 2, Laps: 2, TrackLength: 500, TrackId: 10, Seed: 2})
+	inject := func() {
+		for _, packet := range sim.Step(time.Second / 20) {
+			require.NoError(t, processor.Inject(chair, packet))
+		}
+		for _, packet := range otherSim.Step(time.Second / 20) {
+			require.NoError(t, processor.Inject(other, packet))
+		}
+		// Gives the hooks time to handle the frame, they are called concurrently
+		time.Sleep(time.Millisecond)
+	}
+
+	inject()
+	session, otherSession := sim.SessionUID(), otherSim.SessionUID()
+	for sim.SessionUID() == session {
+		inject()
+	}
+	time.Sleep(10 * time.Millisecond)
+	require.NoError(t, a.Close())
+
+	id := history.SessionId(chair.Id(), session)
+	info, ok := a.Session(id)
+	require.True(t, ok)
+	require.Equal(t, []string{"raw", "10ms"}, info.Tiers)
+	require.Equal(t, id, a.Sessions(chair.Id())[0].Id)
+	track, err := a.Query(archive.Query{SessionId: id, Lap: 1, Channels: []archive.Channel{archive.TrackId}})
+	require.NoError(t, err)
+	require.Equal(t, float32(0), track.Values[0][len(track.Values[0])-1])
+
+	raw, err := a.Query(archive.Query{SessionId: id, Lap: 1, Channels: []archive.Channel{archive.Speed, archive.LapNumber}})
+	require.NoError(t, err)
+	require.Equal(t, "raw", raw.Tier)
+	require.NotEmpty(t, raw.Times)
+	require.Len(t, raw.Values, 2)
+	for i := range raw.Times {
+		require.Equal(t, float32(1), raw.Values[1][i])
+	}
+	require.NotZero(t, max(raw.Values[0][0], raw.Values[0][len(raw.Values[0])-1]))
+
+	downsampled, err := a.Query(archive.Query{SessionId: id, Resolution: time.Second})
+	require.NoError(t, err)
+	require.Equal(t, "10ms", downsampled.Tier)
+	require.Len(t, downsampled.Values, len(archive.Channels()))
+	require.Less(t, len(downsampled.Times), len(raw.Times)*2)
+
+	first, second := archive.LapRef{SessionId: id, Lap: 1}, archive.LapRef{SessionId: id, Lap: 2}
+	comparison, err := a.Compare(first, second, []archive.Channel{archive.Speed}, 10)
+	require.NoError(t, err)
+	require.NotEmpty(t, comparison.Distances)
+	require.Len(t, comparison.Delta, len(comparison.Distances))
+	require.Len(t, comparison.Lap.Values[0], len(comparison.Distances))
+	for i := range comparison.Distances {
+		require.InDelta(t, comparison.Lap.Times[i]-comparison.Reference.Times[i], comparison.Delta[i], 1e-6)
+		if i > 0 {
+			require.InDelta(t, 10, comparison.Distances[i]-comparison.Distances[i-1], 1e-3)
+			require.GreaterOrEqual(t, comparison.Reference.Times[i], comparison.Reference.Times[i-1])
+		}
+	}
+	// The times are lap time, the packets were injected much faster than real time
+	last := len(comparison.Distances) - 1
+	require.Greater(t, comparison.Reference.Times[last], (comparison.Distances[last]-comparison.Distances[0])/100)
+	_, err = a.Compare(first, archive.LapRef{SessionId: id, Lap: 9}, nil, 0)
+	require.ErrorIs(t, err, archive.ErrLapNotFound)
+	_, err = a.Compare(first, second, nil, 0.00001)
+	require.ErrorIs(t, err, archive.ErrInvalidStep)
+	_, err = a.Compare(first, archive.LapRef{SessionId: history.SessionId(other.Id(), otherSession), Lap: 1}, nil, 0)
+	require.ErrorIs(t, err, archive.ErrDifferentTracks)
+
+	_, err = a.Query(archive.Query{SessionId: history.SessionId(chair.Id(), session+1)})
+	require.ErrorIs(t, err, archive.ErrSessionNotFound)
+	_, err = a.Query(archive.Query{SessionId: "../" + id})
+	require.ErrorIs(t, err, archive.ErrSessionNotFound)
+}
+
+func Test_Archive_PrunesExpiredTiers(t *testing.T) {
+	dir := t.TempDir()
+	session := filepath.Join(dir, "20777", "1")
+	require.NoError(t, os.MkdirAll(session, os.ModePerm))
+
+	old := time.Now().Add(-2 * time.Hour)
+	for _, name := range []string{"raw", "1s"} {
+		path := filepath.Join(session, name+archive.FileExtension)
+		writer, err := archive.Create(path, old, 0)
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+		require.NoError(t, os.Chtimes(path, old, old))
+	}
+
+	a, err := archive.New(archive.Options{
+		Directory: dir,
+		Tiers:     []archive.Tier{{Interval: 0, Retention: time.Hour}, {Interval: time.Second, Retention: 0}},
+	})
+	require.NoError(t, err)
+	defer a.Close()
+
+	require.NoFileExists(t, filepath.Join(session, "raw"+archive.FileExtension))
+	require.FileExists(t, filepath.Join(session, "1s"+archive.FileExtension))
+	info, ok := a.Session(history.SessionId("20777", 1))
+	require.True(t, ok)
+	require.Equal(t, []string{"1s"}, info.Tiers)
+}
@@ -0,0 +1,103 @@
+package archive
+
+import (
+	"time"
+)
+
+// Channel is a single value of the player car that is archived
+type Channel uint8
+
+const (
+	Speed              Channel = iota // km/h
+	Throttle                          // 0.0 to 1.0
+	Brake                             // 0.0 to 1.0
+	Steer                             // -1.0 (full lock left) to 1.0 (full lock right)
+	Gear                              // 1-8, N=0, R=-1
+	EngineRPM                         // rpm
+	Drs                               // 0 = off, 1 = on
+	LapNumber                         // The current lap, starts at 1
+	LapDistance                       // Metres around the current lap
+	CurrentLapTime                    // Seconds into the current lap
+	PositionX                         // World space position in metres
+	PositionY                         //
+	PositionZ                         //
+	GForceLateral                     // g
+	GForceLongitudinal                // g
+	FuelInTank                        // kg
+	ErsStoreEnergy                    // Joules
+	TrackId                           // The id of the track of the session, -1 when it is not known yet
+
+	channelCount
+)
+
+var channelNames = [channelCount]string{
+	Speed:              "speed",
+	Throttle:           "throttle",
+	Brake:              "brake",
+	Steer:              "steer",
+	Gear:               "gear",
+	EngineRPM:          "engine_rpm",
+	Drs:                "drs",
+	LapNumber:          "lap_number",
+	LapDistance:        "lap_distance",
+	CurrentLapTime:     "current_lap_time",
+	PositionX:          "position_x",
+	PositionY:          "position_y",
+	PositionZ:          "position_z",
+	GForceLateral:      "g_force_lateral",
+	GForceLongitudinal: "g_force_longitudinal",
+	FuelInTank:         "fuel_in_tank",
+	ErsStoreEnergy:     "ers_store_energy",
+	TrackId:            "track_id",
+}
+
+// Sample holds all channels of the player car at a moment
+type Sample struct {
+	Time   time.Time
+	Values [channelCount]float32
+}
+
+// Channels returns all archived channels
+func Channels() []Channel {
+	result := make([]Channel, channelCount)
+	for i := range result {
+		result[i] = Channel(i)
+	}
+
+	return result
+}
+
+// ParseChannel returns the channel with the given name, e.g. speed
+func ParseChannel(name string) (Channel, bool) {
+	for i, n := range channelNames {
+		if n == name {
+			return Channel(i), true
+		}
+	}
+
+	return 0, false
+}
+
+// String returns the name of the channel
+func (c Channel) String() string {
+	if c >= channelCount {
+		return "unknown"
+	}
+
+	return channelNames[c]
+}
+
+// discrete returns true if averaging the channel makes no sense, so downsampling keeps the last value
+func (c Channel) discrete() bool {
+	switch c {
+	case Gear, Drs, LapNumber, LapDistance, CurrentLapTime, TrackId:
+		return true
+	default:
+		return false
+	}
+}
+
+// Get returns the value of the channel
+func (s Sample) Get(c Channel) float32 {
+	return s.Values[c]
+}
@@ -0,0 +1,202 @@
+package archive
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+const (
+	// DefaultCompareStep is the distance in meters between the points of a comparison, if none is given
+	DefaultCompareStep float32 = 5
+	// MinCompareStep is the smallest distance in meters between the points of a comparison
+	MinCompareStep float32 = 0.5
+	// MaxComparePoints is the most points of a comparison, 0.5 meters on the longest tracks fits well within it
+	MaxComparePoints = 20_000
+)
+
+var (
+	// ErrLapNotFound is returned when no telemetry is archived for the lap
+	ErrLapNotFound = errors.New("archived lap not found")
+	// ErrLapsDoNotOverlap is returned when the laps have no stretch of the track in common
+	ErrLapsDoNotOverlap = errors.New("laps do not cover the same part of the track")
+	// ErrInvalidStep is returned when the distance between the points of a comparison is below MinCompareStep
+	ErrInvalidStep = errors.New("step must be a distance of at least half a meter")
+	// ErrDifferentTracks is returned when the laps were driven on different tracks
+	ErrDifferentTracks = errors.New("laps are driven on different tracks")
+	// ErrTooManyPoints is returned when a comparison would have more than MaxComparePoints points
+	ErrTooManyPoints = errors.New("comparison has too many points, use a larger step")
+)
+
+type (
+	// LapRef points to a lap of an archived session
+	LapRef struct {
+		SessionId string
+		Lap       uint8
+	}
+
+	// LapTrace holds the channels of a lap, resampled at the distances of a comparison
+	LapTrace struct {
+		LapRef
+		Times  []float32   // Seconds of lap time since the first distance of the comparison
+		Values [][]float32 // The values of every channel, in the order of the channels of the comparison
+	}
+
+	// Comparison aligns two laps by their distance into the lap
+	Comparison struct {
+		Channels  []Channel
+		Distances []float32 // Meters into the lap
+		Reference LapTrace
+		Lap       LapTrace
+		Delta     []float32 // Seconds the lap is behind the reference at every distance, negative when it is ahead
+	}
+
+	// lapTrace holds the samples of a lap with a distance that only increases
+	lapTrace struct {
+		distances []float32
+		samples   []Sample
+		track     float32 // -1 when the track is not known, e.g. for files archived before the track was
+	}
+)
+
+// Compare resamples the channels of both laps at every step meters, and computes how far the lap is behind the reference.
+// The finest tier that is left of each session is used
+func (a *Archive) Compare(reference, lap LapRef, channels []Channel, step float32) (Comparison, error) {
+	if step == 0 {
+		step = DefaultCompareStep
+	}
+	// Also catches NaN, which is not comparable
+	if !(step >= MinCompareStep) || math.IsInf(float64(step), 0) {
+		return Comparison{}, ErrInvalidStep
+	}
+	if len(channels) == 0 {
+		channels = Channels()
+	}
+
+	traces := [2]lapTrace{}
+	for i, ref := range []LapRef{reference, lap} {
+		trace, err := a.lapTrace(ref)
+		if err != nil {
+			return Comparison{}, err
+		}
+		traces[i] = trace
+	}
+	// Laps of which the track is not known are still compared, there is nothing to check them against
+	if traces[0].track >= 0 && traces[1].track >= 0 && traces[0].track != traces[1].track {
+		return Comparison{}, ErrDifferentTracks
+	}
+
+	// The points start at a multiple of the step, so comparisons with the same step line up
+	start := max(traces[0].distances[0], traces[1].distances[0])
+	start = float32(math.Ceil(float64(start/step))) * step
+	end := min(traces[0].distances[len(traces[0].distances)-1], traces[1].distances[len(traces[1].distances)-1])
+	if end <= start {
+		return Comparison{}, ErrLapsDoNotOverlap
+	}
+
+	points := int((end-start)/step) + 1
+	if points > MaxComparePoints {
+		return Comparison{}, ErrTooManyPoints
+	}
+
+	result := Comparison{
+		Channels:  channels,
+		Distances: make([]float32, points),
+		Reference: LapTrace{LapRef: reference},
+		Lap:       LapTrace{LapRef: lap},
+	}
+	// Computed from the index, adding up the step loses precision further into the lap
+	for i := range result.Distances {
+		result.Distances[i] = start + float32(i)*step
+	}
+
+	result.Reference.Times, result.Reference.Values = traces[0].resample(result.Distances, channels)
+	result.Lap.Times, result.Lap.Values = traces[1].resample(result.Distances, channels)
+	result.Delta = make([]float32, len(result.Distances))
+	for i := range result.Delta {
+		result.Delta[i] = result.Lap.Times[i] - result.Reference.Times[i]
+	}
+
+	return result, nil
+}
+
+// lapTrace reads the samples of the lap, leaving out the ones before the line and the ones that go back in distance
+func (a *Archive) lapTrace(ref LapRef) (lapTrace, error) {
+	info, ok := a.Session(ref.SessionId)
+	if !ok || ref.Lap == 0 {
+		return lapTrace{}, ErrLapNotFound
+	}
+	samples, err := a.read(info, info.Tiers[0])
+	if err != nil {
+		return lapTrace{}, err
+	}
+
+	trace := lapTrace{
+		distances: make([]float32, 0),
+		samples:   make([]Sample, 0),
+		track:     -1,
+	}
+	for _, sample := range samples {
+		distance := sample.Get(LapDistance)
+		if uint8(sample.Get(LapNumber)) != ref.Lap || distance < 0 {
+			continue
+		}
+		if len(trace.distances) > 0 && distance <= trace.distances[len(trace.distances)-1] {
+			continue
+		}
+		trace.distances = append(trace.distances, distance)
+		trace.samples = append(trace.samples, sample)
+		if trace.track < 0 {
+			trace.track = sample.Get(TrackId)
+		}
+	}
+	if len(trace.samples) < 2 {
+		return lapTrace{}, ErrLapNotFound
+	}
+
+	return trace, nil
+}
+
+// resample interpolates the lap time and the channels at the distances, which must lie within the trace.
+// The lap time of the game is used instead of the time the samples were received, so replays and network jitter do not
+// change the times. The discrete channels take the value of the sample before the distance
+func (t lapTrace) resample(distances []float32, channels []Channel) ([]float32, [][]float32) {
+	times := make([]float32, len(distances))
+	values := make([][]float32, len(channels))
+	for i := range values {
+		values[i] = make([]float32, len(distances))
+	}
+
+	elapsed := func(index int) float32 {
+		return t.samples[index].Get(CurrentLapTime)
+	}
+	for i, d := range distances {
+		next := sort.Search(len(t.distances), func(j int) bool { return t.distances[j] >= d })
+		next = min(max(next, 1), len(t.distances)-1)
+		prev := next - 1
+		f := (d - t.distances[prev]) / (t.distances[next] - t.distances[prev])
+
+		times[i] = elapsed(prev) + f*(elapsed(next)-elapsed(prev))
+		for c, channel := range channels {
+			before, after := t.samples[prev].Get(channel), t.samples[next].Get(channel)
+			if channel.discrete() {
+				values[c][i] = before
+				if f >= 1 {
+					values[c][i] = after
+				}
+			} else {
+				values[c][i] = before + f*(after-before)
+			}
+		}
+	}
+
+	// The times start at the first distance of the comparison
+	if len(times) > 0 {
+		offset := times[0]
+		for i := range times {
+			times[i] -= offset
+		}
+	}
+
+	return times, values
+}
@@ -0,0 +1,34 @@
+package archive
+
+import (
+	"errors"
+	"time"
+)
+
+// An archive file holds the samples of one chair, session and tier. All numbers are little endian:
+//
+//	header: magic "F1TA" | version uint8 | start unix nano int64 | interval ms uint32 | channel count uint8 | per channel: name length uint8 | name
+//	block:  row count uint32 | compressed length uint32 | deflated columns
+//
+// The columns of a block are the offsets of the rows in milliseconds since the start as uint32,
+// followed by the values of every channel as float32 in the order of the header.
+// Blocks are appended while the session is driven, so an interrupted file only loses its last block.
+
+const (
+	// FileExtension is the extension used for archive files
+	FileExtension = ".f1ta"
+
+	magic     = "F1TA"
+	version   = 1
+	blockRows = 1024
+)
+
+var (
+	// ErrInvalidArchive is returned when a file is not an archive file or has an unsupported version
+	ErrInvalidArchive = errors.New("not a valid telemetry archive file")
+)
+
+// offsetOf returns the milliseconds between the start and the moment, moments before the start are clamped to it
+func offsetOf(start, t time.Time) uint32 {
+	return uint32(max(t.Sub(start).Milliseconds(), 0))
+}
@@ -0,0 +1,72 @@
+package archive
+
+import (
+	"sort"
+)
+
+// Ghost is the time into a lap at every distance of it, to compare a lap that is being driven with.
+// The zero value is an empty ghost
+type Ghost struct {
+	distances []float32
+	times     []float32
+}
+
+// Ghost reads the lap as a ghost. The finest tier that is left of the session is used
+func (a *Archive) Ghost(ref LapRef) (Ghost, error) {
+	trace, err := a.lapTrace(ref)
+	if err != nil {
+		return Ghost{}, err
+	}
+
+	ghost := Ghost{}
+	for i, sample := range trace.samples {
+		ghost.Add(trace.distances[i], sample.Get(CurrentLapTime))
+	}
+
+	return ghost, nil
+}
+
+// Add adds the time into the lap at the distance, points that are not further into the lap than the last one are ignored
+func (g *Ghost) Add(distance, seconds float32) bool {
+	if distance < 0 || (len(g.distances) > 0 && distance <= g.distances[len(g.distances)-1]) {
+		return false
+	}
+	g.distances = append(g.distances, distance)
+	g.times = append(g.times, seconds)
+
+	return true
+}
+
+// Start returns the first distance of the ghost, false if it is empty
+func (g Ghost) Start() (float32, bool) {
+	if len(g.distances) == 0 {
+		return 0, false
+	}
+
+	return g.distances[0], true
+}
+
+// Last returns the last distance of the ghost, false if it is empty
+func (g Ghost) Last() (float32, bool) {
+	if len(g.distances) == 0 {
+		return 0, false
+	}
+
+	return g.distances[len(g.distances)-1], true
+}
+
+// TimeAt returns the seconds into the lap at the distance, false if the ghost did not drive that part of the lap
+func (g Ghost) TimeAt(distance float32) (float32, bool) {
+	if len(g.distances) < 2 || distance < g.distances[0] || distance > g.distances[len(g.distances)-1] {
+		return 0, false
+	}
+
+	next := sort.Search(len(g.distances), func(i int) bool { return g.distances[i] >= distance })
+	if next == 0 {
+		return g.times[0], true
+	}
+	prev := next - 1
+	f := (distance - g.distances[prev]) / (g.distances[next] - g.distances[prev])
+
+	return g.times[prev] + f*(g.times[next]-g.times[prev]), true
+}
@@ -0,0 +1,239 @@
+package archive
+
+import (
+	"cmp"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/DaanV2/f1-game-dashboards/server/history"
+)
+
+var (
+	// ErrSessionNotFound is returned when no telemetry is archived for the session
+	ErrSessionNotFound = errors.New("archived session not found")
+)
+
+type (
+	// SessionInfo describes the archived telemetry of a session on a chair
+	SessionInfo struct {
+		Id         string // Same as the id of the session in the lap history
+		ChairId    string
+		SessionUID uint64
+		Start      time.Time
+		Updated    time.Time
+		Tiers      []string // The names of the tiers that still have files, finest first
+	}
+
+	// Query selects the samples of a session, unset fields match everything
+	Query struct {
+		SessionId  string
+		Channels   []Channel     // The channels to return, all channels if empty
+		Resolution time.Duration // The interval the caller needs at most, the coarsest tier that is fine enough is used
+		Lap        uint8         // Only the samples of the lap, 0 for all laps
+		From       time.Time
+		To         time.Time
+	}
+
+	// Result holds the samples of a query as a series per channel
+	Result struct {
+		SessionId string
+		Tier      string
+		Times     []time.Time
+		Channels  []Channel
+		Values    [][]float32 // The values of every channel, in the order of Channels
+	}
+)
+
+// Sessions lists the archived sessions of the chair, or of all chairs when the chair id is empty. Newest first
+func (a *Archive) Sessions(chairId string) []SessionInfo {
+	chairs := []string{chairId}
+	if chairId == "" {
+		chairs = chairs[:0]
+		entries, _ := os.ReadDir(a.options.Directory)
+		for _, entry := range entries {
+			if entry.IsDir() {
+				chairs = append(chairs, entry.Name())
+			}
+		}
+	} else if !validName(chairId) {
+		return []SessionInfo{}
+	}
+
+	result := make([]SessionInfo, 0)
+	for _, chair := range chairs {
+		entries, _ := os.ReadDir(filepath.Join(a.options.Directory, chair))
+		for _, entry := range entries {
+			uid, err := strconv.ParseUint(entry.Name(), 10, 64)
+			if err != nil || !entry.IsDir() {
+				continue
+			}
+			if info, ok := a.session(chair, uid); ok {
+				result = append(result, info)
+			}
+		}
+	}
+	slices.SortFunc(result, func(a, b SessionInfo) int {
+		return b.Start.Compare(a.Start)
+	})
+
+	return result
+}
+
+// Session returns the archived session with the given id
+func (a *Archive) Session(id string) (SessionInfo, bool) {
+	chairId, uid, ok := parseSessionId(id)
+	if !ok {
+		return SessionInfo{}, false
+	}
+
+	return a.session(chairId, uid)
+}
+
+// Query reads the samples of the session that match the query
+func (a *Archive) Query(q Query) (Result, error) {
+	info, ok := a.Session(q.SessionId)
+	if !ok {
+		return Result{}, ErrSessionNotFound
+	}
+	tier := a.tierFor(info, q.Resolution)
+	channels := q.Channels
+	if len(channels) == 0 {
+		channels = Channels()
+	}
+
+	result := Result{
+		SessionId: info.Id,
+		Tier:      tier,
+		Times:     make([]time.Time, 0),
+		Channels:  channels,
+		Values:    make([][]float32, len(channels)),
+	}
+	for i := range result.Values {
+		result.Values[i] = make([]float32, 0)
+	}
+
+	samples, err := a.read(info, tier)
+	if err != nil {
+		return result, err
+	}
+
+	for _, sample := range samples {
+		if !q.match(sample) {
+			continue
+		}
+		result.Times = append(result.Times, sample.Time)
+		for i, c := range channels {
+			result.Values[i] = append(result.Values[i], sample.Get(c))
+		}
+	}
+
+	return result, nil
+}
+
+// read reads all samples of the tier of the session
+func (a *Archive) read(info SessionInfo, tier string) ([]Sample, error) {
+	reader, err := Open(a.sessionFile(info.ChairId, info.SessionUID, tier))
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	return reader.ReadAll()
+}
+
+func (a *Archive) session(chairId string, uid uint64) (SessionInfo, bool) {
+	info := SessionInfo{
+		Id:         history.SessionId(chairId, uid),
+		ChairId:    chairId,
+		SessionUID: uid,
+		Tiers:      make([]string, 0, len(a.options.Tiers)),
+	}
+
+	for _, tier := range a.sortedTiers() {
+		path := a.sessionFile(chairId, uid, tier.Name())
+		stat, err := os.Stat(path)
+		if err != nil {
+			continue
+		}
+		if info.Updated.Before(stat.ModTime()) {
+			info.Updated = stat.ModTime()
+		}
+		if reader, err := Open(path); err == nil {
+			if info.Start.IsZero() || reader.Start().Before(info.Start) {
+				info.Start = reader.Start()
+			}
+			reader.Close()
+		}
+		info.Tiers = append(info.Tiers, tier.Name())
+	}
+
+	return info, len(info.Tiers) > 0
+}
+
+// tierFor returns the coarsest tier of the session that is at least as fine as the resolution,
+// or the finest tier left if none of them is
+func (a *Archive) tierFor(info SessionInfo, resolution time.Duration) string {
+	result := info.Tiers[0]
+	for _, tier := range a.sortedTiers() {
+		if tier.Interval > resolution {
+			break
+		}
+		if slices.Contains(info.Tiers, tier.Name()) {
+			result = tier.Name()
+		}
+	}
+
+	return result
+}
+
+// sortedTiers returns the tiers from fine to coarse
+func (a *Archive) sortedTiers() []Tier {
+	tiers := slices.Clone(a.options.Tiers)
+	slices.SortFunc(tiers, func(a, b Tier) int {
+		return cmp.Compare(a.Interval, b.Interval)
+	})
+
+	return tiers
+}
+
+func (a *Archive) sessionFile(chairId string, uid uint64, tier string) string {
+	return filepath.Join(a.options.Directory, chairId, strconv.FormatUint(uid, 10), tier+FileExtension)
+}
+
+func (q Query) match(sample Sample) bool {
+	if q.Lap != 0 && uint8(sample.Get(LapNumber)) != q.Lap {
+		return false
+	}
+	if !q.From.IsZero() && sample.Time.Before(q.From) {
+		return false
+	}
+	if !q.To.IsZero() && sample.Time.After(q.To) {
+		return false
+	}
+
+	return true
+}
+
+// parseSessionId splits an id made by history.SessionId into the chair and the session uid
+func parseSessionId(id string) (string, uint64, bool) {
+	index := strings.LastIndex(id, "-")
+	if index < 0 {
+		return "", 0, false
+	}
+	uid, err := strconv.ParseUint(id[index+1:], 10, 64)
+	if err != nil || !validName(id[:index]) {
+		return "", 0, false
+	}
+
+	return id[:index], uid, true
+}
+
+// validName checks that the name can be used as a single directory name
+func validName(name string) bool {
+	return name != "" && name != "." && name != ".." && !strings.ContainsAny(name, `/\`)
+}
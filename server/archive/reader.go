@@ -0,0 +1,163 @@
+package archive
+
+import (
+	"bufio"
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"os"
+	"slices"
+	"time"
+)
+
+// Reader reads the samples of an archive file block by block
+type Reader struct {
+	closer   io.Closer
+	buf      *bufio.Reader
+	start    time.Time
+	interval time.Duration
+	channels []int // The channel of every column in the file, -1 for channels this version does not know
+	track    bool  // Files written before the track was archived have no track id column
+}
+
+// Open opens an archive file for reading
+func Open(filepath string) (*Reader, error) {
+	file, err := os.Open(filepath)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := NewReader(file)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	r.closer = file
+
+	return r, nil
+}
+
+// NewReader creates a reader from the given stream, the header is read immediately
+func NewReader(r io.Reader) (*Reader, error) {
+	reader := &Reader{
+		buf: bufio.NewReader(r),
+	}
+
+	var header [len(magic) + 1 + 8 + 4 + 1]byte
+	if _, err := io.ReadFull(reader.buf, header[:]); err != nil {
+		return nil, errors.Join(ErrInvalidArchive, err)
+	}
+	if string(header[:len(magic)]) != magic || header[len(magic)] != version {
+		return nil, ErrInvalidArchive
+	}
+	fields := header[len(magic)+1:]
+	reader.start = time.Unix(0, int64(binary.LittleEndian.Uint64(fields[0:8])))
+	reader.interval = time.Duration(binary.LittleEndian.Uint32(fields[8:12])) * time.Millisecond
+
+	reader.channels = make([]int, fields[12])
+	for i := range reader.channels {
+		length, err := reader.buf.ReadByte()
+		if err != nil {
+			return nil, errors.Join(ErrInvalidArchive, err)
+		}
+		name := make([]byte, length)
+		if _, err := io.ReadFull(reader.buf, name); err != nil {
+			return nil, errors.Join(ErrInvalidArchive, err)
+		}
+
+		reader.channels[i] = -1
+		if channel, ok := ParseChannel(string(name)); ok {
+			reader.channels[i] = int(channel)
+		}
+	}
+	reader.track = slices.Contains(reader.channels, int(TrackId))
+
+	return reader, nil
+}
+
+// Start returns the moment the offsets of the samples are relative to
+func (r *Reader) Start() time.Time {
+	return r.start
+}
+
+// Interval returns the downsampling interval of the file, 0 for raw samples
+func (r *Reader) Interval() time.Duration {
+	return r.interval
+}
+
+// Next reads the samples of the next block, returns io.EOF when all blocks have been read
+func (r *Reader) Next() ([]Sample, error) {
+	var tmp [8]byte
+	if _, err := io.ReadFull(r.buf, tmp[:8]); err != nil {
+		return nil, err
+	}
+	rows := int(binary.LittleEndian.Uint32(tmp[:4]))
+	compressed := make([]byte, binary.LittleEndian.Uint32(tmp[4:8]))
+	if _, err := io.ReadFull(r.buf, compressed); err != nil {
+		return nil, unexpected(err)
+	}
+
+	columns, err := io.ReadAll(flate.NewReader(bytes.NewReader(compressed)))
+	if err != nil {
+		return nil, errors.Join(ErrInvalidArchive, err)
+	}
+	if len(columns) != rows*4*(len(r.channels)+1) {
+		return nil, ErrInvalidArchive
+	}
+
+	samples := make([]Sample, rows)
+	for i := range samples {
+		offset := binary.LittleEndian.Uint32(columns[i*4:])
+		samples[i].Time = r.start.Add(time.Duration(offset) * time.Millisecond)
+		if !r.track {
+			samples[i].Values[TrackId] = -1
+		}
+	}
+	for c, channel := range r.channels {
+		if channel < 0 {
+			continue
+		}
+		column := columns[(c+1)*rows*4:]
+		for i := range samples {
+			samples[i].Values[channel] = math.Float32frombits(binary.LittleEndian.Uint32(column[i*4:]))
+		}
+	}
+
+	return samples, nil
+}
+
+// ReadAll reads the samples of all blocks. A block that is still being written is left out
+func (r *Reader) ReadAll() ([]Sample, error) {
+	result := make([]Sample, 0)
+	for {
+		samples, err := r.Next()
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return result, nil
+		}
+		if err != nil {
+			return result, err
+		}
+		result = append(result, samples...)
+	}
+}
+
+// Close closes the underlying file, if the reader was opened from one
+func (r *Reader) Close() error {
+	if r.closer == nil {
+		return nil
+	}
+
+	return r.closer.Close()
+}
+
+// unexpected turns an end of file in the middle of a block into io.ErrUnexpectedEOF
+func unexpected(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+
+	return err
+}
@@ -0,0 +1,113 @@
+package archive
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+type (
+	// Tier is a resolution the samples are archived at, each with its own retention
+	Tier struct {
+		Interval  time.Duration // The samples are averaged per interval, 0 keeps every sample
+		Retention time.Duration // How long the files of the tier are kept, 0 keeps them forever
+	}
+
+	// downsampler averages the samples per interval, the discrete channels keep their last value
+	downsampler struct {
+		interval time.Duration
+		bucket   time.Time
+		count    int
+		sum      [channelCount]float64
+		last     Sample
+	}
+)
+
+// DefaultTiers keeps the raw samples for a week, 10 Hz for a month and 1 Hz forever
+func DefaultTiers() []Tier {
+	return []Tier{
+		{Interval: 0, Retention: 7 * 24 * time.Hour},
+		{Interval: 100 * time.Millisecond, Retention: 30 * 24 * time.Hour},
+		{Interval: time.Second, Retention: 0},
+	}
+}
+
+// ParseTier parses a tier written as <interval>:<retention>, e.g. raw:168h, 100ms:720h or 1s:0
+func ParseTier(value string) (Tier, error) {
+	interval, retention, ok := strings.Cut(value, ":")
+	if !ok {
+		return Tier{}, fmt.Errorf("tier %q must be written as <interval>:<retention>", value)
+	}
+
+	tier := Tier{}
+	if interval != "raw" {
+		d, err := time.ParseDuration(interval)
+		if err != nil || d < time.Millisecond {
+			return tier, fmt.Errorf("tier %q has an invalid interval, use raw or a duration of at least 1ms", value)
+		}
+		tier.Interval = d
+	}
+	d, err := time.ParseDuration(retention)
+	if err != nil || d < 0 {
+		return tier, fmt.Errorf("tier %q has an invalid retention, use a duration or 0 to keep it forever", value)
+	}
+	tier.Retention = d
+
+	return tier, nil
+}
+
+// Name returns the name of the tier, which is also the name of its files: raw, or the interval such as 100ms
+func (t Tier) Name() string {
+	if t.Interval == 0 {
+		return "raw"
+	}
+
+	return t.Interval.String()
+}
+
+// String returns the tier as it is parsed by ParseTier
+func (t Tier) String() string {
+	return t.Name() + ":" + t.Retention.String()
+}
+
+func newDownsampler(interval time.Duration) *downsampler {
+	return &downsampler{interval: interval}
+}
+
+// Add adds the sample to its interval, and returns the average of the previous interval once a sample of a new one arrives
+func (d *downsampler) Add(sample Sample) (Sample, bool) {
+	bucket := sample.Time.Truncate(d.interval)
+	result, ok := Sample{}, false
+	if d.count > 0 && !bucket.Equal(d.bucket) {
+		result, ok = d.Flush()
+	}
+
+	d.bucket = bucket
+	d.count++
+	d.last = sample
+	for c, value := range sample.Values {
+		d.sum[c] += float64(value)
+	}
+
+	return result, ok
+}
+
+// Flush returns the average of the current interval, and starts over
+func (d *downsampler) Flush() (Sample, bool) {
+	if d.count == 0 {
+		return Sample{}, false
+	}
+
+	result := Sample{Time: d.bucket}
+	for c := range channelCount {
+		if c.discrete() {
+			result.Values[c] = d.last.Values[c]
+		} else {
+			result.Values[c] = float32(d.sum[c] / float64(d.count))
+		}
+	}
+	d.count = 0
+	d.sum = [channelCount]float64{}
+
+	return result, true
+}
@@ -0,0 +1,157 @@
+package archive
+
+import (
+	"bufio"
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"io"
+	"math"
+	"os"
+	"time"
+)
+
+// Writer appends samples to an archive file in blocks
+type Writer struct {
+	file     *os.File
+	buf      *bufio.Writer
+	start    time.Time
+	interval time.Duration
+	rows     []Sample
+}
+
+// Create opens the archive file for appending, writing the header if the file is new.
+// An existing file keeps the start and interval of its header, the start of a new one is truncated to the millisecond
+func Create(filepath string, start time.Time, interval time.Duration) (*Writer, error) {
+	if existing, err := Open(filepath); err == nil {
+		start, interval = existing.Start(), existing.Interval()
+		existing.Close()
+	}
+
+	file, err := os.OpenFile(filepath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &Writer{
+		file:     file,
+		buf:      bufio.NewWriter(file),
+		start:    start.Truncate(time.Millisecond),
+		interval: interval,
+		rows:     make([]Sample, 0, blockRows),
+	}
+
+	info, err := file.Stat()
+	if err == nil && info.Size() == 0 {
+		err = w.writeHeader()
+	}
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// NewWriter creates a writer that writes the archive to the given stream, the header is written immediately.
+// The start is truncated to the millisecond, like the offsets of the samples
+func NewWriter(w io.Writer, start time.Time, interval time.Duration) (*Writer, error) {
+	writer := &Writer{
+		buf:      bufio.NewWriter(w),
+		start:    start.Truncate(time.Millisecond),
+		interval: interval,
+		rows:     make([]Sample, 0, blockRows),
+	}
+
+	return writer, writer.writeHeader()
+}
+
+// Write adds a sample, it is written once its block is full or the writer is flushed
+func (w *Writer) Write(sample Sample) error {
+	w.rows = append(w.rows, sample)
+	if len(w.rows) < blockRows {
+		return nil
+	}
+
+	return w.writeBlock()
+}
+
+// Flush writes the pending samples as a block to the underlying file
+func (w *Writer) Flush() error {
+	if err := w.writeBlock(); err != nil {
+		return err
+	}
+
+	return w.buf.Flush()
+}
+
+// Close flushes and closes the underlying file
+func (w *Writer) Close() error {
+	err := w.Flush()
+	if w.file != nil {
+		if cerr := w.file.Close(); err == nil {
+			err = cerr
+		}
+	}
+
+	return err
+}
+
+func (w *Writer) writeHeader() error {
+	var tmp [8]byte
+	w.buf.WriteString(magic)
+	w.buf.WriteByte(version)
+	binary.LittleEndian.PutUint64(tmp[:], uint64(w.start.UnixNano()))
+	w.buf.Write(tmp[:8])
+	binary.LittleEndian.PutUint32(tmp[:], uint32(w.interval.Milliseconds()))
+	w.buf.Write(tmp[:4])
+	w.buf.WriteByte(uint8(channelCount))
+	for _, name := range channelNames {
+		w.buf.WriteByte(uint8(len(name)))
+		w.buf.WriteString(name)
+	}
+
+	return w.buf.Flush()
+}
+
+// writeBlock compresses the pending samples column by column
+func (w *Writer) writeBlock() error {
+	if len(w.rows) == 0 {
+		return nil
+	}
+
+	var (
+		columns    bytes.Buffer
+		compressed bytes.Buffer
+		tmp        [8]byte
+	)
+	for _, row := range w.rows {
+		binary.LittleEndian.PutUint32(tmp[:], offsetOf(w.start, row.Time))
+		columns.Write(tmp[:4])
+	}
+	for c := range channelCount {
+		for _, row := range w.rows {
+			binary.LittleEndian.PutUint32(tmp[:], math.Float32bits(row.Values[c]))
+			columns.Write(tmp[:4])
+		}
+	}
+
+	deflate, err := flate.NewWriter(&compressed, flate.BestSpeed)
+	if err != nil {
+		return err
+	}
+	if _, err := deflate.Write(columns.Bytes()); err != nil {
+		return err
+	}
+	if err := deflate.Close(); err != nil {
+		return err
+	}
+
+	binary.LittleEndian.PutUint32(tmp[:], uint32(len(w.rows)))
+	binary.LittleEndian.PutUint32(tmp[4:], uint32(compressed.Len()))
+	w.buf.Write(tmp[:8])
+	_, err = w.buf.Write(compressed.Bytes())
+	w.rows = w.rows[:0]
+
+	return err
+}
@@ -20,15 +20,24 @@ func (a *Authenticator) Token(ctx context.Context, header string) (string, error
 	// else its assumed to be a guest name
 	if strings.HasPrefix(header, "Bearer ") {
 		logger.Debug("Refreshing token")
-		return a.refreshToken(ctx, header[7:])
+		return a.Refresh(ctx, header[7:])
 	}
 	if strings.HasPrefix(header, "Basic ") {
 		logger.Debug("Authenticating user with basic token to jwt token")
 		return a.basicToken(ctx, header[6:])
 	}
 
+	return a.Guest(header)
+}
+
+// Guest returns a jwt token for a guest with the given name
+func (a *Authenticator) Guest(name string) (string, error) {
+	if name == "" {
+		return "", errors.New("guest name is required")
+	}
+
 	guest := users.User{
-		Id:       "guest: " + header,
+		Id:       "guest: " + name,
 		Email:    "guest@guest.com",
 		Password: "",
 		Admin:    false,
@@ -48,14 +57,18 @@ func (a *Authenticator) basicToken(ctx context.Context, basic string) (string, e
 		return "", err
 	}
 
-	// Split the email and password
-	parts := strings.Split(string(decoded), ":")
-	if len(parts) != 2 {
+	// Split the email and password, the password may contain colons
+	email, password, ok := strings.Cut(string(decoded), ":")
+	if !ok {
 		return "", errors.New("invalid basic token")
 	}
 
-	// Authenticate the user
-	user, err := a.users.Authenticate(ctx, parts[0], parts[1])
+	return a.Login(ctx, email, password)
+}
+
+// Login returns a jwt token for the user with the given email and password
+func (a *Authenticator) Login(ctx context.Context, email, password string) (string, error) {
+	user, err := a.users.Authenticate(ctx, email, password)
 	if err != nil {
 		return "", err
 	}
@@ -63,7 +76,8 @@ func (a *Authenticator) basicToken(ctx context.Context, basic string) (string, e
 	return a.jwtToken(user, "password")
 }
 
-func (a *Authenticator) refreshToken(ctx context.Context, tokenStr string) (string, error) {
+// Refresh returns a new jwt token for a token that is still valid
+func (a *Authenticator) Refresh(ctx context.Context, tokenStr string) (string, error) {
 	logger := log.FromContext(ctx).With("token", tokenStr)
 	logger.Info("Refreshing token")
 
@@ -0,0 +1,92 @@
+package booking
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+type (
+	// Reservation books a chair for a time slot, the chair is activated when the slot starts and deactivated when it ends
+	Reservation struct {
+		Id       string    `json:"id"`
+		ChairId  string    `json:"chair_id"`
+		Name     string    `json:"name"`                // The name of the customer
+		DriverId string    `json:"driver_id,omitempty"` // The driver profile, empty if none
+		Start    time.Time `json:"start"`
+		End      time.Time `json:"end"`
+		Created  time.Time `json:"created"`
+	}
+
+	// QueueEntry is a walk-in waiting for a free chair
+	QueueEntry struct {
+		Id       string    `json:"id"`
+		Name     string    `json:"name"`
+		DriverId string    `json:"driver_id,omitempty"`
+		Joined   time.Time `json:"joined"`
+	}
+
+	// ReservationQuery filters the reservations, zero values match everything
+	ReservationQuery struct {
+		ChairId string
+		From    time.Time // Reservations that overlap with the range
+		To      time.Time
+		Limit   int // Maximum amount of results, earliest first
+	}
+
+	// ReservationStorage stores the reservations by id
+	ReservationStorage interface {
+		Get(id string) (Reservation, error)
+		Set(id string, value Reservation) error
+		Delete(id string) error
+		Keys() []string
+	}
+
+	// QueueStorage stores the walk-in queue by id
+	QueueStorage interface {
+		Get(id string) (QueueEntry, error)
+		Set(id string, value QueueEntry) error
+		Delete(id string) error
+		Keys() []string
+	}
+)
+
+var (
+	ErrReservationNotFound = errors.New("reservation not found")
+	ErrQueueEntryNotFound  = errors.New("queue entry not found")
+	ErrChairNotFound       = errors.New("chair not found")
+	ErrNameRequired        = errors.New("name is required")
+	ErrInvalidSlot         = errors.New("the slot must end after it starts, and in the future")
+	ErrSlotTaken           = errors.New("the chair is already reserved in the slot")
+	ErrQueueEmpty          = errors.New("no one is waiting in the queue")
+)
+
+// Active returns true if the slot covers the given moment
+func (r Reservation) Active(t time.Time) bool {
+	return !t.Before(r.Start) && t.Before(r.End)
+}
+
+// Overlaps returns true if the slots of both reservations share a moment
+func (r Reservation) Overlaps(other Reservation) bool {
+	return r.Start.Before(other.End) && other.Start.Before(r.End)
+}
+
+// match returns true if the reservation is selected by the query
+func (q ReservationQuery) match(r Reservation) bool {
+	if q.ChairId != "" && r.ChairId != q.ChairId {
+		return false
+	}
+	if !q.To.IsZero() && r.Start.After(q.To) {
+		return false
+	}
+	if !q.From.IsZero() && r.End.Before(q.From) {
+		return false
+	}
+
+	return true
+}
+
+// normalizeName trims the spaces around a name
+func normalizeName(name string) string {
+	return strings.TrimSpace(name)
+}
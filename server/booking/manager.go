@@ -0,0 +1,335 @@
+package booking
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/DaanV2/f1-game-dashboards/server/sessions"
+	"github.com/charmbracelet/log"
+	"github.com/google/uuid"
+)
+
+// Manager manages the reservations and the walk-in queue of the chairs, and activates the chairs of the running slots.
+// It is safe for concurrent use
+type Manager struct {
+	lock               sync.RWMutex
+	reservationStorage ReservationStorage
+	queueStorage       QueueStorage
+	chairs             *sessions.ChairManager
+	reservations       []Reservation // Ordered by start
+	queue              []QueueEntry  // Ordered by joining
+
+	// applyLock serializes toggling the chairs, so an older decision never overwrites a newer one
+	applyLock sync.Mutex
+	applied   map[string]bool // The active state the manager last gave each chair
+}
+
+// NewManager creates the manager and loads the stored reservations and queue
+func NewManager(reservationStorage ReservationStorage, queueStorage QueueStorage, chairs *sessions.ChairManager) *Manager {
+	m := &Manager{
+		reservationStorage: reservationStorage,
+		queueStorage:       queueStorage,
+		chairs:             chairs,
+		reservations:       make([]Reservation, 0),
+		queue:              make([]QueueEntry, 0),
+		applied:            make(map[string]bool),
+	}
+
+	for _, id := range reservationStorage.Keys() {
+		reservation, err := reservationStorage.Get(id)
+		if err != nil {
+			log.Error("could not load reservation", "id", id, "error", err)
+			continue
+		}
+		m.reservations = append(m.reservations, reservation)
+	}
+	for _, id := range queueStorage.Keys() {
+		entry, err := queueStorage.Get(id)
+		if err != nil {
+			log.Error("could not load queue entry", "id", id, "error", err)
+			continue
+		}
+		m.queue = append(m.queue, entry)
+	}
+	slices.SortFunc(m.reservations, compareReservations)
+	slices.SortFunc(m.queue, func(a, b QueueEntry) int { return a.Joined.Compare(b.Joined) })
+
+	return m
+}
+
+// AddChairHooks cancels the reservations that have not ended yet when a chair is removed
+func (m *Manager) AddChairHooks(chairs *sessions.ChairManager) {
+	chairs.OnChairRemoved.Add(func(chair sessions.Chair) {
+		m.removeChair(chair.Id())
+	})
+}
+
+// Run activates and deactivates the chairs when slots start or end, until the context is done
+func (m *Manager) Run(ctx context.Context) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	m.Apply(time.Now())
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			m.Apply(now)
+		}
+	}
+}
+
+// Apply activates the chairs with a slot running at the given moment, and deactivates the chairs whose slot ended.
+// Chairs are only changed when a slot starts or ends, so a chair toggled by hand in between keeps its state
+func (m *Manager) Apply(now time.Time) {
+	m.applyLock.Lock()
+	defer m.applyLock.Unlock()
+
+	m.lock.RLock()
+	wanted := make(map[string]bool)
+	for _, reservation := range m.reservations {
+		wanted[reservation.ChairId] = wanted[reservation.ChairId] || reservation.Active(now)
+	}
+	m.lock.RUnlock()
+	// Chairs whose last reservation was cancelled
+	for id := range m.applied {
+		if _, ok := wanted[id]; !ok {
+			wanted[id] = false
+		}
+	}
+
+	for id, active := range wanted {
+		if m.applied[id] == active {
+			continue
+		}
+		m.applied[id] = active
+
+		chair, ok := m.chairs.Get(id)
+		if !ok || chair.Active == active {
+			continue
+		}
+		log.Info("slot changed, updating chair", "chair", id, "active", active)
+		chair.Active = active
+		m.chairs.Update(chair)
+	}
+}
+
+// Reserve books the chair from start until end for the customer, a zero start is now. The driver id is optional
+func (m *Manager) Reserve(chairId, name, driverId string, start, end time.Time) (Reservation, error) {
+	now := time.Now()
+	if start.IsZero() {
+		start = now
+	}
+	reservation := Reservation{
+		Id:       uuid.NewString(),
+		ChairId:  chairId,
+		Name:     normalizeName(name),
+		DriverId: driverId,
+		Start:    start,
+		End:      end,
+		Created:  now,
+	}
+
+	m.lock.Lock()
+	err := m.add(reservation, now)
+	m.lock.Unlock()
+	if err != nil {
+		return reservation, err
+	}
+
+	m.Apply(time.Now())
+	return reservation, nil
+}
+
+// Cancel removes the reservation, a running slot ends right away
+func (m *Manager) Cancel(id string) (Reservation, error) {
+	m.lock.Lock()
+	index := slices.IndexFunc(m.reservations, func(r Reservation) bool { return r.Id == id })
+	if index < 0 {
+		m.lock.Unlock()
+		return Reservation{}, ErrReservationNotFound
+	}
+	reservation := m.reservations[index]
+	if err := m.reservationStorage.Delete(id); err != nil {
+		m.lock.Unlock()
+		return reservation, err
+	}
+	m.reservations = slices.Delete(m.reservations, index, index+1)
+	m.lock.Unlock()
+
+	m.Apply(time.Now())
+	return reservation, nil
+}
+
+// Get returns the reservation with the given id
+func (m *Manager) Get(id string) (Reservation, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	for _, reservation := range m.reservations {
+		if reservation.Id == id {
+			return reservation, nil
+		}
+	}
+
+	return Reservation{}, ErrReservationNotFound
+}
+
+// Reservations returns the reservations that match the query, earliest first
+func (m *Manager) Reservations(q ReservationQuery) []Reservation {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	result := make([]Reservation, 0)
+	for _, reservation := range m.reservations {
+		if q.match(reservation) {
+			result = append(result, reservation)
+		}
+		if q.Limit > 0 && len(result) >= q.Limit {
+			break
+		}
+	}
+
+	return result
+}
+
+// Join adds a walk-in to the end of the queue. The driver id is optional
+func (m *Manager) Join(name, driverId string) (QueueEntry, error) {
+	entry := QueueEntry{
+		Id:       uuid.NewString(),
+		Name:     normalizeName(name),
+		DriverId: driverId,
+		Joined:   time.Now(),
+	}
+	if entry.Name == "" {
+		return entry, ErrNameRequired
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if err := m.queueStorage.Set(entry.Id, entry); err != nil {
+		return entry, err
+	}
+	m.queue = append(m.queue, entry)
+
+	return entry, nil
+}
+
+// Leave removes the walk-in from the queue
+func (m *Manager) Leave(id string) (QueueEntry, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	index := slices.IndexFunc(m.queue, func(e QueueEntry) bool { return e.Id == id })
+	if index < 0 {
+		return QueueEntry{}, ErrQueueEntryNotFound
+	}
+
+	entry := m.queue[index]
+	return entry, m.removeFromQueue(index)
+}
+
+// Queue returns the walk-ins that are waiting, the first in line first
+func (m *Manager) Queue() []QueueEntry {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return slices.Clone(m.queue)
+}
+
+// ServeNext gives the chair to the first walk-in in line from now for the given duration.
+// The walk-in stays in the queue if the chair is not free for the whole slot
+func (m *Manager) ServeNext(chairId string, duration time.Duration) (Reservation, QueueEntry, error) {
+	now := time.Now()
+
+	m.lock.Lock()
+	if len(m.queue) == 0 {
+		m.lock.Unlock()
+		return Reservation{}, QueueEntry{}, ErrQueueEmpty
+	}
+	entry := m.queue[0]
+	reservation := Reservation{
+		Id:       uuid.NewString(),
+		ChairId:  chairId,
+		Name:     entry.Name,
+		DriverId: entry.DriverId,
+		Start:    now,
+		End:      now.Add(duration),
+		Created:  now,
+	}
+	if err := m.add(reservation, now); err != nil {
+		m.lock.Unlock()
+		return reservation, entry, err
+	}
+	if err := m.removeFromQueue(0); err != nil {
+		log.Error("could not remove served walk-in from the queue", "id", entry.Id, "error", err)
+	}
+	m.lock.Unlock()
+
+	m.Apply(time.Now())
+	return reservation, entry, nil
+}
+
+// add validates and stores the reservation, the lock must be held
+func (m *Manager) add(reservation Reservation, now time.Time) error {
+	if reservation.Name == "" {
+		return ErrNameRequired
+	}
+	if !reservation.End.After(reservation.Start) || !reservation.End.After(now) {
+		return ErrInvalidSlot
+	}
+	if _, ok := m.chairs.Get(reservation.ChairId); !ok {
+		return ErrChairNotFound
+	}
+	for _, other := range m.reservations {
+		if other.ChairId == reservation.ChairId && other.Overlaps(reservation) {
+			return ErrSlotTaken
+		}
+	}
+	if err := m.reservationStorage.Set(reservation.Id, reservation); err != nil {
+		return err
+	}
+	m.reservations = append(m.reservations, reservation)
+	slices.SortFunc(m.reservations, compareReservations)
+
+	return nil
+}
+
+// removeFromQueue deletes the walk-in at the index, the lock must be held
+func (m *Manager) removeFromQueue(index int) error {
+	if err := m.queueStorage.Delete(m.queue[index].Id); err != nil {
+		return err
+	}
+	m.queue = slices.Delete(m.queue, index, index+1)
+
+	return nil
+}
+
+// removeChair deletes the reservations of the chair that have not ended yet
+func (m *Manager) removeChair(chairId string) {
+	now := time.Now()
+
+	m.lock.Lock()
+	m.reservations = slices.DeleteFunc(m.reservations, func(r Reservation) bool {
+		if r.ChairId != chairId || !r.End.After(now) {
+			return false
+		}
+		if err := m.reservationStorage.Delete(r.Id); err != nil {
+			log.Error("could not delete reservation of removed chair", "id", r.Id, "error", err)
+		}
+		return true
+	})
+	m.lock.Unlock()
+
+	m.applyLock.Lock()
+	delete(m.applied, chairId)
+	m.applyLock.Unlock()
+}
+
+func compareReservations(a, b Reservation) int {
+	return a.Start.Compare(b.Start)
+}
@@ -0,0 +1,92 @@
+package booking_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DaanV2/f1-game-dashboards/server/booking"
+	"github.com/DaanV2/f1-game-dashboards/server/pkg/data"
+	"github.com/DaanV2/f1-game-dashboards/server/sessions"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Manager_TogglesChairs(t *testing.T) {
+	chairs := sessions.NewChairManager()
+	chairs.Add(sessions.NewChair("test", 20777, false))
+	database := data.NewMemoryStorage()
+	manager := booking.NewManager(database.Reservations(), database.Queue(), chairs)
+	active := func() bool {
+		chair, _ := chairs.Get("20777")
+		return chair.Active
+	}
+
+	now := time.Now()
+	_, err := manager.Reserve("20777", "Alice", "", time.Time{}, now.Add(-time.Minute))
+	require.ErrorIs(t, err, booking.ErrInvalidSlot)
+	_, err = manager.Reserve("20778", "Alice", "", time.Time{}, now.Add(time.Hour))
+	require.ErrorIs(t, err, booking.ErrChairNotFound)
+
+	// A slot that starts now activates the chair right away
+	reservation, err := manager.Reserve("20777", "Alice", "", time.Time{}, now.Add(time.Hour))
+	require.NoError(t, err)
+	require.True(t, active())
+
+	_, err = manager.Reserve("20777", "Bob", "", now.Add(30*time.Minute), now.Add(2*time.Hour))
+	require.ErrorIs(t, err, booking.ErrSlotTaken)
+	next, err := manager.Reserve("20777", "Bob", "", reservation.End, reservation.End.Add(time.Hour))
+	require.NoError(t, err)
+
+	// The chair stays active from one slot into the next, and is deactivated after the last one
+	manager.Apply(reservation.End)
+	require.True(t, active())
+	manager.Apply(next.End)
+	require.False(t, active())
+
+	// A chair toggled by hand keeps its state until a slot starts or ends
+	chairs.Update(sessions.NewChair("test", 20777, true))
+	manager.Apply(next.End.Add(time.Minute))
+	require.True(t, active())
+
+	// Cancelling a running slot ends it right away
+	_, err = manager.Cancel(reservation.Id)
+	require.NoError(t, err)
+	_, err = manager.Cancel(reservation.Id)
+	require.ErrorIs(t, err, booking.ErrReservationNotFound)
+	require.Len(t, manager.Reservations(booking.ReservationQuery{ChairId: "20777"}), 1)
+}
+
+func Test_Manager_Queue(t *testing.T) {
+	chairs := sessions.NewChairManager()
+	chairs.Add(sessions.NewChair("first", 20777, false))
+	chairs.Add(sessions.NewChair("second", 20778, false))
+	database := data.NewMemoryStorage()
+	manager := booking.NewManager(database.Reservations(), database.Queue(), chairs)
+
+	_, _, err := manager.ServeNext("20777", time.Hour)
+	require.ErrorIs(t, err, booking.ErrQueueEmpty)
+	_, err = manager.Join(" ", "")
+	require.ErrorIs(t, err, booking.ErrNameRequired)
+
+	alice, err := manager.Join("Alice", "")
+	require.NoError(t, err)
+	bob, err := manager.Join("Bob", "")
+	require.NoError(t, err)
+
+	reservation, entry, err := manager.ServeNext("20777", time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, alice.Id, entry.Id)
+	require.Equal(t, "Alice", reservation.Name)
+
+	// The walk-in stays first in line if the chair is taken
+	_, _, err = manager.ServeNext("20777", time.Hour)
+	require.ErrorIs(t, err, booking.ErrSlotTaken)
+	require.Len(t, manager.Queue(), 1)
+	require.Equal(t, bob.Id, manager.Queue()[0].Id)
+
+	// A new manager loads the bookings from the storage
+	reloaded := booking.NewManager(database.Reservations(), database.Queue(), chairs)
+	require.Len(t, reloaded.Queue(), 1)
+	loaded, err := reloaded.Get(reservation.Id)
+	require.NoError(t, err)
+	require.Equal(t, "20777", loaded.ChairId)
+}
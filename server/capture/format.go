@@ -0,0 +1,35 @@
+package capture
+
+import (
+	"errors"
+	"net"
+	"time"
+)
+
+// A capture file is an append-only binary file, all numbers are little endian:
+//
+//	header: magic "F1CP" | version uint8 | chair id length uint16 | chair id
+//	record: received unix nano int64 | ip length uint8 | ip | port uint16 | data length uint16 | data
+//
+// Records are appended in the order they were received, a file only contains the packets of one chair.
+
+const (
+	// FileExtension is the extension used for capture files
+	FileExtension = ".f1cap"
+
+	magic   = "F1CP"
+	version = 1
+)
+
+var (
+	// ErrInvalidCapture is returned when a file is not a capture file or has an unsupported version
+	ErrInvalidCapture = errors.New("not a valid capture file")
+)
+
+// Packet is a single recorded datagram
+type Packet struct {
+	ChairId  string       // The id of the chair the packet was received on
+	Address  *net.UDPAddr // The address the packet was send from
+	Received time.Time    // The moment the packet was received
+	Data     []byte       // The datagram
+}
@@ -0,0 +1,119 @@
+package capture
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"time"
+)
+
+// Reader reads the packets of a capture file in the order they were recorded
+type Reader struct {
+	closer  io.Closer
+	buf     *bufio.Reader
+	chairId string
+}
+
+// Open opens a capture file for reading
+func Open(filepath string) (*Reader, error) {
+	file, err := os.Open(filepath)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := NewReader(file)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	r.closer = file
+
+	return r, nil
+}
+
+// NewReader creates a reader from the given stream, the header is read immediately
+func NewReader(r io.Reader) (*Reader, error) {
+	reader := &Reader{
+		buf: bufio.NewReader(r),
+	}
+
+	var header [len(magic) + 1 + 2]byte
+	if _, err := io.ReadFull(reader.buf, header[:]); err != nil {
+		return nil, errors.Join(ErrInvalidCapture, err)
+	}
+	if string(header[:len(magic)]) != magic || header[len(magic)] != version {
+		return nil, ErrInvalidCapture
+	}
+
+	chairId := make([]byte, binary.LittleEndian.Uint16(header[len(magic)+1:]))
+	if _, err := io.ReadFull(reader.buf, chairId); err != nil {
+		return nil, errors.Join(ErrInvalidCapture, err)
+	}
+	reader.chairId = string(chairId)
+
+	return reader, nil
+}
+
+// ChairId returns the id of the chair the capture was recorded on
+func (r *Reader) ChairId() string {
+	return r.chairId
+}
+
+// Next reads the next packet, returns io.EOF when all packets have been read
+func (r *Reader) Next() (Packet, error) {
+	var tmp [8]byte
+	if _, err := io.ReadFull(r.buf, tmp[:8]); err != nil {
+		return Packet{}, err
+	}
+	received := time.Unix(0, int64(binary.LittleEndian.Uint64(tmp[:8])))
+
+	ipLength, err := r.buf.ReadByte()
+	if err != nil {
+		return Packet{}, unexpected(err)
+	}
+	ip := make(net.IP, ipLength)
+	if _, err := io.ReadFull(r.buf, ip); err != nil {
+		return Packet{}, unexpected(err)
+	}
+
+	if _, err := io.ReadFull(r.buf, tmp[:4]); err != nil {
+		return Packet{}, unexpected(err)
+	}
+	port := binary.LittleEndian.Uint16(tmp[:2])
+	data := make([]byte, binary.LittleEndian.Uint16(tmp[2:4]))
+	if _, err := io.ReadFull(r.buf, data); err != nil {
+		return Packet{}, unexpected(err)
+	}
+
+	packet := Packet{
+		ChairId:  r.chairId,
+		Received: received,
+		Data:     data,
+	}
+	if ipLength > 0 {
+		packet.Address = &net.UDPAddr{IP: ip, Port: int(port)}
+	}
+
+	return packet, nil
+}
+
+// Close closes the underlying file, if any
+func (r *Reader) Close() error {
+	if r.closer == nil {
+		return nil
+	}
+
+	return r.closer.Close()
+}
+
+// unexpected turns an EOF halfway through a record into an unexpected EOF
+func unexpected(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+
+	return err
+}
@@ -0,0 +1,168 @@
+package capture
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/DaanV2/f1-game-dashboards/server/game"
+	"github.com/charmbracelet/log"
+)
+
+const (
+	recorderBuffer = 4096
+	flushInterval  = time.Second
+)
+
+type (
+	// Recorder writes every raw packet it receives into capture files.
+	// Each chair gets its own directory, and a new file is started whenever the session uid changes:
+	// <directory>/<chair id>/<session uid>.f1cap
+	Recorder struct {
+		directory string
+		packets   chan game.RawPacket
+		done      chan struct{}
+		lock      sync.RWMutex
+		closed    bool
+		closeErr  error
+
+		files map[string]*sessionFile
+	}
+
+	sessionFile struct {
+		session uint64
+		writer  *Writer
+	}
+)
+
+var _ game.PacketRecorder = &Recorder{}
+
+// NewRecorder creates a new recorder that writes its captures in the given directory
+func NewRecorder(directory string) (*Recorder, error) {
+	if err := os.MkdirAll(directory, os.ModePerm); err != nil {
+		return nil, err
+	}
+
+	r := &Recorder{
+		directory: directory,
+		packets:   make(chan game.RawPacket, recorderBuffer),
+		done:      make(chan struct{}),
+		files:     make(map[string]*sessionFile),
+	}
+	go r.run()
+
+	return r, nil
+}
+
+// Record queues the packet for writing, if the recorder can't keep up the packet is dropped
+func (r *Recorder) Record(packet game.RawPacket) {
+	packet.Data = append([]byte(nil), packet.Data...)
+
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	if r.closed {
+		return
+	}
+
+	select {
+	case r.packets <- packet:
+	default:
+		log.Warn("capture recorder is falling behind, dropping packet", "chair", packet.ChairId)
+	}
+}
+
+// Close stops the recorder, and flushes and closes all the open captures.
+// Packets recorded after Close are ignored
+func (r *Recorder) Close() error {
+	r.lock.Lock()
+	if !r.closed {
+		r.closed = true
+		close(r.packets)
+	}
+	r.lock.Unlock()
+	<-r.done
+
+	return r.closeErr
+}
+
+func (r *Recorder) run() {
+	defer close(r.done)
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case packet, ok := <-r.packets:
+			if !ok {
+				r.closeErr = r.closeAll()
+				return
+			}
+			if err := r.write(packet); err != nil {
+				log.Error("could not record packet", "chair", packet.ChairId, "error", err)
+			}
+		case <-ticker.C:
+			for chair, file := range r.files {
+				if err := file.writer.Flush(); err != nil {
+					log.Error("could not flush capture", "chair", chair, "error", err)
+				}
+			}
+		}
+	}
+}
+
+func (r *Recorder) write(packet game.RawPacket) error {
+	session, err := game.SessionUID(packet.Data)
+	if err != nil {
+		return err
+	}
+
+	file, ok := r.files[packet.ChairId]
+	if !ok || file.session != session {
+		file, err = r.rotate(packet.ChairId, session)
+		if err != nil {
+			return err
+		}
+	}
+
+	return file.writer.Write(packet.Received, packet.Address, packet.Data)
+}
+
+// rotate closes the current capture of the chair and opens the one for the given session
+func (r *Recorder) rotate(chairId string, session uint64) (*sessionFile, error) {
+	if current, ok := r.files[chairId]; ok {
+		delete(r.files, chairId)
+		if err := current.writer.Close(); err != nil {
+			log.Error("could not close capture", "chair", chairId, "error", err)
+		}
+	}
+
+	dir := filepath.Join(r.directory, chairId)
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, err
+	}
+
+	path := filepath.Join(dir, strconv.FormatUint(session, 10)+FileExtension)
+	writer, err := Create(path, chairId)
+	if err != nil {
+		return nil, err
+	}
+	log.Debug("recording session", "chair", chairId, "session", session, "file", path)
+
+	file := &sessionFile{session: session, writer: writer}
+	r.files[chairId] = file
+
+	return file, nil
+}
+
+func (r *Recorder) closeAll() error {
+	var err error
+	for chair, file := range r.files {
+		err = errors.Join(err, file.writer.Close())
+		delete(r.files, chair)
+	}
+
+	return err
+}
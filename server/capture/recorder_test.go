@@ -0,0 +1,68 @@
+package capture_test
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/DaanV2/f1-game-dashboards/server/capture"
+	"github.com/DaanV2/f1-game-dashboards/server/game"
+	"github.com/DaanV2/go-f1-library/enums"
+	f1_2023 "github.com/DaanV2/go-f1-library/packets/2023"
+	"github.com/stretchr/testify/require"
+)
+
+func packet(session uint64, frame uint32) []byte {
+	data := make([]byte, f1_2023.PacketHeaderSize)
+	binary.LittleEndian.PutUint16(data[0:2], uint16(enums.PF_F1_2023))
+	data[2] = 23
+	binary.LittleEndian.PutUint64(data[7:15], session)
+	binary.LittleEndian.PutUint32(data[19:23], frame)
+
+	return data
+}
+
+func Test_Recorder_RotatesPerSession(t *testing.T) {
+	dir := t.TempDir()
+	recorder, err := capture.NewRecorder(dir)
+	require.NoError(t, err)
+
+	address := &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 20777}
+	start := time.Unix(1_700_000_000, 0)
+	recorder.Record(game.RawPacket{ChairId: "20777", Address: address, Received: start, Data: packet(1, 1)})
+	recorder.Record(game.RawPacket{ChairId: "20777", Address: address, Received: start.Add(time.Millisecond), Data: packet(1, 2)})
+	recorder.Record(game.RawPacket{ChairId: "20777", Address: address, Received: start.Add(time.Second), Data: packet(2, 1)})
+	require.NoError(t, recorder.Close())
+
+	entries, err := os.ReadDir(filepath.Join(dir, "20777"))
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	reader, err := capture.Open(filepath.Join(dir, "20777", "1"+capture.FileExtension))
+	require.NoError(t, err)
+	defer reader.Close()
+	require.Equal(t, "20777", reader.ChairId())
+
+	for i, frame := range []uint32{1, 2} {
+		p, err := reader.Next()
+		require.NoError(t, err)
+		require.Equal(t, packet(1, frame), p.Data)
+		require.Equal(t, start.Add(time.Duration(i)*time.Millisecond).UnixNano(), p.Received.UnixNano())
+		require.Equal(t, address.String(), p.Address.String())
+	}
+
+	_, err = reader.Next()
+	require.ErrorIs(t, err, io.EOF)
+}
+
+func Test_Reader_InvalidCapture(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "invalid"+capture.FileExtension)
+	require.NoError(t, os.WriteFile(path, []byte("not a capture"), 0644))
+
+	_, err := capture.Open(path)
+	require.ErrorIs(t, err, capture.ErrInvalidCapture)
+}
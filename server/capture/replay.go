@@ -0,0 +1,63 @@
+package capture
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+)
+
+// Replay reads all the packets of the capture and passes them to handle, keeping the time between
+// packets as it was recorded divided by speed. A speed of 0 or less replays the packets as fast as possible.
+// Returns the amount of packets replayed
+func Replay(ctx context.Context, reader *Reader, speed float64, handle func(packet Packet)) (int, error) {
+	var (
+		first time.Time
+		start time.Time
+		count int
+		timer *time.Timer
+	)
+	defer func() {
+		if timer != nil {
+			timer.Stop()
+		}
+	}()
+
+	for {
+		packet, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			return count, nil
+		}
+		if err != nil {
+			return count, err
+		}
+
+		if count == 0 {
+			first = packet.Received
+			start = time.Now()
+		}
+
+		if speed > 0 {
+			offset := time.Duration(float64(packet.Received.Sub(first)) / speed)
+			if wait := time.Until(start.Add(offset)); wait > 0 {
+				if timer == nil {
+					timer = time.NewTimer(wait)
+				} else {
+					timer.Reset(wait)
+				}
+
+				select {
+				case <-ctx.Done():
+					return count, ctx.Err()
+				case <-timer.C:
+				}
+			}
+		}
+		if err := ctx.Err(); err != nil {
+			return count, err
+		}
+
+		handle(packet)
+		count++
+	}
+}
@@ -0,0 +1,96 @@
+package capture_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DaanV2/f1-game-dashboards/server/capture"
+	"github.com/DaanV2/f1-game-dashboards/server/game"
+	"github.com/DaanV2/f1-game-dashboards/server/sessions"
+	"github.com/DaanV2/f1-game-dashboards/server/simulator"
+	"github.com/DaanV2/f1-game-dashboards/server/state"
+	"github.com/stretchr/testify/require"
+)
+
+func capturedPackets(t *testing.T, interval time.Duration, amount int) *capture.Reader {
+	var buf bytes.Buffer
+	writer, err := capture.NewWriter(&buf, "20777")
+	require.NoError(t, err)
+
+	start := time.Unix(1_700_000_000, 0)
+	for i := 0; i < amount; i++ {
+		require.NoError(t, writer.Write(start.Add(time.Duration(i)*interval), nil, packet(1, uint32(i))))
+	}
+	require.NoError(t, writer.Flush())
+
+	reader, err := capture.NewReader(&buf)
+	require.NoError(t, err)
+
+	return reader
+}
+
+func Test_Replay_Speed(t *testing.T) {
+	reader := capturedPackets(t, 100*time.Millisecond, 5)
+
+	frames := make([][]byte, 0)
+	start := time.Now()
+	count, err := capture.Replay(context.Background(), reader, 4, func(p capture.Packet) {
+		frames = append(frames, p.Data)
+	})
+	elapsed := time.Since(start)
+
+	require.NoError(t, err)
+	require.Equal(t, 5, count)
+	for i, frame := range frames {
+		require.Equal(t, packet(1, uint32(i)), frame)
+	}
+	// 400ms of packets at 4x speed
+	require.GreaterOrEqual(t, elapsed, 100*time.Millisecond)
+	require.Less(t, elapsed, 400*time.Millisecond)
+}
+
+func Test_Replay_Cancelled(t *testing.T) {
+	reader := capturedPackets(t, time.Hour, 2)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	count, err := capture.Replay(ctx, reader, 1, func(p capture.Packet) {})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Equal(t, 1, count)
+}
+
+func Test_Replay_ReachesSubscribers(t *testing.T) {
+	var buf bytes.Buffer
+	writer, err := capture.NewWriter(&buf, "20777")
+	require.NoError(t, err)
+	sim := simulator.NewSimulator(simulator.Options{Cars: 2, Laps: 1, TrackLength: 500, Seed: 1})
+	start := time.Unix(1_700_000_000, 0)
+	for i := range 10 {
+		for _, p := range sim.Step(time.Second / 20) {
+			require.NoError(t, writer.Write(start.Add(time.Duration(i)*time.Second/20), nil, p))
+		}
+	}
+	require.NoError(t, writer.Flush())
+	reader, err := capture.NewReader(&buf)
+	require.NoError(t, err)
+
+	// Replayed the way the replay command does, into a processor with a subscribed store
+	processor := game.NewPacketProcessor()
+	defer processor.Close()
+	store := state.NewStore()
+	store.Subscribe(processor.Pipeline())
+	chair := sessions.NewChair("replay", 20777, true)
+
+	_, err = capture.Replay(context.Background(), reader, 0, func(p capture.Packet) {
+		require.NoError(t, processor.Inject(chair, p.Data))
+	})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		snapshot, ok := store.Get(chair.Id())
+		return ok && snapshot.SessionUID == sim.SessionUID() && snapshot.LapData != nil
+	}, time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,118 @@
+package capture
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"os"
+	"time"
+)
+
+// Writer appends packets to a capture file
+type Writer struct {
+	file    *os.File
+	buf     *bufio.Writer
+	chairId string
+}
+
+// Create opens the capture file for appending, writing the header if the file is new
+func Create(filepath string, chairId string) (*Writer, error) {
+	file, err := os.OpenFile(filepath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &Writer{
+		file:    file,
+		buf:     bufio.NewWriter(file),
+		chairId: chairId,
+	}
+
+	info, err := file.Stat()
+	if err == nil && info.Size() == 0 {
+		err = w.writeHeader()
+	}
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// NewWriter creates a writer that writes the capture to the given stream, the header is written immediately
+func NewWriter(w io.Writer, chairId string) (*Writer, error) {
+	writer := &Writer{
+		buf:     bufio.NewWriter(w),
+		chairId: chairId,
+	}
+
+	return writer, writer.writeHeader()
+}
+
+// Write appends a single packet
+func (w *Writer) Write(received time.Time, address *net.UDPAddr, data []byte) error {
+	if len(data) > math.MaxUint16 {
+		return fmt.Errorf("packet too large to capture: %d bytes", len(data))
+	}
+
+	var (
+		ip   net.IP
+		port int
+		tmp  [8]byte
+	)
+	if address != nil {
+		ip = address.IP
+		if ip4 := ip.To4(); ip4 != nil {
+			ip = ip4
+		}
+		port = address.Port
+	}
+
+	binary.LittleEndian.PutUint64(tmp[:], uint64(received.UnixNano()))
+	w.buf.Write(tmp[:8])
+	w.buf.WriteByte(uint8(len(ip)))
+	w.buf.Write(ip)
+	binary.LittleEndian.PutUint16(tmp[:], uint16(port))
+	w.buf.Write(tmp[:2])
+	binary.LittleEndian.PutUint16(tmp[:], uint16(len(data)))
+	w.buf.Write(tmp[:2])
+	_, err := w.buf.Write(data)
+
+	return err
+}
+
+// Flush writes any buffered packets to the underlying file
+func (w *Writer) Flush() error {
+	return w.buf.Flush()
+}
+
+// Close flushes and closes the underlying file
+func (w *Writer) Close() error {
+	err := w.Flush()
+	if w.file != nil {
+		if cerr := w.file.Close(); err == nil {
+			err = cerr
+		}
+	}
+
+	return err
+}
+
+func (w *Writer) writeHeader() error {
+	if len(w.chairId) > math.MaxUint16 {
+		return fmt.Errorf("chair id too long: %d", len(w.chairId))
+	}
+
+	var tmp [2]byte
+	w.buf.WriteString(magic)
+	w.buf.WriteByte(version)
+	binary.LittleEndian.PutUint16(tmp[:], uint16(len(w.chairId)))
+	w.buf.Write(tmp[:])
+	w.buf.WriteString(w.chairId)
+
+	return w.buf.Flush()
+}
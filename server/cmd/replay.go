@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"strconv"
+	"sync"
+	"syscall"
+
+	"github.com/DaanV2/f1-game-dashboards/server/capture"
+	"github.com/DaanV2/f1-game-dashboards/server/game"
+	"github.com/DaanV2/f1-game-dashboards/server/pkg/data"
+	"github.com/DaanV2/f1-game-dashboards/server/sessions"
+	"github.com/charmbracelet/log"
+	"github.com/spf13/cobra"
+)
+
+// replayCmd represents the replay command
+var replayCmd = &cobra.Command{
+	Use:   "replay [capture files...]",
+	Short: "Replays recorded capture files through the packet pipeline",
+	Long: `Reads the given capture files and injects the packets into the packet processor as if they came from the chair they were recorded on.
+Captures of different chairs are replayed at the same time, captures of the same chair one after the other.
+The same services and api as the server command are started, without listening on the ports of the chairs, so dashboards can be developed against the replay.`,
+	Args: cobra.MinimumNArgs(1),
+	Run:  ReplayCmd,
+}
+
+func init() {
+	serverCmd.AddCommand(replayCmd)
+
+	replayCmd.Flags().Float64("speed", 1, "The speed to replay at, 1 is real time, 2 is twice as fast, 0 is as fast as possible")
+	replayCmd.Flags().Bool("serve", true, "Whether to keep serving the api after the replay finished, until stopped")
+}
+
+func ReplayCmd(cmd *cobra.Command, args []string) {
+	speed, _ := cmd.Flags().GetFloat64("speed")
+	serve, _ := cmd.Flags().GetBool("serve")
+	database, err := data.NewStorage(cmd.Flags())
+	if err != nil {
+		log.Fatal("could not create storage", "error", err)
+	}
+
+	// The chairs of the captures are not stored, and the processor is not hooked to them so it does not listen on their ports
+	chairs := sessions.NewChairManager()
+	services := newServices(cmd, database, chairs)
+	defer services.Close()
+
+	ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM, syscall.SIGINT, syscall.SIGQUIT)
+	defer cancel()
+
+	// Group the files per chair, keeping the given order
+	files := make(map[string][]*capture.Reader)
+	order := make([]string, 0)
+	for _, file := range args {
+		reader, err := capture.Open(file)
+		if err != nil {
+			log.Fatal("could not open capture", "file", file, "error", err)
+		}
+		defer reader.Close()
+
+		id := reader.ChairId()
+		if _, ok := files[id]; !ok {
+			order = append(order, id)
+		}
+		files[id] = append(files[id], reader)
+	}
+
+	replayChairs := make(map[string]sessions.Chair, len(order))
+	for _, id := range order {
+		replayChairs[id] = replayChairFor(id)
+		chairs.Add(replayChairs[id])
+	}
+
+	if err := services.server.Start(); err != nil {
+		log.Fatal("could not start server", "error", err)
+	}
+	defer func() {
+		if err := services.server.Stop(); err != nil {
+			log.Error("could not stop server", "error", err)
+		}
+	}()
+
+	log.Info("replaying captures", "chairs", len(order), "files", len(args), "speed", speed)
+	var wg sync.WaitGroup
+	for _, id := range order {
+		wg.Add(1)
+		go func(chair sessions.Chair, readers []*capture.Reader) {
+			defer wg.Done()
+			replayChair(ctx, services.processor, chair, readers, speed)
+		}(replayChairs[id], files[id])
+	}
+
+	wg.Wait()
+	if !serve || ctx.Err() != nil {
+		log.Info("replay finished")
+		return
+	}
+
+	log.Info("replay finished, serving until stopped")
+	<-ctx.Done()
+}
+
+// replayChairFor creates the chair the packets of the capture are injected as, the id is the port of chairs without a
+// stable id
+func replayChairFor(id string) sessions.Chair {
+	if port, err := strconv.Atoi(id); err == nil {
+		return sessions.NewChair("replay "+id, port, true)
+	}
+
+	return sessions.NewChair("replay "+id, 0, true).WithId(id)
+}
+
+func replayChair(ctx context.Context, processor *game.PacketProcessor, chair sessions.Chair, readers []*capture.Reader, speed float64) {
+	logger := log.With("chair", chair.Name)
+
+	for _, reader := range readers {
+		count, err := capture.Replay(ctx, reader, speed, func(packet capture.Packet) {
+			if err := processor.Inject(chair, packet.Data); err != nil {
+				logger.Error("error handling packet", "error", err)
+			}
+		})
+		logger.Info("replayed capture", "packets", count)
+
+		if err != nil {
+			if ctx.Err() == nil {
+				logger.Error("could not replay capture", "error", err)
+			}
+			return
+		}
+	}
+}
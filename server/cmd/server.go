@@ -4,14 +4,19 @@ Copyright © 2024 NAME HERE <EMAIL ADDRESS>
 package cmd
 
 import (
+	"context"
 	"os"
 	"os/signal"
 	"syscall"
 
-	"github.com/DaanV2/f1-game-dashboards/server/api"
+	"github.com/DaanV2/f1-game-dashboards/server/archive"
+	"github.com/DaanV2/f1-game-dashboards/server/authenication"
+	"github.com/DaanV2/f1-game-dashboards/server/capture"
 	"github.com/DaanV2/f1-game-dashboards/server/game"
+	"github.com/DaanV2/f1-game-dashboards/server/jwt"
 	"github.com/DaanV2/f1-game-dashboards/server/pkg/data"
 	"github.com/DaanV2/f1-game-dashboards/server/sessions"
+	"github.com/DaanV2/f1-game-dashboards/server/users"
 	"github.com/charmbracelet/log"
 	"github.com/spf13/cobra"
 )
@@ -42,11 +47,17 @@ func init() {
 	// Cobra supports local flags which will only run when this command
 	// is called directly, e.g.:
 	// serverCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
+	serverCmd.Flags().Int("shared-port", 0, "A udp port that receives the packets of all the rigs, routed to the chairs by their source address (0 to disable)")
+	serverCmd.Flags().Int("discovery-port", 0, "A udp port that only lists the rigs sending to it as pending chairs, so they can be adopted (0 to disable)")
+	serverCmd.Flags().Bool("record", false, "Whether to record all raw packets of the active chairs to capture files")
+	serverCmd.Flags().String("record-directory", "./data/captures", "The directory to store the capture files in")
+	serverCmd.PersistentFlags().Bool("archive", true, "Whether to archive the telemetry of the player car on every chair")
+	serverCmd.PersistentFlags().String("archive-directory", "./data/archive", "The directory to store the telemetry archive in")
+	serverCmd.PersistentFlags().StringSlice("archive-tier", defaultArchiveTiers(), "The resolutions to archive the telemetry at as <interval>:<retention>, the interval is raw or a duration and a retention of 0 keeps the files forever")
 }
 
 func ServerCmd(cmd *cobra.Command, args []string) {
 	chairs := sessions.NewChairManager()
-	server := api.NewApiServer(chairs)
 	database, err := data.NewStorage(cmd.Flags())
 	if err != nil {
 		log.Fatal("could not create storage", "error", err)
@@ -58,16 +69,47 @@ func ServerCmd(cmd *cobra.Command, args []string) {
 	}
 
 	// TODO couple options to the packet processor
-	packetProcessor := game.NewPacketProcessor()
-
-	// Setup hooks
+	packetOptions := make([]game.PacketOption, 0)
+	if port, _ := cmd.Flags().GetInt("shared-port"); port > 0 {
+		packetOptions = append(packetOptions, game.WithSharedPort(port))
+	}
+	if port, _ := cmd.Flags().GetInt("discovery-port"); port > 0 {
+		packetOptions = append(packetOptions, game.WithDiscoveryPort(port))
+	}
+	if record, _ := cmd.Flags().GetBool("record"); record {
+		dir, _ := cmd.Flags().GetString("record-directory")
+		recorder, err := capture.NewRecorder(dir)
+		if err != nil {
+			log.Fatal("could not create recorder", "error", err)
+		}
+		// Deferred before the packet processor, so it is closed after the processor stopped
+		defer func() {
+			if err := recorder.Close(); err != nil {
+				log.Error("could not close recorder", "error", err)
+			}
+		}()
+
+		log.Info("recording packets", "directory", dir)
+		packetOptions = append(packetOptions, game.WithRecorder(recorder))
+	}
+	services := newServices(cmd, database, chairs, packetOptions...)
+	defer services.Close()
+	packetProcessor := services.processor
 	packetProcessor.AddChairHooks(chairs)
-	defer packetProcessor.Close()
 
 	data.DatabaseHooks(database, chairs)
 	packetProcessor.AddChairs(chairs)
+	if err := packetProcessor.Listen(); err != nil {
+		log.Fatal("could not listen on the shared or discovery port", "error", err)
+	}
+
+	// Started after the other hooks, so the chairs it toggles are stored and picked up by the packet processor
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go services.bookings.Run(ctx)
 
 	// Setup server
+	server := services.server
 	if err := server.Start(); err != nil {
 		log.Fatal("could not start server", "error", err)
 	}
@@ -97,4 +139,52 @@ func getChairs(database data.Database) []sessions.Chair {
 	}
 	
 	return chairs
-}
\ No newline at end of file
+}
+
+func getAuthenticator(database data.Database, userManagement *users.UserManagement) *authenication.Authenticator {
+	sigs, err := jwt.GetOrCreate(database, false)
+	if err != nil {
+		log.Fatal("could not load jwt signing keys", "error", err)
+	}
+	jwtService, err := jwt.NewJwtService(sigs)
+	if err != nil {
+		log.Fatal("could not create jwt service", "error", err)
+	}
+
+	return authenication.NewAuthenticator(userManagement, jwtService)
+}
+
+// getArchive creates the telemetry archive from the flags, nil if archiving is disabled
+func getArchive(cmd *cobra.Command) *archive.Archive {
+	if enabled, _ := cmd.Flags().GetBool("archive"); !enabled {
+		return nil
+	}
+
+	dir, _ := cmd.Flags().GetString("archive-directory")
+	values, _ := cmd.Flags().GetStringSlice("archive-tier")
+	tiers := make([]archive.Tier, 0, len(values))
+	for _, v := range values {
+		tier, err := archive.ParseTier(v)
+		if err != nil {
+			log.Fatal("invalid archive tier", "error", err)
+		}
+		tiers = append(tiers, tier)
+	}
+
+	telemetryArchive, err := archive.New(archive.Options{Directory: dir, Tiers: tiers})
+	if err != nil {
+		log.Fatal("could not create telemetry archive", "error", err)
+	}
+	log.Info("archiving telemetry", "directory", dir, "tiers", values)
+
+	return telemetryArchive
+}
+
+func defaultArchiveTiers() []string {
+	tiers := make([]string, 0)
+	for _, tier := range archive.DefaultTiers() {
+		tiers = append(tiers, tier.String())
+	}
+
+	return tiers
+}
@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"github.com/DaanV2/f1-game-dashboards/server/api"
+	"github.com/DaanV2/f1-game-dashboards/server/archive"
+	"github.com/DaanV2/f1-game-dashboards/server/booking"
+	"github.com/DaanV2/f1-game-dashboards/server/drivers"
+	"github.com/DaanV2/f1-game-dashboards/server/game"
+	"github.com/DaanV2/f1-game-dashboards/server/ghost"
+	"github.com/DaanV2/f1-game-dashboards/server/history"
+	"github.com/DaanV2/f1-game-dashboards/server/leaderboard"
+	"github.com/DaanV2/f1-game-dashboards/server/pkg/data"
+	"github.com/DaanV2/f1-game-dashboards/server/racecontrol"
+	"github.com/DaanV2/f1-game-dashboards/server/sessions"
+	"github.com/DaanV2/f1-game-dashboards/server/standings"
+	"github.com/DaanV2/f1-game-dashboards/server/state"
+	"github.com/DaanV2/f1-game-dashboards/server/strategy"
+	"github.com/DaanV2/f1-game-dashboards/server/trackmap"
+	"github.com/DaanV2/f1-game-dashboards/server/users"
+	"github.com/charmbracelet/log"
+	"github.com/spf13/cobra"
+)
+
+// services are the packet processor, the subsystems fed by its pipeline and the api server on top of them.
+// The server command feeds the processor from the chair ports, the replay command from capture files
+type services struct {
+	processor *game.PacketProcessor
+	archive   *archive.Archive
+	bookings  *booking.Manager
+	server    *api.ApiServer
+}
+
+// newServices creates the packet processor and wires all the subsystems and the api server to it. The processor is not
+// hooked to the chairs, so it does not listen on their ports
+func newServices(cmd *cobra.Command, database data.Database, chairs *sessions.ChairManager, packetOptions ...game.PacketOption) *services {
+	telemetryArchive := getArchive(cmd)
+	packetProcessor := game.NewPacketProcessor(packetOptions...)
+
+	sessionState := state.NewStore()
+	sessionState.Subscribe(packetProcessor.Pipeline())
+	sessionState.AddChairHooks(chairs)
+
+	driverRegistry := drivers.NewRegistry(database.Drivers(), database.CheckIns())
+	driverRegistry.AddChairHooks(chairs)
+
+	lapHistory := history.NewStore(database.LapHistory(), sessionState, driverRegistry)
+	lapHistory.Subscribe(packetProcessor.Pipeline())
+
+	if telemetryArchive != nil {
+		telemetryArchive.Subscribe(packetProcessor.Pipeline())
+	}
+	ghosts := ghost.NewTracker(lapHistory, telemetryArchive, sessionState, driverRegistry)
+	ghosts.Subscribe(packetProcessor.Pipeline())
+	ghosts.AddChairHooks(chairs)
+
+	standingsEngine := standings.NewEngine(sessionState)
+	standingsEngine.Subscribe(packetProcessor.Pipeline())
+	standingsEngine.AddChairHooks(chairs)
+
+	raceControl := racecontrol.NewFeed(database.RaceControl(), sessionState)
+	raceControl.Subscribe(packetProcessor.Pipeline())
+
+	strategyPlanner := strategy.NewPlanner(sessionState)
+	strategyPlanner.Subscribe(packetProcessor.Pipeline())
+	strategyPlanner.AddChairHooks(chairs)
+
+	trackMaps := trackmap.NewBuilder(database.TrackMaps(), sessionState)
+	trackMaps.Subscribe(packetProcessor.Pipeline())
+	trackMaps.AddChairHooks(chairs)
+
+	bookings := booking.NewManager(database.Reservations(), database.Queue(), chairs)
+	bookings.AddChairHooks(chairs)
+
+	// Created before the chairs are added, so the telemetry hooks are in place before packets arrive
+	userManagement := users.NewUserManagement(database.Users())
+	server := api.NewApiServer(
+		chairs,
+		getAuthenticator(database, userManagement),
+		api.WithPipeline(packetProcessor.Pipeline()),
+		api.WithUsers(userManagement),
+		api.WithLapHistory(lapHistory),
+		api.WithLeaderboard(leaderboard.New(lapHistory, driverRegistry)),
+		api.WithDrivers(driverRegistry),
+		api.WithBooking(bookings),
+		api.WithTelemetryArchive(telemetryArchive),
+		api.WithGhosts(ghosts),
+		api.WithTrackMaps(trackMaps),
+		api.WithStandings(standingsEngine),
+		api.WithRaceControl(raceControl),
+		api.WithStrategy(strategyPlanner),
+		api.WithDiscovery(packetProcessor.Discovery()),
+		api.WithHealth(packetProcessor),
+		api.WithSharedPorts(packetProcessor.SharedPorts()...),
+	)
+
+	return &services{
+		processor: packetProcessor,
+		archive:   telemetryArchive,
+		bookings:  bookings,
+		server:    server,
+	}
+}
+
+// Close stops the packet processor, and then closes the telemetry archive it feeds
+func (s *services) Close() {
+	s.processor.Close()
+	if s.archive == nil {
+		return
+	}
+	if err := s.archive.Close(); err != nil {
+		log.Error("could not close telemetry archive", "error", err)
+	}
+}
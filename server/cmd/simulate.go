@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/DaanV2/f1-game-dashboards/server/pkg/data"
+	"github.com/DaanV2/f1-game-dashboards/server/simulator"
+	"github.com/charmbracelet/log"
+	"github.com/spf13/cobra"
+)
+
+// simulateCmd represents the simulate command
+var simulateCmd = &cobra.Command{
+	Use:   "simulate",
+	Short: "Sends simulated F1 2023 packets to the chair ports",
+	Long: `Simulates a race of virtual cars driving laps for each chair, and sends the motion, lap data, telemetry, session, participants and event packets over UDP to the port of the chair.
+By default the ports of the configured chairs are used, use --ports to send to other ports.`,
+	Run: SimulateCmd,
+}
+
+func init() {
+	serverCmd.AddCommand(simulateCmd)
+
+	defaults := simulator.DefaultOptions()
+	simulateCmd.Flags().String("host", "127.0.0.1", "The host to send the packets to")
+	simulateCmd.Flags().IntSlice("ports", nil, "The ports to send the packets to (default: the ports of the configured chairs)")
+	simulateCmd.Flags().Int("cars", defaults.Cars, fmt.Sprintf("The amount of cars per session (max %d)", simulator.MaxCars))
+	simulateCmd.Flags().Uint8("laps", defaults.Laps, "The amount of laps before a new session is started")
+	simulateCmd.Flags().Float32("track-length", defaults.TrackLength, "The length of a lap in metres")
+	simulateCmd.Flags().Int("rate", 20, "The amount of frames send per second")
+	simulateCmd.Flags().String("name", defaults.PlayerName, "The name of the player, followed by the number of the chair")
+	simulateCmd.Flags().Int("source-port", 0, "The local port the first chair sends from, the next chairs send from the ports after it (0 for random ports)")
+}
+
+func SimulateCmd(cmd *cobra.Command, args []string) {
+	flags := cmd.Flags()
+	host, _ := flags.GetString("host")
+	rate, _ := flags.GetInt("rate")
+	ports, _ := flags.GetIntSlice("ports")
+	sourcePort, _ := flags.GetInt("source-port")
+	options := simulator.DefaultOptions()
+	options.Cars, _ = flags.GetInt("cars")
+	options.Laps, _ = flags.GetUint8("laps")
+	options.TrackLength, _ = flags.GetFloat32("track-length")
+	name, _ := flags.GetString("name")
+
+	if len(ports) == 0 {
+		database, err := data.NewStorage(flags)
+		if err != nil {
+			log.Fatal("could not create storage", "error", err)
+		}
+		for _, c := range getChairs(database) {
+			// Chairs without a port only receive on the shared port, use --ports for those
+			if c.Port != 0 {
+				ports = append(ports, c.Port)
+			}
+		}
+	}
+	if len(ports) == 0 {
+		log.Fatal("no ports to send to, configure chairs or use --ports")
+	}
+
+	ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM, syscall.SIGINT, syscall.SIGQUIT)
+	defer cancel()
+
+	log.Info("simulating sessions", "chairs", len(ports), "cars", options.Cars, "rate", rate)
+	var wg sync.WaitGroup
+	for i, port := range ports {
+		address, err := net.ResolveUDPAddr("udp", net.JoinHostPort(host, fmt.Sprint(port)))
+		if err != nil {
+			log.Fatal("could not resolve address", "port", port, "error", err)
+		}
+
+		// An unconnected socket per chair, so missing listeners don't return errors and every chair has its own source
+		source := &net.UDPAddr{}
+		if sourcePort > 0 {
+			source.Port = sourcePort + i
+		}
+		conn, err := net.ListenUDP("udp", source)
+		if err != nil {
+			log.Fatal("could not create udp socket", "port", source.Port, "error", err)
+		}
+		defer conn.Close()
+
+		chairOptions := options
+		chairOptions.Seed += int64(i)
+		chairOptions.PlayerName = fmt.Sprintf("%s %d", name, i+1)
+		sim := simulator.NewSimulator(chairOptions)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			start := time.Now()
+			count, err := simulator.Run(ctx, sim, &udpTarget{conn, address}, rate)
+			if err != nil {
+				log.Error("could not send packets", "address", address, "error", err)
+			}
+			log.Info("simulation stopped", "address", address, "packets", count, "duration", time.Since(start).Round(time.Second))
+		}()
+	}
+
+	wg.Wait()
+}
+
+// udpTarget writes each packet as a datagram to the address
+type udpTarget struct {
+	conn    *net.UDPConn
+	address *net.UDPAddr
+}
+
+func (t *udpTarget) Write(p []byte) (int, error) {
+	return t.conn.WriteToUDP(p, t.address)
+}
@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/DaanV2/f1-game-dashboards/server/pkg/data"
+	"github.com/DaanV2/f1-game-dashboards/server/users"
+	"github.com/charmbracelet/log"
+	"github.com/spf13/cobra"
+)
+
+// usersCmd represents the users command
+var usersCmd = &cobra.Command{
+	Use:   "users",
+	Short: "Manages the user accounts",
+	Long: `Manages the user accounts directly in the storage, without a running server.
+Use this to create the first admin account, after that the UserService can be used as well.`,
+	Example: `  server server users create admin@example.com --admin
+  server server users list
+  server server users passwd admin@example.com
+  server server users delete guest@example.com`,
+}
+
+var usersCreateCmd = &cobra.Command{
+	Use:   "create [email]",
+	Short: "Creates a new user",
+	Args:  cobra.ExactArgs(1),
+	Run:   UsersCreateCmd,
+}
+
+var usersListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Lists all users",
+	Args:  cobra.NoArgs,
+	Run:   UsersListCmd,
+}
+
+var usersPasswdCmd = &cobra.Command{
+	Use:   "passwd [email]",
+	Short: "Sets a new password for a user",
+	Args:  cobra.ExactArgs(1),
+	Run:   UsersPasswdCmd,
+}
+
+var usersDeleteCmd = &cobra.Command{
+	Use:   "delete [email]",
+	Short: "Deletes a user",
+	Args:  cobra.ExactArgs(1),
+	Run:   UsersDeleteCmd,
+}
+
+func init() {
+	serverCmd.AddCommand(usersCmd)
+	usersCmd.AddCommand(usersCreateCmd, usersListCmd, usersPasswdCmd, usersDeleteCmd)
+
+	usersCreateCmd.Flags().String("password", "", "The password of the user (default: read from stdin)")
+	usersCreateCmd.Flags().Bool("admin", false, "Whether the user is an admin")
+	usersPasswdCmd.Flags().String("password", "", "The new password of the user (default: read from stdin)")
+}
+
+func UsersCreateCmd(cmd *cobra.Command, args []string) {
+	userManagement, _ := getUserManagement(cmd)
+	admin, _ := cmd.Flags().GetBool("admin")
+
+	user, err := userManagement.Create(args[0], readPassword(cmd), admin)
+	if err != nil {
+		log.Fatal("could not create user", "email", args[0], "error", err)
+	}
+
+	log.Info("created user", "id", user.Id, "email", user.Email, "admin", user.Admin)
+}
+
+func UsersListCmd(cmd *cobra.Command, args []string) {
+	userManagement, _ := getUserManagement(cmd)
+
+	list, err := userManagement.List()
+	if err != nil {
+		log.Fatal("could not list users", "error", err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tEMAIL\tADMIN")
+	for _, user := range list {
+		fmt.Fprintf(w, "%s\t%s\t%t\n", user.Id, user.Email, user.Admin)
+	}
+	w.Flush()
+}
+
+func UsersPasswdCmd(cmd *cobra.Command, args []string) {
+	userManagement, _ := getUserManagement(cmd)
+
+	if err := userManagement.UpdatePassword(cmd.Context(), args[0], readPassword(cmd)); err != nil {
+		log.Fatal("could not update password", "email", args[0], "error", err)
+	}
+
+	log.Info("updated password", "email", args[0])
+}
+
+func UsersDeleteCmd(cmd *cobra.Command, args []string) {
+	userManagement, _ := getUserManagement(cmd)
+
+	user, err := userManagement.GetByEmail(args[0])
+	if err != nil {
+		log.Fatal("could not find user", "email", args[0], "error", err)
+	}
+	if err := userManagement.Delete(cmd.Context(), user.Id); err != nil {
+		log.Fatal("could not delete user", "email", args[0], "error", err)
+	}
+
+	log.Info("deleted user", "id", user.Id, "email", user.Email)
+}
+
+func getUserManagement(cmd *cobra.Command) (*users.UserManagement, data.Database) {
+	database, err := data.NewStorage(cmd.Flags())
+	if err != nil {
+		log.Fatal("could not create storage", "error", err)
+	}
+
+	return users.NewUserManagement(database.Users()), database
+}
+
+// readPassword returns the password flag, or reads the password from stdin if it is not set
+func readPassword(cmd *cobra.Command) string {
+	if password, _ := cmd.Flags().GetString("password"); password != "" {
+		return password
+	}
+
+	fmt.Fprint(os.Stderr, "Password: ")
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && line == "" {
+		log.Fatal("could not read password", "error", err)
+	}
+
+	return strings.TrimRight(line, "\r\n")
+}
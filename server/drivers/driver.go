@@ -0,0 +1,99 @@
+package drivers
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+type (
+	// Driver is the profile of a person that drives on the chairs
+	Driver struct {
+		Id          string    `json:"id"`
+		DisplayName string    `json:"display_name"`
+		Nickname    string    `json:"nickname,omitempty"`
+		UserId      string    `json:"user_id,omitempty"` // The linked user account, empty if none
+		Created     time.Time `json:"created"`
+	}
+
+	// CheckIn binds a driver to a chair for a time window, the laps driven on the chair in it are attributed to the driver
+	CheckIn struct {
+		Id       string    `json:"id"`
+		DriverId string    `json:"driver_id"`
+		ChairId  string    `json:"chair_id"`
+		Start    time.Time `json:"start"`
+		End      time.Time `json:"end"` // The planned end or the moment of checking out, zero if open ended
+	}
+
+	// CheckInQuery filters the check-ins, zero values match everything
+	CheckInQuery struct {
+		ChairId  string
+		DriverId string
+		From     time.Time // Check-ins that overlap with the range
+		To       time.Time
+		Limit    int // Maximum amount of results, newest first
+	}
+
+	// DriverStorage stores the drivers by id
+	DriverStorage interface {
+		Get(id string) (Driver, error)
+		Set(id string, value Driver) error
+		Delete(id string) error
+		Keys() []string
+	}
+
+	// CheckInStorage stores the check-ins by id
+	CheckInStorage interface {
+		Get(id string) (CheckIn, error)
+		Set(id string, value CheckIn) error
+		Delete(id string) error
+		Keys() []string
+	}
+)
+
+var (
+	ErrDriverNotFound      = errors.New("driver not found")
+	ErrDisplayNameRequired = errors.New("display name is required")
+	ErrUserLinked          = errors.New("user is already linked to another driver")
+	ErrChairOccupied       = errors.New("another driver is checked in to the chair")
+	ErrDriverCheckedIn     = errors.New("driver is checked in to another chair")
+	ErrNotCheckedIn        = errors.New("no driver is checked in to the chair")
+	ErrInvalidWindow       = errors.New("the check-in must end in the future")
+)
+
+// Name returns the nickname of the driver, or the display name if there is none
+func (d Driver) Name() string {
+	if d.Nickname != "" {
+		return d.Nickname
+	}
+
+	return d.DisplayName
+}
+
+// Active returns true if the check-in covers the given moment
+func (c CheckIn) Active(t time.Time) bool {
+	return !t.Before(c.Start) && (c.End.IsZero() || t.Before(c.End))
+}
+
+// match returns true if the check-in is selected by the query
+func (q CheckInQuery) match(c CheckIn) bool {
+	if q.ChairId != "" && c.ChairId != q.ChairId {
+		return false
+	}
+	if q.DriverId != "" && c.DriverId != q.DriverId {
+		return false
+	}
+	if !q.To.IsZero() && c.Start.After(q.To) {
+		return false
+	}
+	if !q.From.IsZero() && !c.End.IsZero() && c.End.Before(q.From) {
+		return false
+	}
+
+	return true
+}
+
+// normalizeName trims the spaces around a name
+func normalizeName(name string) string {
+	return strings.TrimSpace(name)
+}
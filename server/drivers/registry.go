@@ -0,0 +1,327 @@
+package drivers
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/DaanV2/f1-game-dashboards/server/sessions"
+	"github.com/charmbracelet/log"
+	"github.com/google/uuid"
+)
+
+// Registry manages the driver profiles and who is checked in to which chair. It is safe for concurrent use
+type Registry struct {
+	lock           sync.RWMutex
+	driverStorage  DriverStorage
+	checkInStorage CheckInStorage
+	drivers        map[string]Driver
+	checkIns       []CheckIn // Ordered by start
+}
+
+// NewRegistry creates the registry and loads the stored drivers and check-ins
+func NewRegistry(driverStorage DriverStorage, checkInStorage CheckInStorage) *Registry {
+	r := &Registry{
+		driverStorage:  driverStorage,
+		checkInStorage: checkInStorage,
+		drivers:        make(map[string]Driver),
+		checkIns:       make([]CheckIn, 0),
+	}
+
+	for _, id := range driverStorage.Keys() {
+		driver, err := driverStorage.Get(id)
+		if err != nil {
+			log.Error("could not load driver", "id", id, "error", err)
+			continue
+		}
+		r.drivers[driver.Id] = driver
+	}
+	for _, id := range checkInStorage.Keys() {
+		checkIn, err := checkInStorage.Get(id)
+		if err != nil {
+			log.Error("could not load check-in", "id", id, "error", err)
+			continue
+		}
+		r.checkIns = append(r.checkIns, checkIn)
+	}
+	slices.SortFunc(r.checkIns, func(a, b CheckIn) int { return a.Start.Compare(b.Start) })
+
+	return r
+}
+
+// AddChairHooks checks the driver out when a chair is removed
+func (r *Registry) AddChairHooks(chairs *sessions.ChairManager) {
+	chairs.OnChairRemoved.Add(func(chair sessions.Chair) {
+		if _, err := r.CheckOut(chair.Id()); err != nil && err != ErrNotCheckedIn {
+			log.Error("could not check out driver of removed chair", "chair", chair.Id(), "error", err)
+		}
+	})
+}
+
+// Create creates a new driver profile, the user id is optional
+func (r *Registry) Create(displayName, nickname, userId string) (Driver, error) {
+	driver := Driver{
+		Id:          uuid.NewString(),
+		DisplayName: normalizeName(displayName),
+		Nickname:    normalizeName(nickname),
+		UserId:      userId,
+		Created:     time.Now(),
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if err := r.validate(driver); err != nil {
+		return driver, err
+	}
+
+	return driver, r.storeDriver(driver)
+}
+
+// Update changes the names and linked user of a driver
+func (r *Registry) Update(id, displayName, nickname, userId string) (Driver, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	driver, ok := r.drivers[id]
+	if !ok {
+		return driver, ErrDriverNotFound
+	}
+	driver.DisplayName = normalizeName(displayName)
+	driver.Nickname = normalizeName(nickname)
+	driver.UserId = userId
+
+	if err := r.validate(driver); err != nil {
+		return driver, err
+	}
+
+	return driver, r.storeDriver(driver)
+}
+
+// Delete removes the driver profile and checks it out. The past check-ins are kept, so the laps stay attributed to the id
+func (r *Registry) Delete(id string) (Driver, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	driver, ok := r.drivers[id]
+	if !ok {
+		return driver, ErrDriverNotFound
+	}
+
+	now := time.Now()
+	for i, checkIn := range r.checkIns {
+		if checkIn.DriverId == id && checkIn.Active(now) {
+			if err := r.endCheckIn(i, now); err != nil {
+				return driver, err
+			}
+		}
+	}
+
+	if err := r.driverStorage.Delete(id); err != nil {
+		return driver, err
+	}
+	delete(r.drivers, id)
+
+	return driver, nil
+}
+
+// Get returns the driver with the given id
+func (r *Registry) Get(id string) (Driver, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	driver, ok := r.drivers[id]
+	if !ok {
+		return driver, ErrDriverNotFound
+	}
+
+	return driver, nil
+}
+
+// ByUser returns the driver linked to the user account
+func (r *Registry) ByUser(userId string) (Driver, bool) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	for _, driver := range r.drivers {
+		if userId != "" && driver.UserId == userId {
+			return driver, true
+		}
+	}
+
+	return Driver{}, false
+}
+
+// List returns all drivers, ordered by display name
+func (r *Registry) List() []Driver {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	result := make([]Driver, 0, len(r.drivers))
+	for _, driver := range r.drivers {
+		result = append(result, driver)
+	}
+	slices.SortFunc(result, func(a, b Driver) int {
+		return strings.Compare(strings.ToLower(a.DisplayName), strings.ToLower(b.DisplayName))
+	})
+
+	return result
+}
+
+// CheckIn binds the driver to the chair from now until the given moment, a zero moment is open ended.
+// Checking in again to the same chair changes the end of the window
+func (r *Registry) CheckIn(chairId, driverId string, until time.Time) (CheckIn, error) {
+	now := time.Now()
+	if !until.IsZero() && !until.After(now) {
+		return CheckIn{}, ErrInvalidWindow
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if _, ok := r.drivers[driverId]; !ok {
+		return CheckIn{}, ErrDriverNotFound
+	}
+	for i, checkIn := range r.checkIns {
+		if !checkIn.Active(now) {
+			continue
+		}
+		switch {
+		case checkIn.ChairId == chairId && checkIn.DriverId == driverId:
+			checkIn.End = until
+			if err := r.checkInStorage.Set(checkIn.Id, checkIn); err != nil {
+				return checkIn, err
+			}
+			r.checkIns[i] = checkIn
+			return checkIn, nil
+		case checkIn.ChairId == chairId:
+			return CheckIn{}, ErrChairOccupied
+		case checkIn.DriverId == driverId:
+			return CheckIn{}, ErrDriverCheckedIn
+		}
+	}
+
+	checkIn := CheckIn{
+		Id:       uuid.NewString(),
+		DriverId: driverId,
+		ChairId:  chairId,
+		Start:    now,
+		End:      until,
+	}
+	if err := r.checkInStorage.Set(checkIn.Id, checkIn); err != nil {
+		return checkIn, err
+	}
+	r.checkIns = append(r.checkIns, checkIn)
+
+	return checkIn, nil
+}
+
+// CheckOut ends the check-in of the driver on the chair
+func (r *Registry) CheckOut(chairId string) (CheckIn, error) {
+	now := time.Now()
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for i, checkIn := range r.checkIns {
+		if checkIn.ChairId == chairId && checkIn.Active(now) {
+			if err := r.endCheckIn(i, now); err != nil {
+				return checkIn, err
+			}
+			return r.checkIns[i], nil
+		}
+	}
+
+	return CheckIn{}, ErrNotCheckedIn
+}
+
+// Current returns the check-in of the chair that is active now
+func (r *Registry) Current(chairId string) (CheckIn, bool) {
+	return r.checkInAt(chairId, time.Now())
+}
+
+// At returns the driver that was checked in to the chair at the given moment
+func (r *Registry) At(chairId string, t time.Time) (Driver, bool) {
+	checkIn, ok := r.checkInAt(chairId, t)
+	if !ok {
+		return Driver{}, false
+	}
+
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	driver, ok := r.drivers[checkIn.DriverId]
+	return driver, ok
+}
+
+// CheckIns returns the check-ins that match the query, newest first
+func (r *Registry) CheckIns(q CheckInQuery) []CheckIn {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	result := make([]CheckIn, 0)
+	for i := len(r.checkIns) - 1; i >= 0; i-- {
+		if q.match(r.checkIns[i]) {
+			result = append(result, r.checkIns[i])
+		}
+		if q.Limit > 0 && len(result) >= q.Limit {
+			break
+		}
+	}
+
+	return result
+}
+
+func (r *Registry) checkInAt(chairId string, t time.Time) (CheckIn, bool) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	// Newest first, a later check-in wins when the windows overlap
+	for i := len(r.checkIns) - 1; i >= 0; i-- {
+		if checkIn := r.checkIns[i]; checkIn.ChairId == chairId && checkIn.Active(t) {
+			return checkIn, true
+		}
+	}
+
+	return CheckIn{}, false
+}
+
+// validate checks the driver before it is stored, the lock must be held
+func (r *Registry) validate(driver Driver) error {
+	if driver.DisplayName == "" {
+		return ErrDisplayNameRequired
+	}
+	if driver.UserId == "" {
+		return nil
+	}
+	for _, other := range r.drivers {
+		if other.Id != driver.Id && other.UserId == driver.UserId {
+			return ErrUserLinked
+		}
+	}
+
+	return nil
+}
+
+// storeDriver persists the driver, the lock must be held
+func (r *Registry) storeDriver(driver Driver) error {
+	if err := r.driverStorage.Set(driver.Id, driver); err != nil {
+		return err
+	}
+	r.drivers[driver.Id] = driver
+
+	return nil
+}
+
+// endCheckIn ends the check-in at the given moment, the lock must be held
+func (r *Registry) endCheckIn(index int, end time.Time) error {
+	checkIn := r.checkIns[index]
+	checkIn.End = end
+	if err := r.checkInStorage.Set(checkIn.Id, checkIn); err != nil {
+		return err
+	}
+	r.checkIns[index] = checkIn
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package drivers_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DaanV2/f1-game-dashboards/server/drivers"
+	"github.com/DaanV2/f1-game-dashboards/server/pkg/data"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Registry_Profiles(t *testing.T) {
+	database := data.NewMemoryStorage()
+	registry := drivers.NewRegistry(database.Drivers(), database.CheckIns())
+
+	_, err := registry.Create("  ", "", "")
+	require.ErrorIs(t, err, drivers.ErrDisplayNameRequired)
+
+	alice, err := registry.Create(" Alice ", "Ace", "user-1")
+	require.NoError(t, err)
+	require.Equal(t, "Alice", alice.DisplayName)
+
+	_, err = registry.Create("Bob", "", "user-1")
+	require.ErrorIs(t, err, drivers.ErrUserLinked)
+
+	driver, ok := registry.ByUser("user-1")
+	require.True(t, ok)
+	require.Equal(t, alice.Id, driver.Id)
+
+	// A new registry loads the profiles from the storage
+	reloaded := drivers.NewRegistry(database.Drivers(), database.CheckIns())
+	driver, err = reloaded.Get(alice.Id)
+	require.NoError(t, err)
+	require.Equal(t, alice.DisplayName, driver.DisplayName)
+	require.Equal(t, alice.Nickname, driver.Nickname)
+	require.Equal(t, alice.UserId, driver.UserId)
+}
+
+func Test_Registry_CheckIn(t *testing.T) {
+	database := data.NewMemoryStorage()
+	registry := drivers.NewRegistry(database.Drivers(), database.CheckIns())
+	alice, err := registry.Create("Alice", "", "")
+	require.NoError(t, err)
+	bob, err := registry.Create("Bob", "", "")
+	require.NoError(t, err)
+
+	_, err = registry.CheckIn("20777", "unknown", time.Time{})
+	require.ErrorIs(t, err, drivers.ErrDriverNotFound)
+	_, err = registry.CheckIn("20777", alice.Id, time.Now().Add(-time.Minute))
+	require.ErrorIs(t, err, drivers.ErrInvalidWindow)
+
+	checkIn, err := registry.CheckIn("20777", alice.Id, time.Time{})
+	require.NoError(t, err)
+	_, err = registry.CheckIn("20777", bob.Id, time.Time{})
+	require.ErrorIs(t, err, drivers.ErrChairOccupied)
+	_, err = registry.CheckIn("20778", alice.Id, time.Time{})
+	require.ErrorIs(t, err, drivers.ErrDriverCheckedIn)
+
+	// Checking in again changes the window
+	until := time.Now().Add(time.Hour)
+	extended, err := registry.CheckIn("20777", alice.Id, until)
+	require.NoError(t, err)
+	require.Equal(t, checkIn.Id, extended.Id)
+	require.True(t, until.Equal(extended.End))
+
+	driver, ok := registry.At("20777", time.Now())
+	require.True(t, ok)
+	require.Equal(t, alice.Id, driver.Id)
+
+	checkedOut, err := registry.CheckOut("20777")
+	require.NoError(t, err)
+	_, ok = registry.Current("20777")
+	require.False(t, ok)
+	_, err = registry.CheckOut("20777")
+	require.ErrorIs(t, err, drivers.ErrNotCheckedIn)
+
+	// The laps driven during the window stay attributed to the driver
+	driver, ok = registry.At("20777", checkedOut.End.Add(-time.Nanosecond))
+	require.True(t, ok)
+	require.Equal(t, alice.Id, driver.Id)
+
+	_, err = registry.CheckIn("20777", bob.Id, time.Time{})
+	require.NoError(t, err)
+	reloaded := drivers.NewRegistry(database.Drivers(), database.CheckIns())
+	require.Len(t, reloaded.CheckIns(drivers.CheckInQuery{ChairId: "20777"}), 2)
+	current, ok := reloaded.Current("20777")
+	require.True(t, ok)
+	require.Equal(t, bob.Id, current.DriverId)
+}
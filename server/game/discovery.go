@@ -0,0 +1,178 @@
+package game
+
+import (
+	"cmp"
+	"net"
+	"net/netip"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/DaanV2/go-f1-library/encoding"
+	"github.com/DaanV2/go-f1-library/enums"
+	"github.com/DaanV2/go-f1-library/packets/general"
+)
+
+const (
+	// pendingExpiry is how long a pending chair is kept after its last datagram
+	pendingExpiry = 10 * time.Minute
+	// maxPending is the most pending chairs kept, the least recently seen is forgotten first
+	maxPending = 64
+)
+
+type (
+	// PendingChair is a rig that sends datagrams the server does not route to a chair yet
+	PendingChair struct {
+		Source       string // ip:port the datagrams are send from
+		IP           string
+		Port         int
+		PacketFormat uint16 // 2022, 2023 or 2024
+		GameYear     uint8
+		PlayerName   string // Empty until the participants are received
+		SessionUID   uint64
+		Packets      uint64
+		FirstSeen    time.Time
+		LastSeen     time.Time
+	}
+
+	// Discovery keeps the rigs that send datagrams from unknown sources to the shared port or the discovery port,
+	// so they can be adopted as chairs. It is safe for concurrent use
+	Discovery struct {
+		parsers map[enums.PacketFormat]PacketParser
+
+		lock    sync.Mutex
+		pending map[string]*PendingChair // By source
+	}
+)
+
+func newDiscovery(parsers map[enums.PacketFormat]PacketParser) *Discovery {
+	return &Discovery{
+		parsers: parsers,
+		pending: make(map[string]*PendingChair),
+	}
+}
+
+// Pending returns the rigs that sent datagrams recently, the most recently seen first
+func (d *Discovery) Pending() []PendingChair {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.expire(time.Now())
+
+	result := make([]PendingChair, 0, len(d.pending))
+	for _, p := range d.pending {
+		result = append(result, *p)
+	}
+	slices.SortFunc(result, func(a, b PendingChair) int {
+		return cmp.Or(b.LastSeen.Compare(a.LastSeen), cmp.Compare(a.Source, b.Source))
+	})
+
+	return result
+}
+
+// Get returns the pending chair of the source, false if it did not send datagrams recently
+func (d *Discovery) Get(source string) (PendingChair, bool) {
+	key, ok := NormalizeSource(source)
+	if !ok {
+		return PendingChair{}, false
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.expire(time.Now())
+	p, ok := d.pending[key]
+	if !ok {
+		return PendingChair{}, false
+	}
+
+	return *p, true
+}
+
+// Dismiss forgets the pending chair of the source, it is pending again on its next datagram that is not routed
+func (d *Discovery) Dismiss(source string) bool {
+	key, ok := NormalizeSource(source)
+	if !ok {
+		return false
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	_, ok = d.pending[key]
+	delete(d.pending, key)
+
+	return ok
+}
+
+// record keeps the source of the datagram as a pending chair, datagrams of unknown games are ignored
+func (d *Discovery) record(address *net.UDPAddr, packet []byte) {
+	if len(packet) < min_packet_size {
+		return
+	}
+	parser, ok := d.parsers[general.ParsePacketHeader(packet).PacketFormat]
+	if !ok {
+		return
+	}
+	decoder := encoding.NewDecoder(packet)
+	header, err := parser.PacketHeader(decoder)
+	if err != nil {
+		return
+	}
+
+	name := ""
+	if header.PacketId == enums.PID_Participants {
+		if participants, err := parser.PacketParticipantsData(decoder, header); err == nil && int(header.PlayerCarIndex) < len(participants.Participants) {
+			name = strings.TrimSpace(participants.Participants[header.PlayerCarIndex].ParticipantName())
+		}
+	}
+
+	addrPort := address.AddrPort()
+	source := netip.AddrPortFrom(addrPort.Addr().Unmap(), addrPort.Port())
+	now := time.Now()
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	p, ok := d.pending[source.String()]
+	if !ok {
+		if len(d.pending) >= maxPending {
+			d.expire(now)
+		}
+		if len(d.pending) >= maxPending {
+			d.forgetOldest()
+		}
+		p = &PendingChair{
+			Source:    source.String(),
+			IP:        source.Addr().String(),
+			Port:      int(source.Port()),
+			FirstSeen: now,
+		}
+		d.pending[p.Source] = p
+	}
+	p.PacketFormat = uint16(header.PacketFormat)
+	p.GameYear = header.GameYear
+	p.SessionUID = header.SessionUID
+	p.Packets++
+	p.LastSeen = now
+	if name != "" {
+		p.PlayerName = name
+	}
+}
+
+// expire forgets the pending chairs that did not send datagrams for a while. The lock must be held
+func (d *Discovery) expire(now time.Time) {
+	for source, p := range d.pending {
+		if now.Sub(p.LastSeen) > pendingExpiry {
+			delete(d.pending, source)
+		}
+	}
+}
+
+// forgetOldest forgets the least recently seen pending chair. The lock must be held
+func (d *Discovery) forgetOldest() {
+	oldest := ""
+	for source, p := range d.pending {
+		if oldest == "" || p.LastSeen.Before(d.pending[oldest].LastSeen) {
+			oldest = source
+		}
+	}
+	delete(d.pending, oldest)
+}
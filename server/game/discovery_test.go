@@ -0,0 +1,79 @@
+package game_test
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/DaanV2/f1-game-dashboards/server/game"
+	"github.com/DaanV2/f1-game-dashboards/server/sessions"
+	"github.com/DaanV2/f1-game-dashboards/server/simulator"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Discovery_PendingChairs(t *testing.T) {
+	local := func() *net.UDPConn {
+		conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+		require.NoError(t, err)
+		return conn
+	}
+	// Free ports for the shared and discovery listeners
+	free := func() int {
+		conn := local()
+		defer conn.Close()
+		return conn.LocalAddr().(*net.UDPAddr).Port
+	}
+	sharedPort, discoveryPort := free(), free()
+
+	rigA, rigB := local(), local()
+	defer rigA.Close()
+	defer rigB.Close()
+
+	chairs := sessions.NewChairManager()
+	processor := game.NewPacketProcessor(game.WithHost("127.0.0.1"), game.WithSharedPort(sharedPort), game.WithDiscoveryPort(discoveryPort))
+	processor.AddChairHooks(chairs)
+	require.NoError(t, processor.Listen())
+	defer processor.Close()
+	discovery := processor.Discovery()
+
+	send := func(conn *net.UDPConn, port int, sim *simulator.Simulator) {
+		for range 5 {
+			for _, packet := range sim.Step(time.Second / 20) {
+				_, err := conn.WriteToUDP(packet, &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: port})
+				require.NoError(t, err)
+			}
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	// Rig a sends to the shared port, rig b to the discovery port
+	simA := simulator.NewSimulator(simulator.Options{Cars: 2, Laps: 1, TrackLength: 500, Seed: 1, PlayerName: "Alice"})
+	simB := simulator.NewSimulator(simulator.Options{Cars: 2, Laps: 1, TrackLength: 500, Seed: 2, PlayerName: "Bob"})
+	send(rigA, sharedPort, simA)
+	send(rigB, discoveryPort, simB)
+
+	pending := discovery.Pending()
+	require.Len(t, pending, 2)
+	a, ok := discovery.Get(rigA.LocalAddr().String())
+	require.True(t, ok)
+	require.Equal(t, "Alice", a.PlayerName)
+	require.Equal(t, uint16(2023), a.PacketFormat)
+	require.Equal(t, simA.SessionUID(), a.SessionUID)
+	require.Equal(t, "127.0.0.1", a.IP)
+	b, ok := discovery.Get(rigB.LocalAddr().String())
+	require.True(t, ok)
+	require.Equal(t, "Bob", b.PlayerName)
+
+	// Once adopted, the datagrams of rig a are routed to its chair and it is no longer pending
+	chairs.Add(sessions.NewChair("a", 0, true).WithId("rig-a").WithSources([]string{a.Source}))
+	require.True(t, discovery.Dismiss(a.Source))
+	time.Sleep(20 * time.Millisecond)
+	send(rigA, sharedPort, simA)
+	send(rigA, discoveryPort, simA)
+	_, ok = discovery.Get(a.Source)
+	require.False(t, ok)
+
+	require.True(t, discovery.Dismiss(b.Source))
+	require.False(t, discovery.Dismiss(b.Source))
+	require.Empty(t, discovery.Pending())
+}
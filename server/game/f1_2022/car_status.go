@@ -0,0 +1,48 @@
+package f1_2022
+
+import (
+	"github.com/DaanV2/go-f1-library/encoding"
+	f1_2023 "github.com/DaanV2/go-f1-library/packets/2023"
+)
+
+// ParsePacketCarStatusDataWithHeader will parse the given data into a packet, expected the decoder is past the header.
+// F1 22 does not send the engine power outputs, these are left empty
+func ParsePacketCarStatusDataWithHeader(decoder *encoding.Decoder, header f1_2023.PacketHeader) (f1_2023.PacketCarStatusData, error) {
+	if decoder.LeftToRead() < bodySize(PacketCarStatusDataSize) {
+		return f1_2023.PacketCarStatusData{}, encoding.ErrBufferNotLargeEnough
+	}
+
+	packet := f1_2023.PacketCarStatusData{
+		Header: header,
+	}
+
+	for i := range packet.CarStatusData {
+		packet.CarStatusData[i] = f1_2023.CarStatusData{
+			TractionControl:         decoder.Uint8(),
+			AntiLockBrakes:          decoder.Uint8(),
+			FuelMix:                 decoder.Uint8(),
+			FrontBrakeBias:          decoder.Uint8(),
+			PitLimiterStatus:        decoder.Uint8(),
+			FuelInTank:              decoder.Float32(),
+			FuelCapacity:            decoder.Float32(),
+			FuelRemainingLaps:       decoder.Float32(),
+			MaxRPM:                  decoder.Uint16(),
+			IdleRPM:                 decoder.Uint16(),
+			MaxGears:                decoder.Uint8(),
+			DrsAllowed:              decoder.Uint8(),
+			DrsActivationDistance:   decoder.Uint16(),
+			ActualTyreCompound:      decoder.Uint8(),
+			VisualTyreCompound:      decoder.Uint8(),
+			TyresAgeLaps:            decoder.Uint8(),
+			VehicleFiaFlags:         decoder.Int8(),
+			ErsStoreEnergy:          decoder.Float32(),
+			ErsDeployMode:           decoder.Uint8(),
+			ErsHarvestedThisLapMGUK: decoder.Float32(),
+			ErsHarvestedThisLapMGUH: decoder.Float32(),
+			ErsDeployedThisLap:      decoder.Float32(),
+			NetworkPaused:           decoder.Uint8(),
+		}
+	}
+
+	return packet, nil
+}
@@ -0,0 +1,48 @@
+package f1_2022
+
+import (
+	"errors"
+
+	"github.com/DaanV2/go-f1-library/enums"
+)
+
+// PacketFormat is the packet format send by F1 22
+const PacketFormat = enums.PF_F1_2022
+
+const (
+	PacketHeaderSize                  = 24
+	PacketMotionDataSize              = 1464
+	PacketSessionDataSize             = 632
+	PacketLapDataSize                 = 972
+	PacketEventDataSize               = 40
+	PacketParticipantsDataSize        = 1257
+	PacketCarSetupsDataSize           = 1102
+	PacketCarTelemetryDataSize        = 1347
+	PacketCarStatusDataSize           = 1058
+	PacketFinalClassificationDataSize = 1015
+	PacketLobbyInfoDataSize           = 1191
+	PacketCarDamageDataSize           = 948
+	PacketSessionHistoryDataSize      = 1155
+)
+
+// MAX_PACKET_SIZE is the maximum size of a packet
+const MAX_PACKET_SIZE = max(
+	PacketHeaderSize,
+	PacketMotionDataSize,
+	PacketSessionDataSize,
+	PacketLapDataSize,
+	PacketEventDataSize,
+	PacketParticipantsDataSize,
+	PacketCarSetupsDataSize,
+	PacketCarTelemetryDataSize,
+	PacketCarStatusDataSize,
+	PacketFinalClassificationDataSize,
+	PacketLobbyInfoDataSize,
+	PacketCarDamageDataSize,
+	PacketSessionHistoryDataSize,
+)
+
+var (
+	// ErrUnsupportedPacket is returned when a packet is requested that F1 22 does not send
+	ErrUnsupportedPacket = errors.New("packet is not supported by f1 22")
+)
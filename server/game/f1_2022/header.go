@@ -0,0 +1,37 @@
+package f1_2022
+
+import (
+	"github.com/DaanV2/go-f1-library/encoding"
+	"github.com/DaanV2/go-f1-library/enums"
+	f1_2023 "github.com/DaanV2/go-f1-library/packets/2023"
+)
+
+// ParsePacketHeader will deserialise the F1 22 header into the normalized header.
+// F1 22 does not send a game year or overall frame identifier, these are filled in from the other fields
+func ParsePacketHeader(decoder *encoding.Decoder) (f1_2023.PacketHeader, error) {
+	if decoder.LeftToRead() < PacketHeaderSize {
+		return f1_2023.PacketHeader{}, encoding.ErrBufferNotLargeEnough
+	}
+
+	header := f1_2023.PacketHeader{
+		PacketFormat:            enums.PacketFormat(decoder.Uint16()),
+		GameYear:                22,
+		GameMajorVersion:        decoder.Uint8(),
+		GameMinorVersion:        decoder.Uint8(),
+		PacketVersion:           decoder.Uint8(),
+		PacketId:                enums.PacketId(decoder.Uint8()),
+		SessionUID:              decoder.Uint64(),
+		SessionTime:             decoder.Float32(),
+		FrameIdentifier:         decoder.Uint32(),
+		PlayerCarIndex:          decoder.Uint8(),
+		SecondaryPlayerCarIndex: decoder.Uint8(),
+	}
+	header.OverallFrameIdentifier = header.FrameIdentifier
+
+	return header, nil
+}
+
+// bodySize returns the amount of bytes expected after the header for a packet of the given size
+func bodySize(packetSize int) int {
+	return packetSize - PacketHeaderSize
+}
@@ -0,0 +1,52 @@
+package f1_2022
+
+import (
+	"github.com/DaanV2/go-f1-library/encoding"
+	f1_2023 "github.com/DaanV2/go-f1-library/packets/2023"
+)
+
+// ParsePacketLapDataWithHeader will parse the given data into a packet, expected the decoder is past the header.
+// F1 22 does not send the sector minute parts, deltas or corner cutting warnings, these are left empty
+func ParsePacketLapDataWithHeader(decoder *encoding.Decoder, header f1_2023.PacketHeader) (f1_2023.PacketLapData, error) {
+	if decoder.LeftToRead() < bodySize(PacketLapDataSize) {
+		return f1_2023.PacketLapData{}, encoding.ErrBufferNotLargeEnough
+	}
+
+	packet := f1_2023.PacketLapData{
+		Header: header,
+	}
+
+	for i := range packet.LapData {
+		packet.LapData[i] = f1_2023.LapData{
+			LastLapTimeInMS:             decoder.Uint32(),
+			CurrentLapTimeInMS:          decoder.Uint32(),
+			Sector1TimeInMS:             decoder.Uint16(),
+			Sector2TimeInMS:             decoder.Uint16(),
+			LapDistance:                 decoder.Float32(),
+			TotalDistance:               decoder.Float32(),
+			SafetyCarDelta:              decoder.Float32(),
+			CarPosition:                 decoder.Uint8(),
+			CurrentLapNum:               decoder.Uint8(),
+			PitStatus:                   decoder.Uint8(),
+			NumPitStops:                 decoder.Uint8(),
+			Sector:                      decoder.Uint8(),
+			CurrentLapInvalid:           decoder.Uint8(),
+			Penalties:                   decoder.Uint8(),
+			TotalWarnings:               decoder.Uint8(),
+			NumUnservedDriveThroughPens: decoder.Uint8(),
+			NumUnservedStopGoPens:       decoder.Uint8(),
+			GridPosition:                decoder.Uint8(),
+			DriverStatus:                decoder.Uint8(),
+			ResultStatus:                decoder.Uint8(),
+			PitLaneTimerActive:          decoder.Uint8(),
+			PitLaneTimeInLaneInMS:       decoder.Uint16(),
+			PitStopTimerInMS:            decoder.Uint16(),
+			PitStopShouldServePen:       decoder.Uint8(),
+		}
+	}
+
+	packet.TimeTrialPBCarIdx = decoder.Uint8()
+	packet.TimeTrialRivalCarIdx = decoder.Uint8()
+
+	return packet, nil
+}
@@ -0,0 +1,33 @@
+package f1_2022
+
+import (
+	"github.com/DaanV2/go-f1-library/encoding"
+	f1_2023 "github.com/DaanV2/go-f1-library/packets/2023"
+)
+
+// ParsePacketLobbyInfoDataWithHeader will parse the given data into a packet, expected the decoder is past the header.
+// F1 22 does not send the platform of the players, the platform is set to unknown
+func ParsePacketLobbyInfoDataWithHeader(decoder *encoding.Decoder, header f1_2023.PacketHeader) (f1_2023.PacketLobbyInfoData, error) {
+	if decoder.LeftToRead() < bodySize(PacketLobbyInfoDataSize) {
+		return f1_2023.PacketLobbyInfoData{}, encoding.ErrBufferNotLargeEnough
+	}
+
+	packet := f1_2023.PacketLobbyInfoData{
+		Header:     header,
+		NumPlayers: decoder.Uint8(),
+	}
+
+	for i := range packet.LobbyPlayers {
+		packet.LobbyPlayers[i] = f1_2023.LobbyInfoData{
+			AiControlled: decoder.Uint8(),
+			TeamId:       f1_2023.TeamId(decoder.Uint8()),
+			Nationality:  f1_2023.Nationality(decoder.Uint8()),
+			Platform:     f1_2023.Unknown,
+			Name:         decoder.Read48(),
+			CarNumber:    decoder.Uint8(),
+			ReadyStatus:  decoder.Uint8(),
+		}
+	}
+
+	return packet, nil
+}
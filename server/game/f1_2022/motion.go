@@ -0,0 +1,74 @@
+package f1_2022
+
+import (
+	"github.com/DaanV2/go-f1-library/encoding"
+	f1_2023 "github.com/DaanV2/go-f1-library/packets/2023"
+)
+
+const carMotionDataSize = 60
+
+// ParsePacketMotionDataWithHeader will parse the given data into a packet, expected the decoder is past the header
+func ParsePacketMotionDataWithHeader(decoder *encoding.Decoder, header f1_2023.PacketHeader) (f1_2023.PacketMotionData, error) {
+	if decoder.LeftToRead() < bodySize(PacketMotionDataSize) {
+		return f1_2023.PacketMotionData{}, encoding.ErrBufferNotLargeEnough
+	}
+
+	packet := f1_2023.PacketMotionData{
+		Header: header,
+	}
+
+	for i := range packet.CarMotionData {
+		packet.CarMotionData[i] = f1_2023.CarMotionData{
+			WorldPositionX:     decoder.Float32(),
+			WorldPositionY:     decoder.Float32(),
+			WorldPositionZ:     decoder.Float32(),
+			WorldVelocityX:     decoder.Float32(),
+			WorldVelocityY:     decoder.Float32(),
+			WorldVelocityZ:     decoder.Float32(),
+			WorldForwardDirX:   decoder.Int16(),
+			WorldForwardDirY:   decoder.Int16(),
+			WorldForwardDirZ:   decoder.Int16(),
+			WorldRightDirX:     decoder.Int16(),
+			WorldRightDirY:     decoder.Int16(),
+			WorldRightDirZ:     decoder.Int16(),
+			GForceLateral:      decoder.Float32(),
+			GForceLongitudinal: decoder.Float32(),
+			GForceVertical:     decoder.Float32(),
+			Yaw:                decoder.Float32(),
+			Pitch:              decoder.Float32(),
+			Roll:               decoder.Float32(),
+		}
+	}
+
+	return packet, nil
+}
+
+// ParsePacketMotionExDataWithHeader will parse the extended player data of a motion packet, expected the decoder is past the header.
+// F1 22 sends this data at the end of the motion packet instead of in a separate packet, fields introduced later are left empty
+func ParsePacketMotionExDataWithHeader(decoder *encoding.Decoder, header f1_2023.PacketHeader) (f1_2023.PacketMotionExData, error) {
+	if decoder.LeftToRead() < bodySize(PacketMotionDataSize) {
+		return f1_2023.PacketMotionExData{}, encoding.ErrBufferNotLargeEnough
+	}
+
+	// Skip the motion data of all the cars
+	decoder.Skip(carMotionDataSize * 22)
+
+	return f1_2023.PacketMotionExData{
+		Header:                 header,
+		SuspensionPosition:     encoding.Read4Times(decoder.Float32),
+		SuspensionVelocity:     encoding.Read4Times(decoder.Float32),
+		SuspensionAcceleration: encoding.Read4Times(decoder.Float32),
+		WheelSpeed:             encoding.Read4Times(decoder.Float32),
+		WheelSlipRatio:         encoding.Read4Times(decoder.Float32),
+		LocalVelocityX:         decoder.Float32(),
+		LocalVelocityY:         decoder.Float32(),
+		LocalVelocityZ:         decoder.Float32(),
+		AngularVelocityX:       decoder.Float32(),
+		AngularVelocityY:       decoder.Float32(),
+		AngularVelocityZ:       decoder.Float32(),
+		AngularAccelerationX:   decoder.Float32(),
+		AngularAccelerationY:   decoder.Float32(),
+		AngularAccelerationZ:   decoder.Float32(),
+		FrontWheelsAngle:       decoder.Float32(),
+	}, nil
+}
@@ -0,0 +1,118 @@
+package f1_2022_test
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/DaanV2/f1-game-dashboards/server/game/f1_2022"
+	"github.com/DaanV2/go-f1-library/encoding"
+	"github.com/DaanV2/go-f1-library/enums"
+	"github.com/stretchr/testify/require"
+)
+
+func header(packetId enums.PacketId, size int) []byte {
+	data := make([]byte, size)
+
+	binary.LittleEndian.PutUint16(data[0:2], uint16(f1_2022.PacketFormat))
+	data[2] = 1                                                     // Game major version
+	data[3] = 18                                                    // Game minor version
+	data[4] = 1                                                     // Packet version
+	data[5] = uint8(packetId)                                       // Packet id
+	binary.LittleEndian.PutUint64(data[6:14], 1234)                 // Session UID
+	binary.LittleEndian.PutUint32(data[14:18], math.Float32bits(5)) // Session time
+	binary.LittleEndian.PutUint32(data[18:22], 42)                  // Frame identifier
+	data[22] = 3                                                    // Player car index
+	data[23] = 255                                                  // Secondary player car index
+
+	return data
+}
+
+func Test_ParsePacketHeader(t *testing.T) {
+	decoder := encoding.NewDecoder(header(enums.PID_LapData, f1_2022.PacketHeaderSize))
+	h, err := f1_2022.ParsePacketHeader(decoder)
+	require.NoError(t, err)
+
+	require.Equal(t, enums.PF_F1_2022, h.PacketFormat)
+	require.Equal(t, uint8(22), h.GameYear)
+	require.Equal(t, enums.PID_LapData, h.PacketId)
+	require.Equal(t, uint64(1234), h.SessionUID)
+	require.Equal(t, float32(5), h.SessionTime)
+	require.Equal(t, uint32(42), h.FrameIdentifier)
+	require.Equal(t, uint32(42), h.OverallFrameIdentifier)
+	require.Equal(t, uint8(3), h.PlayerCarIndex)
+	require.False(t, h.HasSecondaryPlayer())
+	require.Equal(t, f1_2022.PacketHeaderSize, decoder.Index())
+}
+
+func Test_Parser_ConsumesWholePacket(t *testing.T) {
+	parser := f1_2022.NewPacketParser()
+
+	tests := []struct {
+		name  string
+		id    enums.PacketId
+		size  int
+		parse func(decoder *encoding.Decoder) error
+	}{
+		{"motion", enums.PID_Motion, f1_2022.PacketMotionDataSize, func(d *encoding.Decoder) error {
+			h, _ := parser.PacketHeader(d)
+			_, err := parser.PacketMotionData(d, h)
+			// The extended motion data at the end is read separately
+			d.Skip(30 * 4)
+			return err
+		}},
+		{"motion ex", enums.PID_Motion, f1_2022.PacketMotionDataSize, func(d *encoding.Decoder) error {
+			h, _ := parser.PacketHeader(d)
+			_, err := parser.PacketMotionExData(d, h)
+			return err
+		}},
+		{"session", enums.PID_Session, f1_2022.PacketSessionDataSize, func(d *encoding.Decoder) error {
+			h, _ := parser.PacketHeader(d)
+			_, err := parser.PacketSessionData(d, h)
+			return err
+		}},
+		{"lap data", enums.PID_LapData, f1_2022.PacketLapDataSize, func(d *encoding.Decoder) error {
+			h, _ := parser.PacketHeader(d)
+			_, err := parser.PacketLapData(d, h)
+			return err
+		}},
+		{"participants", enums.PID_Participants, f1_2022.PacketParticipantsDataSize, func(d *encoding.Decoder) error {
+			h, _ := parser.PacketHeader(d)
+			_, err := parser.PacketParticipantsData(d, h)
+			return err
+		}},
+		{"car status", enums.PID_CarStatus, f1_2022.PacketCarStatusDataSize, func(d *encoding.Decoder) error {
+			h, _ := parser.PacketHeader(d)
+			_, err := parser.PacketCarStatusData(d, h)
+			return err
+		}},
+		{"lobby info", enums.PID_LobbyInfo, f1_2022.PacketLobbyInfoDataSize, func(d *encoding.Decoder) error {
+			h, _ := parser.PacketHeader(d)
+			_, err := parser.PacketLobbyInfoData(d, h)
+			return err
+		}},
+		{"session history", enums.PID_SessionHistory, f1_2022.PacketSessionHistoryDataSize, func(d *encoding.Decoder) error {
+			h, _ := parser.PacketHeader(d)
+			_, err := parser.PacketSessionHistoryData(d, h)
+			return err
+		}},
+		{"car setups", enums.PID_CarSetups, f1_2022.PacketCarSetupsDataSize, func(d *encoding.Decoder) error {
+			h, _ := parser.PacketHeader(d)
+			_, err := parser.PacketCarSetupData(d, h)
+			return err
+		}},
+		{"car damage", enums.PID_CarDamage, f1_2022.PacketCarDamageDataSize, func(d *encoding.Decoder) error {
+			h, _ := parser.PacketHeader(d)
+			_, err := parser.PacketCarDamageData(d, h)
+			return err
+		}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			decoder := encoding.NewDecoder(header(test.id, test.size))
+			require.NoError(t, test.parse(decoder))
+			require.Equal(t, test.size, decoder.Index())
+		})
+	}
+}
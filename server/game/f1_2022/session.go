@@ -0,0 +1,93 @@
+package f1_2022
+
+import (
+	"github.com/DaanV2/go-f1-library/encoding"
+	"github.com/DaanV2/go-f1-library/enums"
+	f1_2023 "github.com/DaanV2/go-f1-library/packets/2023"
+)
+
+// ParsePacketSessionDataWithHeader will parse the given data into a packet, expected the decoder is past the header.
+// F1 22 does not send the unit preferences or the safety car / red flag counters, these are left empty
+func ParsePacketSessionDataWithHeader(decoder *encoding.Decoder, header f1_2023.PacketHeader) (f1_2023.PacketSessionData, error) {
+	if decoder.LeftToRead() < bodySize(PacketSessionDataSize) {
+		return f1_2023.PacketSessionData{}, encoding.ErrBufferNotLargeEnough
+	}
+
+	return f1_2023.PacketSessionData{
+		Header:                    header,
+		Weather:                   enums.WeatherType(decoder.Uint8()),
+		TrackTemperature:          decoder.Int8(),
+		AirTemperature:            decoder.Int8(),
+		TotalLaps:                 decoder.Uint8(),
+		TrackLength:               decoder.Uint16(),
+		SessionType:               enums.SessionType(decoder.Uint8()),
+		TrackId:                   decoder.Int8(),
+		Formula:                   enums.Formula(decoder.Uint8()),
+		SessionTimeLeft:           decoder.Uint16(),
+		SessionDuration:           decoder.Uint16(),
+		PitSpeedLimit:             decoder.Uint8(),
+		GamePaused:                decoder.Uint8(),
+		IsSpectating:              decoder.Uint8(),
+		SpectatorCarIndex:         decoder.Uint8(),
+		SliProNativeSupport:       decoder.Uint8(),
+		NumMarshalZones:           decoder.Uint8(),
+		MarshalZones:              parseMarshalZones(decoder),
+		SafetyCarStatus:           decoder.Uint8(),
+		NetworkGame:               decoder.Uint8(),
+		NumWeatherForecastSamples: decoder.Uint8(),
+		WeatherForecastSamples:    parseWeatherForecastSamples(decoder),
+		ForecastAccuracy:          decoder.Uint8(),
+		AiDifficulty:              decoder.Uint8(),
+		SeasonLinkIdentifier:      decoder.Uint32(),
+		WeekendLinkIdentifier:     decoder.Uint32(),
+		SessionLinkIdentifier:     decoder.Uint32(),
+		PitStopWindowIdealLap:     decoder.Uint8(),
+		PitStopWindowLatestLap:    decoder.Uint8(),
+		PitStopRejoinPosition:     decoder.Uint8(),
+		SteeringAssist:            decoder.Uint8(),
+		BrakingAssist:             decoder.Uint8(),
+		GearboxAssist:             decoder.Uint8(),
+		PitAssist:                 decoder.Uint8(),
+		PitReleaseAssist:          decoder.Uint8(),
+		ERSAssist:                 decoder.Uint8(),
+		DRSAssist:                 decoder.Uint8(),
+		DynamicRacingLine:         decoder.Uint8(),
+		DynamicRacingLineType:     decoder.Uint8(),
+		GameMode:                  decoder.Uint8(),
+		RuleSet:                   decoder.Uint8(),
+		TimeOfDay:                 decoder.Uint32(),
+		SessionLength:             decoder.Uint8(),
+	}, nil
+}
+
+func parseMarshalZones(decoder *encoding.Decoder) [21]f1_2023.MarshalZone {
+	items := [21]f1_2023.MarshalZone{}
+
+	for i := range items {
+		items[i] = f1_2023.MarshalZone{
+			ZoneStart: decoder.Float32(),
+			ZoneFlag:  enums.ZoneFlag(decoder.Int8()),
+		}
+	}
+
+	return items
+}
+
+func parseWeatherForecastSamples(decoder *encoding.Decoder) [56]f1_2023.WeatherForecastSample {
+	items := [56]f1_2023.WeatherForecastSample{}
+
+	for i := range items {
+		items[i] = f1_2023.WeatherForecastSample{
+			SessionType:            enums.SessionType(decoder.Uint8()),
+			TimeOffset:             decoder.Uint8(),
+			WeatherType:            enums.WeatherType(decoder.Uint8()),
+			TrackTemperature:       decoder.Int8(),
+			TrackTemperatureChange: enums.TemperatureChange(decoder.Int8()),
+			AirTemperature:         decoder.Int8(),
+			AirTemperatureChange:   enums.TemperatureChange(decoder.Int8()),
+			RainPercentage:         decoder.Uint8(),
+		}
+	}
+
+	return items
+}
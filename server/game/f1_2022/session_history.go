@@ -0,0 +1,45 @@
+package f1_2022
+
+import (
+	"github.com/DaanV2/go-f1-library/encoding"
+	f1_2023 "github.com/DaanV2/go-f1-library/packets/2023"
+)
+
+// ParsePacketSessionHistoryDataWithHeader will parse the given data into a packet, expected the decoder is past the header.
+// F1 22 does not send the sector minute parts, sectors of a minute or longer will be truncated by the game
+func ParsePacketSessionHistoryDataWithHeader(decoder *encoding.Decoder, header f1_2023.PacketHeader) (f1_2023.PacketSessionHistoryData, error) {
+	if decoder.LeftToRead() < bodySize(PacketSessionHistoryDataSize) {
+		return f1_2023.PacketSessionHistoryData{}, encoding.ErrBufferNotLargeEnough
+	}
+
+	packet := f1_2023.PacketSessionHistoryData{
+		Header:            header,
+		CarIdx:            decoder.Uint8(),
+		NumLaps:           decoder.Uint8(),
+		NumTyreStints:     decoder.Uint8(),
+		BestLapTimeLapNum: decoder.Uint8(),
+		BestSector1LapNum: decoder.Uint8(),
+		BestSector2LapNum: decoder.Uint8(),
+		BestSector3LapNum: decoder.Uint8(),
+	}
+
+	for i := range packet.LapHistoryData {
+		packet.LapHistoryData[i] = f1_2023.LapHistoryData{
+			LapTimeInMS:      decoder.Uint32(),
+			Sector1TimeInMS:  decoder.Uint16(),
+			Sector2TimeInMS:  decoder.Uint16(),
+			Sector3TimeInMS:  decoder.Uint16(),
+			LapValidBitFlags: decoder.Uint8(),
+		}
+	}
+
+	for i := range packet.TyreStintsHistoryData {
+		packet.TyreStintsHistoryData[i] = f1_2023.TyreStintHistoryData{
+			EndLap:             decoder.Uint8(),
+			TyreActualCompound: decoder.Uint8(),
+			TyreVisualCompound: decoder.Uint8(),
+		}
+	}
+
+	return packet, nil
+}
@@ -0,0 +1,50 @@
+package f1_2024
+
+import (
+	"github.com/DaanV2/go-f1-library/encoding"
+	f1_2023 "github.com/DaanV2/go-f1-library/packets/2023"
+)
+
+// ParsePacketCarSetupDataWithHeader will parse the given data into a packet, expected the decoder is past the header.
+// The engine braking and next front wing value introduced in F1 24 are skipped
+func ParsePacketCarSetupDataWithHeader(decoder *encoding.Decoder, header f1_2023.PacketHeader) (f1_2023.PacketCarSetupsData, error) {
+	if decoder.LeftToRead() < bodySize(PacketCarSetupsDataSize) {
+		return f1_2023.PacketCarSetupsData{}, encoding.ErrBufferNotLargeEnough
+	}
+
+	packet := f1_2023.PacketCarSetupsData{
+		Header: header,
+	}
+
+	for i := range packet.CarSetups {
+		setup := f1_2023.CarSetupData{
+			FrontWing:             decoder.Uint8(),
+			RearWing:              decoder.Uint8(),
+			OnThrottle:            decoder.Uint8(),
+			OffThrottle:           decoder.Uint8(),
+			FrontCamber:           decoder.Float32(),
+			RearCamber:            decoder.Float32(),
+			FrontToe:              decoder.Float32(),
+			RearToe:               decoder.Float32(),
+			FrontSuspension:       decoder.Uint8(),
+			RearSuspension:        decoder.Uint8(),
+			FrontAntiRollBar:      decoder.Uint8(),
+			RearAntiRollBar:       decoder.Uint8(),
+			FrontSuspensionHeight: decoder.Uint8(),
+			RearSuspensionHeight:  decoder.Uint8(),
+			BrakePressure:         decoder.Uint8(),
+			BrakeBias:             decoder.Uint8(),
+		}
+
+		decoder.Skip(1) // Engine braking
+		setup.RearLeftTyrePressure = decoder.Float32()
+		setup.RearRightTyrePressure = decoder.Float32()
+		setup.FrontLeftTyrePressure = decoder.Float32()
+		setup.FrontRightTyrePressure = decoder.Float32()
+		setup.Ballast = decoder.Uint8()
+		setup.FuelLoad = decoder.Float32()
+		packet.CarSetups[i] = setup
+	}
+
+	return packet, nil
+}
@@ -0,0 +1,65 @@
+package f1_2024
+
+import (
+	"github.com/DaanV2/go-f1-library/enums"
+	f1_2023 "github.com/DaanV2/go-f1-library/packets/2023"
+)
+
+// PacketFormat is the packet format send by F1 24
+const PacketFormat enums.PacketFormat = 2024
+
+const (
+	PacketHeaderSize                  = f1_2023.PacketHeaderSize
+	PacketMotionDataSize              = 1349
+	PacketSessionDataSize             = 753
+	PacketLapDataSize                 = 1285
+	PacketEventDataSize               = 45
+	PacketParticipantsDataSize        = 1350
+	PacketCarSetupsDataSize           = 1133
+	PacketCarTelemetryDataSize        = 1352
+	PacketCarStatusDataSize           = 1239
+	PacketFinalClassificationDataSize = 1020
+	PacketLobbyInfoDataSize           = 1306
+	PacketCarDamageDataSize           = 953
+	PacketSessionHistoryDataSize      = 1460
+	PacketTyreSetsDataSize            = 231
+	PacketMotionExDataSize            = 237
+	PacketTimeTrialDataSize           = 101
+)
+
+// MAX_PACKET_SIZE is the maximum size of a packet
+const MAX_PACKET_SIZE = max(
+	PacketHeaderSize,
+	PacketMotionDataSize,
+	PacketSessionDataSize,
+	PacketLapDataSize,
+	PacketEventDataSize,
+	PacketParticipantsDataSize,
+	PacketCarSetupsDataSize,
+	PacketCarTelemetryDataSize,
+	PacketCarStatusDataSize,
+	PacketFinalClassificationDataSize,
+	PacketLobbyInfoDataSize,
+	PacketCarDamageDataSize,
+	PacketSessionHistoryDataSize,
+	PacketTyreSetsDataSize,
+	PacketMotionExDataSize,
+	PacketTimeTrialDataSize,
+)
+
+// bodySize returns the amount of bytes expected after the header for a packet of the given size
+func bodySize(packetSize int) int {
+	return packetSize - PacketHeaderSize
+}
+
+// normalizeSessionType maps the F1 24 session types onto the F1 2023 session types.
+// F1 24 inserted the sprint shootout sessions before the races, these are mapped onto their qualifying counterparts
+func normalizeSessionType(sessionType uint8) enums.SessionType {
+	// 10 - 14: Sprint shootout 1, 2, 3, short and one shot => Q1, Q2, Q3, short and one shot qualifying
+	// 15 - 18: Race, race 2, race 3, time trial
+	if sessionType >= 10 && sessionType <= 18 {
+		return enums.SessionType(sessionType - 5)
+	}
+
+	return enums.SessionType(sessionType)
+}
@@ -0,0 +1,48 @@
+package f1_2024
+
+import (
+	"github.com/DaanV2/go-f1-library/encoding"
+	f1_2023 "github.com/DaanV2/go-f1-library/packets/2023"
+)
+
+const (
+	EC_SafetyCar f1_2023.EventCode = "SCAR" // Safety car event - details in event
+	EC_Collision f1_2023.EventCode = "COLL" // Collision between two vehicles has occurred
+)
+
+type (
+	SafetyCar struct {
+		SafetyCarType uint8 `json:"safety_car_type"` // 0 = No Safety Car, 1 = Full Safety Car, 2 = Virtual Safety Car, 3 = Formation Lap Safety Car
+		EventType     uint8 `json:"event_type"`      // 0 = Deployed, 1 = Returning, 2 = Returned, 3 = Resume Race
+	}
+
+	Collision struct {
+		Vehicle1Idx uint8 `json:"vehicle1_idx"` // Vehicle index of the first vehicle involved in the collision
+		Vehicle2Idx uint8 `json:"vehicle2_idx"` // Vehicle index of the second vehicle involved in the collision
+	}
+)
+
+// ParsePacketEventDataWithHeader will parse the given data into a packet, expected the decoder is past the header.
+// The event details of the F1 24 event codes are set to either SafetyCar or Collision
+func ParsePacketEventDataWithHeader(decoder *encoding.Decoder, header f1_2023.PacketHeader) (f1_2023.PacketEventData, error) {
+	packet, err := f1_2023.ParsePacketEventDataWithHeader(decoder, header)
+	if err != nil {
+		return packet, err
+	}
+
+	// The F1 2023 parser leaves the details of unknown event codes untouched
+	switch packet.EventStringCode {
+	case EC_SafetyCar:
+		packet.EventDetails = SafetyCar{
+			SafetyCarType: decoder.Uint8(),
+			EventType:     decoder.Uint8(),
+		}
+	case EC_Collision:
+		packet.EventDetails = Collision{
+			Vehicle1Idx: decoder.Uint8(),
+			Vehicle2Idx: decoder.Uint8(),
+		}
+	}
+
+	return packet, nil
+}
@@ -0,0 +1,30 @@
+package f1_2024
+
+import (
+	"github.com/DaanV2/go-f1-library/encoding"
+	"github.com/DaanV2/go-f1-library/enums"
+	f1_2023 "github.com/DaanV2/go-f1-library/packets/2023"
+)
+
+// ParsePacketHeader will deserialise the F1 23 and F1 24 header, which share their layout.
+// The library reads the session time as an integer instead of a float, so the header is parsed here
+func ParsePacketHeader(decoder *encoding.Decoder) (f1_2023.PacketHeader, error) {
+	if decoder.LeftToRead() < PacketHeaderSize {
+		return f1_2023.PacketHeader{}, encoding.ErrBufferNotLargeEnough
+	}
+
+	return f1_2023.PacketHeader{
+		PacketFormat:            enums.PacketFormat(decoder.Uint16()),
+		GameYear:                decoder.Uint8(),
+		GameMajorVersion:        decoder.Uint8(),
+		GameMinorVersion:        decoder.Uint8(),
+		PacketVersion:           decoder.Uint8(),
+		PacketId:                enums.PacketId(decoder.Uint8()),
+		SessionUID:              decoder.Uint64(),
+		SessionTime:             decoder.Float32(),
+		FrameIdentifier:         decoder.Uint32(),
+		OverallFrameIdentifier:  decoder.Uint32(),
+		PlayerCarIndex:          decoder.Uint8(),
+		SecondaryPlayerCarIndex: decoder.Uint8(),
+	}, nil
+}
@@ -0,0 +1,70 @@
+package f1_2024
+
+import (
+	"math"
+
+	"github.com/DaanV2/go-f1-library/encoding"
+	f1_2023 "github.com/DaanV2/go-f1-library/packets/2023"
+)
+
+// ParsePacketLapDataWithHeader will parse the given data into a packet, expected the decoder is past the header.
+// F1 24 splits the deltas into a milliseconds and minutes part, these are combined back into milliseconds
+func ParsePacketLapDataWithHeader(decoder *encoding.Decoder, header f1_2023.PacketHeader) (f1_2023.PacketLapData, error) {
+	if decoder.LeftToRead() < bodySize(PacketLapDataSize) {
+		return f1_2023.PacketLapData{}, encoding.ErrBufferNotLargeEnough
+	}
+
+	packet := f1_2023.PacketLapData{
+		Header: header,
+	}
+
+	for i := range packet.LapData {
+		packet.LapData[i] = f1_2023.LapData{
+			LastLapTimeInMS:             decoder.Uint32(),
+			CurrentLapTimeInMS:          decoder.Uint32(),
+			Sector1TimeInMS:             decoder.Uint16(),
+			Sector1TimeMinutes:          decoder.Uint8(),
+			Sector2TimeInMS:             decoder.Uint16(),
+			Sector2TimeMinutes:          decoder.Uint8(),
+			DeltaToCarInFrontInMS:       parseDelta(decoder),
+			DeltaToRaceLeaderInMS:       parseDelta(decoder),
+			LapDistance:                 decoder.Float32(),
+			TotalDistance:               decoder.Float32(),
+			SafetyCarDelta:              decoder.Float32(),
+			CarPosition:                 decoder.Uint8(),
+			CurrentLapNum:               decoder.Uint8(),
+			PitStatus:                   decoder.Uint8(),
+			NumPitStops:                 decoder.Uint8(),
+			Sector:                      decoder.Uint8(),
+			CurrentLapInvalid:           decoder.Uint8(),
+			Penalties:                   decoder.Uint8(),
+			TotalWarnings:               decoder.Uint8(),
+			CornerCuttingWarnings:       decoder.Uint8(),
+			NumUnservedDriveThroughPens: decoder.Uint8(),
+			NumUnservedStopGoPens:       decoder.Uint8(),
+			GridPosition:                decoder.Uint8(),
+			DriverStatus:                decoder.Uint8(),
+			ResultStatus:                decoder.Uint8(),
+			PitLaneTimerActive:          decoder.Uint8(),
+			PitLaneTimeInLaneInMS:       decoder.Uint16(),
+			PitStopTimerInMS:            decoder.Uint16(),
+			PitStopShouldServePen:       decoder.Uint8(),
+		}
+
+		// Speed trap fastest speed and lap
+		decoder.Skip(4 + 1)
+	}
+
+	packet.TimeTrialPBCarIdx = decoder.Uint8()
+	packet.TimeTrialRivalCarIdx = decoder.Uint8()
+
+	return packet, nil
+}
+
+// parseDelta reads a delta split in a milliseconds and minutes part, deltas that do not fit are capped
+func parseDelta(decoder *encoding.Decoder) uint16 {
+	ms := uint32(decoder.Uint16())
+	minutes := uint32(decoder.Uint8())
+
+	return uint16(min(ms+minutes*60_000, math.MaxUint16))
+}
@@ -0,0 +1,36 @@
+package f1_2024
+
+import (
+	"github.com/DaanV2/go-f1-library/encoding"
+	f1_2023 "github.com/DaanV2/go-f1-library/packets/2023"
+)
+
+// ParsePacketLobbyInfoDataWithHeader will parse the given data into a packet, expected the decoder is past the header.
+// The telemetry settings and tech level introduced in F1 24 are skipped
+func ParsePacketLobbyInfoDataWithHeader(decoder *encoding.Decoder, header f1_2023.PacketHeader) (f1_2023.PacketLobbyInfoData, error) {
+	if decoder.LeftToRead() < bodySize(PacketLobbyInfoDataSize) {
+		return f1_2023.PacketLobbyInfoData{}, encoding.ErrBufferNotLargeEnough
+	}
+
+	packet := f1_2023.PacketLobbyInfoData{
+		Header:     header,
+		NumPlayers: decoder.Uint8(),
+	}
+
+	for i := range packet.LobbyPlayers {
+		player := f1_2023.LobbyInfoData{
+			AiControlled: decoder.Uint8(),
+			TeamId:       f1_2023.TeamId(decoder.Uint8()),
+			Nationality:  f1_2023.Nationality(decoder.Uint8()),
+			Platform:     f1_2023.Platform(decoder.Uint8()),
+			Name:         decoder.Read48(),
+			CarNumber:    decoder.Uint8(),
+		}
+
+		decoder.Skip(1 + 1 + 2) // Your telemetry, show online names, tech level
+		player.ReadyStatus = decoder.Uint8()
+		packet.LobbyPlayers[i] = player
+	}
+
+	return packet, nil
+}
@@ -0,0 +1,97 @@
+package f1_2024
+
+import (
+	"github.com/DaanV2/go-f1-library/encoding"
+	f1_2023 "github.com/DaanV2/go-f1-library/packets/2023"
+)
+
+// PacketParser parses F1 24 packets into the normalized (F1 2023) packet structures
+type PacketParser struct {
+}
+
+func NewPacketParser() *PacketParser {
+	return &PacketParser{}
+}
+
+// PacketHeader parses the packet and returns the data as a PacketHeader struct
+func (p *PacketParser) PacketHeader(decoder *encoding.Decoder) (f1_2023.PacketHeader, error) {
+	return ParsePacketHeader(decoder)
+}
+
+// PacketCarDamageData parses the packet and returns the data as a PacketCarDamageData struct
+func (p *PacketParser) PacketCarDamageData(decoder *encoding.Decoder, header f1_2023.PacketHeader) (f1_2023.PacketCarDamageData, error) {
+	// Same layout as F1 2023
+	return f1_2023.ParsePacketCarDamageDataWithHeader(decoder, header)
+}
+
+// PacketCarSetupData parses the packet and returns the data as a PacketCarSetupData struct
+func (p *PacketParser) PacketCarSetupData(decoder *encoding.Decoder, header f1_2023.PacketHeader) (f1_2023.PacketCarSetupsData, error) {
+	return ParsePacketCarSetupDataWithHeader(decoder, header)
+}
+
+// PacketCarStatusData parses the packet and returns the data as a PacketCarStatusData struct
+func (p *PacketParser) PacketCarStatusData(decoder *encoding.Decoder, header f1_2023.PacketHeader) (f1_2023.PacketCarStatusData, error) {
+	// Same layout as F1 2023
+	return f1_2023.ParsePacketCarStatusDataWithHeader(decoder, header)
+}
+
+// PacketCarTelemetryData parses the packet and returns the data as a PacketCarTelemetryData struct
+func (p *PacketParser) PacketCarTelemetryData(decoder *encoding.Decoder, header f1_2023.PacketHeader) (f1_2023.PacketCarTelemetryData, error) {
+	// Same layout as F1 2023
+	return f1_2023.ParsePacketCarTelemetryDataWithHeader(decoder, header)
+}
+
+// PacketEventData parses the packet and returns the data as a PacketEventData struct
+func (p *PacketParser) PacketEventData(decoder *encoding.Decoder, header f1_2023.PacketHeader) (f1_2023.PacketEventData, error) {
+	return ParsePacketEventDataWithHeader(decoder, header)
+}
+
+// PacketFinalClassificationData parses the packet and returns the data as a PacketFinalClassificationData struct
+func (p *PacketParser) PacketFinalClassificationData(decoder *encoding.Decoder, header f1_2023.PacketHeader) (f1_2023.PacketFinalClassificationData, error) {
+	// Same layout as F1 2023
+	return f1_2023.ParsePacketFinalClassificationDataWithHeader(decoder, header)
+}
+
+// PacketLapData parses the packet and returns the data as a PacketLapData struct
+func (p *PacketParser) PacketLapData(decoder *encoding.Decoder, header f1_2023.PacketHeader) (f1_2023.PacketLapData, error) {
+	return ParsePacketLapDataWithHeader(decoder, header)
+}
+
+// PacketLobbyInfoData parses the packet and returns the data as a PacketLobbyInfoData struct
+func (p *PacketParser) PacketLobbyInfoData(decoder *encoding.Decoder, header f1_2023.PacketHeader) (f1_2023.PacketLobbyInfoData, error) {
+	return ParsePacketLobbyInfoDataWithHeader(decoder, header)
+}
+
+// PacketMotionData parses the packet and returns the data as a PacketMotionData struct
+func (p *PacketParser) PacketMotionData(decoder *encoding.Decoder, header f1_2023.PacketHeader) (f1_2023.PacketMotionData, error) {
+	// Same layout as F1 2023
+	return f1_2023.ParsePacketMotionDataWithHeader(decoder, header)
+}
+
+// PacketParticipantsData parses the packet and returns the data as a PacketParticipantsData struct
+func (p *PacketParser) PacketParticipantsData(decoder *encoding.Decoder, header f1_2023.PacketHeader) (f1_2023.PacketParticipantsData, error) {
+	return ParsePacketParticipantsDataWithHeader(decoder, header)
+}
+
+// PacketSessionData parses the packet and returns the data as a PacketSessionData struct
+func (p *PacketParser) PacketSessionData(decoder *encoding.Decoder, header f1_2023.PacketHeader) (f1_2023.PacketSessionData, error) {
+	return ParsePacketSessionDataWithHeader(decoder, header)
+}
+
+// PacketSessionHistoryData parses the packet and returns the data as a PacketSessionHistoryData struct
+func (p *PacketParser) PacketSessionHistoryData(decoder *encoding.Decoder, header f1_2023.PacketHeader) (f1_2023.PacketSessionHistoryData, error) {
+	// Same layout as F1 2023
+	return f1_2023.ParsePacketSessionHistoryDataWithHeader(decoder, header)
+}
+
+// PacketTyreSetsData parses the packet and returns the data as a PacketTyreSetsData struct
+func (p *PacketParser) PacketTyreSetsData(decoder *encoding.Decoder, header f1_2023.PacketHeader) (f1_2023.PacketTyreSetsData, error) {
+	// Same layout as F1 2023
+	return f1_2023.ParsePacketTyreSetsDataWithHeader(decoder, header)
+}
+
+// PacketMotionExData parses the packet and returns the data as a PacketMotionExData struct
+func (p *PacketParser) PacketMotionExData(decoder *encoding.Decoder, header f1_2023.PacketHeader) (f1_2023.PacketMotionExData, error) {
+	// F1 24 only appends fields to the F1 2023 layout, these are skipped
+	return f1_2023.ParsePacketMotionExDataWithHeader(decoder, header)
+}
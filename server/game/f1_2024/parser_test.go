@@ -0,0 +1,88 @@
+package f1_2024_test
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/DaanV2/f1-game-dashboards/server/game/f1_2024"
+	"github.com/DaanV2/go-f1-library/encoding"
+	"github.com/DaanV2/go-f1-library/enums"
+	f1_2023 "github.com/DaanV2/go-f1-library/packets/2023"
+	"github.com/stretchr/testify/require"
+)
+
+func header(packetId enums.PacketId, size int) []byte {
+	data := make([]byte, size)
+
+	binary.LittleEndian.PutUint16(data[0:2], uint16(f1_2024.PacketFormat))
+	data[2] = 24                                                       // Game year
+	data[6] = uint8(packetId)                                          // Packet id
+	binary.LittleEndian.PutUint32(data[15:19], math.Float32bits(12.5)) // Session time
+	data[28] = 255                                                     // Secondary player car index
+
+	return data
+}
+
+func Test_ParsePacketLapData(t *testing.T) {
+	data := header(enums.PID_LapData, f1_2024.PacketLapDataSize)
+	car := f1_2023.PacketHeaderSize + 57                // second car
+	binary.LittleEndian.PutUint32(data[car:], 90_000)   // Last lap time
+	binary.LittleEndian.PutUint16(data[car+14:], 1_500) // Delta to car in front, ms part
+	data[car+16] = 0                                    // Delta to car in front, minutes part
+	binary.LittleEndian.PutUint16(data[car+17:], 2_000) // Delta to leader, ms part
+	data[car+19] = 1                                    // Delta to leader, minutes part
+	data[car+32] = 2                                    // Car position
+	data[f1_2024.PacketLapDataSize-2] = 255             // Time trial pb car
+	data[f1_2024.PacketLapDataSize-1] = 255             // Time trial rival car
+
+	decoder := encoding.NewDecoder(data)
+	parser := f1_2024.NewPacketParser()
+	h, err := parser.PacketHeader(decoder)
+	require.NoError(t, err)
+	require.Equal(t, float32(12.5), h.SessionTime)
+	packet, err := parser.PacketLapData(decoder, h)
+	require.NoError(t, err)
+	require.Equal(t, f1_2024.PacketLapDataSize, decoder.Index())
+
+	lap := packet.LapData[1]
+	require.Equal(t, uint32(90_000), lap.LastLapTimeInMS)
+	require.Equal(t, uint16(1_500), lap.DeltaToCarInFrontInMS)
+	require.Equal(t, uint16(62_000), lap.DeltaToRaceLeaderInMS)
+	require.Equal(t, uint8(2), lap.CarPosition)
+	require.Equal(t, uint8(255), packet.TimeTrialPBCarIdx)
+	require.Equal(t, uint8(255), packet.TimeTrialRivalCarIdx)
+}
+
+func Test_ParsePacketSessionData(t *testing.T) {
+	data := header(enums.PID_Session, f1_2024.PacketSessionDataSize)
+	data[f1_2023.PacketHeaderSize+6] = 15 // Session type: race
+
+	decoder := encoding.NewDecoder(data)
+	parser := f1_2024.NewPacketParser()
+	h, err := parser.PacketHeader(decoder)
+	require.NoError(t, err)
+	packet, err := parser.PacketSessionData(decoder, h)
+	require.NoError(t, err)
+
+	require.Equal(t, enums.SE_R, packet.SessionType)
+	// Everything up to the new F1 24 settings is read
+	require.Equal(t, f1_2024.PacketSessionDataSize-45, decoder.Index())
+}
+
+func Test_ParsePacketEventData(t *testing.T) {
+	data := header(enums.PID_Event, f1_2024.PacketEventDataSize)
+	copy(data[f1_2023.PacketHeaderSize:], f1_2024.EC_Collision)
+	data[f1_2023.PacketHeaderSize+4] = 3
+	data[f1_2023.PacketHeaderSize+5] = 7
+
+	decoder := encoding.NewDecoder(data)
+	parser := f1_2024.NewPacketParser()
+	h, err := parser.PacketHeader(decoder)
+	require.NoError(t, err)
+	packet, err := parser.PacketEventData(decoder, h)
+	require.NoError(t, err)
+
+	require.Equal(t, f1_2024.EC_Collision, packet.EventStringCode)
+	require.Equal(t, f1_2024.Collision{Vehicle1Idx: 3, Vehicle2Idx: 7}, packet.EventDetails)
+}
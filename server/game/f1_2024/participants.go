@@ -0,0 +1,40 @@
+package f1_2024
+
+import (
+	"github.com/DaanV2/go-f1-library/encoding"
+	f1_2023 "github.com/DaanV2/go-f1-library/packets/2023"
+)
+
+// ParsePacketParticipantsDataWithHeader will parse the given data into a packet, expected the decoder is past the header.
+// The tech level introduced in F1 24 is skipped
+func ParsePacketParticipantsDataWithHeader(decoder *encoding.Decoder, header f1_2023.PacketHeader) (f1_2023.PacketParticipantsData, error) {
+	if decoder.LeftToRead() < bodySize(PacketParticipantsDataSize) {
+		return f1_2023.PacketParticipantsData{}, encoding.ErrBufferNotLargeEnough
+	}
+
+	packet := f1_2023.PacketParticipantsData{
+		Header:        header,
+		NumActiveCars: decoder.Uint8(),
+	}
+
+	for i := range packet.Participants {
+		participant := f1_2023.ParticipantData{
+			AiControlled:    decoder.Uint8(),
+			DriverId:        f1_2023.Driver(decoder.Uint8()),
+			NetworkId:       decoder.Uint8(),
+			TeamId:          f1_2023.TeamId(decoder.Uint8()),
+			MyTeam:          decoder.Uint8(),
+			RaceNumber:      decoder.Uint8(),
+			Nationality:     f1_2023.Nationality(decoder.Uint8()),
+			Name:            decoder.Read48(),
+			YourTelemetry:   decoder.Uint8(),
+			ShowOnlineNames: decoder.Uint8(),
+		}
+
+		decoder.Skip(2) // Tech level
+		participant.Platform = f1_2023.Platform(decoder.Uint8())
+		packet.Participants[i] = participant
+	}
+
+	return packet, nil
+}
@@ -0,0 +1,110 @@
+package f1_2024
+
+import (
+	"github.com/DaanV2/go-f1-library/encoding"
+	"github.com/DaanV2/go-f1-library/enums"
+	f1_2023 "github.com/DaanV2/go-f1-library/packets/2023"
+)
+
+const weatherForecastSamples = 64
+
+// ParsePacketSessionDataWithHeader will parse the given data into a packet, expected the decoder is past the header.
+// F1 24 sends up to 64 weather forecast samples, only the first 56 are kept. The new session settings are skipped
+func ParsePacketSessionDataWithHeader(decoder *encoding.Decoder, header f1_2023.PacketHeader) (f1_2023.PacketSessionData, error) {
+	if decoder.LeftToRead() < bodySize(PacketSessionDataSize) {
+		return f1_2023.PacketSessionData{}, encoding.ErrBufferNotLargeEnough
+	}
+
+	packet := f1_2023.PacketSessionData{
+		Header:              header,
+		Weather:             enums.WeatherType(decoder.Uint8()),
+		TrackTemperature:    decoder.Int8(),
+		AirTemperature:      decoder.Int8(),
+		TotalLaps:           decoder.Uint8(),
+		TrackLength:         decoder.Uint16(),
+		SessionType:         normalizeSessionType(decoder.Uint8()),
+		TrackId:             decoder.Int8(),
+		Formula:             enums.Formula(decoder.Uint8()),
+		SessionTimeLeft:     decoder.Uint16(),
+		SessionDuration:     decoder.Uint16(),
+		PitSpeedLimit:       decoder.Uint8(),
+		GamePaused:          decoder.Uint8(),
+		IsSpectating:        decoder.Uint8(),
+		SpectatorCarIndex:   decoder.Uint8(),
+		SliProNativeSupport: decoder.Uint8(),
+		NumMarshalZones:     decoder.Uint8(),
+		MarshalZones:        parseMarshalZones(decoder),
+		SafetyCarStatus:     decoder.Uint8(),
+		NetworkGame:         decoder.Uint8(),
+	}
+
+	packet.NumWeatherForecastSamples = min(decoder.Uint8(), uint8(len(packet.WeatherForecastSamples)))
+	packet.WeatherForecastSamples = parseWeatherForecastSamples(decoder)
+
+	packet.ForecastAccuracy = decoder.Uint8()
+	packet.AiDifficulty = decoder.Uint8()
+	packet.SeasonLinkIdentifier = decoder.Uint32()
+	packet.WeekendLinkIdentifier = decoder.Uint32()
+	packet.SessionLinkIdentifier = decoder.Uint32()
+	packet.PitStopWindowIdealLap = decoder.Uint8()
+	packet.PitStopWindowLatestLap = decoder.Uint8()
+	packet.PitStopRejoinPosition = decoder.Uint8()
+	packet.SteeringAssist = decoder.Uint8()
+	packet.BrakingAssist = decoder.Uint8()
+	packet.GearboxAssist = decoder.Uint8()
+	packet.PitAssist = decoder.Uint8()
+	packet.PitReleaseAssist = decoder.Uint8()
+	packet.ERSAssist = decoder.Uint8()
+	packet.DRSAssist = decoder.Uint8()
+	packet.DynamicRacingLine = decoder.Uint8()
+	packet.DynamicRacingLineType = decoder.Uint8()
+	packet.GameMode = decoder.Uint8()
+	packet.RuleSet = decoder.Uint8()
+	packet.TimeOfDay = decoder.Uint32()
+	packet.SessionLength = decoder.Uint8()
+	packet.SpeedUnitsLeadPlayer = enums.SpeedFormat(decoder.Uint8())
+	packet.TemperatureUnitsLeadPlayer = enums.TemperatureFormat(decoder.Uint8())
+	packet.SpeedUnitsSecondaryPlayer = enums.SpeedFormat(decoder.Uint8())
+	packet.TemperatureUnitsSecondaryPlayer = enums.TemperatureFormat(decoder.Uint8())
+	packet.NumSafetyCarPeriods = decoder.Uint8()
+	packet.NumVirtualSafetyCarPeriods = decoder.Uint8()
+	packet.NumRedFlagPeriods = decoder.Uint8()
+
+	return packet, nil
+}
+
+func parseMarshalZones(decoder *encoding.Decoder) [21]f1_2023.MarshalZone {
+	items := [21]f1_2023.MarshalZone{}
+
+	for i := range items {
+		items[i] = f1_2023.MarshalZone{
+			ZoneStart: decoder.Float32(),
+			ZoneFlag:  enums.ZoneFlag(decoder.Int8()),
+		}
+	}
+
+	return items
+}
+
+func parseWeatherForecastSamples(decoder *encoding.Decoder) [56]f1_2023.WeatherForecastSample {
+	items := [56]f1_2023.WeatherForecastSample{}
+
+	for i := range weatherForecastSamples {
+		sample := f1_2023.WeatherForecastSample{
+			SessionType:            normalizeSessionType(decoder.Uint8()),
+			TimeOffset:             decoder.Uint8(),
+			WeatherType:            enums.WeatherType(decoder.Uint8()),
+			TrackTemperature:       decoder.Int8(),
+			TrackTemperatureChange: enums.TemperatureChange(decoder.Int8()),
+			AirTemperature:         decoder.Int8(),
+			AirTemperatureChange:   enums.TemperatureChange(decoder.Int8()),
+			RainPercentage:         decoder.Uint8(),
+		}
+
+		if i < len(items) {
+			items[i] = sample
+		}
+	}
+
+	return items
+}
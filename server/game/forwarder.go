@@ -0,0 +1,106 @@
+package game
+
+import (
+	"net"
+	"slices"
+	"time"
+
+	"github.com/DaanV2/f1-game-dashboards/server/sessions"
+	"github.com/DaanV2/go-f1-library/enums"
+	"github.com/charmbracelet/log"
+)
+
+type (
+	// forwarder re-sends the raw datagrams of a chair to the destinations of its forwards.
+	// It is only used by the goroutine that reads the chair, so it has no lock
+	forwarder struct {
+		forwards     []sessions.Forward // The forwards the destinations were resolved from
+		destinations []*destination
+	}
+
+	// destination is a resolved forward
+	destination struct {
+		address   *net.UDPAddr
+		packetIds []enums.PacketId
+		limiter   *rateLimiter // nil if the rate is unlimited
+	}
+
+	// rateLimiter is a token bucket that allows a second worth of datagrams in a burst
+	rateLimiter struct {
+		rate   float64
+		tokens float64
+		last   time.Time
+	}
+)
+
+// forward sends the datagram to every destination that accepts it, the destinations are resolved again when the
+// forwards of the chair changed
+func (f *forwarder) forward(conn *net.UDPConn, chair sessions.Chair, packet []byte) {
+	if !slices.EqualFunc(f.forwards, chair.Forwards, sessions.Forward.Equal) {
+		f.resolve(chair)
+	}
+	if len(f.destinations) == 0 || conn == nil {
+		return
+	}
+
+	id, err := PacketId(packet)
+	if err != nil {
+		return
+	}
+	now := time.Now()
+	for _, d := range f.destinations {
+		if len(d.packetIds) > 0 && !slices.Contains(d.packetIds, id) {
+			continue
+		}
+		if d.limiter != nil && !d.limiter.allow(now) {
+			continue
+		}
+		if _, err := conn.WriteToUDP(packet, d.address); err != nil {
+			log.Debug("error forwarding packet", "id", chair.Id(), "address", d.address, "error", err)
+		}
+	}
+}
+
+// resolve looks up the addresses of the forwards of the chair, forwards that can not be resolved are skipped
+func (f *forwarder) resolve(chair sessions.Chair) {
+	f.forwards = slices.Clone(chair.Forwards)
+	f.destinations = make([]*destination, 0, len(chair.Forwards))
+
+	for _, forward := range chair.Forwards {
+		address, err := net.ResolveUDPAddr("udp", forward.Address)
+		if err != nil {
+			log.Error("could not resolve forward address", "id", chair.Id(), "address", forward.Address, "error", err)
+			continue
+		}
+
+		d := &destination{address: address, packetIds: make([]enums.PacketId, len(forward.PacketIds))}
+		for i, id := range forward.PacketIds {
+			d.packetIds[i] = enums.PacketId(id)
+		}
+		if forward.MaxRate > 0 {
+			d.limiter = newRateLimiter(forward.MaxRate)
+		}
+		f.destinations = append(f.destinations, d)
+	}
+
+	log.Info("forwarding chair", "id", chair.Id(), "destinations", len(f.destinations))
+}
+
+// newRateLimiter creates a limiter of the given datagrams per second
+func newRateLimiter(rate int) *rateLimiter {
+	return &rateLimiter{rate: float64(rate), tokens: float64(rate)}
+}
+
+// allow returns true if a datagram can be sent now
+func (l *rateLimiter) allow(now time.Time) bool {
+	if !l.last.IsZero() {
+		l.tokens = min(l.tokens+now.Sub(l.last).Seconds()*l.rate, l.rate)
+	}
+	l.last = now
+	if l.tokens < 1 {
+		return false
+	}
+	l.tokens--
+
+	return true
+}
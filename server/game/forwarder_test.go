@@ -0,0 +1,77 @@
+package game_test
+
+import (
+	"errors"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/DaanV2/f1-game-dashboards/server/game"
+	"github.com/DaanV2/f1-game-dashboards/server/sessions"
+	"github.com/DaanV2/f1-game-dashboards/server/simulator"
+	"github.com/DaanV2/go-f1-library/enums"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Forwarder_FiltersAndLimits(t *testing.T) {
+	tool, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	require.NoError(t, err)
+	defer tool.Close()
+
+	// A free port for the chair
+	free, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	require.NoError(t, err)
+	port := free.LocalAddr().(*net.UDPAddr).Port
+	require.NoError(t, free.Close())
+
+	chairs := sessions.NewChairManager()
+	processor := game.NewPacketProcessor(game.WithHost("127.0.0.1"))
+	processor.AddChairHooks(chairs)
+	// Not active, the datagrams are still forwarded
+	chair := sessions.NewChair("test", port, false)
+	chairs.Add(chair.WithForwards([]sessions.Forward{{Address: tool.LocalAddr().String(), PacketIds: []uint8{uint8(enums.PID_LapData)}}}))
+	time.Sleep(50 * time.Millisecond)
+
+	sender, err := net.DialUDP("udp", nil, &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: port})
+	require.NoError(t, err)
+	defer sender.Close()
+	sim := simulator.NewSimulator(simulator.Options{Cars: 2, Laps: 1, TrackLength: 500, Seed: 1})
+	send := func(steps int) {
+		for range steps {
+			for _, packet := range sim.Step(time.Second / 20) {
+				_, err := sender.Write(packet)
+				require.NoError(t, err)
+			}
+		}
+	}
+	receive := func() []enums.PacketId {
+		result := make([]enums.PacketId, 0)
+		buf := make([]byte, 2048)
+		for {
+			require.NoError(t, tool.SetReadDeadline(time.Now().Add(100*time.Millisecond)))
+			n, _, err := tool.ReadFromUDP(buf)
+			if errors.Is(err, os.ErrDeadlineExceeded) {
+				return result
+			}
+			require.NoError(t, err)
+			id, err := game.PacketId(buf[:n])
+			require.NoError(t, err)
+			result = append(result, id)
+		}
+	}
+
+	send(10)
+	ids := receive()
+	require.Len(t, ids, 10)
+	for _, id := range ids {
+		require.Equal(t, enums.PID_LapData, id)
+	}
+
+	// All packets, at most 5 per second
+	chairs.Update(chair.WithForwards([]sessions.Forward{{Address: tool.LocalAddr().String(), MaxRate: 5}}))
+	send(10)
+	ids = receive()
+	require.GreaterOrEqual(t, len(ids), 4)
+	require.LessOrEqual(t, len(ids), 6)
+}
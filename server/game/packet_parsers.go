@@ -0,0 +1,68 @@
+package game
+
+import (
+	"github.com/DaanV2/f1-game-dashboards/server/game/f1_2022"
+	"github.com/DaanV2/f1-game-dashboards/server/game/f1_2024"
+	"github.com/DaanV2/go-f1-library/encoding"
+	"github.com/DaanV2/go-f1-library/enums"
+	f1_2023 "github.com/DaanV2/go-f1-library/packets/2023"
+)
+
+var (
+	_ PacketParser = &f1_2022.PacketParser{}
+	_ PacketParser = &f1_2023PacketParser{}
+	_ PacketParser = &f1_2024.PacketParser{}
+)
+
+type (
+	// PacketParser parses the packets of a single game year into the normalized packet structures.
+	// The F1 2023 structures are used as the normalized model, so handlers do not need to know which game year a chair is running
+	PacketParser interface {
+		PacketHeader(decoder *encoding.Decoder) (f1_2023.PacketHeader, error)
+		PacketCarDamageData(decoder *encoding.Decoder, header f1_2023.PacketHeader) (f1_2023.PacketCarDamageData, error)
+		PacketCarSetupData(decoder *encoding.Decoder, header f1_2023.PacketHeader) (f1_2023.PacketCarSetupsData, error)
+		PacketCarStatusData(decoder *encoding.Decoder, header f1_2023.PacketHeader) (f1_2023.PacketCarStatusData, error)
+		PacketCarTelemetryData(decoder *encoding.Decoder, header f1_2023.PacketHeader) (f1_2023.PacketCarTelemetryData, error)
+		PacketEventData(decoder *encoding.Decoder, header f1_2023.PacketHeader) (f1_2023.PacketEventData, error)
+		PacketFinalClassificationData(decoder *encoding.Decoder, header f1_2023.PacketHeader) (f1_2023.PacketFinalClassificationData, error)
+		PacketLapData(decoder *encoding.Decoder, header f1_2023.PacketHeader) (f1_2023.PacketLapData, error)
+		PacketLobbyInfoData(decoder *encoding.Decoder, header f1_2023.PacketHeader) (f1_2023.PacketLobbyInfoData, error)
+		PacketMotionData(decoder *encoding.Decoder, header f1_2023.PacketHeader) (f1_2023.PacketMotionData, error)
+		PacketParticipantsData(decoder *encoding.Decoder, header f1_2023.PacketHeader) (f1_2023.PacketParticipantsData, error)
+		PacketSessionData(decoder *encoding.Decoder, header f1_2023.PacketHeader) (f1_2023.PacketSessionData, error)
+		PacketSessionHistoryData(decoder *encoding.Decoder, header f1_2023.PacketHeader) (f1_2023.PacketSessionHistoryData, error)
+		PacketTyreSetsData(decoder *encoding.Decoder, header f1_2023.PacketHeader) (f1_2023.PacketTyreSetsData, error)
+		PacketMotionExData(decoder *encoding.Decoder, header f1_2023.PacketHeader) (f1_2023.PacketMotionExData, error)
+	}
+
+	// f1_2023PacketParser is the parser of the library, with the header parsed by f1_2024.ParsePacketHeader as it reads the session time wrong
+	f1_2023PacketParser struct {
+		*f1_2023.PacketParser
+	}
+
+	// motionExFromMotionParser is implemented by parsers of game years that send the extended motion data as part of the motion packet
+	motionExFromMotionParser interface {
+		MotionExFromMotion() bool
+	}
+)
+
+// defaultPacketParsers returns the parsers for all the supported packet formats
+func defaultPacketParsers() map[enums.PacketFormat]PacketParser {
+	return map[enums.PacketFormat]PacketParser{
+		f1_2022.PacketFormat: f1_2022.NewPacketParser(),
+		enums.PF_F1_2023:     &f1_2023PacketParser{f1_2023.NewPacketParser()},
+		f1_2024.PacketFormat: f1_2024.NewPacketParser(),
+	}
+}
+
+// motionExFromMotion returns true if the parser reads the extended motion data from the motion packet
+func motionExFromMotion(parser PacketParser) bool {
+	p, ok := parser.(motionExFromMotionParser)
+	return ok && p.MotionExFromMotion()
+}
+
+// PacketHeader parses the packet and returns the data as a PacketHeader struct
+func (p *f1_2023PacketParser) PacketHeader(decoder *encoding.Decoder) (f1_2023.PacketHeader, error) {
+	// Same layout as F1 24
+	return f1_2024.ParsePacketHeader(decoder)
+}
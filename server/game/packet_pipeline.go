@@ -11,6 +11,8 @@ type PacketWithChair[T any] struct {
 	Packet T              // The packet
 }
 
+// PacketPipeline fans out the decoded packets of all chairs. Packets of every supported game year are
+// normalized into the F1 2023 structures, the original format can still be found in the packet header
 type PacketPipeline struct {
 	Motion              hooks.Hook[PacketWithChair[f1_2023.PacketMotionData]]
 	Session             hooks.Hook[PacketWithChair[f1_2023.PacketSessionData]]
@@ -4,8 +4,13 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"slices"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/DaanV2/f1-game-dashboards/server/game/f1_2022"
+	"github.com/DaanV2/f1-game-dashboards/server/game/f1_2024"
 	"github.com/DaanV2/f1-game-dashboards/server/pkg/hooks"
 	"github.com/DaanV2/f1-game-dashboards/server/sessions"
 	"github.com/DaanV2/go-f1-library/encoding"
@@ -16,28 +21,37 @@ import (
 )
 
 const (
-	max_packet_size = f1_2023.MAX_PACKET_SIZE
-	min_packet_size = f1_2023.PacketHeaderSize
+	max_packet_size = max(f1_2022.MAX_PACKET_SIZE, f1_2023.MAX_PACKET_SIZE, f1_2024.MAX_PACKET_SIZE)
+	min_packet_size = min(f1_2022.PacketHeaderSize, f1_2023.PacketHeaderSize, f1_2024.PacketHeaderSize)
 )
 
 type (
 	PacketProcessor struct {
 		options packetProcessorOptions
 
-		parser   *f1_2023.PacketParser
+		parsers  map[enums.PacketFormat]PacketParser
 		pipeline *PacketPipeline
 
-		chairs map[string]*chairSession
+		lock      sync.Mutex // Guards the chairs, the chair hooks are called concurrently
+		chairs    map[string]*chairSession
+		router    *router
+		discovery *Discovery
+		shared    *net.UDPConn // The listener of the shared port, nil if it is not used
+		discover  *net.UDPConn // The listener of the discovery port, nil if it is not used
 	}
 
 	chairSession struct {
-		chair sessions.Chair
-		conn  *net.UDPConn
+		lock       sync.RWMutex
+		chair      sessions.Chair
+		supervisor *supervisor     // Owns the listener of the port of the chair, nil if it has no port
+		shared     *chairProcessor // Processes the datagrams routed to the chair on the shared port
+		health     health
 	}
 
 	chairProcessor struct {
 		session   *chairSession
 		processor *PacketProcessor
+		forwarder forwarder
 	}
 )
 
@@ -47,11 +61,14 @@ func NewPacketProcessor(options ...PacketOption) *PacketProcessor {
 	opts._default()
 	opts.apply(options...)
 
+	parsers := defaultPacketParsers()
 	processor := &PacketProcessor{
-		chairs:   make(map[string]*chairSession),
-		options:  opts,
-		parser:   f1_2023.NewPacketParser(),
-		pipeline: NewPacketPipeline(),
+		chairs:    make(map[string]*chairSession),
+		options:   opts,
+		parsers:   parsers,
+		pipeline:  NewPacketPipeline(),
+		router:    newRouter(),
+		discovery: newDiscovery(parsers),
 	}
 
 	return processor
@@ -69,8 +86,146 @@ func (pp *PacketProcessor) AddChairs(chairs *sessions.ChairManager) {
 	}
 }
 
+// Pipeline returns the pipeline the decoded packets are published on
+func (pp *PacketProcessor) Pipeline() *PacketPipeline {
+	return pp.pipeline
+}
+
+// Inject processes the packet as if it was received on the given chair, without listening on its port.
+// Injected packets are not passed to the recorders
+func (pp *PacketProcessor) Inject(chair sessions.Chair, packet []byte) error {
+	cp := &chairProcessor{
+		session:   &chairSession{chair: chair},
+		processor: pp,
+	}
+
+	return cp.handlePacket(packet)
+}
+
+// Discovery returns the rigs that send to the shared or discovery port, but are not routed to a chair
+func (pp *PacketProcessor) Discovery() *Discovery {
+	return pp.discovery
+}
+
+// Health returns the health of the listeners of all the chairs, sorted by chair id
+func (pp *PacketProcessor) Health() []ChairHealth {
+	pp.lock.Lock()
+	defer pp.lock.Unlock()
+
+	now := time.Now()
+	result := make([]ChairHealth, 0, len(pp.chairs))
+	for id, session := range pp.chairs {
+		result = append(result, session.health.snapshot(id, session.Chair().Port, now))
+	}
+	slices.SortFunc(result, func(a, b ChairHealth) int {
+		return strings.Compare(a.ChairId, b.ChairId)
+	})
+
+	return result
+}
+
+// ChairHealth returns the health of the listener of the chair, false if the chair is not found
+func (pp *PacketProcessor) ChairHealth(id string) (ChairHealth, bool) {
+	pp.lock.Lock()
+	defer pp.lock.Unlock()
+
+	session, ok := pp.chairs[id]
+	if !ok {
+		return ChairHealth{}, false
+	}
+
+	return session.health.snapshot(id, session.Chair().Port, time.Now()), true
+}
+
+// SharedPorts returns the configured shared and discovery ports, a chair can not listen on those
+func (pp *PacketProcessor) SharedPorts() []int {
+	ports := make([]int, 0, 2)
+	for _, port := range []int{pp.options.sharedPort, pp.options.discoveryPort} {
+		if port != 0 {
+			ports = append(ports, port)
+		}
+	}
+
+	return ports
+}
+
+// Listen starts the listeners of the shared port, that receives the datagrams of all the rigs and routes them to the
+// chairs, and of the discovery port. Nothing is started for the ports that are not configured
+func (pp *PacketProcessor) Listen() (err error) {
+	if pp.options.sharedPort != 0 {
+		if pp.shared, err = pp.listen(pp.options.sharedPort); err != nil {
+			return err
+		}
+		log.Info("listening on the shared port", "port", pp.options.sharedPort)
+		go pp.read(pp.shared, "shared", pp.handleShared)
+	}
+	if pp.options.discoveryPort != 0 {
+		if pp.discover, err = pp.listen(pp.options.discoveryPort); err != nil {
+			return err
+		}
+		log.Info("listening on the discovery port", "port", pp.options.discoveryPort)
+		go pp.read(pp.discover, "discovery", pp.handleDiscovery)
+	}
+
+	return nil
+}
+
+func (pp *PacketProcessor) listen(port int) (*net.UDPConn, error) {
+	address, err := net.ResolveUDPAddr("udp", fmt.Sprintf("%s:%d", pp.options.host, port))
+	if err != nil {
+		return nil, err
+	}
+
+	return net.ListenUDP("udp", address)
+}
+
+// read passes the datagrams of the listener to the handler until the listener is closed
+func (pp *PacketProcessor) read(conn *net.UDPConn, name string, handle func(conn *net.UDPConn, address *net.UDPAddr, packet []byte)) {
+	var buf [max_packet_size]byte
+	for {
+		n, address, err := conn.ReadFromUDP(buf[0:])
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+			log.Error("error reading from udp", "listener", name, "error", err)
+			continue
+		}
+
+		handle(conn, address, buf[:n])
+	}
+}
+
+// handleShared routes the datagram to its chair, or records its source as a pending chair
+func (pp *PacketProcessor) handleShared(conn *net.UDPConn, address *net.UDPAddr, packet []byte) {
+	cp, ok := pp.router.route(address, packet)
+	if !ok {
+		pp.discovery.record(address, packet)
+		return
+	}
+	cp.receive(conn, address, packet)
+}
+
+// handleDiscovery records the source of the datagram as a pending chair, unless it is already routed to a chair
+func (pp *PacketProcessor) handleDiscovery(conn *net.UDPConn, address *net.UDPAddr, packet []byte) {
+	if _, ok := pp.router.route(address, packet); ok {
+		return
+	}
+	pp.discovery.record(address, packet)
+}
+
 // Close closes the packet processor
 func (pp *PacketProcessor) Close() {
+	for _, conn := range []*net.UDPConn{pp.shared, pp.discover} {
+		if conn == nil {
+			continue
+		}
+		if err := conn.Close(); err != nil {
+			log.Error("error closing listener", "address", conn.LocalAddr(), "error", err)
+		}
+	}
+	pp.lock.Lock()
+	defer pp.lock.Unlock()
 	for _, session := range pp.chairs {
 		if err := session.Stop(); err != nil {
 			log.Error("error stopping session", "error", err, "port", session.chair.Port)
@@ -84,29 +239,39 @@ func (pp *PacketProcessor) handleChairAdded(chair sessions.Chair) {
 	logger := log.With("id", id, "name", chair.Name)
 	logger.Info("starting server on chair...")
 
-	session, ok := pp.chairs[id]
-	// If sessions already exists and the connection is still open, return
-	if session != nil && ok && session.conn != nil {
-		logger.Info("skipping start server on chair, already exists and seems healthy")
+	pp.lock.Lock()
+	defer pp.lock.Unlock()
+	// The supervisor keeps the listener of an existing session running, binding the port again if it failed
+	if _, ok := pp.chairs[id]; ok {
+		logger.Info("skipping start server on chair, already exists")
 		return
 	}
 
-	// If session is nil, create a new session
-	if session == nil {
-		session = &chairSession{
-			chair: chair,
-		}
-		pp.chairs[id] = session
+	session := &chairSession{
+		chair: chair,
 	}
-
-	// If crashed or closed, ensure the connection is setup again
-	if !session.IsActive() {
-		data := &chairProcessor{
-			session:   session,
-			processor: pp,
+	session.shared = &chairProcessor{
+		session:   session,
+		processor: pp,
+	}
+	pp.chairs[id] = session
+	pp.router.set(session.shared)
+
+	// Chairs without a port only receive on the shared port
+	if chair.Port == 0 {
+		if pp.options.sharedPort == 0 {
+			session.health.failed(errors.New("the chair has no port and no shared port is configured"), time.Time{})
+		} else {
+			session.health.listening()
 		}
-		go data.Start()
+		return
 	}
+
+	session.supervisor = newSupervisor(&chairProcessor{
+		session:   session,
+		processor: pp,
+	}, chair.Port, &session.health)
+	go session.supervisor.run()
 }
 
 // handleChairRemoved handles the removed chair events
@@ -114,6 +279,9 @@ func (pp *PacketProcessor) handleChairRemoved(chair sessions.Chair) {
 	logger := log.With("id", chair.Id(), "name", chair.Name)
 	logger.Info("closing server on chair...")
 
+	pp.router.remove(chair.Id())
+	pp.lock.Lock()
+	defer pp.lock.Unlock()
 	session, ok := pp.chairs[chair.Id()]
 	if !ok {
 		return // Chair already closed or not found
@@ -123,11 +291,7 @@ func (pp *PacketProcessor) handleChairRemoved(chair sessions.Chair) {
 		logger.Info("closed server on chair")
 	}()
 
-	err := session.conn.Close()
-	if strings.Contains(err.Error(), "use of closed network connection") {
-		return
-	}
-	if err != nil {
+	if err := session.Stop(); err != nil {
 		logger.Error("error closing connection", "error", err)
 	}
 }
@@ -138,85 +302,77 @@ func (pp *PacketProcessor) handleChairUpdated(chair sessions.Chair) {
 	logger.Info("updating")
 
 	// If the chair is not present, add it and process it through that route
+	pp.lock.Lock()
 	session, ok := pp.chairs[chair.Id()]
-	if ok {
+	pp.lock.Unlock()
+	if !ok {
 		pp.handleChairAdded(chair)
 		return
 	}
 
-	// Move data to the new session
-	session.chair = chair
+	// Move data to the running session, the listener picks it up on the next packet
+	session.SetChair(chair)
+	pp.router.set(session.shared)
 }
 
-// IsActive returns if the processor connection is active
-func (cs *chairSession) IsActive() bool {
-	return cs.conn != nil
+// Chair returns the current settings of the chair
+func (cs *chairSession) Chair() sessions.Chair {
+	cs.lock.RLock()
+	defer cs.lock.RUnlock()
+
+	return cs.chair
 }
 
-// Stop stops the connection of the given chair
-func (cs *chairSession) Stop() error {
-	defer func() {
-		cs.conn = nil
-	}()
-	if cs.conn == nil {
-		return nil
-	}
+// SetChair replaces the settings of the chair, such as whether it is active
+func (cs *chairSession) SetChair(chair sessions.Chair) {
+	cs.lock.Lock()
+	defer cs.lock.Unlock()
 
-	err := cs.conn.Close()
-	// Conn closed
-	if strings.Contains(err.Error(), "use of closed network connection") {
+	cs.chair = chair
+}
+
+// Stop stops the listener of the given chair
+func (cs *chairSession) Stop() error {
+	if cs.supervisor == nil {
 		return nil
 	}
 
-	return err
+	return cs.supervisor.stop()
 }
 
-// Start starts the processing of packets for the given chair
-func (cp *chairProcessor) Start() (err error) {
-	logger := log.With("port", cp.session.chair.Port, "name", cp.session.chair.Name)
-	var (
-		buf     [max_packet_size]byte
-		n       int
-		address *net.UDPAddr
-	)
-
-	defer func() {
-		if r := recover(); r != nil {
-			err = fmt.Errorf("panic: %v", r)
-		}
-	}()
+// receive handles a datagram of the chair that was read from the connection
+func (cp *chairProcessor) receive(conn *net.UDPConn, address *net.UDPAddr, packet []byte) {
+	// Forwarded even when the chair is not active, so the tools of the driver keep working
+	chair := cp.session.Chair()
+	cp.session.health.received(time.Now())
+	cp.forwarder.forward(conn, chair, packet)
 
-	// host:port
-	listenAddress := fmt.Sprintf("%s:%d", cp.processor.options.host, cp.session.chair.Port)
-	udpAddr, err := net.ResolveUDPAddr("udp", listenAddress)
-	if err != nil {
-		return err
+	// If the chair is not active, skip the packet
+	if !chair.Active {
+		return
 	}
-	conn, err := net.ListenUDP("udp", udpAddr)
-	if err != nil {
-		panic(err)
+	cp.record(address, packet)
+	if err := cp.handlePacket(packet); err != nil {
+		log.Error("error handling packet", "error", err, "id", chair.Id(), "ip", address.IP, "port", address.Port)
 	}
-	cp.session.conn = conn
-
-	// TODO add signal handling for when the server is shutting down
-
-	for {
-		n, address, err = cp.session.conn.ReadFromUDP(buf[0:])
-		if err != nil {
-			// Conn closed
-			if strings.Contains(err.Error(), "use of closed network connection") {
-				return
-			}
+}
 
-			logger.Error("error reading from udp", "error", err)
+// record passes the raw packet to all the recorders
+func (cp *chairProcessor) record(address *net.UDPAddr, packet []byte) {
+	recorders := cp.processor.options.recorders
+	if len(recorders) == 0 {
+		return
+	}
 
-			// If the chair is not active, skip the packet
-		} else if cp.session.chair.Active {
-			err := cp.handlePacket(buf[:n])
-			if err != nil {
-				logger.Error("error handling packet", "error", err, "ip", address.IP, "port", address.Port)
-			}
-		}
+	chair := cp.session.Chair()
+	raw := RawPacket{
+		ChairId:  chair.Id(),
+		Address:  address,
+		Received: time.Now(),
+		Data:     packet,
+	}
+	for _, r := range recorders {
+		r.Record(raw)
 	}
 }
 
@@ -228,18 +384,17 @@ func (cp *chairProcessor) handlePacket(packet []byte) error {
 	}
 
 	header := general.ParsePacketHeader(packet)
-	switch header.PacketFormat {
-	case enums.PF_F1_2023:
-		return cp.handle2023Packet(packet)
+	parser, ok := cp.processor.parsers[header.PacketFormat]
+	if !ok {
+		return fmt.Errorf("unknown packet format: %d", header.PacketFormat)
 	}
 
-	return fmt.Errorf("unknown packet format: %d", header.PacketFormat)
+	return cp.handleFormatPacket(parser, packet)
 }
 
-// handle2023Packet handles the f1 2023 game packets
-func (cp *chairProcessor) handle2023Packet(packet []byte) error {
+// handleFormatPacket handles the game packets using the parser of the game year
+func (cp *chairProcessor) handleFormatPacket(parser PacketParser, packet []byte) error {
 	pipeline := cp.processor.pipeline
-	parser := cp.processor.parser
 	decoder := encoding.NewDecoder(packet)
 	header, err := parser.PacketHeader(decoder)
 	if err != nil {
@@ -248,7 +403,11 @@ func (cp *chairProcessor) handle2023Packet(packet []byte) error {
 
 	switch header.PacketId {
 	case enums.PID_Motion:
-		return process(cp, decoder, header, pipeline.Motion, parser.PacketMotionData)
+		if motionExFromMotion(parser) {
+			exDecoder := *decoder
+			err = process(cp, &exDecoder, header, pipeline.MotionEx, parser.PacketMotionExData)
+		}
+		return errors.Join(err, process(cp, decoder, header, pipeline.Motion, parser.PacketMotionData))
 	case enums.PID_Session:
 		return process(cp, decoder, header, pipeline.Session, parser.PacketSessionData)
 	case enums.PID_LapData:
@@ -291,7 +450,7 @@ func process[T any](cp *chairProcessor, decoder *encoding.Decoder, header f1_202
 	}
 
 	data := PacketWithChair[T]{
-		Chair:  cp.session.chair,
+		Chair:  cp.session.Chair(),
 		Packet: packet,
 	}
 
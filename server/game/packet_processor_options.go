@@ -2,7 +2,10 @@ package game
 
 type (
 	packetProcessorOptions struct {
-		host string
+		host          string
+		sharedPort    int
+		discoveryPort int
+		recorders     []PacketRecorder
 	}
 
 	PacketOption = func(p *packetProcessorOptions)
@@ -22,4 +25,25 @@ func WithHost(host string) PacketOption {
 	return func(p *packetProcessorOptions) {
 		p.host = host
 	}
-}
\ No newline at end of file
+}
+
+// WithRecorder adds a recorder that receives every raw datagram of the active chairs
+func WithRecorder(recorder PacketRecorder) PacketOption {
+	return func(p *packetProcessorOptions) {
+		p.recorders = append(p.recorders, recorder)
+	}
+}
+
+// WithSharedPort receives the datagrams of all the rigs on one port, they are routed to the chairs by their source
+func WithSharedPort(port int) PacketOption {
+	return func(p *packetProcessorOptions) {
+		p.sharedPort = port
+	}
+}
+
+// WithDiscoveryPort listens on a port that only records the rigs sending to it as pending chairs
+func WithDiscoveryPort(port int) PacketOption {
+	return func(p *packetProcessorOptions) {
+		p.discoveryPort = port
+	}
+}
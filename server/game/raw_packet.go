@@ -0,0 +1,86 @@
+package game
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/DaanV2/f1-game-dashboards/server/game/f1_2022"
+	"github.com/DaanV2/f1-game-dashboards/server/game/f1_2024"
+	"github.com/DaanV2/go-f1-library/encoding"
+	"github.com/DaanV2/go-f1-library/enums"
+	"github.com/DaanV2/go-f1-library/packets/general"
+)
+
+type (
+	// RawPacket is a datagram as it was received from a chair
+	RawPacket struct {
+		ChairId  string       // The id of the chair the packet came from
+		Address  *net.UDPAddr // The address the packet was send from
+		Received time.Time    // The moment the packet was received
+		Data     []byte       // The datagram, owned by the caller so it needs to be copied if kept
+	}
+
+	// PacketRecorder receives every raw datagram of active chairs before it is processed.
+	// Record is called on the receiving goroutine, so it should not block
+	PacketRecorder interface {
+		Record(packet RawPacket)
+	}
+)
+
+// SessionUID reads the session uid from the header of a raw packet
+func SessionUID(data []byte) (uint64, error) {
+	if len(data) < min_packet_size {
+		return 0, encoding.ErrBufferNotLargeEnough
+	}
+
+	header := general.ParsePacketHeader(data)
+	switch header.PacketFormat {
+	case f1_2022.PacketFormat:
+		// F1 22 has no game year in the header
+		return encoding.Uint64(data[6:14]), nil
+	case enums.PF_F1_2023, f1_2024.PacketFormat:
+		return encoding.Uint64(data[7:15]), nil
+	}
+
+	return 0, fmt.Errorf("unknown packet format: %d", header.PacketFormat)
+}
+
+// PacketId reads the packet id from the header of a raw packet
+func PacketId(data []byte) (enums.PacketId, error) {
+	if len(data) < min_packet_size {
+		return 0, encoding.ErrBufferNotLargeEnough
+	}
+
+	header := general.ParsePacketHeader(data)
+	switch header.PacketFormat {
+	case f1_2022.PacketFormat:
+		// F1 22 has no game year in the header
+		return enums.PacketId(data[5]), nil
+	case enums.PF_F1_2023, f1_2024.PacketFormat:
+		return header.PacketId, nil
+	}
+
+	return 0, fmt.Errorf("unknown packet format: %d", header.PacketFormat)
+}
+
+// PlayerCarIndex reads the index of the car of the player from the header of a raw packet
+func PlayerCarIndex(data []byte) (uint8, error) {
+	if len(data) < min_packet_size {
+		return 0, encoding.ErrBufferNotLargeEnough
+	}
+
+	header := general.ParsePacketHeader(data)
+	switch header.PacketFormat {
+	case f1_2022.PacketFormat:
+		// F1 22 has no game year and no overall frame identifier in the header
+		return data[22], nil
+	case enums.PF_F1_2023, f1_2024.PacketFormat:
+		if len(data) < f1_2024.PacketHeaderSize {
+			return 0, encoding.ErrBufferNotLargeEnough
+		}
+		return data[27], nil
+	}
+
+	return 0, fmt.Errorf("unknown packet format: %d", header.PacketFormat)
+}
@@ -0,0 +1,171 @@
+package game
+
+import (
+	"net"
+	"net/netip"
+	"sync"
+
+	"github.com/charmbracelet/log"
+)
+
+type (
+	// router finds the chair of the datagrams received on the shared port. A datagram is routed by its source address
+	// as configured on the chairs, ip:port before ip. The session and player car of a routed datagram are remembered, so
+	// a rig keeps its chair when its source port changes during a session. A datagram that matches no source and no
+	// remembered player is not routed, the header alone can not identify a chair. It is safe for concurrent use
+	router struct {
+		lock    sync.RWMutex
+		chairs  map[string]*chairProcessor // By chair id
+		sources map[string]*chairProcessor // By normalized source address
+		players map[player]*chairProcessor // Learned from the routed datagrams
+		learned map[string]player          // The player last learned per chair id
+	}
+
+	// player is a car in a session, every rig in a multiplayer session has the same session uid but its own car
+	player struct {
+		session uint64
+		car     uint8
+	}
+)
+
+func newRouter() *router {
+	return &router{
+		chairs:  make(map[string]*chairProcessor),
+		sources: make(map[string]*chairProcessor),
+		players: make(map[player]*chairProcessor),
+		learned: make(map[string]player),
+	}
+}
+
+// set adds the chair of the processor, or updates the sources of it
+func (r *router) set(cp *chairProcessor) {
+	chair := cp.session.Chair()
+	id := chair.Id()
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if current, ok := r.chairs[id]; ok && current != cp {
+		r.removeLocked(id)
+	}
+	for key, c := range r.sources {
+		if c == cp {
+			delete(r.sources, key)
+		}
+	}
+	r.chairs[id] = cp
+	for _, source := range chair.Sources {
+		key, ok := NormalizeSource(source)
+		if !ok {
+			log.Warn("skipping invalid source of chair", "id", id, "source", source)
+			continue
+		}
+		if other, ok := r.sources[key]; ok && other != cp {
+			log.Warn("source is already routed to another chair", "id", id, "source", source, "other", other.id())
+			continue
+		}
+		r.sources[key] = cp
+	}
+}
+
+// remove stops routing to the chair
+func (r *router) remove(id string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.removeLocked(id)
+}
+
+func (r *router) removeLocked(id string) {
+	cp, ok := r.chairs[id]
+	if !ok {
+		return
+	}
+
+	delete(r.chairs, id)
+	for key, c := range r.sources {
+		if c == cp {
+			delete(r.sources, key)
+		}
+	}
+	if p, ok := r.learned[id]; ok {
+		delete(r.players, p)
+		delete(r.learned, id)
+	}
+}
+
+// route returns the chair processor of the datagram, false if it could not be routed
+func (r *router) route(address *net.UDPAddr, packet []byte) (*chairProcessor, bool) {
+	p, known := r.player(packet)
+	addrPort := address.AddrPort()
+	ip := addrPort.Addr().Unmap()
+
+	r.lock.RLock()
+	cp, ok := r.sources[netip.AddrPortFrom(ip, addrPort.Port()).String()]
+	if !ok {
+		cp, ok = r.sources[ip.String()]
+	}
+	if !ok {
+		if known {
+			cp, ok = r.players[p]
+		}
+		r.lock.RUnlock()
+		return cp, ok
+	}
+	learn := known && r.players[p] != cp
+	r.lock.RUnlock()
+
+	if learn {
+		r.learn(cp, p)
+	}
+	return cp, true
+}
+
+// learn remembers the player of the chair, the previous player of the chair is forgotten
+func (r *router) learn(cp *chairProcessor, p player) {
+	id := cp.id()
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.chairs[id] != cp {
+		return // Removed in the mean time
+	}
+	if previous, ok := r.learned[id]; ok {
+		delete(r.players, previous)
+	}
+	if other, ok := r.players[p]; ok {
+		delete(r.learned, other.id())
+	}
+	r.players[p] = cp
+	r.learned[id] = p
+}
+
+// player reads the session and player car from the header of the datagram
+func (r *router) player(packet []byte) (player, bool) {
+	session, err := SessionUID(packet)
+	if err != nil || session == 0 {
+		return player{}, false
+	}
+	car, err := PlayerCarIndex(packet)
+	if err != nil {
+		return player{}, false
+	}
+
+	return player{session: session, car: car}, true
+}
+
+// NormalizeSource returns the source address the way the router compares it, false if it is not an ip or ip:port
+func NormalizeSource(source string) (string, bool) {
+	if addrPort, err := netip.ParseAddrPort(source); err == nil {
+		return netip.AddrPortFrom(addrPort.Addr().Unmap(), addrPort.Port()).String(), true
+	}
+	if addr, err := netip.ParseAddr(source); err == nil {
+		return addr.Unmap().String(), true
+	}
+
+	return "", false
+}
+
+// id returns the id of the chair of the processor
+func (cp *chairProcessor) id() string {
+	chair := cp.session.Chair()
+	return chair.Id()
+}
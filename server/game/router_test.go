@@ -0,0 +1,87 @@
+package game_test
+
+import (
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DaanV2/f1-game-dashboards/server/game"
+	"github.com/DaanV2/f1-game-dashboards/server/sessions"
+	"github.com/DaanV2/f1-game-dashboards/server/simulator"
+	f1_2023 "github.com/DaanV2/go-f1-library/packets/2023"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Router_SharedPort(t *testing.T) {
+	local := func() *net.UDPConn {
+		conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+		require.NoError(t, err)
+		return conn
+	}
+	// A free port for the shared listener
+	free := local()
+	port := free.LocalAddr().(*net.UDPAddr).Port
+	require.NoError(t, free.Close())
+	shared := &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: port}
+
+	rigA, rigB, other := local(), local(), local()
+	defer rigA.Close()
+	defer rigB.Close()
+	defer other.Close()
+
+	chairs := sessions.NewChairManager()
+	processor := game.NewPacketProcessor(game.WithHost("127.0.0.1"), game.WithSharedPort(port))
+	processor.AddChairHooks(chairs)
+	require.NoError(t, processor.Listen())
+	defer processor.Close()
+
+	var lock sync.Mutex
+	received := make(map[string]map[uint64]int)
+	processor.Pipeline().LapData.Add(func(p game.PacketWithChair[f1_2023.PacketLapData]) {
+		lock.Lock()
+		defer lock.Unlock()
+		if received[p.Chair.Id()] == nil {
+			received[p.Chair.Id()] = make(map[uint64]int)
+		}
+		received[p.Chair.Id()][p.Packet.Header.SessionUID]++
+	})
+	count := func(id string, session uint64) int {
+		time.Sleep(50 * time.Millisecond)
+		lock.Lock()
+		defer lock.Unlock()
+		return received[id][session]
+	}
+
+	// Rig a is routed by its address, rig b by its ip and port
+	chairs.Add(sessions.NewChair("a", 0, true).WithId("rig-a").WithSources([]string{rigA.LocalAddr().String()}))
+	chairs.Add(sessions.NewChair("b", 0, true).WithId("rig-b").WithSources([]string{fmt.Sprint(rigB.LocalAddr())}))
+	time.Sleep(20 * time.Millisecond)
+
+	simA := simulator.NewSimulator(simulator.Options{Cars: 2, Laps: 1, TrackLength: 500, Seed: 1})
+	simB := simulator.NewSimulator(simulator.Options{Cars: 2, Laps: 1, TrackLength: 500, Seed: 2})
+	send := func(conn *net.UDPConn, sim *simulator.Simulator) {
+		for range 5 {
+			for _, packet := range sim.Step(time.Second / 20) {
+				_, err := conn.WriteToUDP(packet, shared)
+				require.NoError(t, err)
+			}
+		}
+	}
+
+	send(rigA, simA)
+	send(rigB, simB)
+	require.Equal(t, 5, count("rig-a", simA.SessionUID()))
+	require.Equal(t, 5, count("rig-b", simB.SessionUID()))
+
+	// The session of rig a continues from another source port, it is routed by its session and player car
+	send(other, simA)
+	require.Equal(t, 10, count("rig-a", simA.SessionUID()))
+
+	// An unknown source in an unknown session is dropped
+	simC := simulator.NewSimulator(simulator.Options{Cars: 2, Laps: 1, TrackLength: 500, Seed: 3})
+	send(other, simC)
+	require.Zero(t, count("rig-a", simC.SessionUID()))
+	require.Zero(t, count("rig-b", simC.SessionUID()))
+}
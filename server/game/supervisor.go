@@ -0,0 +1,266 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/charmbracelet/log"
+)
+
+const (
+	// idleAfter is how long a chair can go without datagrams before its rig is considered to have stopped sending
+	idleAfter = 10 * time.Second
+	// minBackoff is the wait before binding the port of a chair again, doubled after every failure up to maxBackoff
+	minBackoff = time.Second
+	maxBackoff = 30 * time.Second
+	// maxReadErrors is how many reads in a row can fail before the port of a chair is bound again
+	maxReadErrors = 10
+)
+
+// ListenerState is the state of the listener of a chair
+type ListenerState int
+
+const (
+	ListenerStarting  ListenerState = iota // Binding the port for the first time
+	ListenerListening                      // Bound, but no datagrams are received yet
+	ListenerReceiving                      // Datagrams are received recently
+	ListenerIdle                           // No datagrams are received for a while, the rig stopped sending
+	ListenerFailed                         // Binding or reading failed, the port is bound again after a backoff
+)
+
+func (s ListenerState) String() string {
+	switch s {
+	case ListenerStarting:
+		return "starting"
+	case ListenerListening:
+		return "listening"
+	case ListenerReceiving:
+		return "receiving"
+	case ListenerIdle:
+		return "idle"
+	case ListenerFailed:
+		return "failed"
+	}
+
+	return fmt.Sprintf("ListenerState(%d)", int(s))
+}
+
+type (
+	// ChairHealth is the state of the listener of a chair and the datagrams it received
+	ChairHealth struct {
+		ChairId    string
+		Port       int // 0 if the chair only receives on the shared port
+		State      ListenerState
+		Error      string    // The last error of binding or reading the port, empty once it is bound again
+		Restarts   int       // How many times the port is bound again after a failure
+		NextRetry  time.Time // When the port is bound again, zero unless failed
+		Packets    uint64
+		LastPacket time.Time // Zero if no datagrams are received yet
+	}
+
+	// health tracks the state of the listener of a chair. It is safe for concurrent use
+	health struct {
+		lock       sync.Mutex
+		state      ListenerState // Starting, listening or failed, receiving and idle follow from the last packet
+		err        error
+		restarts   int
+		retry      time.Time
+		packets    uint64
+		lastPacket time.Time
+	}
+
+	// supervisor owns the listener of a chair with its own port. It binds the port again with a backoff when binding
+	// fails, reading keeps failing or the listener panics, until it is stopped
+	supervisor struct {
+		cp     *chairProcessor
+		port   int
+		health *health
+
+		lock sync.Mutex
+		conn *net.UDPConn
+		done chan struct{} // Closed when stopped
+	}
+)
+
+// listening marks the port as bound
+func (h *health) listening() {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if h.state == ListenerFailed {
+		h.restarts++
+	}
+	h.state = ListenerListening
+	h.err = nil
+	h.retry = time.Time{}
+}
+
+// failed marks the port as failed until it is bound again at retry
+func (h *health) failed(err error, retry time.Time) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	h.state = ListenerFailed
+	h.err = err
+	h.retry = retry
+}
+
+// received counts a datagram of the chair
+func (h *health) received(now time.Time) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	h.packets++
+	h.lastPacket = now
+}
+
+func (h *health) snapshot(chair string, port int, now time.Time) ChairHealth {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	result := ChairHealth{
+		ChairId:    chair,
+		Port:       port,
+		State:      h.state,
+		Restarts:   h.restarts,
+		NextRetry:  h.retry,
+		Packets:    h.packets,
+		LastPacket: h.lastPacket,
+	}
+	if h.err != nil {
+		result.Error = h.err.Error()
+	}
+	if h.state == ListenerListening && !h.lastPacket.IsZero() {
+		result.State = ListenerReceiving
+		if now.Sub(h.lastPacket) > idleAfter {
+			result.State = ListenerIdle
+		}
+	}
+
+	return result
+}
+
+func newSupervisor(cp *chairProcessor, port int, health *health) *supervisor {
+	return &supervisor{
+		cp:     cp,
+		port:   port,
+		health: health,
+		done:   make(chan struct{}),
+	}
+}
+
+// run binds the port and reads from it until the supervisor is stopped
+func (s *supervisor) run() {
+	logger := log.With("id", s.cp.id(), "port", s.port)
+	backoff := minBackoff
+	for {
+		bound, err := s.listen()
+		if s.stopped() {
+			return
+		}
+		if bound {
+			backoff = minBackoff
+		}
+
+		logger.Error("listener of chair failed, binding the port again", "error", err, "backoff", backoff)
+		s.health.failed(err, time.Now().Add(backoff))
+		select {
+		case <-s.done:
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, maxBackoff)
+	}
+}
+
+// listen binds the port and reads from it until it is closed or reading keeps failing, bound is false if binding failed
+func (s *supervisor) listen() (bound bool, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	conn, err := s.cp.processor.listen(s.port)
+	if err != nil {
+		return false, err
+	}
+
+	s.lock.Lock()
+	if s.stopped() {
+		s.lock.Unlock()
+		return true, conn.Close()
+	}
+	s.conn = conn
+	s.lock.Unlock()
+	defer s.close(conn)
+	s.health.listening()
+
+	return true, s.read(conn)
+}
+
+// read passes the datagrams of the listener to the chair, until it is closed or too many reads in a row failed
+func (s *supervisor) read(conn *net.UDPConn) error {
+	var buf [max_packet_size]byte
+	failures := 0
+	for {
+		n, address, err := conn.ReadFromUDP(buf[0:])
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return err
+			}
+			failures++
+			if failures >= maxReadErrors {
+				return fmt.Errorf("reading failed %d times in a row: %w", failures, err)
+			}
+			log.Error("error reading from udp", "error", err, "id", s.cp.id(), "port", s.port)
+			continue
+		}
+
+		failures = 0
+		s.cp.receive(conn, address, buf[:n])
+	}
+}
+
+// close closes the listener, unless it was already closed by stop
+func (s *supervisor) close(conn *net.UDPConn) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.conn != conn {
+		return
+	}
+
+	s.conn = nil
+	if err := conn.Close(); err != nil && !errors.Is(err, net.ErrClosed) {
+		log.Error("error closing listener", "error", err, "port", s.port)
+	}
+}
+
+// stop closes the listener and stops binding the port again
+func (s *supervisor) stop() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if !s.stopped() {
+		close(s.done)
+	}
+	if s.conn == nil {
+		return nil
+	}
+
+	err := s.conn.Close()
+	s.conn = nil
+	if errors.Is(err, net.ErrClosed) {
+		return nil
+	}
+
+	return err
+}
+
+func (s *supervisor) stopped() bool {
+	select {
+	case <-s.done:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,69 @@
+package game_test
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/DaanV2/f1-game-dashboards/server/game"
+	"github.com/DaanV2/f1-game-dashboards/server/sessions"
+	"github.com/DaanV2/f1-game-dashboards/server/simulator"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Supervisor_RestartsListener(t *testing.T) {
+	// Another socket holds the port of the chair, so binding it fails
+	blocker, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	require.NoError(t, err)
+	port := blocker.LocalAddr().(*net.UDPAddr).Port
+
+	chairs := sessions.NewChairManager()
+	processor := game.NewPacketProcessor(game.WithHost("127.0.0.1"))
+	defer processor.Close()
+	processor.AddChairHooks(chairs)
+	chair := sessions.NewChair("test", port, true)
+	chairs.Add(chair)
+	chairs.Add(sessions.NewChair("shared", 0, true).WithId("shared"))
+
+	state := func(id string) game.ListenerState {
+		health, ok := processor.ChairHealth(id)
+		require.True(t, ok)
+		return health.State
+	}
+	require.Eventually(t, func() bool { return state(chair.Id()) == game.ListenerFailed }, time.Second, 10*time.Millisecond)
+	health, _ := processor.ChairHealth(chair.Id())
+	require.NotEmpty(t, health.Error)
+	require.False(t, health.NextRetry.IsZero())
+
+	// Without a shared port, a chair without a port never receives datagrams
+	require.Equal(t, game.ListenerFailed, state("shared"))
+
+	// Bound again after the backoff, once the port is free
+	require.NoError(t, blocker.Close())
+	require.Eventually(t, func() bool { return state(chair.Id()) == game.ListenerListening }, 3*time.Second, 10*time.Millisecond)
+	health, _ = processor.ChairHealth(chair.Id())
+	require.Empty(t, health.Error)
+	require.Equal(t, 1, health.Restarts)
+
+	sender, err := net.DialUDP("udp", nil, &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: port})
+	require.NoError(t, err)
+	defer sender.Close()
+	sim := simulator.NewSimulator(simulator.Options{Cars: 2, Laps: 1, TrackLength: 500, Seed: 1})
+	for _, packet := range sim.Step(time.Second / 20) {
+		_, err := sender.Write(packet)
+		require.NoError(t, err)
+	}
+	require.Eventually(t, func() bool { return state(chair.Id()) == game.ListenerReceiving }, time.Second, 10*time.Millisecond)
+
+	all := processor.Health()
+	require.Len(t, all, 2)
+	require.Equal(t, chair.Id(), all[0].ChairId)
+	require.Positive(t, all[0].Packets)
+
+	// Removed chairs have no health
+	chairs.Remove(chair.Id())
+	require.Eventually(t, func() bool {
+		_, ok := processor.ChairHealth(chair.Id())
+		return !ok
+	}, time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,378 @@
+package ghost
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/DaanV2/f1-game-dashboards/server/archive"
+	"github.com/DaanV2/f1-game-dashboards/server/drivers"
+	"github.com/DaanV2/f1-game-dashboards/server/game"
+	"github.com/DaanV2/f1-game-dashboards/server/history"
+	"github.com/DaanV2/f1-game-dashboards/server/pkg/hooks"
+	"github.com/DaanV2/f1-game-dashboards/server/pkg/pubsub"
+	"github.com/DaanV2/f1-game-dashboards/server/sessions"
+	"github.com/DaanV2/f1-game-dashboards/server/state"
+	f1_2023 "github.com/DaanV2/go-f1-library/packets/2023"
+	"github.com/charmbracelet/log"
+)
+
+const (
+	// retryInterval is how often a chair without a reference looks for one again
+	retryInterval = 15 * time.Second
+	// maxStartDistance is how far into the lap the recording of a lap may start, for it to be used as a ghost
+	maxStartDistance = 50
+	// maxCandidates is the amount of fastest laps that are tried, when the telemetry of the fastest is not archived
+	maxCandidates = 5
+)
+
+type (
+	// ReferenceKind is the kind of lap the live lap is compared with
+	ReferenceKind uint8
+
+	// Reference is the lap the live lap is compared with
+	Reference struct {
+		Kind        ReferenceKind
+		SessionId   string
+		LapNumber   uint8
+		LapTimeInMS uint32
+		DriverId    string // The driver that was checked in, empty if none
+		Driver      string // The display name of the driver, or the name of the player in the game
+	}
+
+	// Delta is how far the player on a chair is behind the reference, at the distance into the current lap
+	Delta struct {
+		ChairId            string
+		SessionUID         uint64
+		LapNumber          uint8
+		LapDistance        float32
+		CurrentLapTimeInMS uint32
+		Delta              float32 // Seconds behind the reference, negative when ahead
+		Reference          Reference
+		Received           time.Time
+	}
+
+	// Tracker computes the live delta of the player on every chair to their personal best, or to the track record if
+	// they have none. It is safe for concurrent use
+	Tracker struct {
+		// OnDelta is called with every new delta
+		OnDelta hooks.Hook[Delta]
+
+		history *history.Store
+		archive *archive.Archive
+		state   *state.Store
+		drivers *drivers.Registry
+		deltas  *pubsub.Topic[Delta]
+
+		lock   sync.Mutex
+		chairs map[string]*chairGhost
+	}
+
+	// chairGhost follows the session on a chair
+	chairGhost struct {
+		chairId   string
+		session   uint64
+		frame     uint32
+		driver    player // The driver the reference was chosen for
+		loaded    time.Time
+		loading   bool
+		reference *Reference
+		ghost     archive.Ghost
+
+		// The lap that is being driven, it becomes the ghost if it is a new personal best
+		lap      uint8
+		current  archive.Ghost
+		unusable bool // Invalid, or driven again after a flashback
+	}
+
+	// player is the driver on a chair, either checked in or known by the name in the game
+	player struct {
+		DriverId string
+		Name     string
+	}
+)
+
+const (
+	ReferencePersonalBest ReferenceKind = iota // The fastest valid lap of the driver on the track
+	ReferenceTrackRecord                       // The fastest valid lap of anyone on the track
+)
+
+// NewTracker creates a tracker that finds the laps in the history, and their telemetry in the archive.
+// The state is used for the track and the name of the player, the registry for the checked in driver. The registry can be nil
+func NewTracker(store *history.Store, telemetryArchive *archive.Archive, sessionState *state.Store, registry *drivers.Registry) *Tracker {
+	return &Tracker{
+		history: store,
+		archive: telemetryArchive,
+		state:   sessionState,
+		drivers: registry,
+		deltas:  pubsub.NewTopic[Delta](),
+		chairs:  make(map[string]*chairGhost),
+	}
+}
+
+// Subscribe adds the tracker to the lap data hook of the pipeline
+func (t *Tracker) Subscribe(pipeline *game.PacketPipeline) {
+	pipeline.LapData.Add(func(p game.PacketWithChair[f1_2023.PacketLapData]) {
+		t.handleLapData(p.Chair, &p.Packet)
+	})
+}
+
+// AddChairHooks forgets the session of a chair when it is removed
+func (t *Tracker) AddChairHooks(chairs *sessions.ChairManager) {
+	chairs.OnChairRemoved.Add(func(chair sessions.Chair) {
+		t.lock.Lock()
+		defer t.lock.Unlock()
+		delete(t.chairs, chair.Id())
+	})
+}
+
+// Reference returns the lap the player on the chair is compared with, false if there is none
+func (t *Tracker) Reference(chairId string) (Reference, bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	c, ok := t.chairs[chairId]
+	if !ok || c.reference == nil {
+		return Reference{}, false
+	}
+
+	return *c.reference, true
+}
+
+func (t *Tracker) handleLapData(chair sessions.Chair, packet *f1_2023.PacketLapData) {
+	header := packet.Header
+	if int(header.PlayerCarIndex) >= len(packet.LapData) {
+		return
+	}
+	lap := packet.LapData[header.PlayerCarIndex]
+	now := time.Now()
+	driver := t.playerOf(chair.Id(), header)
+	track, known := t.trackOf(chair.Id(), header)
+
+	t.lock.Lock()
+	c, ok := t.chairs[chair.Id()]
+	if !ok || c.session != header.SessionUID {
+		c = &chairGhost{chairId: chair.Id(), session: header.SessionUID, lap: lap.CurrentLapNum}
+		t.chairs[chair.Id()] = c
+	}
+	// The hooks are called concurrently, so an older frame can arrive after a newer one
+	if c.frame > header.OverallFrameIdentifier {
+		t.lock.Unlock()
+		return
+	}
+	c.frame = header.OverallFrameIdentifier
+	if lap.CurrentLapNum != c.lap {
+		t.completeLap(c, lap, driver)
+	}
+	t.record(c, lap)
+
+	// Another driver took the chair, the reference belongs to the previous one
+	if c.driver != driver {
+		c.driver = driver
+		c.loaded = time.Time{}
+		c.reference = nil
+	}
+	// The track is only known once the session packet is received
+	load := known && !c.loading && (c.loaded.IsZero() || (c.reference == nil && now.Sub(c.loaded) > retryInterval))
+	if load {
+		c.loading = true
+	}
+	t.lock.Unlock()
+
+	if load {
+		t.load(chair.Id(), c, driver, track)
+	}
+	t.publish(chair.Id(), c, header.SessionUID, lap, now)
+}
+
+// completeLap turns the lap that was driven into the ghost, if it is a valid new personal best. The lock must be held
+func (t *Tracker) completeLap(c *chairGhost, lap f1_2023.LapData, driver player) {
+	completed := c.current
+	usable := lap.CurrentLapNum == c.lap+1 && !c.unusable && lap.LastLapTimeInMS > 0 && driver == c.driver
+	c.lap = lap.CurrentLapNum
+	c.current = archive.Ghost{}
+	c.unusable = false
+
+	start, ok := completed.Start()
+	if !usable || !ok || start > maxStartDistance {
+		return
+	}
+	if c.reference != nil && c.reference.Kind == ReferencePersonalBest && c.reference.LapTimeInMS <= lap.LastLapTimeInMS {
+		return
+	}
+
+	c.reference = &Reference{
+		Kind:        ReferencePersonalBest,
+		SessionId:   history.SessionId(c.chairId, c.session),
+		LapNumber:   lap.CurrentLapNum - 1,
+		LapTimeInMS: lap.LastLapTimeInMS,
+		DriverId:    driver.DriverId,
+		Driver:      t.displayName(driver),
+	}
+	c.ghost = completed
+}
+
+// record adds the lap data to the lap that is being driven. The lock must be held
+func (t *Tracker) record(c *chairGhost, lap f1_2023.LapData) {
+	if lap.CurrentLapInvalid != 0 {
+		c.unusable = true
+	}
+	if last, ok := c.current.Last(); ok && lap.LapDistance < last-maxStartDistance {
+		c.unusable = true
+	}
+	c.current.Add(lap.LapDistance, float32(lap.CurrentLapTimeInMS)/1000)
+}
+
+// load looks up the reference of the driver in the history, and reads its ghost from the archive
+func (t *Tracker) load(chairId string, c *chairGhost, driver player, track int8) {
+	reference, ghost, ok := t.find(driver, track)
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	c.loading = false
+	c.loaded = time.Now()
+	if !ok || c.driver != driver {
+		return
+	}
+	// The player might have driven a new personal best while loading
+	if c.reference != nil && c.reference.Kind == ReferencePersonalBest && (reference.Kind != ReferencePersonalBest || c.reference.LapTimeInMS <= reference.LapTimeInMS) {
+		return
+	}
+	c.reference = &reference
+	c.ghost = ghost
+	log.Debug("ghost reference", "chair", chairId, "kind", reference.Kind, "session", reference.SessionId, "lap", reference.LapNumber)
+}
+
+// find returns the fastest valid lap of the driver on the track of the session that has its telemetry archived,
+// or the fastest lap of anyone if there is none
+func (t *Tracker) find(driver player, track int8) (Reference, archive.Ghost, bool) {
+	if t.history == nil || t.archive == nil {
+		return Reference{}, archive.Ghost{}, false
+	}
+
+	query := history.Query{TrackId: &track, ValidOnly: true, DriverId: driver.DriverId}
+	if driver.DriverId == "" {
+		query.Driver = driver.Name
+	}
+	if query.DriverId != "" || query.Driver != "" {
+		if reference, ghost, ok := t.fastest(query, ReferencePersonalBest); ok {
+			return reference, ghost, true
+		}
+	}
+
+	return t.fastest(history.Query{TrackId: &track, ValidOnly: true}, ReferenceTrackRecord)
+}
+
+// fastest returns the fastest lap of the query that has its telemetry archived
+func (t *Tracker) fastest(query history.Query, kind ReferenceKind) (Reference, archive.Ghost, bool) {
+	laps := slices.DeleteFunc(t.history.Laps(query), func(l history.LapRecord) bool { return l.Lap.LapTimeInMS == 0 })
+	slices.SortFunc(laps, func(a, b history.LapRecord) int {
+		return int(a.Lap.LapTimeInMS) - int(b.Lap.LapTimeInMS)
+	})
+
+	for _, record := range laps[:min(len(laps), maxCandidates)] {
+		ghost, err := t.archive.Ghost(archive.LapRef{SessionId: record.Session.Id, Lap: record.Lap.LapNumber})
+		if err != nil {
+			continue
+		}
+		if start, ok := ghost.Start(); !ok || start > maxStartDistance {
+			continue
+		}
+
+		driver := player{DriverId: record.Lap.DriverId}
+		// The session is named after the driver of its last lap
+		if record.Session.DriverId == record.Lap.DriverId {
+			driver.Name = record.Session.Driver
+		}
+		reference := Reference{
+			Kind:        kind,
+			SessionId:   record.Session.Id,
+			LapNumber:   record.Lap.LapNumber,
+			LapTimeInMS: record.Lap.LapTimeInMS,
+			DriverId:    driver.DriverId,
+			Driver:      t.displayName(driver),
+		}
+
+		return reference, ghost, true
+	}
+
+	return Reference{}, archive.Ghost{}, false
+}
+
+// publish sends the delta of the lap to the reference, if the ghost drove that part of the lap
+func (t *Tracker) publish(chairId string, c *chairGhost, session uint64, lap f1_2023.LapData, now time.Time) {
+	t.lock.Lock()
+	if c.reference == nil {
+		t.lock.Unlock()
+		return
+	}
+	reference, ghost := *c.reference, c.ghost
+	t.lock.Unlock()
+
+	seconds, ok := ghost.TimeAt(lap.LapDistance)
+	if !ok {
+		return
+	}
+	delta := Delta{
+		ChairId:            chairId,
+		SessionUID:         session,
+		LapNumber:          lap.CurrentLapNum,
+		LapDistance:        lap.LapDistance,
+		CurrentLapTimeInMS: lap.CurrentLapTimeInMS,
+		Delta:              float32(lap.CurrentLapTimeInMS)/1000 - seconds,
+		Reference:          reference,
+		Received:           now,
+	}
+
+	t.OnDelta.Call(delta)
+	t.deltas.Publish(delta)
+}
+
+// playerOf returns the driver checked in to the chair, or the name of the player in the game
+func (t *Tracker) playerOf(chairId string, header f1_2023.PacketHeader) player {
+	if t.drivers != nil {
+		if check, ok := t.drivers.Current(chairId); ok {
+			return player{DriverId: check.DriverId}
+		}
+	}
+	if t.state != nil {
+		if snapshot, ok := t.state.Get(chairId); ok && snapshot.SessionUID == header.SessionUID {
+			return player{Name: playerName(snapshot)}
+		}
+	}
+
+	return player{}
+}
+
+// trackOf returns the track of the session on the chair, false if it is not known yet
+func (t *Tracker) trackOf(chairId string, header f1_2023.PacketHeader) (int8, bool) {
+	if t.state == nil {
+		return 0, false
+	}
+	snapshot, ok := t.state.Get(chairId)
+	if !ok || snapshot.SessionUID != header.SessionUID || snapshot.Session == nil {
+		return 0, false
+	}
+
+	return snapshot.Session.TrackId, true
+}
+
+// displayName returns the display name of the checked in driver, or the name of the player
+func (t *Tracker) displayName(p player) string {
+	if p.DriverId != "" && t.drivers != nil {
+		if driver, err := t.drivers.Get(p.DriverId); err == nil {
+			return driver.DisplayName
+		}
+	}
+
+	return p.Name
+}
+
+func playerName(snapshot state.Snapshot) string {
+	index, ok := snapshot.PlayerCarIndex()
+	if !ok || snapshot.Participants == nil || int(index) >= len(snapshot.Participants.Participants) {
+		return ""
+	}
+
+	return snapshot.Participants.Participants[index].ParticipantName()
+}
@@ -0,0 +1,76 @@
+package ghost_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DaanV2/f1-game-dashboards/server/archive"
+	"github.com/DaanV2/f1-game-dashboards/server/game"
+	"github.com/DaanV2/f1-game-dashboards/server/ghost"
+	"github.com/DaanV2/f1-game-dashboards/server/history"
+	"github.com/DaanV2/f1-game-dashboards/server/pkg/data"
+	"github.com/DaanV2/f1-game-dashboards/server/sessions"
+	"github.com/DaanV2/f1-game-dashboards/server/simulator"
+	"github.com/DaanV2/f1-game-dashboards/server/state"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Tracker_DeltaToPersonalBest(t *testing.T) {
+	processor := game.NewPacketProcessor()
+	sessionState := state.NewStore()
+	sessionState.Subscribe(processor.Pipeline())
+	store := history.NewStore(data.NewMemoryStorage().LapHistory(), sessionState, nil)
+	store.Subscribe(processor.Pipeline())
+	telemetryArchive, err := archive.New(archive.Options{Directory: t.TempDir(), Tiers: []archive.Tier{{Interval: 0}}})
+	require.NoError(t, err)
+	telemetryArchive.Subscribe(processor.Pipeline())
+
+	chair := sessions.NewChair("test", 20777, true)
+	sim := simulator.NewSimulator(simulator.Options{Cars: 2, Laps: 2, TrackLength: 500, Seed: 1})
+	inject := func() {
+		for _, packet := range sim.Step(time.Second / 20) {
+			require.NoError(t, processor.Inject(chair, packet))
+		}
+		// Gives the hooks time to handle the frame, they are called concurrently
+		time.Sleep(time.Millisecond)
+	}
+
+	// Without any history, the first lap becomes the personal best once it is completed
+	tracker := ghost.NewTracker(store, telemetryArchive, sessionState, nil)
+	tracker.Subscribe(processor.Pipeline())
+	watcher := tracker.Watch(chair.Id())
+	defer watcher.Close()
+
+	inject()
+	first := sim.SessionUID()
+	for sim.SessionUID() == first {
+		inject()
+	}
+	reference, ok := tracker.Reference(chair.Id())
+	require.True(t, ok)
+	require.Equal(t, ghost.ReferencePersonalBest, reference.Kind)
+	require.Equal(t, history.SessionId(chair.Id(), first), reference.SessionId)
+	require.NotZero(t, reference.LapTimeInMS)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	err = watcher.Run(ctx, func(delta ghost.Delta) error {
+		require.Equal(t, chair.Id(), delta.ChairId)
+		require.Greater(t, delta.LapNumber, reference.LapNumber)
+		cancel()
+		return nil
+	})
+	require.NoError(t, err)
+	require.ErrorIs(t, ctx.Err(), context.Canceled)
+
+	// The archive is closed so the laps of the first session are written, it can still be read
+	require.NoError(t, telemetryArchive.Close())
+	loaded := ghost.NewTracker(store, telemetryArchive, sessionState, nil)
+	loaded.Subscribe(processor.Pipeline())
+	require.Eventually(t, func() bool {
+		inject()
+		reference, ok := loaded.Reference(chair.Id())
+		return ok && reference.SessionId == history.SessionId(chair.Id(), first)
+	}, 5*time.Second, time.Millisecond)
+}
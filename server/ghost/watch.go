@@ -0,0 +1,49 @@
+package ghost
+
+import (
+	"context"
+
+	"github.com/DaanV2/f1-game-dashboards/server/pkg/pubsub"
+)
+
+// watchBuffer is the amount of deltas a watcher buffers before they are dropped
+const watchBuffer = 256
+
+// Watcher receives the deltas of a chair, or of all chairs
+type Watcher struct {
+	chairId string
+	deltas  *pubsub.Subscription[Delta]
+}
+
+// Watch starts receiving the deltas of the chair, all chairs if the id is empty. Run sends them and Close stops watching
+func (t *Tracker) Watch(chairId string) *Watcher {
+	w := &Watcher{chairId: chairId}
+	// Filtered before buffering, so the deltas of other chairs can not crowd out the watched chair
+	w.deltas = t.deltas.SubscribeFunc(watchBuffer, func(d Delta) bool {
+		return w.chairId == "" || d.ChairId == w.chairId
+	})
+
+	return w
+}
+
+// Run sends the deltas until the context is done or sending fails
+func (w *Watcher) Run(ctx context.Context, send func(Delta) error) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case delta, ok := <-w.deltas.Values():
+			if !ok {
+				return nil
+			}
+			if err := send(delta); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Close stops watching
+func (w *Watcher) Close() {
+	w.deltas.Close()
+}
@@ -0,0 +1,53 @@
+package history
+
+import (
+	f1_2023 "github.com/DaanV2/go-f1-library/packets/2023"
+)
+
+// AssistLevel groups the driving assists a lap was driven with, so laps are only compared with laps that had similar help
+type AssistLevel uint8
+
+const (
+	AssistUnknown AssistLevel = iota // The session packet was not received
+	AssistNone                       // No driving assists
+	AssistPartial                    // Traction control, anti-lock brakes, automatic gears or the racing line
+	AssistFull                       // Steering or braking assist
+)
+
+// gearboxAutomatic is the gearbox assist setting for automatic gears
+const gearboxAutomatic = 3
+
+// AssistLevels are all known assist levels, from the least to the most help
+var AssistLevels = []AssistLevel{AssistNone, AssistPartial, AssistFull}
+
+// String returns the name of the assist level
+func (a AssistLevel) String() string {
+	switch a {
+	case AssistNone:
+		return "none"
+	case AssistPartial:
+		return "partial"
+	case AssistFull:
+		return "full"
+	default:
+		return "unknown"
+	}
+}
+
+// AssistLevelOf determines the assist level of the session settings, and the car status of the player if it is known
+func AssistLevelOf(session *f1_2023.PacketSessionData, status *f1_2023.CarStatusData) AssistLevel {
+	if session == nil {
+		return AssistUnknown
+	}
+	if session.SteeringAssist != 0 || session.BrakingAssist != 0 {
+		return AssistFull
+	}
+	if session.GearboxAssist == gearboxAutomatic || session.DynamicRacingLine != 0 {
+		return AssistPartial
+	}
+	if status != nil && (status.AntiLockBrakes != 0 || status.TractionControl != 0) {
+		return AssistPartial
+	}
+
+	return AssistNone
+}
@@ -0,0 +1,101 @@
+package history
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+type (
+	// Session is the record of a session played on a chair, with the laps the player completed
+	Session struct {
+		Id          string    `json:"id"`
+		ChairId     string    `json:"chair_id"`
+		SessionUID  uint64    `json:"session_uid"`
+		DriverId    string    `json:"driver_id,omitempty"` // The driver checked in when the last lap was completed, empty if none
+		Driver      string    `json:"driver"`              // The display name of the driver, or the name of the player in the game. Empty if unknown
+		TrackId     int8      `json:"track_id"`            // -1 if unknown
+		SessionType uint8     `json:"session_type"`        // 0 if unknown
+		Started     time.Time `json:"started"`
+		Updated     time.Time `json:"updated"`
+		Laps        []Lap     `json:"laps"`
+
+		// Set when the final classification is received
+		Finished        bool    `json:"finished"`
+		Position        uint8   `json:"position,omitempty"`
+		BestLapTimeInMS uint32  `json:"best_lap_time_in_ms,omitempty"`
+		TotalRaceTime   float64 `json:"total_race_time,omitempty"` // In seconds, without penalties
+	}
+
+	// Lap is a lap completed by the player
+	Lap struct {
+		LapNumber       uint8       `json:"lap_number"`
+		LapTimeInMS     uint32      `json:"lap_time_in_ms"`
+		Sector1TimeInMS uint32      `json:"sector1_time_in_ms"`
+		Sector2TimeInMS uint32      `json:"sector2_time_in_ms"`
+		Sector3TimeInMS uint32      `json:"sector3_time_in_ms"`
+		Valid           bool        `json:"valid"`
+		TyreCompound    uint8       `json:"tyre_compound"` // The visual compound, 0 if unknown
+		Assists         AssistLevel `json:"assists"`
+		DriverId        string      `json:"driver_id,omitempty"` // The driver that was checked in to the chair, empty if none
+		Completed       time.Time   `json:"completed"`
+	}
+
+	// LapRecord is a lap together with the session it was driven in
+	LapRecord struct {
+		Session *Session // Shared, must not be modified
+		Lap     Lap
+	}
+
+	// Query filters the laps and sessions, zero values match everything
+	Query struct {
+		ChairId   string
+		TrackId   *int8
+		Driver    string // Case insensitive
+		DriverId  string // The laps of the driver, or the sessions the driver drove a lap in
+		From      time.Time
+		To        time.Time
+		ValidOnly bool // Only applies to laps
+		Limit     int  // Maximum amount of results, newest first
+	}
+)
+
+// SessionId returns the id of the record of the session on the chair
+func SessionId(chairId string, sessionUID uint64) string {
+	return fmt.Sprintf("%s-%d", chairId, sessionUID)
+}
+
+// matchSession returns true if the session matches the chair, track and driver of the query
+func (q Query) matchSession(s *Session) bool {
+	if q.ChairId != "" && s.ChairId != q.ChairId {
+		return false
+	}
+	if q.TrackId != nil && s.TrackId != *q.TrackId {
+		return false
+	}
+	if q.Driver != "" && !strings.EqualFold(s.Driver, q.Driver) {
+		return false
+	}
+
+	return true
+}
+
+// matchTime returns true if the moment is in the date range of the query
+func (q Query) matchTime(t time.Time) bool {
+	if !q.From.IsZero() && t.Before(q.From) {
+		return false
+	}
+	if !q.To.IsZero() && t.After(q.To) {
+		return false
+	}
+
+	return true
+}
+
+// clone copies the session, so the laps can be changed without affecting the copy
+func (s *Session) clone() *Session {
+	c := *s
+	c.Laps = append([]Lap(nil), s.Laps...)
+
+	return &c
+}
@@ -0,0 +1,389 @@
+package history
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/DaanV2/f1-game-dashboards/server/drivers"
+	"github.com/DaanV2/f1-game-dashboards/server/game"
+	"github.com/DaanV2/f1-game-dashboards/server/pkg/hooks"
+	"github.com/DaanV2/f1-game-dashboards/server/sessions"
+	"github.com/DaanV2/f1-game-dashboards/server/state"
+	f1_2023 "github.com/DaanV2/go-f1-library/packets/2023"
+	"github.com/charmbracelet/log"
+)
+
+const (
+	// resultFinished is the result status of a car that took the chequered flag
+	resultFinished = 3
+	// endLapCurrent is the end lap of the tyre stint that is still running
+	endLapCurrent = 255
+)
+
+type (
+	// Storage persists the session records
+	Storage interface {
+		Get(id string) (Session, error)
+		Set(id string, value Session) error
+		Keys() []string
+	}
+
+	// Store records the laps the players complete on the chairs, and persists them per session. It is safe for concurrent use
+	Store struct {
+		// OnSessionUpdated is called with the new version of a session record, every time it changes
+		OnSessionUpdated hooks.Hook[*Session]
+
+		lock     sync.RWMutex
+		storage  Storage
+		state    *state.Store
+		drivers  *drivers.Registry
+		sessions map[string]*Session // Copy on write, so returned sessions are never modified
+		chairs   map[string]*chairLaps
+	}
+
+	// chairLaps tracks the lap of the player on a chair, to detect when it is completed
+	chairLaps struct {
+		sessionUID uint64
+		previous   uint64 // The uid of the previous session, late packets of it are dropped
+		frame      uint32
+		last       *f1_2023.LapData
+	}
+)
+
+// NewStore creates the store and loads the stored sessions. The state is used for the track, session type, player name and tyre compound.
+// The laps are attributed to the drivers checked in to the chairs of the registry. Both can be nil
+func NewStore(storage Storage, sessionState *state.Store, registry *drivers.Registry) *Store {
+	store := &Store{
+		storage:  storage,
+		state:    sessionState,
+		drivers:  registry,
+		sessions: make(map[string]*Session),
+		chairs:   make(map[string]*chairLaps),
+	}
+
+	for _, id := range storage.Keys() {
+		session, err := storage.Get(id)
+		if err != nil {
+			log.Error("could not load session history", "id", id, "error", err)
+			continue
+		}
+		store.sessions[session.Id] = &session
+	}
+
+	return store
+}
+
+// Subscribe adds the store to the lap data, session history and final classification hooks of the pipeline
+func (s *Store) Subscribe(pipeline *game.PacketPipeline) {
+	pipeline.LapData.Add(func(p game.PacketWithChair[f1_2023.PacketLapData]) {
+		s.handleLapData(p.Chair, &p.Packet)
+	})
+	pipeline.SessionHistory.Add(func(p game.PacketWithChair[f1_2023.PacketSessionHistoryData]) {
+		s.handleSessionHistory(p.Chair, &p.Packet)
+	})
+	pipeline.FinalClassification.Add(func(p game.PacketWithChair[f1_2023.PacketFinalClassificationData]) {
+		s.handleFinalClassification(p.Chair, &p.Packet)
+	})
+}
+
+// Session returns the session with the given id
+func (s *Store) Session(id string) (*Session, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	session, ok := s.sessions[id]
+	return session, ok
+}
+
+// Sessions returns the sessions that match the query and were played in the date range, newest first.
+// The laps are included, the sessions are shared and must not be modified
+func (s *Store) Sessions(q Query) []*Session {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	result := make([]*Session, 0)
+	for _, session := range s.sessions {
+		if !q.matchSession(session) {
+			continue
+		}
+		if q.DriverId != "" && session.DriverId != q.DriverId && !slices.ContainsFunc(session.Laps, func(l Lap) bool { return l.DriverId == q.DriverId }) {
+			continue
+		}
+		// Overlaps with the date range
+		if (!q.From.IsZero() && session.Updated.Before(q.From)) || (!q.To.IsZero() && session.Started.After(q.To)) {
+			continue
+		}
+		result = append(result, session)
+	}
+
+	slices.SortFunc(result, func(a, b *Session) int {
+		return b.Started.Compare(a.Started)
+	})
+	return limit(result, q.Limit)
+}
+
+// Laps returns the laps that match the query and were completed in the date range, newest first
+func (s *Store) Laps(q Query) []LapRecord {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	result := make([]LapRecord, 0)
+	for _, session := range s.sessions {
+		if !q.matchSession(session) {
+			continue
+		}
+		for _, lap := range session.Laps {
+			if (q.ValidOnly && !lap.Valid) || (q.DriverId != "" && lap.DriverId != q.DriverId) || !q.matchTime(lap.Completed) {
+				continue
+			}
+			result = append(result, LapRecord{Session: session, Lap: lap})
+		}
+	}
+
+	slices.SortFunc(result, func(a, b LapRecord) int {
+		return b.Lap.Completed.Compare(a.Lap.Completed)
+	})
+	return limit(result, q.Limit)
+}
+
+func (s *Store) handleLapData(chair sessions.Chair, packet *f1_2023.PacketLapData) {
+	header := packet.Header
+	car := header.PlayerCarIndex
+	if int(car) >= len(packet.LapData) {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	id := chair.Id()
+	cl, ok := s.chairs[id]
+	if !ok {
+		cl = &chairLaps{sessionUID: header.SessionUID}
+		s.chairs[id] = cl
+	}
+	if cl.sessionUID != header.SessionUID {
+		// Late packet from the previous session
+		if cl.previous == header.SessionUID {
+			return
+		}
+		*cl = chairLaps{sessionUID: header.SessionUID, previous: cl.sessionUID}
+	}
+	// Hooks are called concurrently, so older frames can arrive after newer ones
+	if cl.last != nil && header.OverallFrameIdentifier < cl.frame {
+		return
+	}
+
+	lap := packet.LapData[car]
+	if last := cl.last; last != nil {
+		switch {
+		case lap.CurrentLapNum > last.CurrentLapNum,
+			lap.ResultStatus == resultFinished && last.ResultStatus != resultFinished:
+			s.completeLap(chair, header, last, lap.LastLapTimeInMS)
+		case lap.CurrentLapNum < last.CurrentLapNum:
+			// Flashback to a previous lap, the laps after it will be driven again
+			s.removeLaps(chair.Id(), header.SessionUID, lap.CurrentLapNum)
+		}
+	}
+
+	cl.last = &lap
+	cl.frame = header.OverallFrameIdentifier
+}
+
+// completeLap records the lap the last lap data was of, the lock must be held
+func (s *Store) completeLap(chair sessions.Chair, header f1_2023.PacketHeader, last *f1_2023.LapData, lapTime uint32) {
+	if lapTime == 0 || last.CurrentLapNum == 0 {
+		return
+	}
+
+	lap := Lap{
+		LapNumber:       last.CurrentLapNum,
+		LapTimeInMS:     lapTime,
+		Sector1TimeInMS: sectorTime(last.Sector1TimeInMS, last.Sector1TimeMinutes),
+		Sector2TimeInMS: sectorTime(last.Sector2TimeInMS, last.Sector2TimeMinutes),
+		Valid:           last.CurrentLapInvalid == 0,
+		Completed:       time.Now(),
+	}
+	if lap.Sector1TimeInMS > 0 && lap.Sector2TimeInMS > 0 && lap.Sector1TimeInMS+lap.Sector2TimeInMS < lapTime {
+		lap.Sector3TimeInMS = lapTime - lap.Sector1TimeInMS - lap.Sector2TimeInMS
+	}
+
+	s.update(chair, header, func(session *Session, snapshot *state.Snapshot) {
+		if snapshot != nil {
+			var status *f1_2023.CarStatusData
+			if snapshot.CarStatus != nil {
+				status = &snapshot.CarStatus.CarStatusData[header.PlayerCarIndex]
+				lap.TyreCompound = status.VisualTyreCompound
+			}
+			lap.Assists = AssistLevelOf(snapshot.Session, status)
+		}
+
+		if s.drivers != nil {
+			if driver, ok := s.drivers.At(chair.Id(), lap.Completed); ok {
+				lap.DriverId = driver.Id
+				session.DriverId = driver.Id
+				session.Driver = driver.DisplayName
+			}
+		}
+
+		// Driven again after a flashback
+		session.Laps = slices.DeleteFunc(session.Laps, func(l Lap) bool { return l.LapNumber == lap.LapNumber })
+		session.Laps = append(session.Laps, lap)
+	})
+}
+
+// removeLaps removes the laps from the given lap number, the lock must be held
+func (s *Store) removeLaps(chairId string, sessionUID uint64, from uint8) {
+	existing, ok := s.sessions[SessionId(chairId, sessionUID)]
+	if !ok || !slices.ContainsFunc(existing.Laps, func(l Lap) bool { return l.LapNumber >= from }) {
+		return
+	}
+
+	session := existing.clone()
+	session.Laps = slices.DeleteFunc(session.Laps, func(l Lap) bool { return l.LapNumber >= from })
+	session.Updated = time.Now()
+	s.store(session)
+}
+
+func (s *Store) handleSessionHistory(chair sessions.Chair, packet *f1_2023.PacketSessionHistoryData) {
+	if packet.CarIdx != packet.Header.PlayerCarIndex {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	// Only corrects the laps that were recorded from the lap data
+	existing, ok := s.sessions[SessionId(chair.Id(), packet.Header.SessionUID)]
+	if !ok {
+		return
+	}
+
+	laps := slices.Clone(existing.Laps)
+	for i := range laps {
+		lap := &laps[i]
+		index := int(lap.LapNumber) - 1
+		if index < 0 || index >= int(packet.NumLaps) || index >= len(packet.LapHistoryData) {
+			continue
+		}
+
+		history := packet.LapHistoryData[index]
+		if history.LapTimeInMS == 0 {
+			continue
+		}
+		lap.LapTimeInMS = history.LapTimeInMS
+		lap.Sector1TimeInMS = sectorTime(history.Sector1TimeInMS, history.Sector1TimeMinutes)
+		lap.Sector2TimeInMS = sectorTime(history.Sector2TimeInMS, history.Sector2TimeMinutes)
+		lap.Sector3TimeInMS = sectorTime(history.Sector3TimeInMS, history.Sector3TimeMinutes)
+		lap.Valid = history.LapValidBitFlags&0x01 != 0
+
+		for _, stint := range packet.TyreStintsHistoryData[:min(int(packet.NumTyreStints), len(packet.TyreStintsHistoryData))] {
+			if stint.EndLap >= lap.LapNumber || stint.EndLap == endLapCurrent {
+				lap.TyreCompound = stint.TyreVisualCompound
+				break
+			}
+		}
+	}
+	// The history is sent about once per second, the session is only stored when a lap changed
+	if slices.Equal(laps, existing.Laps) {
+		return
+	}
+
+	s.update(chair, packet.Header, func(session *Session, _ *state.Snapshot) {
+		session.Laps = laps
+	})
+}
+
+func (s *Store) handleFinalClassification(chair sessions.Chair, packet *f1_2023.PacketFinalClassificationData) {
+	car := packet.Header.PlayerCarIndex
+	if int(car) >= len(packet.ClassificationData) {
+		return
+	}
+	classification := packet.ClassificationData[car]
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.update(chair, packet.Header, func(session *Session, snapshot *state.Snapshot) {
+		session.Finished = true
+		session.Position = classification.Position
+		session.BestLapTimeInMS = classification.BestLapTimeInMS
+		session.TotalRaceTime = classification.TotalRaceTime
+	})
+}
+
+// update applies the change to a copy of the session record, creating it if needed, and stores it. The lock must be held
+func (s *Store) update(chair sessions.Chair, header f1_2023.PacketHeader, apply func(session *Session, snapshot *state.Snapshot)) {
+	now := time.Now()
+	id := SessionId(chair.Id(), header.SessionUID)
+
+	var session *Session
+	if existing, ok := s.sessions[id]; ok {
+		session = existing.clone()
+	} else {
+		session = &Session{
+			Id:         id,
+			ChairId:    chair.Id(),
+			SessionUID: header.SessionUID,
+			TrackId:    -1,
+			Started:    now,
+		}
+	}
+
+	// The session info is updated every time, it might not have been received when the session started
+	var snapshot *state.Snapshot
+	if s.state != nil {
+		if sn, ok := s.state.Get(chair.Id()); ok && sn.SessionUID == header.SessionUID {
+			snapshot = &sn
+		}
+	}
+	if snapshot != nil {
+		if snapshot.Session != nil {
+			session.TrackId = snapshot.Session.TrackId
+			session.SessionType = uint8(snapshot.Session.SessionType)
+		}
+		// The name in the game is only used if no driver is checked in
+		if session.DriverId == "" && snapshot.Participants != nil && int(header.PlayerCarIndex) < len(snapshot.Participants.Participants) {
+			session.Driver = participantName(snapshot.Participants.Participants[header.PlayerCarIndex].Name)
+		}
+	}
+
+	apply(session, snapshot)
+	session.Updated = now
+	s.store(session)
+}
+
+// store replaces the session record and persists it, the lock must be held
+func (s *Store) store(session *Session) {
+	s.sessions[session.Id] = session
+	if err := s.storage.Set(session.Id, *session); err != nil {
+		log.Error("could not store session history", "id", session.Id, "error", err)
+	}
+
+	s.OnSessionUpdated.Call(session)
+}
+
+// sectorTime combines the milliseconds and minutes parts of a sector time
+func sectorTime(ms uint16, minutes uint8) uint32 {
+	return uint32(minutes)*60_000 + uint32(ms)
+}
+
+// participantName converts the null terminated name
+func participantName(name [48]uint8) string {
+	for i, c := range name {
+		if c == 0 {
+			return string(name[:i])
+		}
+	}
+
+	return string(name[:])
+}
+
+func limit[T any](items []T, n int) []T {
+	if n > 0 && len(items) > n {
+		return items[:n]
+	}
+
+	return items
+}
@@ -0,0 +1,109 @@
+package history_test
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/DaanV2/f1-game-dashboards/server/drivers"
+	"github.com/DaanV2/f1-game-dashboards/server/game"
+	"github.com/DaanV2/f1-game-dashboards/server/history"
+	"github.com/DaanV2/f1-game-dashboards/server/pkg/data"
+	"github.com/DaanV2/f1-game-dashboards/server/sessions"
+	"github.com/DaanV2/f1-game-dashboards/server/simulator"
+	"github.com/DaanV2/f1-game-dashboards/server/state"
+	f1_2023 "github.com/DaanV2/go-f1-library/packets/2023"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Store_RecordsLaps(t *testing.T) {
+	processor := game.NewPacketProcessor()
+	sessionState := state.NewStore()
+	sessionState.Subscribe(processor.Pipeline())
+	database := data.NewMemoryStorage()
+	registry := drivers.NewRegistry(database.Drivers(), database.CheckIns())
+	storage := database.LapHistory()
+	store := history.NewStore(storage, sessionState, registry)
+	store.Subscribe(processor.Pipeline())
+
+	chair := sessions.NewChair("test", 20777, true)
+	driver, err := registry.Create("Alice", "", "")
+	require.NoError(t, err)
+	_, err = registry.CheckIn(chair.Id(), driver.Id, time.Time{})
+	require.NoError(t, err)
+	sim := simulator.NewSimulator(simulator.Options{Cars: 4, Laps: 2, TrackLength: 500, Seed: 1})
+	inject := func() {
+		for _, packet := range sim.Step(time.Second / 20) {
+			require.NoError(t, processor.Inject(chair, packet))
+		}
+		// Gives the hooks time to handle the frame, they are called concurrently
+		time.Sleep(time.Millisecond)
+	}
+
+	// Drive until the simulator starts the next session
+	inject()
+	first := sim.SessionUID()
+	for sim.SessionUID() == first {
+		inject()
+	}
+
+	id := history.SessionId(chair.Id(), first)
+	require.Eventually(t, func() bool {
+		session, ok := store.Session(id)
+		return ok && len(session.Laps) == 2
+	}, time.Second, time.Millisecond)
+
+	session, _ := store.Session(id)
+	require.Equal(t, chair.Id(), session.ChairId)
+	require.Equal(t, driver.Id, session.DriverId)
+	require.Equal(t, "Alice", session.Driver)
+	require.NotEqual(t, int8(-1), session.TrackId)
+	for i, lap := range session.Laps {
+		require.Equal(t, uint8(i+1), lap.LapNumber)
+		require.NotZero(t, lap.Sector1TimeInMS)
+		require.NotZero(t, lap.Sector2TimeInMS)
+		require.Equal(t, lap.LapTimeInMS, lap.Sector1TimeInMS+lap.Sector2TimeInMS+lap.Sector3TimeInMS)
+		require.True(t, lap.Valid)
+		require.Equal(t, driver.Id, lap.DriverId)
+	}
+
+	track := session.TrackId
+	require.Len(t, store.Laps(history.Query{ChairId: chair.Id(), TrackId: &track}), 2)
+	require.Len(t, store.Laps(history.Query{ChairId: chair.Id(), Limit: 1}), 1)
+	require.Len(t, store.Laps(history.Query{DriverId: driver.Id}), 2)
+	require.Empty(t, store.Laps(history.Query{DriverId: "someone else"}))
+	require.Empty(t, store.Laps(history.Query{ChairId: "20778"}))
+	require.Empty(t, store.Sessions(history.Query{From: time.Now().Add(time.Hour)}))
+
+	// A history that matches the recorded laps does not store the session again
+	updates := atomic.Int32{}
+	store.OnSessionUpdated.Add(func(*history.Session) { updates.Add(1) })
+	packet := f1_2023.PacketSessionHistoryData{Header: f1_2023.PacketHeader{SessionUID: first}, NumLaps: uint8(len(session.Laps))}
+	for i, lap := range session.Laps {
+		packet.LapHistoryData[i] = f1_2023.LapHistoryData{
+			LapTimeInMS:        lap.LapTimeInMS,
+			Sector1TimeInMS:    uint16(lap.Sector1TimeInMS % 60_000),
+			Sector1TimeMinutes: uint8(lap.Sector1TimeInMS / 60_000),
+			Sector2TimeInMS:    uint16(lap.Sector2TimeInMS % 60_000),
+			Sector2TimeMinutes: uint8(lap.Sector2TimeInMS / 60_000),
+			Sector3TimeInMS:    uint16(lap.Sector3TimeInMS % 60_000),
+			Sector3TimeMinutes: uint8(lap.Sector3TimeInMS / 60_000),
+			LapValidBitFlags:   0x0F,
+		}
+	}
+	processor.Pipeline().SessionHistory.Call(game.PacketWithChair[f1_2023.PacketSessionHistoryData]{Chair: chair, Packet: packet})
+	time.Sleep(10 * time.Millisecond)
+	require.Zero(t, updates.Load())
+
+	// A corrected lap is stored
+	packet.LapHistoryData[0].LapValidBitFlags = 0
+	processor.Pipeline().SessionHistory.Call(game.PacketWithChair[f1_2023.PacketSessionHistoryData]{Chair: chair, Packet: packet})
+	require.Eventually(t, func() bool { return updates.Load() == 1 }, time.Second, time.Millisecond)
+	session, _ = store.Session(id)
+	require.False(t, session.Laps[0].Valid)
+
+	// Loaded again from the storage
+	reloaded, ok := history.NewStore(storage, nil, nil).Session(id)
+	require.True(t, ok)
+	require.Len(t, reloaded.Laps, 2)
+}
@@ -12,6 +12,7 @@ func GetOrCreate(database data.Database, generateNew bool) ([]*SigningInfo, erro
 	sigs, err := loadSigningInfo(database)
 	if errors.Is(err, data.ErrNotFound) {
 		generateNew = true
+		err = nil
 	}
 	if err != nil {
 		return sigs, err
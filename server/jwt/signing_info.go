@@ -5,6 +5,7 @@ import (
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 
@@ -66,8 +67,15 @@ func (s *SigningInfo) UnmarshalJSON(data []byte) error {
 		return errors.New("method not found")
 	}
 
-	s.PrivateKey, aErr = x509.ParsePKCS8PrivateKey([]byte(private))
-	s.PublicKey, bErr = x509.ParsePKIXPublicKey([]byte(public))
+	// The keys are marshalled as []byte, so they are base64 encoded
+	privateKey, aErr := base64.StdEncoding.DecodeString(private)
+	publicKey, bErr := base64.StdEncoding.DecodeString(public)
+	if err := errors.Join(aErr, bErr); err != nil {
+		return err
+	}
+
+	s.PrivateKey, aErr = x509.ParsePKCS8PrivateKey(privateKey)
+	s.PublicKey, bErr = x509.ParsePKIXPublicKey(publicKey)
 	return errors.Join(aErr, bErr)
 }
 
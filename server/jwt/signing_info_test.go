@@ -0,0 +1,33 @@
+package jwt_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/DaanV2/f1-game-dashboards/server/jwt"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SigningInfo_JSON(t *testing.T) {
+	sigInfo, err := jwt.GenerateSigningInfo()
+	require.NoError(t, err)
+
+	data, err := json.Marshal([]*jwt.SigningInfo{sigInfo})
+	require.NoError(t, err)
+
+	loaded := make([]*jwt.SigningInfo, 0)
+	require.NoError(t, json.Unmarshal(data, &loaded))
+	require.Len(t, loaded, 1)
+	require.Equal(t, sigInfo, loaded[0])
+
+	// Tokens signed before the restart are still valid
+	before, err := jwt.NewJwtService([]*jwt.SigningInfo{sigInfo})
+	require.NoError(t, err)
+	after, err := jwt.NewJwtService(loaded)
+	require.NoError(t, err)
+
+	token, err := before.Sign(jwt.MapClaims{"sub": "user"})
+	require.NoError(t, err)
+	_, err = after.Verify(token)
+	require.NoError(t, err)
+}
@@ -0,0 +1,227 @@
+package leaderboard
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/DaanV2/f1-game-dashboards/server/drivers"
+	"github.com/DaanV2/f1-game-dashboards/server/history"
+	"github.com/DaanV2/f1-game-dashboards/server/pkg/pubsub"
+)
+
+type (
+	// Key identifies a leaderboard, laps are only ranked against laps of the same track, session type and assist level
+	Key struct {
+		TrackId     int8
+		SessionType uint8
+		Assists     history.AssistLevel
+	}
+
+	// Entry is the best lap of a driver on a leaderboard
+	Entry struct {
+		Position  int    // Starts at 1
+		DriverId  string // The profile of the driver, empty if no driver was checked in
+		Driver    string // The display name of the driver, or the name of the player in the game
+		Nickname  string
+		ChairId   string
+		SessionId string
+		Lap       history.Lap
+	}
+
+	// Board is a ranked leaderboard, the fastest lap first
+	Board struct {
+		Key     Key
+		Entries []Entry
+	}
+
+	// Leaderboard ranks the valid laps of the lap history across all chairs. It is safe for concurrent use
+	Leaderboard struct {
+		lock     sync.RWMutex
+		sessions map[string]*history.Session
+		drivers  *drivers.Registry
+		laps     *pubsub.Topic[newLap]
+	}
+
+	// newLap is published for every valid lap that is added to a leaderboard
+	newLap struct {
+		Key   Key
+		Entry Entry
+	}
+)
+
+// New creates the leaderboard from the laps in the store, and follows the sessions that are recorded after it.
+// The names of the drivers are taken from the registry, which can be nil
+func New(store *history.Store, registry *drivers.Registry) *Leaderboard {
+	l := &Leaderboard{
+		sessions: make(map[string]*history.Session),
+		drivers:  registry,
+		laps:     pubsub.NewTopic[newLap](),
+	}
+
+	store.OnSessionUpdated.Add(l.update)
+	for _, session := range store.Sessions(history.Query{}) {
+		l.update(session)
+	}
+
+	return l
+}
+
+// Keys returns the keys of the leaderboards that have laps
+func (l *Leaderboard) Keys() []Key {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+
+	keys := make([]Key, 0)
+	for _, session := range l.sessions {
+		for _, lap := range session.Laps {
+			if key, ok := keyOf(session, lap); ok && !slices.Contains(keys, key) {
+				keys = append(keys, key)
+			}
+		}
+	}
+
+	slices.SortFunc(keys, compareKeys)
+	return keys
+}
+
+// Board ranks the best lap of every driver completed since the given moment, a zero moment includes all laps.
+// A limit of 0 returns all drivers
+func (l *Leaderboard) Board(key Key, since time.Time, limit int) Board {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+
+	best := make(map[string]Entry)
+	for _, session := range l.sessions {
+		for _, lap := range session.Laps {
+			if k, ok := keyOf(session, lap); !ok || k != key || lap.Completed.Before(since) {
+				continue
+			}
+
+			driver := driverOf(session, lap)
+			if current, ok := best[driver]; ok && !faster(lap, current.Lap) {
+				continue
+			}
+			best[driver] = l.entry(session, lap)
+		}
+	}
+
+	entries := make([]Entry, 0, len(best))
+	for _, entry := range best {
+		entries = append(entries, entry)
+	}
+	slices.SortFunc(entries, func(a, b Entry) int {
+		if faster(a.Lap, b.Lap) {
+			return -1
+		}
+		if faster(b.Lap, a.Lap) {
+			return 1
+		}
+		return strings.Compare(a.SessionId, b.SessionId)
+	})
+	for i := range entries {
+		entries[i].Position = i + 1
+	}
+	if limit > 0 && len(entries) > limit {
+		entries = entries[:limit]
+	}
+
+	return Board{Key: key, Entries: entries}
+}
+
+// update replaces the session and publishes the valid laps it did not have before
+func (l *Leaderboard) update(session *history.Session) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	previous, ok := l.sessions[session.Id]
+	// The hooks are called concurrently, so an older version can arrive after a newer one
+	if ok && previous.Updated.After(session.Updated) {
+		return
+	}
+	l.sessions[session.Id] = session
+
+	for _, lap := range session.Laps {
+		key, ok := keyOf(session, lap)
+		if !ok || (previous != nil && slices.ContainsFunc(previous.Laps, func(p history.Lap) bool { return sameLap(p, lap) })) {
+			continue
+		}
+
+		l.laps.Publish(newLap{Key: key, Entry: l.entry(session, lap)})
+	}
+}
+
+// entry creates the unranked entry of the lap, with the current names of the driver
+func (l *Leaderboard) entry(session *history.Session, lap history.Lap) Entry {
+	entry := Entry{
+		DriverId:  lap.DriverId,
+		Driver:    session.Driver,
+		ChairId:   session.ChairId,
+		SessionId: session.Id,
+		Lap:       lap,
+	}
+	// The session is named after the driver of its last lap
+	if session.DriverId != lap.DriverId {
+		entry.Driver = ""
+	}
+	if lap.DriverId != "" && l.drivers != nil {
+		if driver, err := l.drivers.Get(lap.DriverId); err == nil {
+			entry.Driver = driver.DisplayName
+			entry.Nickname = driver.Nickname
+		}
+	}
+
+	return entry
+}
+
+// keyOf returns the leaderboard the lap is ranked on, or false if the lap is not ranked
+func keyOf(session *history.Session, lap history.Lap) (Key, bool) {
+	if !lap.Valid || lap.LapTimeInMS == 0 || session.TrackId < 0 || lap.Assists == history.AssistUnknown {
+		return Key{}, false
+	}
+
+	return Key{
+		TrackId:     session.TrackId,
+		SessionType: session.SessionType,
+		Assists:     lap.Assists,
+	}, true
+}
+
+// driverOf returns what identifies the driver of the lap: the checked in driver, the name in the game or the chair
+func driverOf(session *history.Session, lap history.Lap) string {
+	if lap.DriverId != "" {
+		return "id:" + lap.DriverId
+	}
+	// The name of the session belongs to the driver checked in for another lap
+	if session.Driver == "" || session.DriverId != "" {
+		return "chair:" + session.ChairId
+	}
+
+	return "driver:" + strings.ToLower(session.Driver)
+}
+
+// faster returns true if lap a is faster than b, on equal times the lap that was set first wins
+func faster(a, b history.Lap) bool {
+	if a.LapTimeInMS != b.LapTimeInMS {
+		return a.LapTimeInMS < b.LapTimeInMS
+	}
+
+	return a.Completed.Before(b.Completed)
+}
+
+// sameLap returns true if the lap was already ranked with the same time and validity
+func sameLap(a, b history.Lap) bool {
+	return a.LapNumber == b.LapNumber && a.LapTimeInMS == b.LapTimeInMS && a.Valid == b.Valid && a.Assists == b.Assists && a.DriverId == b.DriverId
+}
+
+func compareKeys(a, b Key) int {
+	switch {
+	case a.TrackId != b.TrackId:
+		return int(a.TrackId) - int(b.TrackId)
+	case a.SessionType != b.SessionType:
+		return int(a.SessionType) - int(b.SessionType)
+	default:
+		return int(a.Assists) - int(b.Assists)
+	}
+}
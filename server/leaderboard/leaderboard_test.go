@@ -0,0 +1,104 @@
+package leaderboard_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DaanV2/f1-game-dashboards/server/game"
+	"github.com/DaanV2/f1-game-dashboards/server/history"
+	"github.com/DaanV2/f1-game-dashboards/server/leaderboard"
+	"github.com/DaanV2/f1-game-dashboards/server/pkg/data"
+	"github.com/DaanV2/f1-game-dashboards/server/sessions"
+	"github.com/DaanV2/f1-game-dashboards/server/simulator"
+	"github.com/DaanV2/f1-game-dashboards/server/state"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Leaderboard_RanksBestLapPerDriver(t *testing.T) {
+	now := time.Now()
+	lap := func(number uint8, ms uint32, valid bool, assists history.AssistLevel, ago time.Duration) history.Lap {
+		return history.Lap{LapNumber: number, LapTimeInMS: ms, Valid: valid, Assists: assists, Completed: now.Add(-ago)}
+	}
+
+	storage := data.NewMemoryStorage().LapHistory()
+	for _, session := range []history.Session{
+		{Id: "a", ChairId: "20777", Driver: "Alice", TrackId: 3, SessionType: 10, Laps: []history.Lap{
+			lap(1, 90_000, true, history.AssistNone, 48*time.Hour),
+			lap(2, 80_000, false, history.AssistNone, 48*time.Hour), // Invalid
+			lap(3, 85_000, true, history.AssistNone, 48*time.Hour),
+		}},
+		{Id: "b", ChairId: "20778", Driver: "Bob", TrackId: 3, SessionType: 10, Laps: []history.Lap{
+			lap(1, 88_000, true, history.AssistNone, time.Hour),
+			lap(2, 70_000, true, history.AssistFull, time.Hour), // Other board
+		}},
+		{Id: "c", ChairId: "20777", Driver: "alice", TrackId: 3, SessionType: 10, Laps: []history.Lap{
+			lap(1, 89_000, true, history.AssistNone, time.Hour),
+		}},
+	} {
+		require.NoError(t, storage.Set(session.Id, session))
+	}
+
+	board := leaderboard.New(history.NewStore(storage, nil, nil), nil)
+	key := leaderboard.Key{TrackId: 3, SessionType: 10, Assists: history.AssistNone}
+	require.Equal(t, []leaderboard.Key{key, {TrackId: 3, SessionType: 10, Assists: history.AssistFull}}, board.Keys())
+
+	all := board.Board(key, time.Time{}, 0)
+	require.Len(t, all.Entries, 2)
+	require.Equal(t, 1, all.Entries[0].Position)
+	require.Equal(t, "a", all.Entries[0].SessionId)
+	require.Equal(t, uint32(85_000), all.Entries[0].Lap.LapTimeInMS)
+	require.Equal(t, "Bob", all.Entries[1].Driver)
+	require.Equal(t, 2, all.Entries[1].Position)
+
+	week := board.Board(key, now.Add(-24*time.Hour), 0)
+	require.Len(t, week.Entries, 2)
+	require.Equal(t, "Bob", week.Entries[0].Driver)
+	require.Equal(t, "c", week.Entries[1].SessionId)
+
+	require.Len(t, board.Board(key, time.Time{}, 1).Entries, 1)
+}
+
+func Test_Leaderboard_WatchesNewBests(t *testing.T) {
+	processor := game.NewPacketProcessor()
+	sessionState := state.NewStore()
+	sessionState.Subscribe(processor.Pipeline())
+	store := history.NewStore(data.NewMemoryStorage().LapHistory(), sessionState, nil)
+	store.Subscribe(processor.Pipeline())
+	board := leaderboard.New(store, nil)
+
+	watcher := board.Watch(leaderboard.Filter{})
+	defer watcher.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	updates := make(chan leaderboard.Update, 16)
+	go func() {
+		_ = watcher.Run(ctx, func(u leaderboard.Update) error {
+			updates <- u
+			return nil
+		})
+	}()
+
+	chair := sessions.NewChair("test", 20777, true)
+	sim := simulator.NewSimulator(simulator.Options{Cars: 4, Laps: 2, TrackLength: 500, Seed: 1})
+	deadline := time.After(10 * time.Second)
+	for {
+		for _, packet := range sim.Step(time.Second / 20) {
+			require.NoError(t, processor.Inject(chair, packet))
+		}
+		time.Sleep(time.Millisecond)
+
+		select {
+		case update := <-updates:
+			require.Equal(t, leaderboard.UpdateOverallBest, update.Kind)
+			require.Equal(t, 1, update.Entry.Position)
+			require.Equal(t, chair.Id(), update.Entry.ChairId)
+			require.Equal(t, history.AssistPartial, update.Board.Key.Assists) // The simulator uses automatic gears
+			require.Len(t, update.Board.Entries, 1)
+			return
+		case <-deadline:
+			t.Fatal("no leaderboard update received")
+		default:
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package leaderboard
+
+import (
+	"context"
+	"time"
+
+	"github.com/DaanV2/f1-game-dashboards/server/history"
+	"github.com/DaanV2/f1-game-dashboards/server/pkg/pubsub"
+)
+
+// watchBuffer is the amount of new laps a watcher buffers before they are dropped
+const watchBuffer = 64
+
+type (
+	// UpdateKind is the reason an update is send to a watcher
+	UpdateKind uint8
+
+	// Filter selects the leaderboards to watch, nil fields match every leaderboard
+	Filter struct {
+		TrackId     *int8
+		SessionType *uint8
+		Assists     *history.AssistLevel
+		Since       time.Time // Only laps completed since this moment are ranked, zero for all laps
+		Limit       int       // The maximum amount of entries per board, 0 for all
+	}
+
+	// Update is send to a watcher when a leaderboard changes
+	Update struct {
+		Kind  UpdateKind
+		Entry Entry // The new best lap, empty for snapshots
+		Board Board // The leaderboard after the change
+	}
+
+	// Watcher receives the updates of the leaderboards that match its filter
+	Watcher struct {
+		leaderboard *Leaderboard
+		filter      Filter
+		laps        *pubsub.Subscription[newLap]
+	}
+)
+
+const (
+	UpdateSnapshot     UpdateKind = iota // The current leaderboard, send for every matching board when watching starts
+	UpdatePersonalBest                   // A driver improved their own best lap
+	UpdateOverallBest                    // A driver set the fastest lap of the leaderboard
+)
+
+// Watch starts receiving the new laps, Run sends the updates and Close stops watching
+func (l *Leaderboard) Watch(filter Filter) *Watcher {
+	w := &Watcher{leaderboard: l, filter: filter}
+	// Filtered before buffering, so the laps of other leaderboards can not crowd out the watched ones
+	w.laps = l.laps.SubscribeFunc(watchBuffer, func(lap newLap) bool {
+		return w.filter.match(lap.Key) && !lap.Entry.Lap.Completed.Before(w.filter.Since)
+	})
+
+	return w
+}
+
+// Run sends a snapshot of every matching leaderboard, and after that an update whenever a driver sets a new best,
+// until the context is done or sending fails
+func (w *Watcher) Run(ctx context.Context, send func(Update) error) error {
+	for _, key := range w.leaderboard.Keys() {
+		if !w.filter.match(key) {
+			continue
+		}
+		if err := send(Update{Kind: UpdateSnapshot, Board: w.leaderboard.Board(key, w.filter.Since, w.filter.Limit)}); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case lap, ok := <-w.laps.Values():
+			if !ok {
+				return nil
+			}
+			update, ok := w.check(lap)
+			if !ok {
+				continue
+			}
+			if err := send(update); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Close stops watching
+func (w *Watcher) Close() {
+	w.laps.Close()
+}
+
+// check returns the update if the lap is the best of its driver on the leaderboard
+func (w *Watcher) check(lap newLap) (Update, bool) {
+	// Ranked on the whole board, the driver might be outside of the limit
+	board := w.leaderboard.Board(lap.Key, w.filter.Since, 0)
+	for _, entry := range board.Entries {
+		if entry.SessionId != lap.Entry.SessionId || entry.Lap.LapNumber != lap.Entry.Lap.LapNumber {
+			continue
+		}
+
+		update := Update{Kind: UpdatePersonalBest, Entry: entry, Board: board}
+		if entry.Position == 1 {
+			update.Kind = UpdateOverallBest
+		}
+		if w.filter.Limit > 0 && len(board.Entries) > w.filter.Limit {
+			update.Board.Entries = board.Entries[:w.filter.Limit]
+		}
+
+		return update, true
+	}
+
+	return Update{}, false
+}
+
+// match returns true if the leaderboard is selected by the filter
+func (f Filter) match(key Key) bool {
+	if f.TrackId != nil && *f.TrackId != key.TrackId {
+		return false
+	}
+	if f.SessionType != nil && *f.SessionType != key.SessionType {
+		return false
+	}
+	if f.Assists != nil && *f.Assists != key.Assists {
+		return false
+	}
+
+	return true
+}
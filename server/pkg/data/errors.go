@@ -3,5 +3,6 @@ package data
 import "errors"
 
 var (
-	ErrNotFound = errors.New("item not found")
+	ErrNotFound      = errors.New("item not found")
+	ErrAlreadyExists = errors.New("item already exists")
 )
\ No newline at end of file
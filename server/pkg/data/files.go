@@ -1,14 +1,19 @@
 package data
 
 import (
-	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path"
 	"strings"
 	"sync"
 
+	"github.com/DaanV2/f1-game-dashboards/server/booking"
+	"github.com/DaanV2/f1-game-dashboards/server/drivers"
+	"github.com/DaanV2/f1-game-dashboards/server/history"
+	"github.com/DaanV2/f1-game-dashboards/server/racecontrol"
 	"github.com/DaanV2/f1-game-dashboards/server/sessions"
+	"github.com/DaanV2/f1-game-dashboards/server/trackmap"
 	"github.com/charmbracelet/log"
 )
 
@@ -16,8 +21,16 @@ type (
 	FileStorage struct {
 		folder string
 
-		chairs *TypedStorage[sessions.Chair]
-		config *DirectoryStorage
+		chairs       *TypedStorage[sessions.Chair]
+		config       *DirectoryStorage
+		users        *IndexedUserStorage
+		laps         *TypedStorage[history.Session]
+		drivers      *TypedStorage[drivers.Driver]
+		checkIns     *TypedStorage[drivers.CheckIn]
+		reservations *TypedStorage[booking.Reservation]
+		queue        *TypedStorage[booking.QueueEntry]
+		trackMaps    *TypedStorage[trackmap.Map]
+		raceControl  *TypedStorage[racecontrol.Log]
 	}
 
 	DirectoryStorage struct {
@@ -38,8 +51,16 @@ func NewFileStorage(folder string) *FileStorage {
 	return &FileStorage{
 		folder: folder,
 
-		chairs: NewTypedStorage[sessions.Chair](NewDirectoryStorage(path.Join(folder, "chairs"))),
-		config: NewDirectoryStorage(path.Join(folder, "config")),
+		chairs:       NewTypedStorage[sessions.Chair](NewDirectoryStorage(path.Join(folder, "chairs"))),
+		config:       NewDirectoryStorage(path.Join(folder, "config")),
+		users:        NewIndexedUserStorage(NewDirectoryStorage(path.Join(folder, "users"))),
+		laps:         NewTypedStorage[history.Session](NewDirectoryStorage(path.Join(folder, "laps"))),
+		drivers:      NewTypedStorage[drivers.Driver](NewDirectoryStorage(path.Join(folder, "drivers"))),
+		checkIns:     NewTypedStorage[drivers.CheckIn](NewDirectoryStorage(path.Join(folder, "checkins"))),
+		reservations: NewTypedStorage[booking.Reservation](NewDirectoryStorage(path.Join(folder, "reservations"))),
+		queue:        NewTypedStorage[booking.QueueEntry](NewDirectoryStorage(path.Join(folder, "queue"))),
+		trackMaps:    NewTypedStorage[trackmap.Map](NewDirectoryStorage(path.Join(folder, "trackmaps"))),
+		raceControl:  NewTypedStorage[racecontrol.Log](NewDirectoryStorage(path.Join(folder, "racecontrol"))),
 	}
 }
 
@@ -51,6 +72,38 @@ func (fs *FileStorage) Config() RawStorage {
 	return fs.config
 }
 
+func (fs *FileStorage) Users() UserStorage {
+	return fs.users
+}
+
+func (fs *FileStorage) LapHistory() Storage[history.Session] {
+	return fs.laps
+}
+
+func (fs *FileStorage) Drivers() Storage[drivers.Driver] {
+	return fs.drivers
+}
+
+func (fs *FileStorage) CheckIns() Storage[drivers.CheckIn] {
+	return fs.checkIns
+}
+
+func (fs *FileStorage) Reservations() Storage[booking.Reservation] {
+	return fs.reservations
+}
+
+func (fs *FileStorage) Queue() Storage[booking.QueueEntry] {
+	return fs.queue
+}
+
+func (fs *FileStorage) TrackMaps() Storage[trackmap.Map] {
+	return fs.trackMaps
+}
+
+func (fs *FileStorage) RaceControl() Storage[racecontrol.Log] {
+	return fs.raceControl
+}
+
 func NewDirectoryStorage(folder string) *DirectoryStorage {
 	checkFolder(folder)
 
@@ -80,12 +133,23 @@ func (ds *DirectoryStorage) Set(id string, value []byte) error {
 	filepath := ds.filepath(id)
 	log.Debug("saving to storage", "id", id, "filepath", filepath, "value", value)
 
-	data, err := json.Marshal(value)
+	// The value is already encoded, Get returns the file as is.
+	// Written to a temporary file first, so a crash never leaves a partially written file behind
+	tmp, err := os.CreateTemp(ds.folder, id+".*.tmp")
 	if err != nil {
 		return err
 	}
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(value)
+	if err := errors.Join(err, tmp.Close()); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), 0644); err != nil {
+		return err
+	}
 
-	return os.WriteFile(filepath, data, 0644)
+	return os.Rename(tmp.Name(), filepath)
 }
 
 func (ds *DirectoryStorage) Delete(id string) error {
@@ -1,11 +1,32 @@
 package data
 
-import "github.com/DaanV2/f1-game-dashboards/server/sessions"
+import (
+	"github.com/DaanV2/f1-game-dashboards/server/booking"
+	"github.com/DaanV2/f1-game-dashboards/server/drivers"
+	"github.com/DaanV2/f1-game-dashboards/server/history"
+	"github.com/DaanV2/f1-game-dashboards/server/racecontrol"
+	"github.com/DaanV2/f1-game-dashboards/server/sessions"
+	"github.com/DaanV2/f1-game-dashboards/server/trackmap"
+	"github.com/DaanV2/f1-game-dashboards/server/users"
+)
 
 type (
 	Database interface {
 		Chairs() Storage[sessions.Chair]
 		Config() RawStorage
+		Users() UserStorage
+		LapHistory() Storage[history.Session]
+		Drivers() Storage[drivers.Driver]
+		CheckIns() Storage[drivers.CheckIn]
+		Reservations() Storage[booking.Reservation]
+		Queue() Storage[booking.QueueEntry]
+		TrackMaps() Storage[trackmap.Map]
+		RaceControl() Storage[racecontrol.Log]
+	}
+
+	// UserStorage stores the users by id, emails are unique between users
+	UserStorage interface {
+		users.UserStorage
 	}
 
 	Storage[T any] interface {
@@ -3,13 +3,26 @@ package data
 import (
 	"sync"
 
+	"github.com/DaanV2/f1-game-dashboards/server/booking"
+	"github.com/DaanV2/f1-game-dashboards/server/drivers"
+	"github.com/DaanV2/f1-game-dashboards/server/history"
+	"github.com/DaanV2/f1-game-dashboards/server/racecontrol"
 	"github.com/DaanV2/f1-game-dashboards/server/sessions"
+	"github.com/DaanV2/f1-game-dashboards/server/trackmap"
 )
 
 type (
 	MemoryStorage struct {
-		chairs *TypedStorage[sessions.Chair]
-		config *memStorage
+		chairs       *TypedStorage[sessions.Chair]
+		config       *memStorage
+		users        *IndexedUserStorage
+		laps         *TypedStorage[history.Session]
+		drivers      *TypedStorage[drivers.Driver]
+		checkIns     *TypedStorage[drivers.CheckIn]
+		reservations *TypedStorage[booking.Reservation]
+		queue        *TypedStorage[booking.QueueEntry]
+		trackMaps    *TypedStorage[trackmap.Map]
+		raceControl  *TypedStorage[racecontrol.Log]
 	}
 
 	memStorage struct {
@@ -20,8 +33,16 @@ type (
 
 func NewMemoryStorage() *MemoryStorage {
 	return &MemoryStorage{
-		config: newMStorage(),
-		chairs: NewTypedStorage[sessions.Chair](newMStorage()),
+		config:       newMStorage(),
+		chairs:       NewTypedStorage[sessions.Chair](newMStorage()),
+		users:        NewIndexedUserStorage(newMStorage()),
+		laps:         NewTypedStorage[history.Session](newMStorage()),
+		drivers:      NewTypedStorage[drivers.Driver](newMStorage()),
+		checkIns:     NewTypedStorage[drivers.CheckIn](newMStorage()),
+		reservations: NewTypedStorage[booking.Reservation](newMStorage()),
+		queue:        NewTypedStorage[booking.QueueEntry](newMStorage()),
+		trackMaps:    NewTypedStorage[trackmap.Map](newMStorage()),
+		raceControl:  NewTypedStorage[racecontrol.Log](newMStorage()),
 	}
 }
 
@@ -33,6 +54,38 @@ func (fs *MemoryStorage) Config() RawStorage {
 	return fs.config
 }
 
+func (fs *MemoryStorage) Users() UserStorage {
+	return fs.users
+}
+
+func (fs *MemoryStorage) LapHistory() Storage[history.Session] {
+	return fs.laps
+}
+
+func (fs *MemoryStorage) Drivers() Storage[drivers.Driver] {
+	return fs.drivers
+}
+
+func (fs *MemoryStorage) CheckIns() Storage[drivers.CheckIn] {
+	return fs.checkIns
+}
+
+func (fs *MemoryStorage) Reservations() Storage[booking.Reservation] {
+	return fs.reservations
+}
+
+func (fs *MemoryStorage) Queue() Storage[booking.QueueEntry] {
+	return fs.queue
+}
+
+func (fs *MemoryStorage) TrackMaps() Storage[trackmap.Map] {
+	return fs.trackMaps
+}
+
+func (fs *MemoryStorage) RaceControl() Storage[racecontrol.Log] {
+	return fs.raceControl
+}
+
 func newMStorage() *memStorage {
 	return &memStorage{
 		lock:  sync.Mutex{},
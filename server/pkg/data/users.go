@@ -0,0 +1,142 @@
+package data
+
+import (
+	"errors"
+	"strings"
+	"sync"
+
+	"github.com/DaanV2/f1-game-dashboards/server/users"
+	"github.com/charmbracelet/log"
+)
+
+var _ UserStorage = &IndexedUserStorage{}
+
+// IndexedUserStorage stores the users by id, and keeps an index of the emails in memory.
+// Writes are serialized, so two users can never end up with the same email
+type IndexedUserStorage struct {
+	lock   sync.RWMutex
+	users  *TypedStorage[users.User]
+	emails map[string]string // Normalized email to user id
+}
+
+// NewIndexedUserStorage creates the user storage on top of the given storage, and builds the email index from the stored users
+func NewIndexedUserStorage(base RawStorage) *IndexedUserStorage {
+	storage := &IndexedUserStorage{
+		users:  NewTypedStorage[users.User](base),
+		emails: make(map[string]string),
+	}
+
+	for _, id := range storage.users.Keys() {
+		user, err := storage.users.Get(id)
+		if err != nil {
+			log.Error("could not load user", "id", id, "error", err)
+			continue
+		}
+
+		email := normalizeEmail(user.Email)
+		if other, exists := storage.emails[email]; exists {
+			log.Error("duplicate email, skipping user", "id", id, "other", other, "email", user.Email)
+			continue
+		}
+		storage.emails[email] = id
+	}
+
+	return storage
+}
+
+// Get returns the user with the given id
+func (s *IndexedUserStorage) Get(id string) (*users.User, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.get(id)
+}
+
+// GetByEmail returns the user with the given email, emails are case insensitive
+func (s *IndexedUserStorage) GetByEmail(email string) (*users.User, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	id, ok := s.emails[normalizeEmail(email)]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	return s.get(id)
+}
+
+// Set creates or updates the user, returns ErrAlreadyExists if another user has the same email
+func (s *IndexedUserStorage) Set(user *users.User) error {
+	if user == nil || user.Id == "" {
+		return errors.New("user id is required")
+	}
+	email := normalizeEmail(user.Email)
+	if email == "" {
+		return errors.New("user email is required")
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if id, exists := s.emails[email]; exists && id != user.Id {
+		return ErrAlreadyExists
+	}
+	// If the email changed, the old one is released
+	old, err := s.get(user.Id)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return err
+	}
+
+	if err := s.users.Set(user.Id, *user); err != nil {
+		return err
+	}
+	if old != nil {
+		delete(s.emails, normalizeEmail(old.Email))
+	}
+	s.emails[email] = user.Id
+
+	return nil
+}
+
+// Delete removes the user with the given id
+func (s *IndexedUserStorage) Delete(id string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	user, err := s.get(id)
+	if err != nil {
+		return err
+	}
+	if err := s.users.Delete(id); err != nil {
+		return err
+	}
+	delete(s.emails, normalizeEmail(user.Email))
+
+	return nil
+}
+
+// Keys returns the ids of all the users
+func (s *IndexedUserStorage) Keys() []string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	keys := make([]string, 0, len(s.emails))
+	for _, id := range s.emails {
+		keys = append(keys, id)
+	}
+
+	return keys
+}
+
+func (s *IndexedUserStorage) get(id string) (*users.User, error) {
+	user, err := s.users.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
@@ -0,0 +1,72 @@
+package data_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/DaanV2/f1-game-dashboards/server/pkg/data"
+	"github.com/DaanV2/f1-game-dashboards/server/users"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_UserStorage(t *testing.T) {
+	folder := t.TempDir()
+	storage := data.NewFileStorage(folder).Users()
+
+	daan := &users.User{Id: "1", Email: "Daan@example.com", Password: "hash"}
+	require.NoError(t, storage.Set(daan))
+
+	user, err := storage.GetByEmail(" daan@EXAMPLE.com")
+	require.NoError(t, err)
+	require.Equal(t, daan, user)
+
+	// Emails are unique
+	err = storage.Set(&users.User{Id: "2", Email: "daan@example.com"})
+	require.ErrorIs(t, err, data.ErrAlreadyExists)
+
+	// Changing the email releases the old one
+	daan.Email = "daan@example.org"
+	require.NoError(t, storage.Set(daan))
+	_, err = storage.GetByEmail("daan@example.com")
+	require.ErrorIs(t, err, data.ErrNotFound)
+	require.NoError(t, storage.Set(&users.User{Id: "2", Email: "daan@example.com"}))
+
+	// Survives a restart
+	reloaded := data.NewFileStorage(folder).Users()
+	require.ElementsMatch(t, []string{"1", "2"}, reloaded.Keys())
+	user, err = reloaded.GetByEmail("daan@example.org")
+	require.NoError(t, err)
+	require.Equal(t, "1", user.Id)
+
+	require.NoError(t, reloaded.Delete("2"))
+	_, err = reloaded.GetByEmail("daan@example.com")
+	require.ErrorIs(t, err, data.ErrNotFound)
+}
+
+func Test_UserStorage_ConcurrentWrites(t *testing.T) {
+	storage := data.NewMemoryStorage().Users()
+
+	// Only one of the users can claim the email
+	var (
+		wg      sync.WaitGroup
+		lock    sync.Mutex
+		created int
+	)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			err := storage.Set(&users.User{Id: fmt.Sprint(i), Email: "same@example.com"})
+			if err == nil {
+				lock.Lock()
+				created++
+				lock.Unlock()
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	require.Equal(t, 1, created)
+	require.Len(t, storage.Keys(), 1)
+}
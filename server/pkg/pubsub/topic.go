@@ -0,0 +1,98 @@
+package pubsub
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+type (
+	// Topic fans out published values to all its subscriptions, unlike a hook subscriptions can be removed again.
+	// It is safe for concurrent use
+	Topic[T any] struct {
+		lock          sync.RWMutex
+		subscriptions map[*Subscription[T]]struct{}
+	}
+
+	// Subscription receives the values published on a topic, until it is closed
+	Subscription[T any] struct {
+		topic   *Topic[T]
+		values  chan T
+		accept  func(T) bool // Only the accepted values are buffered, all values if nil
+		dropped atomic.Uint64
+		closed  sync.Once
+	}
+)
+
+// NewTopic creates a new topic without subscriptions
+func NewTopic[T any]() *Topic[T] {
+	return &Topic[T]{
+		subscriptions: make(map[*Subscription[T]]struct{}),
+	}
+}
+
+// Subscribe creates a new subscription that buffers at most the given amount of values
+func (t *Topic[T]) Subscribe(buffer int) *Subscription[T] {
+	return t.SubscribeFunc(buffer, nil)
+}
+
+// SubscribeFunc creates a new subscription that only receives the values accept returns true for, so other values do
+// not take up its buffer. Accept is called concurrently by the publishers
+func (t *Topic[T]) SubscribeFunc(buffer int, accept func(T) bool) *Subscription[T] {
+	s := &Subscription[T]{
+		topic:  t,
+		values: make(chan T, buffer),
+		accept: accept,
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.subscriptions[s] = struct{}{}
+
+	return s
+}
+
+// Active returns true if the topic has subscriptions
+func (t *Topic[T]) Active() bool {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	return len(t.subscriptions) > 0
+}
+
+// Publish sends the value to all subscriptions, it never blocks. If the buffer of a subscription is full the value is dropped for that subscription
+func (t *Topic[T]) Publish(value T) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	for s := range t.subscriptions {
+		if s.accept != nil && !s.accept(value) {
+			continue
+		}
+		select {
+		case s.values <- value:
+		default:
+			s.dropped.Add(1)
+		}
+	}
+}
+
+// Values returns the channel the values are received on, it is closed when the subscription is closed
+func (s *Subscription[T]) Values() <-chan T {
+	return s.values
+}
+
+// Dropped returns the amount of values that were dropped because the subscription could not keep up
+func (s *Subscription[T]) Dropped() uint64 {
+	return s.dropped.Load()
+}
+
+// Close removes the subscription from the topic
+func (s *Subscription[T]) Close() {
+	s.closed.Do(func() {
+		s.topic.lock.Lock()
+		defer s.topic.lock.Unlock()
+
+		delete(s.topic.subscriptions, s)
+		close(s.values)
+	})
+}
@@ -0,0 +1,54 @@
+package pubsub_test
+
+import (
+	"testing"
+
+	"github.com/DaanV2/f1-game-dashboards/server/pkg/pubsub"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Topic_Publish(t *testing.T) {
+	topic := pubsub.NewTopic[int]()
+	require.False(t, topic.Active())
+
+	fast := topic.Subscribe(10)
+	slow := topic.Subscribe(1)
+	require.True(t, topic.Active())
+
+	for i := 0; i < 3; i++ {
+		topic.Publish(i)
+	}
+
+	require.Len(t, fast.Values(), 3)
+	require.Equal(t, uint64(0), fast.Dropped())
+	require.Equal(t, 0, <-slow.Values())
+	require.Equal(t, uint64(2), slow.Dropped())
+
+	fast.Close()
+	slow.Close()
+	slow.Close()
+	require.False(t, topic.Active())
+
+	// Closed subscriptions no longer receive values
+	topic.Publish(4)
+	values := make([]int, 0)
+	for v := range fast.Values() {
+		values = append(values, v)
+	}
+	require.Equal(t, []int{0, 1, 2}, values)
+}
+
+func Test_Topic_SubscribeFunc(t *testing.T) {
+	topic := pubsub.NewTopic[int]()
+	even := topic.SubscribeFunc(2, func(v int) bool { return v%2 == 0 })
+	defer even.Close()
+
+	for i := 0; i < 5; i++ {
+		topic.Publish(i)
+	}
+
+	// The odd values do not take up the buffer
+	require.Equal(t, 0, <-even.Values())
+	require.Equal(t, 2, <-even.Values())
+	require.Equal(t, uint64(1), even.Dropped())
+}
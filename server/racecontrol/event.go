@@ -0,0 +1,251 @@
+package racecontrol
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/DaanV2/f1-game-dashboards/server/game/f1_2024"
+	"github.com/DaanV2/f1-game-dashboards/server/state"
+	f1_2023 "github.com/DaanV2/go-f1-library/packets/2023"
+)
+
+type (
+	// Kind is the kind of a race control event
+	Kind uint8
+
+	// Car is a car involved in an event
+	Car struct {
+		Index      uint8  `json:"index"`
+		Name       string `json:"name"` // The name of the driver in the game, empty if the participants were not received
+		RaceNumber uint8  `json:"race_number"`
+		TeamId     uint8  `json:"team_id"`
+		LapNumber  uint8  `json:"lap_number"` // The lap the car was on, 0 if unknown
+	}
+
+	// Penalty holds the details of a penalty
+	Penalty struct {
+		Type         string `json:"type"`
+		Infringement string `json:"infringement"`
+		Time         uint8  `json:"time"` // Seconds of a time penalty, or of the action
+		LapNumber    uint8  `json:"lap_number"`
+		PlacesGained uint8  `json:"places_gained"`
+	}
+
+	// Event is something that happened in a session, readable for a race director or commentator
+	Event struct {
+		Sequence    uint32    `json:"sequence"` // Increases with every event of the session
+		Kind        Kind      `json:"kind"`
+		SessionTime float32   `json:"session_time"` // Seconds
+		Cars        []Car     `json:"cars,omitempty"`
+		LapTimeInMS uint32    `json:"lap_time_in_ms,omitempty"` // The fastest lap
+		Penalty     *Penalty  `json:"penalty,omitempty"`
+		SafetyCar   string    `json:"safety_car,omitempty"` // The kind of safety car: full, virtual or formation lap
+		Phase       string    `json:"phase,omitempty"`      // The phase of the safety car: deployed, returning, returned or resumed
+		Message     string    `json:"message"`
+		Received    time.Time `json:"received"`
+	}
+)
+
+const (
+	KindUnknown Kind = iota
+	KindSessionStarted
+	KindSessionEnded
+	KindLightsOut
+	KindFastestLap
+	KindRetirement
+	KindPenalty
+	KindDriveThroughServed
+	KindStopGoServed
+	KindSafetyCar
+	KindOvertake
+	KindCollision
+	KindDRSEnabled
+	KindDRSDisabled
+	KindRedFlag
+	KindChequeredFlag
+	KindRaceWinner
+)
+
+var kindNames = [...]string{
+	KindUnknown:            "unknown",
+	KindSessionStarted:     "session_started",
+	KindSessionEnded:       "session_ended",
+	KindLightsOut:          "lights_out",
+	KindFastestLap:         "fastest_lap",
+	KindRetirement:         "retirement",
+	KindPenalty:            "penalty",
+	KindDriveThroughServed: "drive_through_served",
+	KindStopGoServed:       "stop_go_served",
+	KindSafetyCar:          "safety_car",
+	KindOvertake:           "overtake",
+	KindCollision:          "collision",
+	KindDRSEnabled:         "drs_enabled",
+	KindDRSDisabled:        "drs_disabled",
+	KindRedFlag:            "red_flag",
+	KindChequeredFlag:      "chequered_flag",
+	KindRaceWinner:         "race_winner",
+}
+
+var (
+	safetyCarTypes  = [...]string{"none", "full", "virtual", "formation lap"}
+	safetyCarPhases = [...]string{"deployed", "returning", "returned", "resumed"}
+)
+
+// Kinds returns all known kinds
+func Kinds() []Kind {
+	result := make([]Kind, 0, len(kindNames)-1)
+	for k := KindUnknown + 1; int(k) < len(kindNames); k++ {
+		result = append(result, k)
+	}
+
+	return result
+}
+
+// ParseKind returns the kind with the given name
+func ParseKind(name string) (Kind, bool) {
+	for k, n := range kindNames {
+		if n == name {
+			return Kind(k), true
+		}
+	}
+
+	return KindUnknown, false
+}
+
+func (k Kind) String() string {
+	if int(k) < len(kindNames) {
+		return kindNames[k]
+	}
+
+	return kindNames[KindUnknown]
+}
+
+// decode turns the event packet into an event, false if it is not a race control event.
+// The snapshot is used for the names of the drivers and their laps
+func decode(packet *f1_2023.PacketEventData, snapshot *state.Snapshot) (Event, bool) {
+	event := Event{SessionTime: packet.Header.SessionTime}
+	car := func(index uint8) Car { return carOf(index, snapshot) }
+
+	switch details := packet.EventDetails.(type) {
+	case f1_2023.FastestLap:
+		event.Kind = KindFastestLap
+		event.Cars = []Car{car(details.VehicleIdx)}
+		event.LapTimeInMS = uint32(details.LapTime * 1000)
+		event.Message = fmt.Sprintf("%s set the fastest lap: %s", event.Cars[0], lapTime(event.LapTimeInMS))
+	case f1_2023.Retirement:
+		event.Kind = KindRetirement
+		event.Cars = []Car{car(details.VehicleIdx)}
+		event.Message = fmt.Sprintf("%s retired", event.Cars[0])
+	case f1_2023.Penalty:
+		event.Kind = KindPenalty
+		event.Cars = []Car{car(details.VehicleIdx)}
+		if details.OtherVehicleIdx != details.VehicleIdx && int(details.OtherVehicleIdx) < state.MaxCars {
+			event.Cars = append(event.Cars, car(details.OtherVehicleIdx))
+		}
+		event.Penalty = &Penalty{
+			Type:         details.PenaltyType.String(),
+			Infringement: details.InfringementType.String(),
+			Time:         details.Time,
+			LapNumber:    details.LapNum,
+			PlacesGained: details.PlacesGained,
+		}
+		event.Message = fmt.Sprintf("%s: %s for %s", event.Cars[0], event.Penalty.Type, event.Penalty.Infringement)
+		if details.PenaltyType == f1_2023.P_TimePenalty && details.Time > 0 {
+			event.Message += fmt.Sprintf(" (%ds)", details.Time)
+		}
+	case f1_2023.DriveThroughPenaltyServed:
+		event.Kind = KindDriveThroughServed
+		event.Cars = []Car{car(details.VehicleIdx)}
+		event.Message = fmt.Sprintf("%s served a drive through penalty", event.Cars[0])
+	case f1_2023.StopGoPenaltyServed:
+		event.Kind = KindStopGoServed
+		event.Cars = []Car{car(details.VehicleIdx)}
+		event.Message = fmt.Sprintf("%s served a stop go penalty", event.Cars[0])
+	case f1_2023.Overtake:
+		event.Kind = KindOvertake
+		event.Cars = []Car{car(details.OvertakingVehicleIdx), car(details.BeingOvertakenVehicleIdx)}
+		event.Message = fmt.Sprintf("%s overtook %s", event.Cars[0], event.Cars[1])
+	case f1_2023.RaceWinner:
+		event.Kind = KindRaceWinner
+		event.Cars = []Car{car(details.VehicleIdx)}
+		event.Message = fmt.Sprintf("%s won the race", event.Cars[0])
+	case f1_2024.Collision:
+		event.Kind = KindCollision
+		event.Cars = []Car{car(details.Vehicle1Idx), car(details.Vehicle2Idx)}
+		event.Message = fmt.Sprintf("Collision between %s and %s", event.Cars[0], event.Cars[1])
+	case f1_2024.SafetyCar:
+		if int(details.SafetyCarType) >= len(safetyCarTypes) || int(details.EventType) >= len(safetyCarPhases) {
+			return Event{}, false
+		}
+		event.Kind = KindSafetyCar
+		event.SafetyCar = safetyCarTypes[details.SafetyCarType]
+		event.Phase = safetyCarPhases[details.EventType]
+		name := "Safety car"
+		switch details.SafetyCarType {
+		case 2:
+			name = "Virtual safety car"
+		case 3:
+			name = "Formation lap safety car"
+		}
+		if details.EventType == 3 {
+			event.Message = fmt.Sprintf("%s ended, race resumed", name)
+		} else {
+			event.Message = fmt.Sprintf("%s %s", name, event.Phase)
+		}
+	default:
+		// Events without details
+		switch packet.EventStringCode {
+		case f1_2023.EC_SessionStarted:
+			event.Kind, event.Message = KindSessionStarted, "Session started"
+		case f1_2023.EC_SessionEnded:
+			event.Kind, event.Message = KindSessionEnded, "Session ended"
+		case f1_2023.EC_LightsOut:
+			event.Kind, event.Message = KindLightsOut, "Lights out"
+		case f1_2023.EC_DRSenabled:
+			event.Kind, event.Message = KindDRSEnabled, "DRS enabled"
+		case f1_2023.EC_DRSdisabled:
+			event.Kind, event.Message = KindDRSDisabled, "DRS disabled"
+		case f1_2023.EC_RedFlag:
+			event.Kind, event.Message = KindRedFlag, "Red flag"
+		case f1_2023.EC_ChequeredFlag:
+			event.Kind, event.Message = KindChequeredFlag, "Chequered flag"
+		default:
+			return Event{}, false
+		}
+	}
+
+	return event, true
+}
+
+// carOf returns the car with its driver and lap from the snapshot
+func carOf(index uint8, snapshot *state.Snapshot) Car {
+	car := Car{Index: index}
+	if snapshot.Participants != nil && int(index) < len(snapshot.Participants.Participants) {
+		participant := &snapshot.Participants.Participants[index]
+		car.Name = participant.ParticipantName()
+		car.RaceNumber = participant.RaceNumber
+		car.TeamId = uint8(participant.TeamId)
+	}
+	if snapshot.LapData != nil && int(index) < len(snapshot.LapData.LapData) {
+		car.LapNumber = snapshot.LapData.LapData[index].CurrentLapNum
+	}
+
+	return car
+}
+
+// String returns the name of the driver, or the car number if it is not known
+func (c Car) String() string {
+	if name := strings.TrimSpace(c.Name); name != "" {
+		return name
+	}
+	if c.RaceNumber > 0 {
+		return fmt.Sprintf("Car #%d", c.RaceNumber)
+	}
+
+	return fmt.Sprintf("Car %d", c.Index+1)
+}
+
+func lapTime(ms uint32) string {
+	return fmt.Sprintf("%d:%02d.%03d", ms/60_000, ms/1000%60, ms%1000)
+}
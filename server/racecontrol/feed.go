@@ -0,0 +1,197 @@
+package racecontrol
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/DaanV2/f1-game-dashboards/server/game"
+	"github.com/DaanV2/f1-game-dashboards/server/history"
+	"github.com/DaanV2/f1-game-dashboards/server/pkg/hooks"
+	"github.com/DaanV2/f1-game-dashboards/server/pkg/pubsub"
+	"github.com/DaanV2/f1-game-dashboards/server/sessions"
+	"github.com/DaanV2/f1-game-dashboards/server/state"
+	f1_2023 "github.com/DaanV2/go-f1-library/packets/2023"
+	"github.com/charmbracelet/log"
+)
+
+var (
+	ErrLogNotFound = errors.New("race control log not found")
+)
+
+type (
+	// Storage persists the logs by session id
+	Storage interface {
+		Get(id string) (Log, error)
+		Set(id string, value Log) error
+		Keys() []string
+	}
+
+	// Log is the record of the race control events of a session played on a chair
+	Log struct {
+		Id          string    `json:"id"` // The same id as the session in the lap history
+		ChairId     string    `json:"chair_id"`
+		SessionUID  uint64    `json:"session_uid"`
+		TrackId     int8      `json:"track_id"`     // -1 if unknown
+		SessionType uint8     `json:"session_type"` // 0 if unknown
+		Started     time.Time `json:"started"`
+		Updated     time.Time `json:"updated"`
+		Sequence    uint32    `json:"sequence"` // The sequence of the last event
+		Events      []Event   `json:"events"`   // Ordered by session time
+	}
+
+	// Message is a new event of a session, as it is published to the watchers
+	Message struct {
+		LogId      string
+		ChairId    string
+		SessionUID uint64
+		Event      Event
+	}
+
+	// Feed decodes the events of the sessions on the chairs, and keeps a log of them per session. It is safe for concurrent use
+	Feed struct {
+		// OnEvent is called with every new event
+		OnEvent hooks.Hook[Message]
+
+		storage  Storage
+		state    *state.Store
+		messages *pubsub.Topic[Message]
+
+		lock sync.RWMutex
+		logs map[string]*Log // Copy on write, so returned logs are never modified
+	}
+)
+
+// NewFeed creates the feed and loads the stored logs. The state is used for the names of the drivers and the track
+func NewFeed(storage Storage, sessionState *state.Store) *Feed {
+	feed := &Feed{
+		storage:  storage,
+		state:    sessionState,
+		messages: pubsub.NewTopic[Message](),
+		logs:     make(map[string]*Log),
+	}
+
+	for _, id := range storage.Keys() {
+		l, err := storage.Get(id)
+		if err != nil {
+			log.Error("could not load race control log", "id", id, "error", err)
+			continue
+		}
+		feed.logs[l.Id] = &l
+	}
+
+	return feed
+}
+
+// Subscribe adds the feed to the event hook of the pipeline
+func (f *Feed) Subscribe(pipeline *game.PacketPipeline) {
+	pipeline.Event.Add(func(p game.PacketWithChair[f1_2023.PacketEventData]) {
+		f.handleEvent(p.Chair, &p.Packet)
+	})
+}
+
+// Log returns the log of the session, it is shared and must not be modified
+func (f *Feed) Log(id string) (*Log, bool) {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	l, ok := f.logs[id]
+	return l, ok
+}
+
+// Logs returns the logs of the sessions on the chair, all chairs if the id is empty. Newest first, the logs are shared and must not be modified
+func (f *Feed) Logs(chairId string) []*Log {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	result := make([]*Log, 0)
+	for _, l := range f.logs {
+		if chairId == "" || l.ChairId == chairId {
+			result = append(result, l)
+		}
+	}
+	slices.SortFunc(result, func(a, b *Log) int { return b.Started.Compare(a.Started) })
+
+	return result
+}
+
+func (f *Feed) handleEvent(chair sessions.Chair, packet *f1_2023.PacketEventData) {
+	header := packet.Header
+	snapshot := state.Snapshot{}
+	if f.state != nil {
+		if current, ok := f.state.Get(chair.Id()); ok && current.SessionUID == header.SessionUID {
+			snapshot = current
+		}
+	}
+
+	if details, ok := packet.EventDetails.(f1_2023.Flashback); ok {
+		f.rewind(history.SessionId(chair.Id(), header.SessionUID), details.FlashbackSessionTime)
+		return
+	}
+	event, ok := decode(packet, &snapshot)
+	if !ok {
+		return
+	}
+	event.Received = time.Now()
+
+	id := history.SessionId(chair.Id(), header.SessionUID)
+	f.update(id, func(l *Log) {
+		if l.Id == "" {
+			*l = Log{Id: id, ChairId: chair.Id(), SessionUID: header.SessionUID, TrackId: -1, Started: event.Received}
+		}
+		if snapshot.Session != nil {
+			l.TrackId = snapshot.Session.TrackId
+			l.SessionType = uint8(snapshot.Session.SessionType)
+		}
+
+		l.Sequence++
+		event.Sequence = l.Sequence
+		// The hooks are called concurrently, so the events are sorted on the moment they happened
+		index, _ := slices.BinarySearchFunc(l.Events, event.SessionTime, func(e Event, t float32) int {
+			if e.SessionTime <= t {
+				return -1
+			}
+			return 1
+		})
+		l.Events = slices.Insert(l.Events, index, event)
+		l.Updated = event.Received
+	})
+
+	message := Message{LogId: id, ChairId: chair.Id(), SessionUID: header.SessionUID, Event: event}
+	f.OnEvent.Call(message)
+	f.messages.Publish(message)
+}
+
+// rewind removes the events that happened after the session time that was flashed back to
+func (f *Feed) rewind(id string, sessionTime float32) {
+	f.lock.RLock()
+	current, ok := f.logs[id]
+	f.lock.RUnlock()
+	if !ok || !slices.ContainsFunc(current.Events, func(e Event) bool { return e.SessionTime > sessionTime }) {
+		return
+	}
+
+	f.update(id, func(l *Log) {
+		l.Events = slices.DeleteFunc(l.Events, func(e Event) bool { return e.SessionTime > sessionTime })
+		l.Updated = time.Now()
+	})
+}
+
+// update changes a copy of the log and stores it, the log is empty if it did not exist yet
+func (f *Feed) update(id string, change func(l *Log)) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	l := Log{}
+	if current, ok := f.logs[id]; ok {
+		l = *current
+		l.Events = slices.Clone(current.Events)
+	}
+	change(&l)
+
+	if err := f.storage.Set(l.Id, l); err != nil {
+		log.Error("could not store race control log", "id", l.Id, "error", err)
+	}
+	f.logs[l.Id] = &l
+}
@@ -0,0 +1,105 @@
+package racecontrol_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/DaanV2/f1-game-dashboards/server/game"
+	"github.com/DaanV2/f1-game-dashboards/server/history"
+	"github.com/DaanV2/f1-game-dashboards/server/pkg/data"
+	"github.com/DaanV2/f1-game-dashboards/server/racecontrol"
+	"github.com/DaanV2/f1-game-dashboards/server/sessions"
+	"github.com/DaanV2/f1-game-dashboards/server/simulator"
+	"github.com/DaanV2/f1-game-dashboards/server/state"
+	"github.com/DaanV2/go-f1-library/enums"
+	f1_2023 "github.com/DaanV2/go-f1-library/packets/2023"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Feed_LogsSessionEvents(t *testing.T) {
+	processor := game.NewPacketProcessor()
+	sessionState := state.NewStore()
+	sessionState.Subscribe(processor.Pipeline())
+	storage := data.NewMemoryStorage().RaceControl()
+	feed := racecontrol.NewFeed(storage, sessionState)
+	feed.Subscribe(processor.Pipeline())
+
+	chair := sessions.NewChair("test", 20777, true)
+	sim := simulator.NewSimulator(simulator.Options{Cars: 3, Laps: 2, TrackLength: 500, Seed: 1})
+	inject := func() {
+		for _, packet := range sim.Step(time.Second / 20) {
+			require.NoError(t, processor.Inject(chair, packet))
+		}
+		// Gives the hooks time to handle the frame, they are called concurrently
+		time.Sleep(time.Millisecond)
+	}
+
+	inject()
+	first := sim.SessionUID()
+	for sim.SessionUID() == first {
+		inject()
+	}
+	time.Sleep(10 * time.Millisecond)
+
+	l, ok := feed.Log(history.SessionId(chair.Id(), first))
+	require.True(t, ok)
+	require.Equal(t, chair.Id(), l.ChairId)
+	kinds := make([]racecontrol.Kind, len(l.Events))
+	for i, event := range l.Events {
+		kinds[i] = event.Kind
+		require.NotEmpty(t, event.Message)
+		if i > 0 {
+			require.GreaterOrEqual(t, event.SessionTime, l.Events[i-1].SessionTime)
+		}
+	}
+	for _, kind := range []racecontrol.Kind{racecontrol.KindLightsOut, racecontrol.KindFastestLap, racecontrol.KindChequeredFlag, racecontrol.KindRaceWinner} {
+		require.Contains(t, kinds, kind)
+	}
+	require.Equal(t, uint32(len(l.Events)), l.Sequence)
+
+	// A penalty is received, and removed again by a flashback to before it
+	watcher := feed.Watch(chair.Id(), []racecontrol.Kind{racecontrol.KindPenalty})
+	defer watcher.Close()
+	second := sim.SessionUID()
+	send := func(code f1_2023.EventCode, sessionTime float32, details any) {
+		packet, err := simulator.EncodePacketEventData(f1_2023.PacketEventData{
+			Header: f1_2023.PacketHeader{
+				PacketFormat: enums.PF_F1_2023,
+				GameYear:     23,
+				PacketId:     enums.PID_Event,
+				SessionUID:   second,
+				SessionTime:  sessionTime,
+			},
+			EventStringCode: code,
+			EventDetails:    details,
+		})
+		require.NoError(t, err)
+		require.NoError(t, processor.Inject(chair, packet))
+		time.Sleep(10 * time.Millisecond)
+	}
+	send(f1_2023.EC_PenaltyIssued, 1000, f1_2023.Penalty{PenaltyType: f1_2023.P_TimePenalty, VehicleIdx: 1, OtherVehicleIdx: 255, Time: 5, LapNum: 2})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	require.NoError(t, watcher.Run(ctx, func(message racecontrol.Message) error {
+		require.Equal(t, racecontrol.KindPenalty, message.Event.Kind)
+		require.Equal(t, "time penalty", message.Event.Penalty.Type)
+		require.Len(t, message.Event.Cars, 1)
+		require.Contains(t, message.Event.Message, "(5s)")
+		cancel()
+		return nil
+	}))
+
+	id := history.SessionId(chair.Id(), second)
+	l, ok = feed.Log(id)
+	require.True(t, ok)
+	require.True(t, slices.ContainsFunc(l.Events, func(e racecontrol.Event) bool { return e.Kind == racecontrol.KindPenalty }))
+	send(f1_2023.EC_Flashback, 1001, f1_2023.Flashback{FlashbackSessionTime: 999})
+	l, _ = feed.Log(id)
+	require.False(t, slices.ContainsFunc(l.Events, func(e racecontrol.Event) bool { return e.Kind == racecontrol.KindPenalty }))
+
+	// The logs are stored, a new feed loads them
+	require.Len(t, racecontrol.NewFeed(storage, sessionState).Logs(chair.Id()), 2)
+}
@@ -0,0 +1,59 @@
+package racecontrol
+
+import (
+	"context"
+	"slices"
+
+	"github.com/DaanV2/f1-game-dashboards/server/pkg/pubsub"
+)
+
+// watchBuffer is the amount of events a watcher buffers before they are dropped
+const watchBuffer = 128
+
+// Watcher receives the new events of a chair, or of all chairs
+type Watcher struct {
+	chairId  string
+	kinds    []Kind
+	messages *pubsub.Subscription[Message]
+}
+
+// Watch starts receiving the events of the chair, all chairs if the id is empty. Only the given kinds are received,
+// all kinds if there are none. Run sends them and Close stops watching
+func (f *Feed) Watch(chairId string, kinds []Kind) *Watcher {
+	w := &Watcher{chairId: chairId, kinds: kinds}
+	// Filtered before buffering, so frequent events of other chairs or kinds can not crowd out the watched ones
+	w.messages = f.messages.SubscribeFunc(watchBuffer, w.match)
+
+	return w
+}
+
+// Run sends the new events until the context is done or sending fails
+func (w *Watcher) Run(ctx context.Context, send func(Message) error) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case message, ok := <-w.messages.Values():
+			if !ok {
+				return nil
+			}
+			if err := send(message); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// match returns true if the message is of the watched chair and kinds
+func (w *Watcher) match(message Message) bool {
+	if w.chairId != "" && message.ChairId != w.chairId {
+		return false
+	}
+
+	return len(w.kinds) == 0 || slices.Contains(w.kinds, message.Event.Kind)
+}
+
+// Close stops watching
+func (w *Watcher) Close() {
+	w.messages.Close()
+}
@@ -3,12 +3,17 @@ package sessions
 import (
 	"fmt"
 	"maps"
+	"slices"
 	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/DaanV2/f1-game-dashboards/server/pkg/hooks"
 )
 
+// maxIdLength is the longest stable id of a chair
+const maxIdLength = 64
+
 type (
 	// ChairManager is a struct that manages all the chairs
 	ChairManager struct {
@@ -22,23 +27,61 @@ type (
 
 	// Chair is a readonly struct that represents a chair
 	Chair struct {
-		Active bool   `json:"is_active"` // readonly, If the chair is active
-		Name   string `json:"name"`      // readonly, The name of the chair
-		Port   int    `json:"port"`      // readonly, The upd port of the chair
+		Active   bool      `json:"is_active"`          // readonly, If the chair is active
+		Name     string    `json:"name"`               // readonly, The name of the chair
+		Port     int       `json:"port"`               // readonly, The upd port of the chair, 0 if it only receives on the shared port
+		Key      string    `json:"id,omitempty"`       // readonly, The stable id of the chair, the port is the id if empty
+		Sources  []string  `json:"sources,omitempty"`  // readonly, The source addresses, ip or ip:port, routed to the chair on the shared port. Rigs behind the same ip need their ip:port
+		Forwards []Forward `json:"forwards,omitempty"` // readonly, The destinations the datagrams of the chair are re-sent to
+	}
+
+	// Forward is a destination the raw datagrams of a chair are re-sent to, such as SimHub or a motion platform
+	Forward struct {
+		Address   string  `json:"address"`              // host:port of the destination
+		PacketIds []uint8 `json:"packet_ids,omitempty"` // Only these packet ids are forwarded, all packets if empty
+		MaxRate   int     `json:"max_rate,omitempty"`   // The most datagrams per second, unlimited if 0
 	}
 )
 
 // NewChair creates a new chair
 func NewChair(name string, port int, active bool) Chair {
 	return Chair{
-		active,
-		name,
-		port,
+		Active: active,
+		Name:   name,
+		Port:   port,
 	}
 }
 
-// Id returns the id of the chair
+// WithId returns a copy of the chair with a stable id that does not depend on the port
+func (c Chair) WithId(id string) Chair {
+	c.Key = id
+	return c
+}
+
+// WithSources returns a copy of the chair that receives the datagrams of the given source addresses on the shared port.
+// The session and player car of a rig are only learned after one of its datagrams matched a source, they never route a
+// datagram to a chair on their own. Rigs behind the same ip can only be told apart by their ip:port
+func (c Chair) WithSources(sources []string) Chair {
+	c.Sources = slices.Clone(sources)
+	return c
+}
+
+// WithForwards returns a copy of the chair that forwards its datagrams to the given destinations
+func (c Chair) WithForwards(forwards []Forward) Chair {
+	c.Forwards = slices.Clone(forwards)
+	return c
+}
+
+// Equal returns true if the forwards have the same destination, filter and rate
+func (f Forward) Equal(other Forward) bool {
+	return f.Address == other.Address && f.MaxRate == other.MaxRate && slices.Equal(f.PacketIds, other.PacketIds)
+}
+
+// Id returns the id of the chair, the port if it has no stable id
 func (c *Chair) Id() string {
+	if c.Key != "" {
+		return c.Key
+	}
 	return fmt.Sprint(c.Port)
 }
 
@@ -99,8 +142,16 @@ func (cm *ChairManager) All() map[string]Chair {
 	return maps.Clone(cm.chairs)
 }
 
-// IsChairId checks if the id is a valid chair id
+// IsChairId checks if the id is a valid chair id, a port or a stable id of letters, digits, dashes and underscores
 func IsChairId(id string) bool {
-	_, err := strconv.Atoi(id)
-	return err == nil
+	if _, err := strconv.Atoi(id); err == nil {
+		return true
+	}
+	if id == "" || len(id) > maxIdLength {
+		return false
+	}
+
+	return !strings.ContainsFunc(id, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_')
+	})
 }
\ No newline at end of file
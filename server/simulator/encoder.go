@@ -0,0 +1,66 @@
+package simulator
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// encoder writes little endian values into a fixed size packet, the counterpart of encoding.Decoder
+type encoder struct {
+	buf   []byte
+	index int
+}
+
+func newEncoder(size int) *encoder {
+	return &encoder{
+		buf: make([]byte, size),
+	}
+}
+
+// Bytes returns the packet, any bytes that were not written are zero
+func (e *encoder) Bytes() []byte {
+	return e.buf
+}
+
+func (e *encoder) Uint8(v uint8) {
+	e.buf[e.index] = v
+	e.index++
+}
+
+func (e *encoder) Int8(v int8) {
+	e.Uint8(uint8(v))
+}
+
+func (e *encoder) Uint16(v uint16) {
+	binary.LittleEndian.PutUint16(e.buf[e.index:], v)
+	e.index += 2
+}
+
+func (e *encoder) Int16(v int16) {
+	e.Uint16(uint16(v))
+}
+
+func (e *encoder) Uint32(v uint32) {
+	binary.LittleEndian.PutUint32(e.buf[e.index:], v)
+	e.index += 4
+}
+
+func (e *encoder) Uint64(v uint64) {
+	binary.LittleEndian.PutUint64(e.buf[e.index:], v)
+	e.index += 8
+}
+
+func (e *encoder) Float32(v float32) {
+	e.Uint32(math.Float32bits(v))
+}
+
+func (e *encoder) Write(p []byte) {
+	e.index += copy(e.buf[e.index:], p)
+}
+
+// write4Times writes all four values of a per wheel array
+func write4Times[T any](f func(T), values [4]T) {
+	for _, v := range values {
+		f(v)
+	}
+}
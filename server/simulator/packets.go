@@ -0,0 +1,275 @@
+package simulator
+
+import (
+	"fmt"
+
+	f1_2023 "github.com/DaanV2/go-f1-library/packets/2023"
+)
+
+// encodePacketHeader writes the header in the F1 2023 layout
+func encodePacketHeader(e *encoder, header f1_2023.PacketHeader) {
+	e.Uint16(uint16(header.PacketFormat))
+	e.Uint8(header.GameYear)
+	e.Uint8(header.GameMajorVersion)
+	e.Uint8(header.GameMinorVersion)
+	e.Uint8(header.PacketVersion)
+	e.Uint8(uint8(header.PacketId))
+	e.Uint64(header.SessionUID)
+	e.Float32(header.SessionTime)
+	e.Uint32(header.FrameIdentifier)
+	e.Uint32(header.OverallFrameIdentifier)
+	e.Uint8(header.PlayerCarIndex)
+	e.Uint8(header.SecondaryPlayerCarIndex)
+}
+
+// EncodePacketMotionData serialises the packet in the F1 2023 layout
+func EncodePacketMotionData(packet f1_2023.PacketMotionData) []byte {
+	e := newEncoder(f1_2023.PacketMotionDataSize)
+	encodePacketHeader(e, packet.Header)
+
+	for _, car := range packet.CarMotionData {
+		e.Float32(car.WorldPositionX)
+		e.Float32(car.WorldPositionY)
+		e.Float32(car.WorldPositionZ)
+		e.Float32(car.WorldVelocityX)
+		e.Float32(car.WorldVelocityY)
+		e.Float32(car.WorldVelocityZ)
+		e.Int16(car.WorldForwardDirX)
+		e.Int16(car.WorldForwardDirY)
+		e.Int16(car.WorldForwardDirZ)
+		e.Int16(car.WorldRightDirX)
+		e.Int16(car.WorldRightDirY)
+		e.Int16(car.WorldRightDirZ)
+		e.Float32(car.GForceLateral)
+		e.Float32(car.GForceLongitudinal)
+		e.Float32(car.GForceVertical)
+		e.Float32(car.Yaw)
+		e.Float32(car.Pitch)
+		e.Float32(car.Roll)
+	}
+
+	return e.Bytes()
+}
+
+// EncodePacketLapData serialises the packet in the F1 2023 layout
+func EncodePacketLapData(packet f1_2023.PacketLapData) []byte {
+	e := newEncoder(f1_2023.PacketLapDataSize)
+	encodePacketHeader(e, packet.Header)
+
+	for _, lap := range packet.LapData {
+		e.Uint32(lap.LastLapTimeInMS)
+		e.Uint32(lap.CurrentLapTimeInMS)
+		e.Uint16(lap.Sector1TimeInMS)
+		e.Uint8(lap.Sector1TimeMinutes)
+		e.Uint16(lap.Sector2TimeInMS)
+		e.Uint8(lap.Sector2TimeMinutes)
+		e.Uint16(lap.DeltaToCarInFrontInMS)
+		e.Uint16(lap.DeltaToRaceLeaderInMS)
+		e.Float32(lap.LapDistance)
+		e.Float32(lap.TotalDistance)
+		e.Float32(lap.SafetyCarDelta)
+		e.Uint8(lap.CarPosition)
+		e.Uint8(lap.CurrentLapNum)
+		e.Uint8(lap.PitStatus)
+		e.Uint8(lap.NumPitStops)
+		e.Uint8(lap.Sector)
+		e.Uint8(lap.CurrentLapInvalid)
+		e.Uint8(lap.Penalties)
+		e.Uint8(lap.TotalWarnings)
+		e.Uint8(lap.CornerCuttingWarnings)
+		e.Uint8(lap.NumUnservedDriveThroughPens)
+		e.Uint8(lap.NumUnservedStopGoPens)
+		e.Uint8(lap.GridPosition)
+		e.Uint8(lap.DriverStatus)
+		e.Uint8(lap.ResultStatus)
+		e.Uint8(lap.PitLaneTimerActive)
+		e.Uint16(lap.PitLaneTimeInLaneInMS)
+		e.Uint16(lap.PitStopTimerInMS)
+		e.Uint8(lap.PitStopShouldServePen)
+	}
+	e.Uint8(packet.TimeTrialPBCarIdx)
+	e.Uint8(packet.TimeTrialRivalCarIdx)
+
+	return e.Bytes()
+}
+
+// EncodePacketCarTelemetryData serialises the packet in the F1 2023 layout
+func EncodePacketCarTelemetryData(packet f1_2023.PacketCarTelemetryData) []byte {
+	e := newEncoder(f1_2023.PacketCarTelemetryDataSize)
+	encodePacketHeader(e, packet.Header)
+
+	for _, car := range packet.CarTelemetryData {
+		e.Uint16(car.Speed)
+		e.Float32(car.Throttle)
+		e.Float32(car.Steer)
+		e.Float32(car.Brake)
+		e.Uint8(car.Clutch)
+		e.Int8(car.Gear)
+		e.Uint16(car.EngineRPM)
+		e.Uint8(car.Drs)
+		e.Uint8(car.RevLightsPercent)
+		e.Uint16(car.RevLightsBitValue)
+		write4Times(e.Uint16, car.BrakesTemperature)
+		write4Times(e.Uint8, car.TyresSurfaceTemperature)
+		write4Times(e.Uint8, car.TyresInnerTemperature)
+		e.Uint16(car.EngineTemperature)
+		write4Times(e.Float32, car.TyresPressure)
+		write4Times(e.Uint8, car.SurfaceType)
+	}
+	e.Uint8(packet.MfdPanelIndex)
+	e.Uint8(packet.MfdPanelIndexSecondaryPlayer)
+	e.Int8(packet.SuggestedGear)
+
+	return e.Bytes()
+}
+
+// EncodePacketParticipantsData serialises the packet in the F1 2023 layout
+func EncodePacketParticipantsData(packet f1_2023.PacketParticipantsData) []byte {
+	e := newEncoder(f1_2023.PacketParticipantsDataSize)
+	encodePacketHeader(e, packet.Header)
+
+	e.Uint8(packet.NumActiveCars)
+	for _, p := range packet.Participants {
+		e.Uint8(p.AiControlled)
+		e.Uint8(uint8(p.DriverId))
+		e.Uint8(p.NetworkId)
+		e.Uint8(uint8(p.TeamId))
+		e.Uint8(p.MyTeam)
+		e.Uint8(p.RaceNumber)
+		e.Uint8(uint8(p.Nationality))
+		e.Write(p.Name[:])
+		e.Uint8(p.YourTelemetry)
+		e.Uint8(p.ShowOnlineNames)
+		e.Uint8(uint8(p.Platform))
+	}
+
+	return e.Bytes()
+}
+
+// EncodePacketSessionData serialises the packet in the F1 2023 layout
+func EncodePacketSessionData(packet f1_2023.PacketSessionData) []byte {
+	e := newEncoder(f1_2023.PacketSessionDataSize)
+	encodePacketHeader(e, packet.Header)
+
+	e.Uint8(uint8(packet.Weather))
+	e.Int8(packet.TrackTemperature)
+	e.Int8(packet.AirTemperature)
+	e.Uint8(packet.TotalLaps)
+	e.Uint16(packet.TrackLength)
+	e.Uint8(uint8(packet.SessionType))
+	e.Int8(packet.TrackId)
+	e.Uint8(uint8(packet.Formula))
+	e.Uint16(packet.SessionTimeLeft)
+	e.Uint16(packet.SessionDuration)
+	e.Uint8(packet.PitSpeedLimit)
+	e.Uint8(packet.GamePaused)
+	e.Uint8(packet.IsSpectating)
+	e.Uint8(packet.SpectatorCarIndex)
+	e.Uint8(packet.SliProNativeSupport)
+	e.Uint8(packet.NumMarshalZones)
+	for _, zone := range packet.MarshalZones {
+		e.Float32(zone.ZoneStart)
+		e.Int8(int8(zone.ZoneFlag))
+	}
+	e.Uint8(packet.SafetyCarStatus)
+	e.Uint8(packet.NetworkGame)
+	e.Uint8(packet.NumWeatherForecastSamples)
+	for _, sample := range packet.WeatherForecastSamples {
+		e.Uint8(uint8(sample.SessionType))
+		e.Uint8(sample.TimeOffset)
+		e.Uint8(uint8(sample.WeatherType))
+		e.Int8(sample.TrackTemperature)
+		e.Int8(int8(sample.TrackTemperatureChange))
+		e.Int8(sample.AirTemperature)
+		e.Int8(int8(sample.AirTemperatureChange))
+		e.Uint8(sample.RainPercentage)
+	}
+	e.Uint8(packet.ForecastAccuracy)
+	e.Uint8(packet.AiDifficulty)
+	e.Uint32(packet.SeasonLinkIdentifier)
+	e.Uint32(packet.WeekendLinkIdentifier)
+	e.Uint32(packet.SessionLinkIdentifier)
+	e.Uint8(packet.PitStopWindowIdealLap)
+	e.Uint8(packet.PitStopWindowLatestLap)
+	e.Uint8(packet.PitStopRejoinPosition)
+	e.Uint8(packet.SteeringAssist)
+	e.Uint8(packet.BrakingAssist)
+	e.Uint8(packet.GearboxAssist)
+	e.Uint8(packet.PitAssist)
+	e.Uint8(packet.PitReleaseAssist)
+	e.Uint8(packet.ERSAssist)
+	e.Uint8(packet.DRSAssist)
+	e.Uint8(packet.DynamicRacingLine)
+	e.Uint8(packet.DynamicRacingLineType)
+	e.Uint8(packet.GameMode)
+	e.Uint8(packet.RuleSet)
+	e.Uint32(packet.TimeOfDay)
+	e.Uint8(packet.SessionLength)
+	e.Uint8(uint8(packet.SpeedUnitsLeadPlayer))
+	e.Uint8(uint8(packet.TemperatureUnitsLeadPlayer))
+	e.Uint8(uint8(packet.SpeedUnitsSecondaryPlayer))
+	e.Uint8(uint8(packet.TemperatureUnitsSecondaryPlayer))
+	e.Uint8(packet.NumSafetyCarPeriods)
+	e.Uint8(packet.NumVirtualSafetyCarPeriods)
+	e.Uint8(packet.NumRedFlagPeriods)
+
+	return e.Bytes()
+}
+
+// EncodePacketEventData serialises the packet in the F1 2023 layout, the event details need to be one of
+// the f1_2023 event structs (not a pointer) or nil for events without details
+func EncodePacketEventData(packet f1_2023.PacketEventData) ([]byte, error) {
+	if len(packet.EventStringCode) != 4 {
+		return nil, fmt.Errorf("invalid event code: %q", packet.EventStringCode)
+	}
+
+	e := newEncoder(f1_2023.PacketEventDataSize)
+	encodePacketHeader(e, packet.Header)
+	e.Write([]byte(packet.EventStringCode))
+
+	switch details := packet.EventDetails.(type) {
+	case nil:
+	case f1_2023.FastestLap:
+		e.Uint8(details.VehicleIdx)
+		e.Float32(details.LapTime)
+	case f1_2023.Retirement:
+		e.Uint8(details.VehicleIdx)
+	case f1_2023.TeamMateInPits:
+		e.Uint8(details.VehicleIdx)
+	case f1_2023.RaceWinner:
+		e.Uint8(details.VehicleIdx)
+	case f1_2023.Penalty:
+		e.Uint8(uint8(details.PenaltyType))
+		e.Uint8(uint8(details.InfringementType))
+		e.Uint8(details.VehicleIdx)
+		e.Uint8(details.OtherVehicleIdx)
+		e.Uint8(details.Time)
+		e.Uint8(details.LapNum)
+		e.Uint8(details.PlacesGained)
+	case f1_2023.SpeedTrap:
+		e.Uint8(details.VehicleIdx)
+		e.Float32(details.Speed)
+		e.Uint8(details.IsOverallFastestInSession)
+		e.Uint8(details.IsDriverFastestInSession)
+		e.Uint8(details.FastestVehicleIdxInSession)
+		e.Float32(details.FastestSpeedInSession)
+	case f1_2023.StartLights:
+		e.Uint8(details.NumLights)
+	case f1_2023.DriveThroughPenaltyServed:
+		e.Uint8(details.VehicleIdx)
+	case f1_2023.StopGoPenaltyServed:
+		e.Uint8(details.VehicleIdx)
+	case f1_2023.Flashback:
+		e.Uint32(details.FlashbackFrameIdentifier)
+		e.Float32(details.FlashbackSessionTime)
+	case f1_2023.Buttons:
+		e.Uint32(uint32(details.ButtonStatus))
+	case f1_2023.Overtake:
+		e.Uint8(details.OvertakingVehicleIdx)
+		e.Uint8(details.BeingOvertakenVehicleIdx)
+	default:
+		return nil, fmt.Errorf("unsupported event details: %T", details)
+	}
+
+	return e.Bytes(), nil
+}
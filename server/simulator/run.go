@@ -0,0 +1,36 @@
+package simulator
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+)
+
+// Run steps the simulation in real time at the given rate (steps per second) and writes every packet
+// to the writer, until the context is done. Returns the amount of packets written
+func Run(ctx context.Context, sim *Simulator, w io.Writer, rate int) (int, error) {
+	if rate <= 0 {
+		return 0, errors.New("rate must be larger than 0")
+	}
+
+	interval := time.Second / time.Duration(rate)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	count := 0
+	for {
+		for _, packet := range sim.Step(interval) {
+			if _, err := w.Write(packet); err != nil {
+				return count, err
+			}
+			count++
+		}
+
+		select {
+		case <-ctx.Done():
+			return count, nil
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,476 @@
+package simulator
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/DaanV2/go-f1-library/enums"
+	f1_2023 "github.com/DaanV2/go-f1-library/packets/2023"
+)
+
+const (
+	// MaxCars is the maximum amount of cars in a single session
+	MaxCars = 22
+
+	sessionInterval = 500 * time.Millisecond
+	sessionDuration = 2 * time.Hour
+	gridSpacing     = 8  // metres between cars on the grid
+	corners         = 4  // slow sections per lap
+	basePace        = 70 // average top speed of the fastest car in m/s
+	gearSpeed       = 40 // km/h per gear
+	minRPM          = 4_000
+	maxRPM          = 11_000
+	gravity         = 9.81
+
+	// participantsInterval is how often the participants are send, like the game does
+	participantsInterval = 5 * time.Second
+)
+
+type (
+	// Options configures the simulated session
+	Options struct {
+		Cars        int     // The amount of cars driving, at most MaxCars
+		Laps        uint8   // The amount of laps before the session ends and a new one starts
+		TrackLength float32 // The length of a lap in metres
+		TrackId     int8    // The track id send in the session packet
+		Seed        int64   // The seed of the random variation between cars and laps
+		PlayerName  string  // The name of the player car in the participants packet
+	}
+
+	// Simulator generates valid F1 2023 packets for cars driving laps around a circular track.
+	// It is not safe for concurrent use
+	Simulator struct {
+		options Options
+		random  *rand.Rand
+
+		session        uint64
+		sessionTime    time.Duration
+		lastSession    time.Duration
+		lastPlayers    time.Duration
+		frame          uint32
+		overallFrame   uint32
+		fastestLapInMS uint32
+		finished       bool
+		cars           []car
+		pendingEvents  []f1_2023.PacketEventData
+	}
+
+	car struct {
+		pace          float32 // top speed in m/s, varies per lap
+		speed         float32 // current speed in m/s
+		acceleration  float32 // current acceleration in m/s²
+		lapDistance   float32
+		totalDistance float32
+		lap           uint8
+		lapTime       time.Duration
+		lastLapTime   time.Duration
+		sector1       time.Duration
+		sector2       time.Duration
+		position      uint8
+		gridPosition  uint8
+	}
+)
+
+// DefaultOptions returns the options for a full grid on a 5km track
+func DefaultOptions() Options {
+	return Options{
+		Cars:        20,
+		Laps:        5,
+		TrackLength: 5_000,
+		TrackId:     0,
+		Seed:        time.Now().UnixNano(),
+		PlayerName:  "Player",
+	}
+}
+
+// NewSimulator creates a new simulator, the first step starts a new session
+func NewSimulator(options Options) *Simulator {
+	options.Cars = min(max(options.Cars, 1), MaxCars)
+	options.Laps = max(options.Laps, 1)
+	if options.TrackLength <= 0 {
+		options.TrackLength = DefaultOptions().TrackLength
+	}
+	if options.PlayerName == "" {
+		options.PlayerName = DefaultOptions().PlayerName
+	}
+
+	return &Simulator{
+		options: options,
+		random:  rand.New(rand.NewSource(options.Seed)),
+	}
+}
+
+// SessionUID returns the uid of the current session, 0 before the first step
+func (s *Simulator) SessionUID() uint64 {
+	return s.session
+}
+
+// Step advances the simulation by the given time and returns the packets of that frame.
+// Motion, lap data and telemetry are send every step, the session packet twice per second, the participants every 5 seconds
+// and events when they happen
+func (s *Simulator) Step(dt time.Duration) [][]byte {
+	packets := make([][]byte, 0, 5)
+	if s.session == 0 {
+		s.startSession()
+	} else {
+		s.frame++
+		s.overallFrame++
+		s.sessionTime += dt
+		s.advance(dt)
+	}
+
+	packets = append(packets, EncodePacketMotionData(s.motion()))
+	packets = append(packets, EncodePacketLapData(s.lapData()))
+	packets = append(packets, EncodePacketCarTelemetryData(s.telemetry()))
+
+	if s.frame == 0 || s.sessionTime-s.lastSession >= sessionInterval {
+		s.lastSession = s.sessionTime
+		packets = append(packets, EncodePacketSessionData(s.sessionData()))
+	}
+	if s.frame == 0 || s.sessionTime-s.lastPlayers >= participantsInterval {
+		s.lastPlayers = s.sessionTime
+		packets = append(packets, EncodePacketParticipantsData(s.participants()))
+	}
+
+	for _, event := range s.pendingEvents {
+		if data, err := EncodePacketEventData(event); err == nil {
+			packets = append(packets, data)
+		}
+	}
+	s.pendingEvents = s.pendingEvents[:0]
+
+	// Leader finished, the next step starts a new session
+	if s.finished {
+		s.session = 0
+	}
+
+	return packets
+}
+
+func (s *Simulator) startSession() {
+	for s.session == 0 {
+		s.session = s.random.Uint64()
+	}
+	s.sessionTime = 0
+	s.lastSession = 0
+	s.lastPlayers = 0
+	s.frame = 0
+	s.fastestLapInMS = 0
+	s.finished = false
+
+	s.cars = make([]car, s.options.Cars)
+	for i := range s.cars {
+		c := &s.cars[i]
+		c.lap = 1
+		c.lapDistance = -float32(i) * gridSpacing
+		c.totalDistance = c.lapDistance
+		c.position = uint8(i + 1)
+		c.gridPosition = c.position
+		c.newLap(s.random, i)
+	}
+
+	s.event(f1_2023.EC_SessionStarted, nil)
+	s.event(f1_2023.EC_LightsOut, nil)
+}
+
+func (s *Simulator) advance(dt time.Duration) {
+	length := s.options.TrackLength
+	seconds := float32(dt.Seconds())
+
+	for i := range s.cars {
+		c := &s.cars[i]
+		previous := c.speed
+		c.speed = c.speedAt(c.lapDistance / length)
+		if seconds > 0 {
+			c.acceleration = (c.speed - previous) / seconds
+		}
+
+		distance := c.speed * seconds
+		c.lapDistance += distance
+		c.totalDistance += distance
+		c.lapTime += dt
+
+		if c.sector1 == 0 && c.lapDistance >= length/3 {
+			c.sector1 = c.lapTime
+		}
+		if c.sector2 == 0 && c.lapDistance >= length*2/3 {
+			c.sector2 = c.lapTime - c.sector1
+		}
+		if c.lapDistance >= length {
+			s.finishLap(i)
+		}
+	}
+
+	s.updatePositions()
+}
+
+func (s *Simulator) finishLap(index int) {
+	c := &s.cars[index]
+	c.lapDistance -= s.options.TrackLength
+	c.lastLapTime = c.lapTime
+	c.lapTime = 0
+	c.sector1 = 0
+	c.sector2 = 0
+	c.lap++
+	c.newLap(s.random, index)
+
+	lapTime := uint32(c.lastLapTime.Milliseconds())
+	if s.fastestLapInMS == 0 || lapTime < s.fastestLapInMS {
+		s.fastestLapInMS = lapTime
+		s.event(f1_2023.EC_FastestLap, f1_2023.FastestLap{
+			VehicleIdx: uint8(index),
+			LapTime:    float32(c.lastLapTime.Seconds()),
+		})
+	}
+
+	if c.lap > s.options.Laps && !s.finished {
+		s.finished = true
+		s.event(f1_2023.EC_ChequeredFlag, nil)
+		s.event(f1_2023.EC_RaceWinner, f1_2023.RaceWinner{VehicleIdx: uint8(index)})
+		s.event(f1_2023.EC_SessionEnded, nil)
+	}
+}
+
+// updatePositions orders the cars on distance and raises overtake events for the cars that swapped places
+func (s *Simulator) updatePositions() {
+	order := make([]int, len(s.cars))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return s.cars[order[a]].totalDistance > s.cars[order[b]].totalDistance
+	})
+
+	previous := make([]uint8, len(s.cars))
+	for position, i := range order {
+		previous[i] = s.cars[i].position
+		s.cars[i].position = uint8(position + 1)
+	}
+
+	for i := range s.cars {
+		for o := range s.cars {
+			if previous[i] > previous[o] && s.cars[i].position < s.cars[o].position {
+				s.event(f1_2023.EC_Overtake, f1_2023.Overtake{
+					OvertakingVehicleIdx:     uint8(i),
+					BeingOvertakenVehicleIdx: uint8(o),
+				})
+			}
+		}
+	}
+}
+
+func (s *Simulator) event(code f1_2023.EventCode, details any) {
+	s.pendingEvents = append(s.pendingEvents, f1_2023.PacketEventData{
+		Header:          s.header(enums.PID_Event),
+		EventStringCode: code,
+		EventDetails:    details,
+	})
+}
+
+func (s *Simulator) header(id enums.PacketId) f1_2023.PacketHeader {
+	return f1_2023.PacketHeader{
+		PacketFormat:            enums.PF_F1_2023,
+		GameYear:                23,
+		GameMajorVersion:        1,
+		GameMinorVersion:        0,
+		PacketVersion:           1,
+		PacketId:                id,
+		SessionUID:              s.session,
+		SessionTime:             float32(s.sessionTime.Seconds()),
+		FrameIdentifier:         s.frame,
+		OverallFrameIdentifier:  s.overallFrame,
+		PlayerCarIndex:          0,
+		SecondaryPlayerCarIndex: 255,
+	}
+}
+
+func (s *Simulator) motion() f1_2023.PacketMotionData {
+	packet := f1_2023.PacketMotionData{
+		Header: s.header(enums.PID_Motion),
+	}
+	radius := s.options.TrackLength / (2 * math.Pi)
+
+	for i, c := range s.cars {
+		angle := 2 * math.Pi * float64(c.lapDistance/s.options.TrackLength)
+		sin, cos := float32(math.Sin(angle)), float32(math.Cos(angle))
+
+		packet.CarMotionData[i] = f1_2023.CarMotionData{
+			WorldPositionX:     radius * cos,
+			WorldPositionZ:     radius * sin,
+			WorldVelocityX:     -sin * c.speed,
+			WorldVelocityZ:     cos * c.speed,
+			WorldForwardDirX:   normalized(-sin),
+			WorldForwardDirZ:   normalized(cos),
+			WorldRightDirX:     normalized(cos),
+			WorldRightDirZ:     normalized(sin),
+			GForceLateral:      c.speed * c.speed / radius / gravity,
+			GForceLongitudinal: c.acceleration / gravity,
+			GForceVertical:     1,
+			Yaw:                float32(angle + math.Pi/2),
+		}
+	}
+
+	return packet
+}
+
+func (s *Simulator) lapData() f1_2023.PacketLapData {
+	packet := f1_2023.PacketLapData{
+		Header:               s.header(enums.PID_LapData),
+		TimeTrialPBCarIdx:    255,
+		TimeTrialRivalCarIdx: 255,
+	}
+	positions := make([]int, len(s.cars)+1)
+	for i, c := range s.cars {
+		positions[c.position] = i
+	}
+
+	for i, c := range s.cars {
+		lap := f1_2023.LapData{
+			LastLapTimeInMS:    uint32(c.lastLapTime.Milliseconds()),
+			CurrentLapTimeInMS: uint32(c.lapTime.Milliseconds()),
+			LapDistance:        c.lapDistance,
+			TotalDistance:      c.totalDistance,
+			CarPosition:        c.position,
+			CurrentLapNum:      min(c.lap, s.options.Laps),
+			GridPosition:       c.gridPosition,
+			DriverStatus:       4, // On track
+			ResultStatus:       2, // Active
+		}
+		if c.lap > s.options.Laps {
+			lap.ResultStatus = 3 // Finished
+		}
+		if c.sector1 > 0 {
+			lap.Sector = 1
+			lap.Sector1TimeInMS, lap.Sector1TimeMinutes = splitMinutes(c.sector1)
+		}
+		if c.sector2 > 0 {
+			lap.Sector = 2
+			lap.Sector2TimeInMS, lap.Sector2TimeMinutes = splitMinutes(c.sector2)
+		}
+		if c.position > 1 {
+			front := s.cars[positions[c.position-1]]
+			leader := s.cars[positions[1]]
+			lap.DeltaToCarInFrontInMS = c.gapTo(front)
+			lap.DeltaToRaceLeaderInMS = c.gapTo(leader)
+		}
+
+		packet.LapData[i] = lap
+	}
+
+	return packet
+}
+
+func (s *Simulator) telemetry() f1_2023.PacketCarTelemetryData {
+	packet := f1_2023.PacketCarTelemetryData{
+		Header:                       s.header(enums.PID_CarTelemetry),
+		MfdPanelIndex:                255,
+		MfdPanelIndexSecondaryPlayer: 255,
+	}
+
+	for i, c := range s.cars {
+		kmh := c.speed * 3.6
+		gear := min(max(int(kmh/gearSpeed)+1, 1), 8)
+		rev := min(max((kmh-float32(gear-1)*gearSpeed)/gearSpeed, 0), 1)
+		throttle, brake := float32(1), float32(0)
+		if c.acceleration < 0 {
+			throttle, brake = 0, min(-c.acceleration/(3*gravity), 1)
+		}
+
+		packet.CarTelemetryData[i] = f1_2023.CarTelemetryData{
+			Speed:                   uint16(kmh),
+			Throttle:                throttle,
+			Steer:                   1 - c.speed/c.pace,
+			Brake:                   brake,
+			Gear:                    int8(gear),
+			EngineRPM:               uint16(minRPM + rev*(maxRPM-minRPM)),
+			RevLightsPercent:        uint8(rev * 100),
+			RevLightsBitValue:       uint16(1<<uint(rev*15)) - 1,
+			BrakesTemperature:       [4]uint16{500, 500, 520, 520},
+			TyresSurfaceTemperature: [4]uint8{92, 92, 95, 95},
+			TyresInnerTemperature:   [4]uint8{100, 100, 102, 102},
+			EngineTemperature:       105,
+			TyresPressure:           [4]float32{22.5, 22.5, 21.5, 21.5},
+		}
+	}
+
+	return packet
+}
+
+func (s *Simulator) participants() f1_2023.PacketParticipantsData {
+	packet := f1_2023.PacketParticipantsData{
+		Header:        s.header(enums.PID_Participants),
+		NumActiveCars: uint8(len(s.cars)),
+	}
+	for i := range s.cars {
+		p := &packet.Participants[i]
+		p.AiControlled = 1
+		p.RaceNumber = uint8(i + 1)
+		p.YourTelemetry = 1
+		p.Platform = 255
+		name := fmt.Sprintf("Driver %d", i+1)
+		if i == 0 {
+			p.AiControlled = 0
+			name = s.options.PlayerName
+		}
+		copy(p.Name[:len(p.Name)-1], name)
+	}
+
+	return packet
+}
+
+func (s *Simulator) sessionData() f1_2023.PacketSessionData {
+	return f1_2023.PacketSessionData{
+		Header:                    s.header(enums.PID_Session),
+		Weather:                   enums.WeatherType(0),
+		TrackTemperature:          32,
+		AirTemperature:            24,
+		TotalLaps:                 s.options.Laps,
+		TrackLength:               uint16(s.options.TrackLength),
+		SessionType:               enums.SE_R,
+		TrackId:                   s.options.TrackId,
+		SessionTimeLeft:           uint16(max(sessionDuration-s.sessionTime, 0).Seconds()),
+		SessionDuration:           uint16(sessionDuration.Seconds()),
+		PitSpeedLimit:             80,
+		SpectatorCarIndex:         255,
+		NumWeatherForecastSamples: 0,
+		AiDifficulty:              90,
+		GearboxAssist:             3,
+		SessionLength:             7,
+	}
+}
+
+// newLap picks the pace of the car for the next lap, the further back on the grid the slower the car,
+// with some variation per lap so cars overtake each other
+func (c *car) newLap(random *rand.Rand, index int) {
+	c.pace = basePace * (1 - 0.004*float32(index)) * (0.98 + 0.04*random.Float32())
+}
+
+// speedAt returns the speed of the car at the given fraction of the lap
+func (c *car) speedAt(fraction float32) float32 {
+	return c.pace * (0.8 + 0.2*float32(math.Cos(2*math.Pi*corners*float64(fraction))))
+}
+
+// gapTo returns the time in ms it takes this car to reach the current position of the other car
+func (c *car) gapTo(other car) uint16 {
+	if c.speed <= 0 {
+		return math.MaxUint16
+	}
+	gap := (other.totalDistance - c.totalDistance) / c.speed * 1000
+
+	return uint16(min(max(gap, 0), math.MaxUint16))
+}
+
+// splitMinutes splits the duration into the milliseconds and minutes parts used by the sector times
+func splitMinutes(d time.Duration) (uint16, uint8) {
+	ms := d.Milliseconds()
+
+	return uint16(ms % 60_000), uint8(ms / 60_000)
+}
+
+// normalized converts a normalised direction into the int16 representation of the motion packet
+func normalized(v float32) int16 {
+	return int16(v * math.MaxInt16)
+}
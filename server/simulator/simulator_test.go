@@ -0,0 +1,79 @@
+package simulator_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DaanV2/f1-game-dashboards/server/simulator"
+	"github.com/DaanV2/go-f1-library/encoding"
+	"github.com/DaanV2/go-f1-library/enums"
+	f1_2023 "github.com/DaanV2/go-f1-library/packets/2023"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Simulator_PacketsDecode(t *testing.T) {
+	sim := simulator.NewSimulator(simulator.Options{
+		Cars:        20,
+		Laps:        2,
+		TrackLength: 1_000,
+		Seed:        1,
+	})
+
+	counts := make(map[enums.PacketId]int)
+	events := make(map[f1_2023.EventCode]int)
+	sessions := make(map[uint64]bool)
+	var lastLap f1_2023.PacketLapData
+
+	// A bit more than 2 laps of 1km at ~200km/h
+	for i := 0; i < 60*60; i++ {
+		for _, data := range sim.Step(time.Second / 60) {
+			decoder := encoding.NewDecoder(data)
+			header, err := f1_2023.ParsePacketHeader(decoder)
+			require.NoError(t, err)
+			require.Equal(t, enums.PF_F1_2023, header.PacketFormat)
+			sessions[header.SessionUID] = true
+			counts[header.PacketId]++
+
+			switch header.PacketId {
+			case enums.PID_Motion:
+				_, err = f1_2023.ParsePacketMotionDataWithHeader(decoder, header)
+			case enums.PID_LapData:
+				lastLap, err = f1_2023.ParsePacketLapDataWithHeader(decoder, header)
+			case enums.PID_CarTelemetry:
+				_, err = f1_2023.ParsePacketCarTelemetryDataWithHeader(decoder, header)
+			case enums.PID_Session:
+				var session f1_2023.PacketSessionData
+				session, err = f1_2023.ParsePacketSessionDataWithHeader(decoder, header)
+				require.Equal(t, uint8(2), session.TotalLaps)
+			case enums.PID_Participants:
+				var participants f1_2023.PacketParticipantsData
+				participants, err = f1_2023.ParsePacketParticipantsDataWithHeader(decoder, header)
+				require.Equal(t, "Player", participants.Participants[header.PlayerCarIndex].ParticipantName())
+			case enums.PID_Event:
+				var event f1_2023.PacketEventData
+				event, err = f1_2023.ParsePacketEventDataWithHeader(decoder, header)
+				events[event.EventStringCode]++
+			default:
+				t.Fatalf("unexpected packet: %v", header.PacketId)
+			}
+			require.NoError(t, err)
+		}
+	}
+
+	require.Equal(t, 60*60, counts[enums.PID_Motion])
+	require.Equal(t, 60*60, counts[enums.PID_LapData])
+	require.Equal(t, 60*60, counts[enums.PID_CarTelemetry])
+	require.Greater(t, counts[enums.PID_Session], 0)
+	require.Greater(t, counts[enums.PID_Participants], 0)
+	require.Equal(t, 1, events[f1_2023.EC_ChequeredFlag])
+	require.Equal(t, 2, events[f1_2023.EC_SessionStarted])
+	require.Greater(t, events[f1_2023.EC_FastestLap], 0)
+	require.Len(t, sessions, 2)
+
+	// Every car has a unique position
+	positions := make(map[uint8]bool)
+	for _, lap := range lastLap.LapData[:20] {
+		positions[lap.CarPosition] = true
+	}
+	require.Len(t, positions, 20)
+}
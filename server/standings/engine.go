@@ -0,0 +1,356 @@
+package standings
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/DaanV2/f1-game-dashboards/server/game"
+	"github.com/DaanV2/f1-game-dashboards/server/pkg/hooks"
+	"github.com/DaanV2/f1-game-dashboards/server/pkg/pubsub"
+	"github.com/DaanV2/f1-game-dashboards/server/sessions"
+	"github.com/DaanV2/f1-game-dashboards/server/state"
+	"github.com/DaanV2/go-f1-library/enums"
+	f1_2023 "github.com/DaanV2/go-f1-library/packets/2023"
+)
+
+const (
+	// publishInterval is how often standings are published when only the gaps changed
+	publishInterval = 500 * time.Millisecond
+
+	resultInactive = 1
+	resultActive   = 2
+	endLapCurrent  = 255
+)
+
+type (
+	// Entry is the place of a car in the standings
+	Entry struct {
+		CarIndex        uint8
+		Position        uint8
+		Name            string // The name of the driver in the game, empty until the participants are received
+		TeamId          uint8
+		RaceNumber      uint8
+		Player          bool // The car of the player on the chair
+		LapNumber       uint8
+		LapDistance     float32
+		TotalDistance   float32
+		GapToLeader     float32 // Seconds behind the leader, 0 for the leader or when unknown
+		Interval        float32 // Seconds behind the car ahead, 0 for the leader or when unknown
+		LapsDown        uint8
+		PitStops        uint8
+		PitStatus       uint8 // 0 = none, 1 = pitting, 2 = in pit area
+		TyreCompound    uint8 // The visual compound, 0 if unknown
+		TyreAgeLaps     uint8
+		LastLapTimeInMS uint32
+		BestLapTimeInMS uint32 // 0 until the session history of the car is received
+		ResultStatus    uint8  // 2 = active, 3 = finished, 4 = did not finish, 5 = disqualified, 6 = not classified, 7 = retired
+	}
+
+	// Standings are the positions of the cars in the session on a chair
+	Standings struct {
+		ChairId     string
+		SessionUID  uint64
+		Race        bool    // The gaps are the time between the cars on track, otherwise between their best laps
+		SessionTime float32 // Seconds
+		Entries     []Entry // Ordered by position
+		Updated     time.Time
+	}
+
+	// Engine computes the standings of the session on every chair from the lap data, participants and session history.
+	// It is safe for concurrent use
+	Engine struct {
+		// OnStandingsChanged is called with the new standings, when the order, laps, pit stops or tyres changed, and
+		// at most every publishInterval when only the gaps changed
+		OnStandingsChanged hooks.Hook[Standings]
+
+		state   *state.Store
+		updates *pubsub.Topic[Standings]
+
+		lock   sync.Mutex
+		chairs map[string]*chairStandings
+	}
+
+	// chairStandings follows the session on a chair
+	chairStandings struct {
+		session   uint64
+		frame     uint32
+		time      float32
+		cars      [state.MaxCars]carTiming
+		standings *Standings // The latest standings, never modified
+		published time.Time
+	}
+)
+
+// NewEngine creates the engine, the state is used for the participants, session history, tyres and session type
+func NewEngine(sessionState *state.Store) *Engine {
+	return &Engine{
+		state:   sessionState,
+		updates: pubsub.NewTopic[Standings](),
+		chairs:  make(map[string]*chairStandings),
+	}
+}
+
+// Subscribe adds the engine to the lap data hook of the pipeline
+func (e *Engine) Subscribe(pipeline *game.PacketPipeline) {
+	pipeline.LapData.Add(func(p game.PacketWithChair[f1_2023.PacketLapData]) {
+		e.handleLapData(p.Chair, &p.Packet)
+	})
+}
+
+// AddChairHooks forgets the standings of a chair when it is removed
+func (e *Engine) AddChairHooks(chairs *sessions.ChairManager) {
+	chairs.OnChairRemoved.Add(func(chair sessions.Chair) {
+		e.lock.Lock()
+		defer e.lock.Unlock()
+		delete(e.chairs, chair.Id())
+	})
+}
+
+// Standings returns the latest standings of the chair, false if no lap data was received for it
+func (e *Engine) Standings(chairId string) (Standings, bool) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	c, ok := e.chairs[chairId]
+	if !ok || c.standings == nil {
+		return Standings{}, false
+	}
+
+	return *c.standings, true
+}
+
+// All returns the latest standings of every chair, ordered by chair id
+func (e *Engine) All() []Standings {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	result := make([]Standings, 0, len(e.chairs))
+	for _, c := range e.chairs {
+		if c.standings != nil {
+			result = append(result, *c.standings)
+		}
+	}
+	slices.SortFunc(result, func(a, b Standings) int { return cmp.Compare(a.ChairId, b.ChairId) })
+
+	return result
+}
+
+func (e *Engine) handleLapData(chair sessions.Chair, packet *f1_2023.PacketLapData) {
+	header := packet.Header
+	snapshot, ok := e.state.Get(chair.Id())
+	if !ok || snapshot.SessionUID != header.SessionUID {
+		snapshot = state.Snapshot{}
+	}
+	now := time.Now()
+
+	e.lock.Lock()
+	c, ok := e.chairs[chair.Id()]
+	if !ok || c.session != header.SessionUID {
+		c = &chairStandings{session: header.SessionUID}
+		for i := range c.cars {
+			c.cars[i] = newCarTiming()
+		}
+		e.chairs[chair.Id()] = c
+	}
+	// The hooks are called concurrently, so an older frame can arrive after a newer one
+	if c.frame > header.OverallFrameIdentifier {
+		e.lock.Unlock()
+		return
+	}
+	c.frame = header.OverallFrameIdentifier
+	// After a flashback the cars pass the timing points again
+	if header.SessionTime < c.time {
+		for i := range c.cars {
+			c.cars[i].rewind(header.SessionTime)
+		}
+	}
+	c.time = header.SessionTime
+
+	for i, lap := range packet.LapData {
+		if lap.ResultStatus == resultActive {
+			c.cars[i].add(lap.TotalDistance, header.SessionTime)
+		}
+	}
+
+	standings := compute(chair.Id(), c, packet, &snapshot, now)
+	previous := c.standings
+	c.standings = &standings
+	publish := previous == nil || changed(previous, &standings) || now.Sub(c.published) >= publishInterval
+	if publish {
+		c.published = now
+	}
+	e.lock.Unlock()
+
+	if publish {
+		e.OnStandingsChanged.Call(standings)
+		e.updates.Publish(standings)
+	}
+}
+
+// compute orders the cars and computes their gaps. The lock must be held
+func compute(chairId string, c *chairStandings, packet *f1_2023.PacketLapData, snapshot *state.Snapshot, now time.Time) Standings {
+	header := packet.Header
+	standings := Standings{
+		ChairId:     chairId,
+		SessionUID:  header.SessionUID,
+		Race:        isRace(snapshot.Session),
+		SessionTime: header.SessionTime,
+		Entries:     make([]Entry, 0, len(packet.LapData)),
+		Updated:     now,
+	}
+
+	for i, lap := range packet.LapData {
+		if lap.ResultStatus <= resultInactive || lap.CarPosition == 0 {
+			continue
+		}
+		entry := Entry{
+			CarIndex:        uint8(i),
+			Position:        lap.CarPosition,
+			Player:          uint8(i) == header.PlayerCarIndex,
+			LapNumber:       lap.CurrentLapNum,
+			LapDistance:     lap.LapDistance,
+			TotalDistance:   lap.TotalDistance,
+			PitStops:        lap.NumPitStops,
+			PitStatus:       lap.PitStatus,
+			LastLapTimeInMS: lap.LastLapTimeInMS,
+			ResultStatus:    lap.ResultStatus,
+		}
+		describe(&entry, snapshot)
+		standings.Entries = append(standings.Entries, entry)
+	}
+	slices.SortFunc(standings.Entries, func(a, b Entry) int { return cmp.Compare(a.Position, b.Position) })
+	if len(standings.Entries) == 0 {
+		return standings
+	}
+
+	leader := &standings.Entries[0]
+	if !standings.Race {
+		for i := 1; i < len(standings.Entries); i++ {
+			entry := &standings.Entries[i]
+			entry.GapToLeader = lapGap(entry.BestLapTimeInMS, leader.BestLapTimeInMS)
+			entry.Interval = lapGap(entry.BestLapTimeInMS, standings.Entries[i-1].BestLapTimeInMS)
+		}
+		return standings
+	}
+
+	lowest := leader.TotalDistance
+	for i := 1; i < len(standings.Entries); i++ {
+		entry := &standings.Entries[i]
+		entry.LapsDown = lapsDown(leader, entry)
+		if entry.ResultStatus != resultActive {
+			continue
+		}
+		lowest = min(lowest, entry.TotalDistance)
+		entry.GapToLeader = c.gap(entry.TotalDistance, leader.CarIndex, header.SessionTime)
+		entry.Interval = c.gap(entry.TotalDistance, standings.Entries[i-1].CarIndex, header.SessionTime)
+	}
+	// No car is compared at a distance behind the last car anymore
+	for i := range c.cars {
+		c.cars[i].prune(lowest - checkpointDistance)
+	}
+
+	return standings
+}
+
+// gap returns the seconds since the car ahead passed the distance, 0 if it is not known
+func (c *chairStandings) gap(distance float32, ahead uint8, now float32) float32 {
+	if int(ahead) >= len(c.cars) {
+		return 0
+	}
+	passed, ok := c.cars[ahead].timeAt(distance)
+	if !ok {
+		return 0
+	}
+
+	return max(now-passed, 0)
+}
+
+// describe adds the details of the car from the participants, session history and car status
+func describe(entry *Entry, snapshot *state.Snapshot) {
+	i := int(entry.CarIndex)
+	if snapshot.Participants != nil && i < len(snapshot.Participants.Participants) {
+		participant := &snapshot.Participants.Participants[i]
+		entry.Name = participant.ParticipantName()
+		entry.TeamId = uint8(participant.TeamId)
+		entry.RaceNumber = participant.RaceNumber
+	}
+
+	if history := snapshot.SessionHistory[i]; history != nil {
+		if best := int(history.BestLapTimeLapNum) - 1; best >= 0 && best < int(history.NumLaps) && best < len(history.LapHistoryData) {
+			entry.BestLapTimeInMS = history.LapHistoryData[best].LapTimeInMS
+		}
+		stints := history.TyreStintsHistoryData[:min(int(history.NumTyreStints), len(history.TyreStintsHistoryData))]
+		if len(stints) > 0 {
+			entry.TyreCompound = stints[len(stints)-1].TyreVisualCompound
+			if len(stints) > 1 && stints[len(stints)-2].EndLap != endLapCurrent && entry.LapNumber > stints[len(stints)-2].EndLap {
+				entry.TyreAgeLaps = entry.LapNumber - 1 - stints[len(stints)-2].EndLap
+			} else {
+				entry.TyreAgeLaps = max(entry.LapNumber, 1) - 1
+			}
+		}
+	}
+
+	// The car status is sent more often than the session history
+	if snapshot.CarStatus != nil && i < len(snapshot.CarStatus.CarStatusData) {
+		status := &snapshot.CarStatus.CarStatusData[i]
+		if status.VisualTyreCompound != 0 {
+			entry.TyreCompound = status.VisualTyreCompound
+			entry.TyreAgeLaps = status.TyresAgeLaps
+		}
+	}
+}
+
+// lapsDown returns how many laps the car is behind the leader
+func lapsDown(leader, entry *Entry) uint8 {
+	if entry.LapNumber >= leader.LapNumber {
+		return 0
+	}
+	down := leader.LapNumber - entry.LapNumber
+	if entry.LapDistance > leader.LapDistance {
+		down--
+	}
+
+	return down
+}
+
+// lapGap returns the seconds between the best laps, 0 if either has no lap time
+func lapGap(lap, ahead uint32) float32 {
+	if lap == 0 || ahead == 0 || lap < ahead {
+		return 0
+	}
+
+	return float32(lap-ahead) / 1000
+}
+
+// isRace returns true if the cars are ranked by the distance they drove, an unknown session is treated as a race
+func isRace(session *f1_2023.PacketSessionData) bool {
+	if session == nil {
+		return true
+	}
+
+	switch session.SessionType {
+	case enums.SE_Unknown, enums.SE_R, enums.SE_R2, enums.SE_R3:
+		return true
+	}
+	return false
+}
+
+// changed returns true if more than the gaps and distances changed
+func changed(previous, next *Standings) bool {
+	if previous.Race != next.Race || len(previous.Entries) != len(next.Entries) {
+		return true
+	}
+
+	for i := range next.Entries {
+		a, b := &previous.Entries[i], &next.Entries[i]
+		if a.CarIndex != b.CarIndex || a.LapNumber != b.LapNumber || a.LapsDown != b.LapsDown || a.PitStops != b.PitStops ||
+			a.PitStatus != b.PitStatus || a.TyreCompound != b.TyreCompound || a.BestLapTimeInMS != b.BestLapTimeInMS ||
+			a.ResultStatus != b.ResultStatus || a.Name != b.Name {
+			return true
+		}
+	}
+
+	return false
+}
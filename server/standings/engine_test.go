@@ -0,0 +1,67 @@
+package standings_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DaanV2/f1-game-dashboards/server/game"
+	"github.com/DaanV2/f1-game-dashboards/server/sessions"
+	"github.com/DaanV2/f1-game-dashboards/server/simulator"
+	"github.com/DaanV2/f1-game-dashboards/server/standings"
+	"github.com/DaanV2/f1-game-dashboards/server/state"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Engine_GapsBetweenCars(t *testing.T) {
+	processor := game.NewPacketProcessor()
+	sessionState := state.NewStore()
+	sessionState.Subscribe(processor.Pipeline())
+	engine := standings.NewEngine(sessionState)
+	engine.Subscribe(processor.Pipeline())
+
+	chair := sessions.NewChair("test", 20777, true)
+	sim := simulator.NewSimulator(simulator.Options{Cars: 4, Laps: 5, TrackLength: 1000, Seed: 1})
+	inject := func() {
+		for _, packet := range sim.Step(time.Second / 20) {
+			require.NoError(t, processor.Inject(chair, packet))
+		}
+		// Gives the hooks time to handle the frame, they are called concurrently
+		time.Sleep(time.Millisecond)
+	}
+
+	watcher := engine.Watch(chair.Id())
+	defer watcher.Close()
+	for range 20 * 20 {
+		inject()
+	}
+
+	result, ok := engine.Standings(chair.Id())
+	require.True(t, ok)
+	require.True(t, result.Race)
+	require.Len(t, result.Entries, 4)
+	leader := result.Entries[0]
+	require.Equal(t, uint8(1), leader.Position)
+	require.Zero(t, leader.GapToLeader)
+	for i, entry := range result.Entries[1:] {
+		ahead := result.Entries[i]
+		require.Equal(t, ahead.Position+1, entry.Position)
+		require.LessOrEqual(t, entry.TotalDistance, ahead.TotalDistance)
+		require.Positive(t, entry.GapToLeader)
+		require.Positive(t, entry.Interval)
+		require.InDelta(t, entry.GapToLeader, ahead.GapToLeader+entry.Interval, 0.2)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	received := 0
+	err := watcher.Run(ctx, func(s standings.Standings) error {
+		require.Equal(t, chair.Id(), s.ChairId)
+		if received++; received == 5 {
+			cancel()
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, received, 5)
+}
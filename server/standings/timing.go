@@ -0,0 +1,92 @@
+package standings
+
+import "math"
+
+// checkpointDistance is the distance between the timing points the cars are timed at, in metres
+const checkpointDistance = 10
+
+// carTiming holds the session time a car passed every timing point it still needs to be compared at
+type carTiming struct {
+	times    map[int32]float32 // Session time in seconds per timing point
+	lowest   int32             // The lowest timing point that is kept
+	distance float32           // The total distance of the last sample
+	time     float32           // The session time of the last sample
+	sampled  bool
+}
+
+func newCarTiming() carTiming {
+	return carTiming{times: make(map[int32]float32)}
+}
+
+// add records the timing points the car passed since the last sample, interpolating the time between the samples
+func (c *carTiming) add(distance, time float32) {
+	if !c.sampled || distance < c.distance {
+		c.distance, c.time, c.sampled = distance, time, true
+		if _, ok := c.times[checkpointOf(distance)]; !ok {
+			c.set(checkpointOf(distance), time)
+		}
+		return
+	}
+
+	for point := checkpointOf(c.distance) + 1; point <= checkpointOf(distance); point++ {
+		f := (float32(point)*checkpointDistance - c.distance) / (distance - c.distance)
+		c.set(point, c.time+(time-c.time)*f)
+	}
+	c.distance, c.time = distance, time
+}
+
+func (c *carTiming) set(point int32, time float32) {
+	if len(c.times) == 0 || point < c.lowest {
+		c.lowest = point
+	}
+	c.times[point] = time
+}
+
+// timeAt returns the session time the car passed the distance, false if it was not recorded
+func (c *carTiming) timeAt(distance float32) (float32, bool) {
+	point := checkpointOf(distance)
+	before, ok := c.times[point]
+	if !ok {
+		return 0, false
+	}
+	after, ok := c.times[point+1]
+	if !ok {
+		return before, true
+	}
+
+	f := (distance - float32(point)*checkpointDistance) / checkpointDistance
+	return before + (after-before)*f, true
+}
+
+// rewind forgets the timing points passed after the session time, after a flashback
+func (c *carTiming) rewind(time float32) {
+	for point, t := range c.times {
+		if t > time {
+			delete(c.times, point)
+		}
+	}
+	c.sampled = false
+}
+
+// prune forgets the timing points before the distance, no car will be compared at them anymore
+func (c *carTiming) prune(distance float32) {
+	limit := checkpointOf(distance)
+	if limit-c.lowest > int32(len(c.times)) {
+		// Jumped far ahead, faster to walk the map than every point
+		for point := range c.times {
+			if point < limit {
+				delete(c.times, point)
+			}
+		}
+		c.lowest = limit
+		return
+	}
+
+	for ; c.lowest < limit; c.lowest++ {
+		delete(c.times, c.lowest)
+	}
+}
+
+func checkpointOf(distance float32) int32 {
+	return int32(math.Floor(float64(distance) / checkpointDistance))
+}
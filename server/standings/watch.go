@@ -0,0 +1,62 @@
+package standings
+
+import (
+	"context"
+
+	"github.com/DaanV2/f1-game-dashboards/server/pkg/pubsub"
+)
+
+// watchBuffer is the amount of standings a watcher buffers before they are dropped
+const watchBuffer = 64
+
+// Watcher receives the standings of a chair, or of all chairs
+type Watcher struct {
+	engine    *Engine
+	chairId   string
+	standings *pubsub.Subscription[Standings]
+}
+
+// Watch starts receiving the standings of the chair, all chairs if the id is empty. Run sends them and Close stops watching
+func (e *Engine) Watch(chairId string) *Watcher {
+	w := &Watcher{engine: e, chairId: chairId}
+	// Filtered before buffering, so the standings of other chairs can not crowd out the watched chair
+	w.standings = e.updates.SubscribeFunc(watchBuffer, w.match)
+
+	return w
+}
+
+// Run sends the current standings of the watched chairs, and after that every change, until the context is done or sending fails
+func (w *Watcher) Run(ctx context.Context, send func(Standings) error) error {
+	for _, standings := range w.engine.All() {
+		if !w.match(standings) {
+			continue
+		}
+		if err := send(standings); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case standings, ok := <-w.standings.Values():
+			if !ok {
+				return nil
+			}
+			if err := send(standings); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// match returns true if the standings are of the watched chair
+func (w *Watcher) match(standings Standings) bool {
+	return w.chairId == "" || standings.ChairId == w.chairId
+}
+
+// Close stops watching
+func (w *Watcher) Close() {
+	w.standings.Close()
+}
@@ -0,0 +1,60 @@
+package state
+
+import (
+	"time"
+
+	"github.com/DaanV2/f1-game-dashboards/server/sessions"
+	f1_2023 "github.com/DaanV2/go-f1-library/packets/2023"
+)
+
+const (
+	// MaxCars is the amount of cars in the packets
+	MaxCars = 22
+	// MaxEvents is the amount of recent events kept per session
+	MaxEvents = 32
+)
+
+// Snapshot is the latest picture of the session of a chair. The packets are nil until they are received.
+// The packets are shared between snapshots and must not be modified
+type Snapshot struct {
+	Chair      sessions.Chair // The chair the session is played on
+	SessionUID uint64         // The uid of the session
+	Updated    time.Time      // The moment the last packet was received
+
+	Session             *f1_2023.PacketSessionData
+	Participants        *f1_2023.PacketParticipantsData
+	Motion              *f1_2023.PacketMotionData
+	MotionEx            *f1_2023.PacketMotionExData
+	LapData             *f1_2023.PacketLapData
+	CarSetups           *f1_2023.PacketCarSetupsData
+	CarTelemetry        *f1_2023.PacketCarTelemetryData
+	CarStatus           *f1_2023.PacketCarStatusData
+	CarDamage           *f1_2023.PacketCarDamageData
+	FinalClassification *f1_2023.PacketFinalClassificationData
+	LobbyInfo           *f1_2023.PacketLobbyInfoData
+	SessionHistory      [MaxCars]*f1_2023.PacketSessionHistoryData // Per car index
+	TyreSets            [MaxCars]*f1_2023.PacketTyreSetsData       // Per car index
+	Events              []f1_2023.PacketEventData                  // The most recent events, oldest first
+}
+
+// PlayerCarIndex returns the index of the car of the player on the chair, or false if no packet was received yet
+func (s Snapshot) PlayerCarIndex() (uint8, bool) {
+	switch {
+	case s.LapData != nil:
+		return s.LapData.Header.PlayerCarIndex, true
+	case s.CarTelemetry != nil:
+		return s.CarTelemetry.Header.PlayerCarIndex, true
+	case s.Motion != nil:
+		return s.Motion.Header.PlayerCarIndex, true
+	}
+
+	return 0, false
+}
+
+// clone copies the snapshot, so the arrays and events can be changed without affecting the copy
+func (s *Snapshot) clone() Snapshot {
+	c := *s
+	c.Events = append([]f1_2023.PacketEventData(nil), s.Events...)
+
+	return c
+}
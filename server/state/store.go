@@ -0,0 +1,202 @@
+package state
+
+import (
+	"sync"
+	"time"
+
+	"github.com/DaanV2/f1-game-dashboards/server/game"
+	"github.com/DaanV2/f1-game-dashboards/server/pkg/hooks"
+	"github.com/DaanV2/f1-game-dashboards/server/sessions"
+	"github.com/DaanV2/go-f1-library/enums"
+	f1_2023 "github.com/DaanV2/go-f1-library/packets/2023"
+)
+
+type (
+	// Store keeps the latest snapshot of the session of every chair, it is safe for concurrent use
+	Store struct {
+		lock   sync.RWMutex
+		chairs map[string]*chairState
+	}
+
+	chairState struct {
+		snapshot Snapshot
+		previous uint64              // The uid of the previous session, late packets of it are dropped
+		frames   map[frameKey]uint32 // The last frame per packet, to drop packets that are handled out of order
+	}
+
+	frameKey struct {
+		packet enums.PacketId
+		car    uint8
+	}
+
+	packet interface {
+		GetHeader() f1_2023.PacketHeader
+	}
+)
+
+// NewStore creates a new empty store
+func NewStore() *Store {
+	return &Store{
+		chairs: make(map[string]*chairState),
+	}
+}
+
+// Subscribe adds the store to all the hooks of the pipeline
+func (s *Store) Subscribe(pipeline *game.PacketPipeline) {
+	subscribe(s, &pipeline.Motion, enums.PID_Motion, func(sn *Snapshot, p *f1_2023.PacketMotionData) { sn.Motion = p })
+	subscribe(s, &pipeline.Session, enums.PID_Session, func(sn *Snapshot, p *f1_2023.PacketSessionData) { sn.Session = p })
+	subscribe(s, &pipeline.LapData, enums.PID_LapData, func(sn *Snapshot, p *f1_2023.PacketLapData) { sn.LapData = p })
+	subscribe(s, &pipeline.Participants, enums.PID_Participants, func(sn *Snapshot, p *f1_2023.PacketParticipantsData) { sn.Participants = p })
+	subscribe(s, &pipeline.CarSetups, enums.PID_CarSetups, func(sn *Snapshot, p *f1_2023.PacketCarSetupsData) { sn.CarSetups = p })
+	subscribe(s, &pipeline.CarTelemetry, enums.PID_CarTelemetry, func(sn *Snapshot, p *f1_2023.PacketCarTelemetryData) { sn.CarTelemetry = p })
+	subscribe(s, &pipeline.CarStatus, enums.PID_CarStatus, func(sn *Snapshot, p *f1_2023.PacketCarStatusData) { sn.CarStatus = p })
+	subscribe(s, &pipeline.FinalClassification, enums.PID_FinalClassification, func(sn *Snapshot, p *f1_2023.PacketFinalClassificationData) { sn.FinalClassification = p })
+	subscribe(s, &pipeline.LobbyInfo, enums.PID_LobbyInfo, func(sn *Snapshot, p *f1_2023.PacketLobbyInfoData) { sn.LobbyInfo = p })
+	subscribe(s, &pipeline.CarDamage, enums.PID_CarDamage, func(sn *Snapshot, p *f1_2023.PacketCarDamageData) { sn.CarDamage = p })
+	subscribe(s, &pipeline.MotionEx, enums.PID_MotionEx, func(sn *Snapshot, p *f1_2023.PacketMotionExData) { sn.MotionEx = p })
+
+	// Per car packets
+	pipeline.SessionHistory.Add(func(p game.PacketWithChair[f1_2023.PacketSessionHistoryData]) {
+		car := p.Packet.CarIdx
+		if car >= MaxCars {
+			return
+		}
+		s.update(p.Chair, p.Packet.Header, &frameKey{enums.PID_SessionHistory, car}, func(sn *Snapshot) {
+			sn.SessionHistory[car] = &p.Packet
+		})
+	})
+	pipeline.TyreSets.Add(func(p game.PacketWithChair[f1_2023.PacketTyreSetsData]) {
+		car := p.Packet.CarIdx
+		if car >= MaxCars {
+			return
+		}
+		s.update(p.Chair, p.Packet.Header, &frameKey{enums.PID_TyreSets, car}, func(sn *Snapshot) {
+			sn.TyreSets[car] = &p.Packet
+		})
+	})
+
+	// Every event is kept, so no ordering on frames
+	pipeline.Event.Add(func(p game.PacketWithChair[f1_2023.PacketEventData]) {
+		s.update(p.Chair, p.Packet.Header, nil, func(sn *Snapshot) {
+			// F1 22 has no overall frame identifier, so the frames go back after a flashback
+			if p.Packet.EventStringCode == f1_2023.EC_Flashback {
+				s.resetFrames(p.Chair.Id())
+			}
+
+			if len(sn.Events) >= MaxEvents {
+				sn.Events = sn.Events[1:]
+			}
+			sn.Events = append(sn.Events, p.Packet)
+		})
+	})
+}
+
+// AddChairHooks removes the state of chairs when they are removed
+func (s *Store) AddChairHooks(chairs *sessions.ChairManager) {
+	chairs.OnChairRemoved.Add(func(chair sessions.Chair) {
+		s.Remove(chair.Id())
+	})
+}
+
+// Get returns the snapshot of the chair, or false if no packets were received for it
+func (s *Store) Get(chairId string) (Snapshot, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	cs, ok := s.chairs[chairId]
+	if !ok {
+		return Snapshot{}, false
+	}
+
+	return cs.snapshot.clone(), true
+}
+
+// All returns the snapshots of all chairs
+func (s *Store) All() []Snapshot {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	result := make([]Snapshot, 0, len(s.chairs))
+	for _, cs := range s.chairs {
+		result = append(result, cs.snapshot.clone())
+	}
+
+	return result
+}
+
+// Session returns the snapshots of the chairs that are currently in the given session
+func (s *Store) Session(sessionUID uint64) []Snapshot {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	result := make([]Snapshot, 0)
+	for _, cs := range s.chairs {
+		if cs.snapshot.SessionUID == sessionUID {
+			result = append(result, cs.snapshot.clone())
+		}
+	}
+
+	return result
+}
+
+// Remove removes the state of the chair
+func (s *Store) Remove(chairId string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	delete(s.chairs, chairId)
+}
+
+// update applies the packet to the snapshot of the chair, starting a new snapshot if the session changed.
+// If key is given, packets older than the last one of the same key are dropped
+func (s *Store) update(chair sessions.Chair, header f1_2023.PacketHeader, key *frameKey, apply func(snapshot *Snapshot)) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	id := chair.Id()
+	cs, ok := s.chairs[id]
+	if !ok {
+		cs = &chairState{}
+		s.chairs[id] = cs
+	}
+
+	if !ok || cs.snapshot.SessionUID != header.SessionUID {
+		// Late packet from the previous session
+		if ok && cs.previous == header.SessionUID {
+			return
+		}
+
+		cs.previous = cs.snapshot.SessionUID
+		cs.snapshot = Snapshot{SessionUID: header.SessionUID}
+		cs.frames = make(map[frameKey]uint32)
+	}
+
+	if key != nil {
+		frame := header.OverallFrameIdentifier
+		last, ok := cs.frames[*key]
+		if ok && frame < last {
+			return
+		}
+		cs.frames[*key] = frame
+	}
+
+	cs.snapshot.Chair = chair
+	cs.snapshot.Updated = time.Now()
+	apply(&cs.snapshot)
+}
+
+// resetFrames forgets the last frames of the chair, the lock must be held
+func (s *Store) resetFrames(chairId string) {
+	if cs, ok := s.chairs[chairId]; ok {
+		clear(cs.frames)
+	}
+}
+
+// subscribe adds a handler to the hook that sets the packet on the snapshot
+func subscribe[T packet](s *Store, hook *hooks.Hook[game.PacketWithChair[T]], id enums.PacketId, set func(snapshot *Snapshot, packet *T)) {
+	hook.Add(func(p game.PacketWithChair[T]) {
+		s.update(p.Chair, p.Packet.GetHeader(), &frameKey{packet: id}, func(sn *Snapshot) {
+			set(sn, &p.Packet)
+		})
+	})
+}
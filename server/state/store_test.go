@@ -0,0 +1,71 @@
+package state_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DaanV2/f1-game-dashboards/server/game"
+	"github.com/DaanV2/f1-game-dashboards/server/sessions"
+	"github.com/DaanV2/f1-game-dashboards/server/simulator"
+	"github.com/DaanV2/f1-game-dashboards/server/state"
+	f1_2023 "github.com/DaanV2/go-f1-library/packets/2023"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Store_FollowsSession(t *testing.T) {
+	processor := game.NewPacketProcessor()
+	store := state.NewStore()
+	store.Subscribe(processor.Pipeline())
+
+	chair := sessions.NewChair("test", 20777, true)
+	sim := simulator.NewSimulator(simulator.Options{Cars: 4, Laps: 1, TrackLength: 500, Seed: 1})
+	inject := func(steps int) {
+		for i := 0; i < steps; i++ {
+			for _, packet := range sim.Step(time.Second / 20) {
+				require.NoError(t, processor.Inject(chair, packet))
+			}
+		}
+	}
+
+	inject(20)
+	first := sim.SessionUID()
+	require.Eventually(t, func() bool {
+		snapshot, ok := store.Get(chair.Id())
+		return ok && snapshot.LapData != nil && snapshot.LapData.Header.FrameIdentifier == 19
+	}, time.Second, time.Millisecond)
+
+	snapshot, _ := store.Get(chair.Id())
+	require.Equal(t, first, snapshot.SessionUID)
+	require.Equal(t, chair, snapshot.Chair)
+	require.NotNil(t, snapshot.Session)
+	require.NotNil(t, snapshot.CarTelemetry)
+	require.NotNil(t, snapshot.Motion)
+	require.Nil(t, snapshot.CarDamage)
+	require.Contains(t, eventCodes(snapshot), f1_2023.EC_SessionStarted)
+	require.Len(t, store.Session(first), 1)
+
+	// Drive until the simulator starts the next session
+	for sim.SessionUID() == first {
+		inject(1)
+	}
+	inject(1)
+	second := sim.SessionUID()
+	require.Eventually(t, func() bool {
+		snapshot, ok := store.Get(chair.Id())
+		return ok && snapshot.SessionUID == second && snapshot.LapData != nil
+	}, time.Second, time.Millisecond)
+	require.Empty(t, store.Session(first))
+
+	store.Remove(chair.Id())
+	_, ok := store.Get(chair.Id())
+	require.False(t, ok)
+}
+
+func eventCodes(snapshot state.Snapshot) []f1_2023.EventCode {
+	codes := make([]f1_2023.EventCode, 0, len(snapshot.Events))
+	for _, e := range snapshot.Events {
+		codes = append(codes, e.EventStringCode)
+	}
+
+	return codes
+}
@@ -0,0 +1,361 @@
+package strategy
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/DaanV2/f1-game-dashboards/server/game"
+	"github.com/DaanV2/f1-game-dashboards/server/sessions"
+	"github.com/DaanV2/f1-game-dashboards/server/state"
+	"github.com/DaanV2/go-f1-library/enums"
+	f1_2023 "github.com/DaanV2/go-f1-library/packets/2023"
+)
+
+const (
+	resultActive = 2
+)
+
+type (
+	// LapWear is the projected wear of the tyres at the end of a lap, in percent: rear left, rear right, front left, front right
+	LapWear struct {
+		Lap  uint8
+		Wear [4]float32
+	}
+
+	// TyreSet is a set of tyres assigned to a car, with the laps it lasts until the cliff
+	TyreSet struct {
+		Index          uint8 // The index of the set in the tyre sets of the car
+		ActualCompound uint8
+		VisualCompound uint8
+		Wear           float32 // Percent
+		Fitted         bool
+		LifeLaps       uint8 // The laps the set lasts until it hits the cliff, 0 if it is worn out or the wear rate is not known
+		CliffLap       uint8 // The lap the set hits the cliff when it is fitted at the end of the current lap, or the fitted set from now on
+	}
+
+	// PitWindow is a recommended stop, made at the end of a lap between opening and closing
+	PitWindow struct {
+		Stop           uint8 // 1 for the first stop
+		Opens          uint8
+		Closes         uint8
+		TyreSet        uint8 // The index of the set to fit
+		VisualCompound uint8
+	}
+
+	// Strategy is the fuel and tyre prediction of a car for the remaining race distance
+	Strategy struct {
+		ChairId    string
+		SessionUID uint64
+		CarIndex   uint8
+		Race       bool // Pit windows are only recommended for a race
+		LapNumber  uint8
+		TotalLaps  uint8 // 0 if the session is not known
+
+		FuelInTank        float32 // Kilograms
+		FuelRemainingLaps float32 // The laps of fuel left over at the finish, as shown in the game
+		FuelPerLap        float32 // Kilograms, 0 until two laps are driven
+		FuelAtFinish      float32 // Kilograms, the projected fuel at the finish when the current lap counts as a full lap
+
+		TyreCompound  uint8 // The visual compound, 0 if unknown
+		TyreAgeLaps   uint8
+		TyreWear      [4]float32 // Percent: rear left, rear right, front left, front right
+		WearPerLap    [4]float32 // Percent, 0 until the rate is known
+		ProjectedWear []LapWear  // Until the finish, or the next laps if the race distance is not known
+		CliffLap      uint8      // The lap the fitted tyres hit the cliff, 0 if the wear rate is not known
+
+		TyreSets      []TyreSet   // Empty until the tyre sets of the car are received
+		PitWindows    []PitWindow // The fewest stops that reach the finish
+		ReachesFinish bool        // False if the available sets do not reach the finish in MaxStops stops, or nothing is known yet
+		Updated       time.Time
+	}
+
+	// Planner predicts the strategy of every car on the chairs, from the lap data, car status, car damage and tyre sets.
+	// It is safe for concurrent use
+	Planner struct {
+		state *state.Store
+
+		lock   sync.Mutex
+		chairs map[string]*chairPlanner
+	}
+
+	// chairPlanner follows the session on a chair
+	chairPlanner struct {
+		session uint64
+		frame   uint32
+		cars    [state.MaxCars]carPlanner
+	}
+
+	// carPlanner follows the tyres and fuel of a car, sampled at the start of every lap
+	carPlanner struct {
+		lap      uint8
+		pitStops uint8
+		compound uint8 // The actual compound of the stint
+		age      uint8 // The age of the tyres of the stint at the last sample
+		stint    []wearSample
+		fuel     []fuelSample
+		used     []uint8           // The visual compounds used
+		rates    map[uint8]float32 // The wear per lap of the worst tyre of the previous stints, per actual compound
+	}
+)
+
+// NewPlanner creates the planner, the state is used for the car status, car damage, tyre sets and session
+func NewPlanner(sessionState *state.Store) *Planner {
+	return &Planner{
+		state:  sessionState,
+		chairs: make(map[string]*chairPlanner),
+	}
+}
+
+// Subscribe adds the planner to the lap data hook of the pipeline
+func (p *Planner) Subscribe(pipeline *game.PacketPipeline) {
+	pipeline.LapData.Add(func(packet game.PacketWithChair[f1_2023.PacketLapData]) {
+		p.handleLapData(packet.Chair, &packet.Packet)
+	})
+}
+
+// AddChairHooks forgets the cars of a chair when it is removed
+func (p *Planner) AddChairHooks(chairs *sessions.ChairManager) {
+	chairs.OnChairRemoved.Add(func(chair sessions.Chair) {
+		p.lock.Lock()
+		defer p.lock.Unlock()
+		delete(p.chairs, chair.Id())
+	})
+}
+
+// Strategy returns the prediction for the car on the chair, false if no lap data was received for it
+func (p *Planner) Strategy(chairId string, carIndex uint8) (Strategy, bool) {
+	snapshot, ok := p.state.Get(chairId)
+	if !ok || snapshot.LapData == nil || int(carIndex) >= len(snapshot.LapData.LapData) {
+		return Strategy{}, false
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	c, ok := p.chairs[chairId]
+	if !ok || c.session != snapshot.SessionUID || snapshot.LapData.LapData[carIndex].ResultStatus < resultActive {
+		return Strategy{}, false
+	}
+
+	return c.cars[carIndex].predict(chairId, carIndex, &snapshot, time.Now()), true
+}
+
+// Strategies returns the prediction for every car on the chair, ordered by car index
+func (p *Planner) Strategies(chairId string) []Strategy {
+	result := make([]Strategy, 0)
+	for i := range state.MaxCars {
+		if s, ok := p.Strategy(chairId, uint8(i)); ok {
+			result = append(result, s)
+		}
+	}
+
+	return result
+}
+
+func (p *Planner) handleLapData(chair sessions.Chair, packet *f1_2023.PacketLapData) {
+	header := packet.Header
+	snapshot, ok := p.state.Get(chair.Id())
+	if !ok || snapshot.SessionUID != header.SessionUID {
+		snapshot = state.Snapshot{}
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	c, ok := p.chairs[chair.Id()]
+	if !ok || c.session != header.SessionUID {
+		c = &chairPlanner{session: header.SessionUID}
+		p.chairs[chair.Id()] = c
+	}
+	// The hooks are called concurrently, so an older frame can arrive after a newer one
+	if c.frame > header.OverallFrameIdentifier {
+		return
+	}
+	c.frame = header.OverallFrameIdentifier
+
+	for i := range packet.LapData {
+		lap := &packet.LapData[i]
+		if lap.ResultStatus == resultActive {
+			c.cars[i].sample(i, lap, &snapshot)
+		}
+	}
+}
+
+// sample records the tyres and fuel of the car when it starts a new lap
+func (c *carPlanner) sample(index int, lap *f1_2023.LapData, snapshot *state.Snapshot) {
+	previous := c.lap
+	c.lap = lap.CurrentLapNum
+	switch {
+	case previous == 0 || lap.CurrentLapNum == previous:
+		return
+	case lap.CurrentLapNum < previous:
+		// A flashback, the laps after it are driven again
+		c.stint = slices.DeleteFunc(c.stint, func(s wearSample) bool { return s.lap > lap.CurrentLapNum })
+		c.fuel = slices.DeleteFunc(c.fuel, func(s fuelSample) bool { return s.lap > lap.CurrentLapNum })
+		return
+	}
+
+	if snapshot.CarStatus != nil {
+		status := &snapshot.CarStatus.CarStatusData[index]
+		if status.ActualTyreCompound != c.compound || status.TyresAgeLaps < c.age || lap.NumPitStops != c.pitStops {
+			c.newStint(status.ActualTyreCompound)
+		}
+		c.age, c.pitStops = status.TyresAgeLaps, lap.NumPitStops
+		if status.VisualTyreCompound != 0 && !slices.Contains(c.used, status.VisualTyreCompound) {
+			c.used = append(c.used, status.VisualTyreCompound)
+		}
+		c.fuel = append(c.fuel, fuelSample{lap: lap.CurrentLapNum, fuel: status.FuelInTank})
+	}
+	if snapshot.CarDamage != nil {
+		c.stint = append(c.stint, wearSample{lap: lap.CurrentLapNum, wear: snapshot.CarDamage.CarDamageData[index].TyresWear})
+	}
+}
+
+// newStint starts following new tyres, the wear rate of the previous stint is kept for its compound
+func (c *carPlanner) newStint(compound uint8) {
+	if rate, ok := wearRate(c.stint); ok {
+		if c.rates == nil {
+			c.rates = make(map[uint8]float32)
+		}
+		if previous, ok := c.rates[c.compound]; ok {
+			c.rates[c.compound] = (previous + worst(rate)) / 2
+		} else {
+			c.rates[c.compound] = worst(rate)
+		}
+	}
+	c.compound = compound
+	c.stint = c.stint[:0]
+}
+
+// predict computes the strategy of the car from its samples and the latest snapshot
+func (c *carPlanner) predict(chairId string, index uint8, snapshot *state.Snapshot, now time.Time) Strategy {
+	lap := &snapshot.LapData.LapData[index]
+	result := Strategy{
+		ChairId:       chairId,
+		SessionUID:    snapshot.SessionUID,
+		CarIndex:      index,
+		Race:          isRace(snapshot.Session),
+		LapNumber:     lap.CurrentLapNum,
+		TyreSets:      []TyreSet{},
+		PitWindows:    []PitWindow{},
+		ProjectedWear: []LapWear{},
+		Updated:       now,
+	}
+	if snapshot.Session != nil {
+		result.TotalLaps = snapshot.Session.TotalLaps
+	}
+	remaining := float32(0)
+	if result.TotalLaps >= result.LapNumber {
+		remaining = float32(result.TotalLaps - result.LapNumber + 1)
+	}
+
+	compound := c.compound
+	if snapshot.CarStatus != nil {
+		status := &snapshot.CarStatus.CarStatusData[index]
+		result.FuelInTank = status.FuelInTank
+		result.FuelRemainingLaps = status.FuelRemainingLaps
+		result.TyreCompound = status.VisualTyreCompound
+		result.TyreAgeLaps = status.TyresAgeLaps
+		compound = status.ActualTyreCompound
+	}
+	if rate, ok := fuelRate(c.fuel); ok {
+		result.FuelPerLap = rate
+		result.FuelAtFinish = result.FuelInTank - rate*remaining
+	}
+
+	// The rate of the worst tyre per compound, from this stint or the previous ones
+	rateOf := func(compound uint8, usableLife uint8) float32 {
+		if compound == c.compound {
+			if rate, ok := wearRate(c.stint); ok {
+				return worst(rate)
+			}
+		}
+		if rate, ok := c.rates[compound]; ok {
+			return rate
+		}
+		if usableLife > 0 {
+			return CliffWear / float32(usableLife)
+		}
+		return 0
+	}
+
+	sets := snapshot.TyreSets[index]
+	fittedLife := uint8(0)
+	if sets != nil && int(sets.FittedIdx) < len(sets.TyreSetData) {
+		fittedLife = sets.TyreSetData[sets.FittedIdx].UsableLife
+	}
+
+	if snapshot.CarDamage != nil {
+		result.TyreWear = snapshot.CarDamage.CarDamageData[index].TyresWear
+		if rate, ok := wearRate(c.stint); ok && compound == c.compound {
+			result.WearPerLap = rate
+		} else if rate := rateOf(compound, fittedLife); rate > 0 {
+			result.WearPerLap = [4]float32{rate, rate, rate, rate}
+		}
+		if worst(result.WearPerLap) > 0 {
+			result.ProjectedWear = project(result.LapNumber, result.TotalLaps, result.TyreWear, result.WearPerLap)
+			cliff := uint8(255)
+			for tyre := range result.TyreWear {
+				if laps, ok := lapsToCliff(result.TyreWear[tyre], result.WearPerLap[tyre]); ok {
+					cliff = min(cliff, result.LapNumber+max(laps, 1)-1)
+				}
+			}
+			result.CliffLap = cliff
+		}
+	}
+
+	if sets != nil {
+		for i, set := range sets.TyreSetData {
+			if set.Available == 0 && set.Fitted == 0 {
+				continue
+			}
+			tyreSet := TyreSet{
+				Index:          uint8(i),
+				ActualCompound: set.ActualTyreCompound,
+				VisualCompound: set.VisualTyreCompound,
+				Wear:           float32(set.Wear),
+				Fitted:         set.Fitted != 0,
+			}
+			if tyreSet.Fitted {
+				tyreSet.Wear = worst(result.TyreWear)
+				tyreSet.CliffLap = result.CliffLap
+				if result.CliffLap >= result.LapNumber {
+					tyreSet.LifeLaps = result.CliffLap - result.LapNumber + 1
+				}
+			} else if laps, ok := lapsToCliff(tyreSet.Wear, rateOf(set.ActualTyreCompound, set.UsableLife)); ok {
+				tyreSet.LifeLaps = laps
+				tyreSet.CliffLap = uint8(min(int(result.LapNumber)+int(laps), 255))
+			}
+			result.TyreSets = append(result.TyreSets, tyreSet)
+		}
+	}
+
+	if result.Race {
+		available := slices.DeleteFunc(slices.Clone(result.TyreSets), func(s TyreSet) bool { return s.Fitted })
+		used := slices.Clone(c.used)
+		if result.TyreCompound != 0 && !slices.Contains(used, result.TyreCompound) {
+			used = append(used, result.TyreCompound)
+		}
+		result.PitWindows, result.ReachesFinish = recommend(plan{
+			lap:       result.LapNumber,
+			totalLaps: result.TotalLaps,
+			cliffLap:  result.CliffLap,
+			used:      used,
+			sets:      available,
+		})
+	}
+
+	return result
+}
+
+// isRace returns true if the session is a race, an unknown session is treated as a race
+func isRace(session *f1_2023.PacketSessionData) bool {
+	if session == nil {
+		return true
+	}
+
+	switch session.SessionType {
+	case enums.SE_Unknown, enums.SE_R, enums.SE_R2, enums.SE_R3:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+package strategy_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DaanV2/f1-game-dashboards/server/game"
+	"github.com/DaanV2/f1-game-dashboards/server/sessions"
+	"github.com/DaanV2/f1-game-dashboards/server/state"
+	"github.com/DaanV2/f1-game-dashboards/server/strategy"
+	"github.com/DaanV2/go-f1-library/enums"
+	f1_2023 "github.com/DaanV2/go-f1-library/packets/2023"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Planner_RecommendsPitWindow(t *testing.T) {
+	pipeline := game.NewPacketProcessor().Pipeline()
+	sessionState := state.NewStore()
+	sessionState.Subscribe(pipeline)
+	planner := strategy.NewPlanner(sessionState)
+	planner.Subscribe(pipeline)
+
+	chair := sessions.NewChair("test", 20777, true)
+	frame := uint32(0)
+	header := func(id enums.PacketId) f1_2023.PacketHeader {
+		frame++
+		return f1_2023.PacketHeader{PacketFormat: enums.PF_F1_2023, PacketId: id, SessionUID: 1, FrameIdentifier: frame, OverallFrameIdentifier: frame}
+	}
+	// Gives the hooks time to handle the packet, they are called concurrently
+	wait := func() { time.Sleep(5 * time.Millisecond) }
+
+	pipeline.Session.Call(game.PacketWithChair[f1_2023.PacketSessionData]{Chair: chair, Packet: f1_2023.PacketSessionData{
+		Header: header(enums.PID_Session), SessionType: enums.SE_R, TotalLaps: 20,
+	}})
+	sets := f1_2023.PacketTyreSetsData{Header: header(enums.PID_TyreSets), CarIdx: 0, FittedIdx: 0}
+	sets.TyreSetData[0] = f1_2023.TyreSetData{ActualTyreCompound: 18, VisualTyreCompound: 17, Available: 1, Fitted: 1, UsableLife: 20}
+	sets.TyreSetData[1] = f1_2023.TyreSetData{ActualTyreCompound: 19, VisualTyreCompound: 18, Available: 1, UsableLife: 30}
+	sets.TyreSetData[2] = f1_2023.TyreSetData{ActualTyreCompound: 17, VisualTyreCompound: 17, Wear: 10, Available: 1, UsableLife: 20}
+	pipeline.TyreSets.Call(game.PacketWithChair[f1_2023.PacketTyreSetsData]{Chair: chair, Packet: sets})
+
+	// The rear tyres wear 5% and the fronts 4% per lap, 1.5 kg of fuel is used per lap
+	for lap := uint8(1); lap <= 6; lap++ {
+		used := float32(lap - 1)
+		status := f1_2023.PacketCarStatusData{Header: header(enums.PID_CarStatus)}
+		status.CarStatusData[0] = f1_2023.CarStatusData{FuelInTank: 100 - 1.5*used, FuelRemainingLaps: 2, ActualTyreCompound: 18, VisualTyreCompound: 17, TyresAgeLaps: lap - 1}
+		pipeline.CarStatus.Call(game.PacketWithChair[f1_2023.PacketCarStatusData]{Chair: chair, Packet: status})
+		damage := f1_2023.PacketCarDamageData{Header: header(enums.PID_CarDamage)}
+		damage.CarDamageData[0].TyresWear = [4]float32{5 * used, 5 * used, 4 * used, 4 * used}
+		pipeline.CarDamage.Call(game.PacketWithChair[f1_2023.PacketCarDamageData]{Chair: chair, Packet: damage})
+		wait()
+
+		laps := f1_2023.PacketLapData{Header: header(enums.PID_LapData)}
+		laps.LapData[0] = f1_2023.LapData{CurrentLapNum: lap, CarPosition: 1, ResultStatus: 2}
+		pipeline.LapData.Call(game.PacketWithChair[f1_2023.PacketLapData]{Chair: chair, Packet: laps})
+		wait()
+	}
+
+	result, ok := planner.Strategy(chair.Id(), 0)
+	require.True(t, ok)
+	require.True(t, result.Race)
+	require.Equal(t, uint8(6), result.LapNumber)
+	require.Equal(t, uint8(20), result.TotalLaps)
+	require.InDelta(t, 1.5, result.FuelPerLap, 0.01)
+	require.InDelta(t, 92.5-1.5*15, result.FuelAtFinish, 0.01)
+	require.InDelta(t, 5, result.WearPerLap[0], 0.01)
+	require.InDelta(t, 4, result.WearPerLap[2], 0.01)
+	require.Len(t, result.ProjectedWear, 15)
+	require.InDelta(t, 30, result.ProjectedWear[0].Wear[0], 0.01)
+	// 25% wear, the rears pass 70% after 9 more laps
+	require.Equal(t, uint8(15), result.CliffLap)
+	require.Len(t, result.TyreSets, 3)
+	require.True(t, result.TyreSets[0].Fitted)
+
+	// Only mediums were used, so another dry compound is fitted, the hards last the longest
+	require.True(t, result.ReachesFinish)
+	require.Len(t, result.PitWindows, 1)
+	window := result.PitWindows[0]
+	require.Equal(t, uint8(1), window.TyreSet)
+	require.Equal(t, uint8(18), window.VisualCompound)
+	require.Equal(t, uint8(6), window.Opens)
+	require.Equal(t, uint8(15), window.Closes)
+
+	require.Len(t, planner.Strategies(chair.Id()), 1)
+	_, ok = planner.Strategy(chair.Id(), 1)
+	require.False(t, ok)
+}
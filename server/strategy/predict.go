@@ -0,0 +1,201 @@
+package strategy
+
+import (
+	"cmp"
+	"math"
+	"slices"
+)
+
+const (
+	// CliffWear is the tyre wear in percent after which the grip falls away
+	CliffWear float32 = 70
+	// MaxStops is the most stops a recommended strategy makes
+	MaxStops = 3
+
+	// projectionLaps is how far the wear is projected when the race distance is not known
+	projectionLaps = 10
+
+	visualSoft   = 16
+	visualMedium = 17
+	visualHard   = 18
+)
+
+type (
+	// wearSample is the wear of the tyres at the start of a lap
+	wearSample struct {
+		lap  uint8
+		wear [4]float32
+	}
+
+	// fuelSample is the fuel in the tank at the start of a lap
+	fuelSample struct {
+		lap  uint8
+		fuel float32
+	}
+
+	// plan is the input of a pit window recommendation
+	plan struct {
+		lap       uint8     // The current lap
+		totalLaps uint8     // The laps of the race
+		cliffLap  uint8     // The lap the fitted tyres hit the cliff, 0 if unknown
+		used      []uint8   // The visual compounds used in the race
+		sets      []TyreSet // The sets that can be fitted
+	}
+)
+
+// wearRate returns the wear per lap of every tyre, fitted over the samples with least squares. False if there are
+// less than two laps to fit over
+func wearRate(samples []wearSample) ([4]float32, bool) {
+	result := [4]float32{}
+	if len(samples) < 2 || samples[0].lap == samples[len(samples)-1].lap {
+		return result, false
+	}
+
+	var meanLap float64
+	for _, s := range samples {
+		meanLap += float64(s.lap)
+	}
+	meanLap /= float64(len(samples))
+
+	for tyre := range result {
+		var meanWear float64
+		for _, s := range samples {
+			meanWear += float64(s.wear[tyre])
+		}
+		meanWear /= float64(len(samples))
+
+		var covariance, variance float64
+		for _, s := range samples {
+			dx := float64(s.lap) - meanLap
+			covariance += dx * (float64(s.wear[tyre]) - meanWear)
+			variance += dx * dx
+		}
+		result[tyre] = float32(max(covariance/variance, 0))
+	}
+
+	return result, true
+}
+
+// fuelRate returns the fuel used per lap, false if there are less than two laps
+func fuelRate(samples []fuelSample) (float32, bool) {
+	if len(samples) < 2 {
+		return 0, false
+	}
+	first, last := samples[0], samples[len(samples)-1]
+	if last.lap <= first.lap {
+		return 0, false
+	}
+
+	return max(first.fuel-last.fuel, 0) / float32(last.lap-first.lap), true
+}
+
+// worst returns the highest of the values
+func worst(values [4]float32) float32 {
+	return max(values[0], values[1], values[2], values[3])
+}
+
+// lapsToCliff returns the laps the tyres last from the given wear until they hit the cliff, including the lap the cliff is
+// hit on. False if the rate is not known
+func lapsToCliff(wear, rate float32) (uint8, bool) {
+	if rate <= 0 {
+		return 0, false
+	}
+	if wear >= CliffWear {
+		return 0, true
+	}
+
+	return uint8(min(math.Floor(float64((CliffWear-wear)/rate))+1, math.MaxUint8)), true
+}
+
+// project returns the wear of the tyres at the end of the laps from the current lap on
+func project(lap, totalLaps uint8, wear, rate [4]float32) []LapWear {
+	last := int(lap) + projectionLaps - 1
+	if totalLaps > 0 {
+		last = int(totalLaps)
+	}
+
+	result := make([]LapWear, 0, max(last-int(lap)+1, 0))
+	for l := int(lap); l <= last && l <= math.MaxUint8; l++ {
+		projected := LapWear{Lap: uint8(l)}
+		for tyre := range projected.Wear {
+			projected.Wear[tyre] = min(wear[tyre]+rate[tyre]*float32(l-int(lap)+1), 100)
+		}
+		result = append(result, projected)
+	}
+
+	return result
+}
+
+// isDry returns true if the visual compound is a dry compound
+func isDry(visual uint8) bool {
+	return visual == visualSoft || visual == visualMedium || visual == visualHard
+}
+
+// needsCompound returns true if the rules still require another dry compound to be used in the race
+func needsCompound(used []uint8) bool {
+	dry := make([]uint8, 0, len(used))
+	for _, compound := range used {
+		if !isDry(compound) {
+			// A wet race has no compound rule
+			return false
+		}
+		if !slices.Contains(dry, compound) {
+			dry = append(dry, compound)
+		}
+	}
+
+	return len(dry) == 1
+}
+
+// recommend picks the sets for the fewest stops that reach the finish, and returns the laps to pit on for each of them.
+// False if the sets cannot reach the finish in MaxStops stops
+func recommend(p plan) ([]PitWindow, bool) {
+	if p.cliffLap == 0 || p.totalLaps == 0 || p.lap > p.totalLaps {
+		return []PitWindow{}, false
+	}
+
+	candidates := slices.Clone(p.sets)
+	slices.SortStableFunc(candidates, func(a, b TyreSet) int { return cmp.Compare(b.LifeLaps, a.LifeLaps) })
+	used := slices.Clone(p.used)
+
+	chosen := make([]TyreSet, 0, MaxStops)
+	reach := int(p.cliffLap)
+	for len(chosen) < MaxStops && (reach < int(p.totalLaps) || needsCompound(used)) {
+		index := 0
+		if needsCompound(used) {
+			// The longest lasting set of another dry compound is fitted first
+			index = slices.IndexFunc(candidates, func(s TyreSet) bool {
+				return isDry(s.VisualCompound) && !slices.Contains(used, s.VisualCompound)
+			})
+		}
+		if index < 0 || index >= len(candidates) || candidates[index].LifeLaps == 0 {
+			break
+		}
+
+		set := candidates[index]
+		candidates = slices.Delete(candidates, index, index+1)
+		chosen = append(chosen, set)
+		used = append(used, set.VisualCompound)
+		reach += int(set.LifeLaps)
+	}
+	reaches := reach >= int(p.totalLaps) && !needsCompound(used)
+
+	// A stop is made at the end of a lap, the latest stop is on the lap the tyres hit the cliff and the earliest leaves
+	// enough laps on the later sets to reach the finish
+	windows := make([]PitWindow, len(chosen))
+	latest := int(p.cliffLap)
+	for i, set := range chosen {
+		windows[i] = PitWindow{Stop: uint8(i + 1), Closes: uint8(min(latest, int(p.totalLaps)-1)), TyreSet: set.Index, VisualCompound: set.VisualCompound}
+		latest += int(set.LifeLaps)
+	}
+	earliest := int(p.totalLaps)
+	for i := len(chosen) - 1; i >= 0; i-- {
+		earliest -= int(chosen[i].LifeLaps)
+		windows[i].Opens = uint8(max(earliest, int(p.lap)+i, 0))
+		if windows[i].Opens > windows[i].Closes {
+			windows[i].Opens = windows[i].Closes
+		}
+	}
+
+	return windows, reaches
+}
@@ -0,0 +1,293 @@
+package trackmap
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/DaanV2/f1-game-dashboards/server/game"
+	"github.com/DaanV2/f1-game-dashboards/server/pkg/hooks"
+	"github.com/DaanV2/f1-game-dashboards/server/sessions"
+	"github.com/DaanV2/f1-game-dashboards/server/state"
+	f1_2023 "github.com/DaanV2/go-f1-library/packets/2023"
+	"github.com/charmbracelet/log"
+)
+
+// maxPositionAge is how many frames the position of the player may be older than the lap data it is paired with
+const maxPositionAge = 5
+
+var (
+	ErrMapNotFound = errors.New("track map not found")
+)
+
+type (
+	// Storage persists the maps by track id
+	Storage interface {
+		Get(id string) (Map, error)
+		Set(id string, value Map) error
+		Delete(id string) error
+		Keys() []string
+	}
+
+	// Builder draws the maps of the tracks from the positions of the players on the chairs, averaging their clean laps.
+	// It is safe for concurrent use
+	Builder struct {
+		// OnMapUpdated is called with the new version of a map, every time a lap or pit stop is added to it
+		OnMapUpdated hooks.Hook[Map]
+
+		lock    sync.Mutex
+		storage Storage
+		state   *state.Store
+		maps    map[int8]*Map // Copy on write, so returned maps are never modified
+		chairs  map[string]*chairRecording
+	}
+
+	// chairRecording follows the player on a chair
+	chairRecording struct {
+		session  uint64
+		frame    uint32 // The frame of the last lap data
+		position Point
+		at       uint32 // The frame of the position
+		located  bool
+		lap      lapRecording
+		pit      pitRecording
+	}
+)
+
+// NewBuilder creates the builder and loads the stored maps. The state is used for the track and its length
+func NewBuilder(storage Storage, sessionState *state.Store) *Builder {
+	builder := &Builder{
+		storage: storage,
+		state:   sessionState,
+		maps:    make(map[int8]*Map),
+		chairs:  make(map[string]*chairRecording),
+	}
+
+	for _, id := range storage.Keys() {
+		m, err := storage.Get(id)
+		if err != nil {
+			log.Error("could not load track map", "id", id, "error", err)
+			continue
+		}
+		builder.maps[m.TrackId] = &m
+	}
+
+	return builder
+}
+
+// Subscribe adds the builder to the motion and lap data hooks of the pipeline
+func (b *Builder) Subscribe(pipeline *game.PacketPipeline) {
+	pipeline.Motion.Add(func(p game.PacketWithChair[f1_2023.PacketMotionData]) {
+		b.handleMotion(p.Chair, &p.Packet)
+	})
+	pipeline.LapData.Add(func(p game.PacketWithChair[f1_2023.PacketLapData]) {
+		b.handleLapData(p.Chair, &p.Packet)
+	})
+}
+
+// AddChairHooks forgets the recording of a chair when it is removed
+func (b *Builder) AddChairHooks(chairs *sessions.ChairManager) {
+	chairs.OnChairRemoved.Add(func(chair sessions.Chair) {
+		b.lock.Lock()
+		defer b.lock.Unlock()
+		delete(b.chairs, chair.Id())
+	})
+}
+
+// Map returns the map of the track, false if no clean lap was driven on it yet
+func (b *Builder) Map(trackId int8) (Map, bool) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	m, ok := b.maps[trackId]
+	if !ok {
+		return Map{}, false
+	}
+
+	return *m, true
+}
+
+// Maps returns all maps, ordered by track id
+func (b *Builder) Maps() []Map {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	result := make([]Map, 0, len(b.maps))
+	for _, m := range b.maps {
+		result = append(result, *m)
+	}
+	slices.SortFunc(result, func(a, b Map) int { return int(a.TrackId) - int(b.TrackId) })
+
+	return result
+}
+
+// Delete removes the map of the track, so it is drawn again from the next clean laps
+func (b *Builder) Delete(trackId int8) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if _, ok := b.maps[trackId]; !ok {
+		return ErrMapNotFound
+	}
+	if err := b.storage.Delete(storageId(trackId)); err != nil {
+		return err
+	}
+	delete(b.maps, trackId)
+
+	return nil
+}
+
+func (b *Builder) handleMotion(chair sessions.Chair, packet *f1_2023.PacketMotionData) {
+	header := packet.Header
+	if int(header.PlayerCarIndex) >= len(packet.CarMotionData) {
+		return
+	}
+	motion := packet.CarMotionData[header.PlayerCarIndex]
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	c := b.recording(chair.Id(), header)
+	// The hooks are called concurrently, so an older frame can arrive after a newer one
+	if c.located && c.at > header.OverallFrameIdentifier {
+		return
+	}
+	c.position = Point{X: motion.WorldPositionX, Y: motion.WorldPositionZ}
+	c.at = header.OverallFrameIdentifier
+	c.located = true
+}
+
+func (b *Builder) handleLapData(chair sessions.Chair, packet *f1_2023.PacketLapData) {
+	header := packet.Header
+	if int(header.PlayerCarIndex) >= len(packet.LapData) {
+		return
+	}
+	lap := packet.LapData[header.PlayerCarIndex]
+	track, length, known := b.trackOf(chair.Id(), header)
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	c := b.recording(chair.Id(), header)
+	if c.frame > header.OverallFrameIdentifier {
+		return
+	}
+	c.frame = header.OverallFrameIdentifier
+
+	if lap.CurrentLapNum != c.lap.lap {
+		if lap.CurrentLapNum == c.lap.lap+1 && known {
+			b.completeLap(track, length, &c.lap)
+		}
+		c.lap = newLapRecording(lap.CurrentLapNum)
+	}
+	if lap.CurrentLapInvalid != 0 || lap.PitStatus != 0 {
+		c.lap.clean = false
+	}
+	c.lap.setSector(lap.Sector, lap.LapDistance)
+
+	located := c.located && c.at+maxPositionAge >= header.OverallFrameIdentifier
+	if !located {
+		return
+	}
+	c.lap.add(lap.LapDistance, c.position)
+
+	switch {
+	case lap.PitStatus != 0:
+		c.pit.add(c.position)
+	case c.pit.active:
+		if known {
+			b.completePit(track, length, &c.pit)
+		}
+		c.pit = pitRecording{}
+	}
+}
+
+// recording returns the recording of the chair, a new one if the session changed. The lock must be held
+func (b *Builder) recording(chairId string, header f1_2023.PacketHeader) *chairRecording {
+	c, ok := b.chairs[chairId]
+	if !ok || c.session != header.SessionUID {
+		c = &chairRecording{session: header.SessionUID}
+		// The first lap is only used once it is driven from the start
+		c.lap.lap = 255
+		b.chairs[chairId] = c
+	}
+
+	return c
+}
+
+// completeLap adds the lap to the map of the track, if it was clean and recorded from start to finish. The lock must be held
+func (b *Builder) completeLap(track int8, length uint16, lap *lapRecording) {
+	outline, ok := lap.outline(length)
+	if !ok {
+		return
+	}
+
+	b.update(track, length, func(m *Map) {
+		m.Outline = average(m.Outline, outline, m.Laps)
+		m.Laps++
+		if lap.sectors[0] > 0 && lap.sectors[1] > lap.sectors[0] {
+			weight := float32(min(m.SectorLaps, maxWeight))
+			for i, distance := range lap.sectors {
+				m.Sectors[i] = (m.Sectors[i]*weight + distance) / (weight + 1)
+			}
+			m.SectorLaps++
+		}
+	})
+}
+
+// completePit adds the drive through the pit lane to the map of the track, once it has an outline. The lock must be held
+func (b *Builder) completePit(track int8, length uint16, pit *pitRecording) {
+	lane, ok := pit.lane()
+	if !ok {
+		return
+	}
+	if _, ok := b.maps[track]; !ok {
+		return
+	}
+
+	b.update(track, length, func(m *Map) {
+		m.PitLane = average(m.PitLane, lane, m.PitStops)
+		m.PitStops++
+	})
+}
+
+// update changes a copy of the map of the track and stores it. The lock must be held
+func (b *Builder) update(track int8, length uint16, change func(m *Map)) {
+	m := Map{TrackId: track}
+	if current, ok := b.maps[track]; ok {
+		m = *current
+	}
+	// The track was changed by an update of the game, the old outline does not fit anymore
+	if m.TrackLength != length {
+		m = Map{TrackId: track, TrackLength: length}
+	}
+	change(&m)
+	m.Updated = time.Now()
+
+	if err := b.storage.Set(storageId(track), m); err != nil {
+		log.Error("could not store track map", "track", track, "error", err)
+	}
+	b.maps[track] = &m
+	log.Debug("track map updated", "track", track, "laps", m.Laps, "pit_stops", m.PitStops)
+
+	b.OnMapUpdated.Call(m)
+}
+
+// trackOf returns the track of the session on the chair and its length, false if it is not known yet
+func (b *Builder) trackOf(chairId string, header f1_2023.PacketHeader) (int8, uint16, bool) {
+	if b.state == nil {
+		return 0, 0, false
+	}
+	snapshot, ok := b.state.Get(chairId)
+	if !ok || snapshot.SessionUID != header.SessionUID || snapshot.Session == nil || snapshot.Session.TrackLength == 0 {
+		return 0, 0, false
+	}
+
+	return snapshot.Session.TrackId, snapshot.Session.TrackLength, true
+}
+
+func storageId(track int8) string {
+	return strconv.Itoa(int(track))
+}
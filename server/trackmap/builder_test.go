@@ -0,0 +1,75 @@
+package trackmap_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/DaanV2/f1-game-dashboards/server/game"
+	"github.com/DaanV2/f1-game-dashboards/server/pkg/data"
+	"github.com/DaanV2/f1-game-dashboards/server/sessions"
+	"github.com/DaanV2/f1-game-dashboards/server/simulator"
+	"github.com/DaanV2/f1-game-dashboards/server/state"
+	"github.com/DaanV2/f1-game-dashboards/server/trackmap"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Builder_DrawsTrackFromCleanLaps(t *testing.T) {
+	processor := game.NewPacketProcessor()
+	sessionState := state.NewStore()
+	sessionState.Subscribe(processor.Pipeline())
+	storage := data.NewMemoryStorage().TrackMaps()
+	builder := trackmap.NewBuilder(storage, sessionState)
+	builder.Subscribe(processor.Pipeline())
+
+	const length = 500
+	chair := sessions.NewChair("test", 20777, true)
+	sim := simulator.NewSimulator(simulator.Options{Cars: 2, Laps: 3, TrackLength: length, Seed: 1})
+	inject := func() {
+		for _, packet := range sim.Step(time.Second / 20) {
+			require.NoError(t, processor.Inject(chair, packet))
+		}
+		// Gives the hooks time to handle the frame, they are called concurrently
+		time.Sleep(time.Millisecond)
+	}
+
+	inject()
+	first := sim.SessionUID()
+	for sim.SessionUID() == first {
+		inject()
+	}
+
+	m, ok := builder.Map(0)
+	require.True(t, ok)
+	require.GreaterOrEqual(t, m.Laps, 1)
+	require.Equal(t, uint16(length), m.TrackLength)
+	require.Len(t, m.Outline, trackmap.OutlinePoints)
+	require.Empty(t, m.PitLane)
+
+	// The simulator drives a circle around the origin, starting on the x axis
+	radius := length / (2 * math.Pi)
+	for _, p := range m.Outline {
+		require.InDelta(t, radius, math.Hypot(float64(p.X), float64(p.Y)), 1)
+	}
+	require.InDelta(t, radius, m.Outline[0].X, 1)
+	require.InDelta(t, length/3.0, m.Sectors[0], 10)
+	require.InDelta(t, 2*length/3.0, m.Sectors[1], 10)
+
+	normalized := m.Normalize()
+	require.Len(t, normalized.Sectors, 2)
+	for _, p := range normalized.Outline {
+		require.True(t, p.X >= 0 && p.X <= 1 && p.Y >= 0 && p.Y <= 1)
+	}
+	center := normalized.Transform.Apply(trackmap.Point{})
+	require.InDelta(t, 0.5, center.X, 0.01)
+	require.InDelta(t, 0.5, center.Y, 0.01)
+
+	// The map is stored, a new builder loads it
+	loaded := trackmap.NewBuilder(storage, sessionState).Maps()
+	require.Len(t, loaded, 1)
+	require.True(t, m.Updated.Equal(loaded[0].Updated))
+	loaded[0].Updated = m.Updated
+	require.Equal(t, m, loaded[0])
+	require.NoError(t, builder.Delete(0))
+	require.ErrorIs(t, builder.Delete(0), trackmap.ErrMapNotFound)
+}
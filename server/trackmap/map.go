@@ -0,0 +1,147 @@
+package trackmap
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	// OutlinePoints is the amount of points of an outline, spread evenly over the lap distance
+	OutlinePoints = 512
+	// PitLanePoints is the amount of points of a pit lane, spread evenly over its length
+	PitLanePoints = 128
+	// maxWeight caps the weight of the laps that are already averaged, so a map keeps improving with new laps
+	maxWeight = 20
+)
+
+type (
+	// Point is a position seen from above in metres, X is the world x axis and Y the world z axis
+	Point struct {
+		X float32 `json:"x"`
+		Y float32 `json:"y"`
+	}
+
+	// Map is the outline of a track, averaged over the clean laps driven on it
+	Map struct {
+		TrackId     int8       `json:"track_id"`
+		TrackLength uint16     `json:"track_length"` // In metres
+		Laps        int        `json:"laps"`         // The amount of clean laps the outline is made of
+		Outline     []Point    `json:"outline"`      // OutlinePoints positions, the first on the line
+		Sectors     [2]float32 `json:"sectors"`      // The lap distance sector 2 and 3 start at, 0 if unknown
+		SectorLaps  int        `json:"sector_laps"`
+		PitLane     []Point    `json:"pit_lane,omitempty"` // PitLanePoints positions from the pit entry to the exit, empty if no pit stop was seen
+		PitStops    int        `json:"pit_stops"`
+		Updated     time.Time  `json:"updated"`
+	}
+
+	// Transform converts world positions to the normalized positions of a map
+	Transform struct {
+		Origin Point   // The world position that becomes 0,0
+		Scale  float32 // Multiplies the world distance from the origin
+	}
+
+	// Normalized is a map that fits in a square of 0 to 1, keeping its aspect ratio
+	Normalized struct {
+		Transform Transform
+		Outline   []Point
+		PitLane   []Point
+		Sectors   []Point // The positions sector 2 and 3 start at, if known
+	}
+)
+
+// Normalize fits the map in a square of 0 to 1. Live positions can be placed on it with the transform
+func (m Map) Normalize() Normalized {
+	minX, minY := float32(math.MaxFloat32), float32(math.MaxFloat32)
+	maxX, maxY := float32(-math.MaxFloat32), float32(-math.MaxFloat32)
+	for _, points := range [][]Point{m.Outline, m.PitLane} {
+		for _, p := range points {
+			minX, minY = min(minX, p.X), min(minY, p.Y)
+			maxX, maxY = max(maxX, p.X), max(maxY, p.Y)
+		}
+	}
+
+	result := Normalized{Transform: Transform{Scale: 1}}
+	if len(m.Outline) == 0 {
+		return result
+	}
+	size := max(maxX-minX, maxY-minY)
+	if size > 0 {
+		result.Transform.Scale = 1 / size
+	}
+	// Centered in the square
+	result.Transform.Origin = Point{
+		X: minX - (size-(maxX-minX))/2,
+		Y: minY - (size-(maxY-minY))/2,
+	}
+
+	result.Outline = result.Transform.applyAll(m.Outline)
+	result.PitLane = result.Transform.applyAll(m.PitLane)
+	result.Sectors = make([]Point, 0, len(m.Sectors))
+	for _, distance := range m.Sectors {
+		if distance > 0 {
+			result.Sectors = append(result.Sectors, result.Transform.Apply(m.At(distance)))
+		}
+	}
+
+	return result
+}
+
+// At returns the position on the outline at the lap distance
+func (m Map) At(distance float32) Point {
+	if len(m.Outline) == 0 || m.TrackLength == 0 {
+		return Point{}
+	}
+
+	position := float64(distance) / float64(m.TrackLength) * float64(len(m.Outline))
+	position = math.Mod(position, float64(len(m.Outline)))
+	if position < 0 {
+		position += float64(len(m.Outline))
+	}
+	index := int(position)
+	next := (index + 1) % len(m.Outline)
+
+	return lerp(m.Outline[index], m.Outline[next], float32(position-float64(index)))
+}
+
+// Apply converts the world position to the normalized position
+func (t Transform) Apply(p Point) Point {
+	return Point{
+		X: (p.X - t.Origin.X) * t.Scale,
+		Y: (p.Y - t.Origin.Y) * t.Scale,
+	}
+}
+
+func (t Transform) applyAll(points []Point) []Point {
+	result := make([]Point, len(points))
+	for i, p := range points {
+		result[i] = t.Apply(p)
+	}
+
+	return result
+}
+
+// average adds the points to the averaged points, which are made of the given amount of recordings
+func average(averaged, points []Point, count int) []Point {
+	if count == 0 || len(averaged) != len(points) {
+		return points
+	}
+
+	weight := float32(min(count, maxWeight))
+	result := make([]Point, len(points))
+	for i := range points {
+		result[i] = Point{
+			X: (averaged[i].X*weight + points[i].X) / (weight + 1),
+			Y: (averaged[i].Y*weight + points[i].Y) / (weight + 1),
+		}
+	}
+
+	return result
+}
+
+func lerp(a, b Point, f float32) Point {
+	return Point{X: a.X + (b.X-a.X)*f, Y: a.Y + (b.Y-a.Y)*f}
+}
+
+func distance(a, b Point) float32 {
+	return float32(math.Hypot(float64(b.X-a.X), float64(b.Y-a.Y)))
+}
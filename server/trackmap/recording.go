@@ -0,0 +1,129 @@
+package trackmap
+
+import (
+	"sort"
+)
+
+const (
+	// edgeDistance is how far from the line a lap recording may start and end, to be used for the outline
+	edgeDistance = 50
+	// minPitStep is the distance a car must move in the pit lane before a new point is recorded
+	minPitStep = 1
+)
+
+type (
+	// lapRecording holds the positions of the lap that is being driven
+	lapRecording struct {
+		lap       uint8
+		clean     bool // Valid, not in the pits and not driven again after a flashback
+		distances []float32
+		positions []Point
+		sector    uint8
+		sectors   [2]float32
+	}
+
+	// pitRecording holds the positions of a drive through the pit lane
+	pitRecording struct {
+		active    bool
+		positions []Point
+	}
+)
+
+func newLapRecording(lap uint8) lapRecording {
+	return lapRecording{lap: lap, clean: true}
+}
+
+// add adds the position at the distance into the lap, a position that is not further into the lap makes the lap unclean
+func (r *lapRecording) add(distance float32, position Point) {
+	if distance < 0 {
+		return
+	}
+	if last := len(r.distances) - 1; last >= 0 && distance <= r.distances[last] {
+		if distance < r.distances[last]-edgeDistance {
+			r.clean = false
+		}
+		return
+	}
+
+	r.distances = append(r.distances, distance)
+	r.positions = append(r.positions, position)
+}
+
+// setSector records the distance a sector started at
+func (r *lapRecording) setSector(sector uint8, distance float32) {
+	if sector == r.sector {
+		return
+	}
+	if sector == r.sector+1 && sector <= 2 {
+		r.sectors[sector-1] = distance
+	}
+	r.sector = sector
+}
+
+// outline resamples the positions evenly over the track, false if the recording does not cover the whole lap
+func (r *lapRecording) outline(trackLength uint16) ([]Point, bool) {
+	length := float32(trackLength)
+	if !r.clean || length <= 0 || len(r.distances) < 2 || r.distances[0] > edgeDistance || r.distances[len(r.distances)-1] < length-edgeDistance {
+		return nil, false
+	}
+
+	points := make([]Point, OutlinePoints)
+	for i := range points {
+		d := float32(i) * length / OutlinePoints
+		next := sort.Search(len(r.distances), func(j int) bool { return r.distances[j] >= d })
+		switch {
+		case next == 0:
+			points[i] = r.positions[0]
+		case next == len(r.distances):
+			// Closes the gap between the end of the recording and the line
+			last := len(r.distances) - 1
+			f := (d - r.distances[last]) / (length - r.distances[last] + r.distances[0])
+			points[i] = lerp(r.positions[last], r.positions[0], f)
+		default:
+			prev := next - 1
+			f := (d - r.distances[prev]) / (r.distances[next] - r.distances[prev])
+			points[i] = lerp(r.positions[prev], r.positions[next], f)
+		}
+	}
+
+	return points, true
+}
+
+// add adds a position in the pit lane
+func (r *pitRecording) add(position Point) {
+	r.active = true
+	if last := len(r.positions) - 1; last >= 0 && distance(r.positions[last], position) < minPitStep {
+		return
+	}
+	r.positions = append(r.positions, position)
+}
+
+// lane resamples the positions evenly over the length of the pit lane, false if too little was recorded
+func (r *pitRecording) lane() ([]Point, bool) {
+	if len(r.positions) < 2 {
+		return nil, false
+	}
+
+	lengths := make([]float32, len(r.positions))
+	for i := 1; i < len(r.positions); i++ {
+		lengths[i] = lengths[i-1] + distance(r.positions[i-1], r.positions[i])
+	}
+	total := lengths[len(lengths)-1]
+	if total <= 0 {
+		return nil, false
+	}
+
+	points := make([]Point, PitLanePoints)
+	for i := range points {
+		d := float32(i) * total / (PitLanePoints - 1)
+		next := min(max(sort.Search(len(lengths), func(j int) bool { return lengths[j] >= d }), 1), len(lengths)-1)
+		prev := next - 1
+		f := float32(0)
+		if lengths[next] > lengths[prev] {
+			f = min((d-lengths[prev])/(lengths[next]-lengths[prev]), 1)
+		}
+		points[i] = lerp(r.positions[prev], r.positions[next], f)
+	}
+
+	return points, true
+}
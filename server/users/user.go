@@ -3,6 +3,8 @@ package users
 import (
 	"context"
 	"errors"
+	"slices"
+	"strings"
 
 	"github.com/charmbracelet/log"
 	"github.com/google/uuid"
@@ -18,10 +20,19 @@ type User struct {
 }
 
 type UserStorage interface {
+	Get(id string) (*User, error)
 	GetByEmail(email string) (*User, error)
 	Set(value *User) error
+	Delete(id string) error
+	Keys() []string
 }
 
+var (
+	ErrUserExists       = errors.New("user already exists")
+	ErrPasswordRequired = errors.New("password is required")
+	ErrEmailRequired    = errors.New("email is required")
+)
+
 type UserManagement struct {
 	db UserStorage
 }
@@ -55,7 +66,10 @@ func (um *UserManagement) Authenticate(ctx context.Context, email, password stri
 // UpdatePassword updates the password of the user
 func (um *UserManagement) UpdatePassword(ctx context.Context, email, password string) error {
 	logger := log.FromContext(ctx).With("email", email)
-	logger.Debug("user authenticated")
+	logger.Info("updating password")
+	if password == "" {
+		return ErrPasswordRequired
+	}
 
 	user, err := um.db.GetByEmail(email)
 	if err != nil {
@@ -71,9 +85,15 @@ func (um *UserManagement) UpdatePassword(ctx context.Context, email, password st
 }
 
 // Create creates a new user
-func (um *UserManagement) Create(email, password string, admin bool) error {
-	if _, err := um.db.GetByEmail(email); err != nil {
-		return errors.New("user already exists")
+func (um *UserManagement) Create(email, password string, admin bool) (*User, error) {
+	if email == "" {
+		return nil, ErrEmailRequired
+	}
+	if password == "" {
+		return nil, ErrPasswordRequired
+	}
+	if _, err := um.db.GetByEmail(email); err == nil {
+		return nil, ErrUserExists
 	}
 
 	user := &User{
@@ -83,10 +103,15 @@ func (um *UserManagement) Create(email, password string, admin bool) error {
 		Admin:    admin,
 	}
 	if err := hashPassword(user); err != nil {
-		return err
+		return nil, err
 	}
 
-	return um.db.Set(user)
+	return user, um.db.Set(user)
+}
+
+// Get returns a user by id
+func (um *UserManagement) Get(id string) (*User, error) {
+	return um.db.Get(id)
 }
 
 // GetByEmail returns a user by email
@@ -94,6 +119,46 @@ func (um *UserManagement) GetByEmail(email string) (*User, error) {
 	return um.db.GetByEmail(email)
 }
 
+// List returns all the users
+func (um *UserManagement) List() ([]*User, error) {
+	keys := um.db.Keys()
+	result := make([]*User, 0, len(keys))
+
+	for _, id := range keys {
+		user, err := um.db.Get(id)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, user)
+	}
+	slices.SortFunc(result, func(a, b *User) int {
+		return strings.Compare(a.Email, b.Email)
+	})
+
+	return result, nil
+}
+
+// SetAdmin updates the admin role of the user
+func (um *UserManagement) SetAdmin(ctx context.Context, id string, admin bool) (*User, error) {
+	logger := log.FromContext(ctx).With("id", id, "admin", admin)
+	logger.Info("updating user roles")
+
+	user, err := um.db.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	user.Admin = admin
+	return user, um.db.Set(user)
+}
+
+// Delete removes the user
+func (um *UserManagement) Delete(ctx context.Context, id string) error {
+	log.FromContext(ctx).Info("deleting user", "id", id)
+
+	return um.db.Delete(id)
+}
+
 func hashPassword(user *User) error {
 	data, err := bcrypt.GenerateFromPassword([]byte(user.Password), bcrypt.DefaultCost)
 	if err != nil {
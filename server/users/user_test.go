@@ -0,0 +1,39 @@
+package users_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DaanV2/f1-game-dashboards/server/pkg/data"
+	"github.com/DaanV2/f1-game-dashboards/server/users"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_UserManagement(t *testing.T) {
+	ctx := context.Background()
+	um := users.NewUserManagement(data.NewMemoryStorage().Users())
+
+	admin, err := um.Create("admin@example.com", "secret", true)
+	require.NoError(t, err)
+	require.NotEqual(t, "secret", admin.Password, "password should be hashed")
+
+	_, err = um.Create("admin@example.com", "other", false)
+	require.ErrorIs(t, err, users.ErrUserExists)
+	_, err = um.Create("bob@example.com", "", false)
+	require.ErrorIs(t, err, users.ErrPasswordRequired)
+
+	_, err = um.Authenticate(ctx, "admin@example.com", "secret")
+	require.NoError(t, err)
+	require.NoError(t, um.UpdatePassword(ctx, "admin@example.com", "new"))
+	_, err = um.Authenticate(ctx, "admin@example.com", "secret")
+	require.Error(t, err)
+
+	user, err := um.SetAdmin(ctx, admin.Id, false)
+	require.NoError(t, err)
+	require.False(t, user.Admin)
+
+	require.NoError(t, um.Delete(ctx, admin.Id))
+	list, err := um.List()
+	require.NoError(t, err)
+	require.Empty(t, list)
+}